@@ -25,8 +25,14 @@ type Basket struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Items []*Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Id         string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items      []*Item             `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Coupons    []string            `protobuf:"bytes,3,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	Promotions []*AppliedPromotion `protobuf:"bytes,4,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	Subtotal   float64             `protobuf:"fixed64,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount   float64             `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	Total      float64             `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	Warnings   []*Warning          `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
 func (x *Basket) Reset() {
@@ -75,17 +81,63 @@ func (x *Basket) GetItems() []*Item {
 	return nil
 }
 
+func (x *Basket) GetCoupons() []string {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *Basket) GetPromotions() []*AppliedPromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *Basket) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Basket) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Basket) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Basket) GetWarnings() []*Warning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StoreId      string  `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	ProductId    string  `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	StoreName    string  `protobuf:"bytes,3,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
-	ProductName  string  `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductPrice float64 `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity     int32   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	StoreId      string            `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId    string            `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreName    string            `protobuf:"bytes,3,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	ProductName  string            `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductPrice float64           `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	Quantity     int32             `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Discount     float64           `protobuf:"fixed64,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	VariantId    string            `protobuf:"bytes,8,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku          string            `protobuf:"bytes,9,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes   map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Item) Reset() {
@@ -162,16 +214,47 @@ func (x *Item) GetQuantity() int32 {
 	return 0
 }
 
-type StartBasketRequest struct {
+func (x *Item) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Item) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Item) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Warning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerId string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	VariantId string `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 }
 
-func (x *StartBasketRequest) Reset() {
-	*x = StartBasketRequest{}
+func (x *Warning) Reset() {
+	*x = Warning{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,13 +262,13 @@ func (x *StartBasketRequest) Reset() {
 	}
 }
 
-func (x *StartBasketRequest) String() string {
+func (x *Warning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartBasketRequest) ProtoMessage() {}
+func (*Warning) ProtoMessage() {}
 
-func (x *StartBasketRequest) ProtoReflect() protoreflect.Message {
+func (x *Warning) ProtoReflect() protoreflect.Message {
 	mi := &file_basketspb_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,28 +280,52 @@ func (x *StartBasketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartBasketRequest.ProtoReflect.Descriptor instead.
-func (*StartBasketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Warning.ProtoReflect.Descriptor instead.
+func (*Warning) Descriptor() ([]byte, []int) {
 	return file_basketspb_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StartBasketRequest) GetCustomerId() string {
+func (x *Warning) GetProductId() string {
 	if x != nil {
-		return x.CustomerId
+		return x.ProductId
 	}
 	return ""
 }
 
-type StartBasketResponse struct {
+func (x *Warning) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Warning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Warning) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type AppliedPromotion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromotionId string  `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code        string  `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Discount    float64 `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
 }
 
-func (x *StartBasketResponse) Reset() {
-	*x = StartBasketResponse{}
+func (x *AppliedPromotion) Reset() {
+	*x = AppliedPromotion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,13 +333,13 @@ func (x *StartBasketResponse) Reset() {
 	}
 }
 
-func (x *StartBasketResponse) String() string {
+func (x *AppliedPromotion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartBasketResponse) ProtoMessage() {}
+func (*AppliedPromotion) ProtoMessage() {}
 
-func (x *StartBasketResponse) ProtoReflect() protoreflect.Message {
+func (x *AppliedPromotion) ProtoReflect() protoreflect.Message {
 	mi := &file_basketspb_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,28 +351,59 @@ func (x *StartBasketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartBasketResponse.ProtoReflect.Descriptor instead.
-func (*StartBasketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppliedPromotion.ProtoReflect.Descriptor instead.
+func (*AppliedPromotion) Descriptor() ([]byte, []int) {
 	return file_basketspb_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *StartBasketResponse) GetId() string {
+func (x *AppliedPromotion) GetPromotionId() string {
 	if x != nil {
-		return x.Id
+		return x.PromotionId
 	}
 	return ""
 }
 
-type CancelBasketRequest struct {
+func (x *AppliedPromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+type Promotion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *CancelBasketRequest) Reset() {
-	*x = CancelBasketRequest{}
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StoreId      string  `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId    string  `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Code         string  `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Kind         string  `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Percent      float64 `protobuf:"fixed64,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount       float64 `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	BuyQuantity  int32   `protobuf:"varint,9,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity  int32   `protobuf:"varint,10,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	MinimumSpend float64 `protobuf:"fixed64,11,opt,name=minimum_spend,json=minimumSpend,proto3" json:"minimum_spend,omitempty"`
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +411,13 @@ func (x *CancelBasketRequest) Reset() {
 	}
 }
 
-func (x *CancelBasketRequest) String() string {
+func (x *Promotion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBasketRequest) ProtoMessage() {}
+func (*Promotion) ProtoMessage() {}
 
-func (x *CancelBasketRequest) ProtoReflect() protoreflect.Message {
+func (x *Promotion) ProtoReflect() protoreflect.Message {
 	mi := &file_basketspb_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,26 +429,98 @@ func (x *CancelBasketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBasketRequest.ProtoReflect.Descriptor instead.
-func (*CancelBasketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
 	return file_basketspb_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CancelBasketRequest) GetId() string {
+func (x *Promotion) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CancelBasketResponse struct {
+func (x *Promotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Promotion) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Promotion) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Promotion) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Promotion) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Promotion) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Promotion) GetMinimumSpend() float64 {
+	if x != nil {
+		return x.MinimumSpend
+	}
+	return 0
+}
+
+type StartBasketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CustomerId string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 }
 
-func (x *CancelBasketResponse) Reset() {
-	*x = CancelBasketResponse{}
+func (x *StartBasketRequest) Reset() {
+	*x = StartBasketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -318,13 +528,13 @@ func (x *CancelBasketResponse) Reset() {
 	}
 }
 
-func (x *CancelBasketResponse) String() string {
+func (x *StartBasketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBasketResponse) ProtoMessage() {}
+func (*StartBasketRequest) ProtoMessage() {}
 
-func (x *CancelBasketResponse) ProtoReflect() protoreflect.Message {
+func (x *StartBasketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basketspb_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,22 +546,28 @@ func (x *CancelBasketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBasketResponse.ProtoReflect.Descriptor instead.
-func (*CancelBasketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartBasketRequest.ProtoReflect.Descriptor instead.
+func (*StartBasketRequest) Descriptor() ([]byte, []int) {
 	return file_basketspb_api_proto_rawDescGZIP(), []int{5}
 }
 
-type CheckoutBasketRequest struct {
+func (x *StartBasketRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type StartBasketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PaymentId string `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CheckoutBasketRequest) Reset() {
-	*x = CheckoutBasketRequest{}
+func (x *StartBasketResponse) Reset() {
+	*x = StartBasketResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -359,13 +575,13 @@ func (x *CheckoutBasketRequest) Reset() {
 	}
 }
 
-func (x *CheckoutBasketRequest) String() string {
+func (x *StartBasketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckoutBasketRequest) ProtoMessage() {}
+func (*StartBasketResponse) ProtoMessage() {}
 
-func (x *CheckoutBasketRequest) ProtoReflect() protoreflect.Message {
+func (x *StartBasketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basketspb_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -377,33 +593,28 @@ func (x *CheckoutBasketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckoutBasketRequest.ProtoReflect.Descriptor instead.
-func (*CheckoutBasketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartBasketResponse.ProtoReflect.Descriptor instead.
+func (*StartBasketResponse) Descriptor() ([]byte, []int) {
 	return file_basketspb_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CheckoutBasketRequest) GetId() string {
+func (x *StartBasketResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CheckoutBasketRequest) GetPaymentId() string {
-	if x != nil {
-		return x.PaymentId
-	}
-	return ""
-}
-
-type CheckoutBasketResponse struct {
+type CancelBasketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CheckoutBasketResponse) Reset() {
-	*x = CheckoutBasketResponse{}
+func (x *CancelBasketRequest) Reset() {
+	*x = CancelBasketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basketspb_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,14 +622,557 @@ func (x *CheckoutBasketResponse) Reset() {
 	}
 }
 
-func (x *CheckoutBasketResponse) String() string {
+func (x *CancelBasketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckoutBasketResponse) ProtoMessage() {}
+func (*CancelBasketRequest) ProtoMessage() {}
+
+func (x *CancelBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBasketRequest.ProtoReflect.Descriptor instead.
+func (*CancelBasketRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelBasketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelBasketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelBasketResponse) Reset() {
+	*x = CancelBasketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBasketResponse) ProtoMessage() {}
+
+func (x *CancelBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBasketResponse.ProtoReflect.Descriptor instead.
+func (*CancelBasketResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{8}
+}
+
+type CheckoutBasketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentId string `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+}
+
+func (x *CheckoutBasketRequest) Reset() {
+	*x = CheckoutBasketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutBasketRequest) ProtoMessage() {}
+
+func (x *CheckoutBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutBasketRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutBasketRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckoutBasketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutBasketRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type CheckoutBasketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckoutBasketResponse) Reset() {
+	*x = CheckoutBasketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutBasketResponse) ProtoMessage() {}
+
+func (x *CheckoutBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutBasketResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutBasketResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{10}
+}
+
+type AddItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+}
+
+func (x *AddItemRequest) Reset() {
+	*x = AddItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemRequest) ProtoMessage() {}
+
+func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
+func (*AddItemRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type AddItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddItemResponse) Reset() {
+	*x = AddItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemResponse) ProtoMessage() {}
+
+func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemResponse.ProtoReflect.Descriptor instead.
+func (*AddItemResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{12}
+}
+
+type RemoveItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+}
+
+func (x *RemoveItemRequest) Reset() {
+	*x = RemoveItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemRequest) ProtoMessage() {}
+
+func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RemoveItemRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type RemoveItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveItemResponse) Reset() {
+	*x = RemoveItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemResponse) ProtoMessage() {}
+
+func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{14}
+}
+
+type GetBasketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetBasketRequest) Reset() {
+	*x = GetBasketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBasketRequest) ProtoMessage() {}
+
+func (x *GetBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBasketRequest.ProtoReflect.Descriptor instead.
+func (*GetBasketRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBasketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBasketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Basket *Basket `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"`
+}
+
+func (x *GetBasketResponse) Reset() {
+	*x = GetBasketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBasketResponse) ProtoMessage() {}
+
+func (x *GetBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBasketResponse.ProtoReflect.Descriptor instead.
+func (*GetBasketResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBasketResponse) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+type ApplyCouponRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *ApplyCouponRequest) Reset() {
+	*x = ApplyCouponRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponRequest) ProtoMessage() {}
+
+func (x *ApplyCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCouponRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCouponRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApplyCouponRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApplyCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ApplyCouponResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyCouponResponse) Reset() {
+	*x = ApplyCouponResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basketspb_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCouponResponse) ProtoMessage() {}
 
-func (x *CheckoutBasketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[7]
+func (x *ApplyCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,38 +1183,37 @@ func (x *CheckoutBasketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckoutBasketResponse.ProtoReflect.Descriptor instead.
-func (*CheckoutBasketResponse) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ApplyCouponResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCouponResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{18}
 }
 
-type AddItemRequest struct {
+type RemoveCouponRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity  int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *AddItemRequest) Reset() {
-	*x = AddItemRequest{}
+func (x *RemoveCouponRequest) Reset() {
+	*x = RemoveCouponRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[8]
+		mi := &file_basketspb_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddItemRequest) String() string {
+func (x *RemoveCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemRequest) ProtoMessage() {}
+func (*RemoveCouponRequest) ProtoMessage() {}
 
-func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[8]
+func (x *RemoveCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,55 +1224,48 @@ func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
-func (*AddItemRequest) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RemoveCouponRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCouponRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddItemRequest) GetId() string {
+func (x *RemoveCouponRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AddItemRequest) GetProductId() string {
+func (x *RemoveCouponRequest) GetCode() string {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AddItemRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-type AddItemResponse struct {
+type RemoveCouponResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddItemResponse) Reset() {
-	*x = AddItemResponse{}
+func (x *RemoveCouponResponse) Reset() {
+	*x = RemoveCouponResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[9]
+		mi := &file_basketspb_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddItemResponse) String() string {
+func (x *RemoveCouponResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemResponse) ProtoMessage() {}
+func (*RemoveCouponResponse) ProtoMessage() {}
 
-func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[9]
+func (x *RemoveCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,38 +1276,36 @@ func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemResponse.ProtoReflect.Descriptor instead.
-func (*AddItemResponse) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RemoveCouponResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCouponResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{20}
 }
 
-type RemoveItemRequest struct {
+type AddPromotionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity  int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Promotion *Promotion `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
 }
 
-func (x *RemoveItemRequest) Reset() {
-	*x = RemoveItemRequest{}
+func (x *AddPromotionRequest) Reset() {
+	*x = AddPromotionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[10]
+		mi := &file_basketspb_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveItemRequest) String() string {
+func (x *AddPromotionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveItemRequest) ProtoMessage() {}
+func (*AddPromotionRequest) ProtoMessage() {}
 
-func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[10]
+func (x *AddPromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,55 +1316,43 @@ func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
-func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *RemoveItemRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RemoveItemRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+// Deprecated: Use AddPromotionRequest.ProtoReflect.Descriptor instead.
+func (*AddPromotionRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *RemoveItemRequest) GetQuantity() int32 {
+func (x *AddPromotionRequest) GetPromotion() *Promotion {
 	if x != nil {
-		return x.Quantity
+		return x.Promotion
 	}
-	return 0
+	return nil
 }
 
-type RemoveItemResponse struct {
+type AddPromotionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *RemoveItemResponse) Reset() {
-	*x = RemoveItemResponse{}
+func (x *AddPromotionResponse) Reset() {
+	*x = AddPromotionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[11]
+		mi := &file_basketspb_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveItemResponse) String() string {
+func (x *AddPromotionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveItemResponse) ProtoMessage() {}
+func (*AddPromotionResponse) ProtoMessage() {}
 
-func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[11]
+func (x *AddPromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,12 +1363,19 @@ func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveItemResponse.ProtoReflect.Descriptor instead.
-func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AddPromotionResponse.ProtoReflect.Descriptor instead.
+func (*AddPromotionResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{22}
 }
 
-type GetBasketRequest struct {
+func (x *AddPromotionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemovePromotionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -644,23 +1383,23 @@ type GetBasketRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetBasketRequest) Reset() {
-	*x = GetBasketRequest{}
+func (x *RemovePromotionRequest) Reset() {
+	*x = RemovePromotionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[12]
+		mi := &file_basketspb_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBasketRequest) String() string {
+func (x *RemovePromotionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBasketRequest) ProtoMessage() {}
+func (*RemovePromotionRequest) ProtoMessage() {}
 
-func (x *GetBasketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[12]
+func (x *RemovePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,43 +1410,41 @@ func (x *GetBasketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBasketRequest.ProtoReflect.Descriptor instead.
-func (*GetBasketRequest) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RemovePromotionRequest.ProtoReflect.Descriptor instead.
+func (*RemovePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetBasketRequest) GetId() string {
+func (x *RemovePromotionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetBasketResponse struct {
+type RemovePromotionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Basket *Basket `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"`
 }
 
-func (x *GetBasketResponse) Reset() {
-	*x = GetBasketResponse{}
+func (x *RemovePromotionResponse) Reset() {
+	*x = RemovePromotionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basketspb_api_proto_msgTypes[13]
+		mi := &file_basketspb_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBasketResponse) String() string {
+func (x *RemovePromotionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBasketResponse) ProtoMessage() {}
+func (*RemovePromotionResponse) ProtoMessage() {}
 
-func (x *GetBasketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basketspb_api_proto_msgTypes[13]
+func (x *RemovePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basketspb_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,16 +1455,9 @@ func (x *GetBasketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBasketResponse.ProtoReflect.Descriptor instead.
-func (*GetBasketResponse) Descriptor() ([]byte, []int) {
-	return file_basketspb_api_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GetBasketResponse) GetBasket() *Basket {
-	if x != nil {
-		return x.Basket
-	}
-	return nil
+// Deprecated: Use RemovePromotionResponse.ProtoReflect.Descriptor instead.
+func (*RemovePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_basketspb_api_proto_rawDescGZIP(), []int{24}
 }
 
 var File_basketspb_api_proto protoreflect.FileDescriptor
@@ -735,60 +1465,146 @@ var File_basketspb_api_proto protoreflect.FileDescriptor
 var file_basketspb_api_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62,
-	0x22, 0x3f, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73, 0x6b,
-	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0xc3, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x35, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x25,
-	0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x94, 0x02, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x90, 0x03, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x75, 0x0a, 0x07, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x79, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x02, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x65, 0x74,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x35, 0x0a,
+	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70,
-	0x62, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
-	0x32, 0xe6, 0x03, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x15,
+	0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x16, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xb8, 0x06, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65,
 	0x74, 0x12, 0x1d, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74,
 	0x61, 0x72, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
@@ -818,16 +1634,37 @@ var file_basketspb_api_proto_rawDesc = []byte{
 	0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b,
 	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x61, 0x73, 0x6b,
 	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x88, 0x01, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6d, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x42, 0x08, 0x41, 0x70, 0x69,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x29, 0x65, 0x64, 0x61, 0x2d, 0x69, 0x6e, 0x2d,
-	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x62,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73,
-	0x70, 0x62, 0xa2, 0x02, 0x03, 0x42, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x42, 0x61, 0x73, 0x6b, 0x65,
-	0x74, 0x73, 0x70, 0x62, 0xca, 0x02, 0x09, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62,
-	0xe2, 0x02, 0x15, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x42, 0x61, 0x73, 0x6b, 0x65,
-	0x74, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x73, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5a, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x88, 0x01, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x42, 0x08, 0x41,
+	0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x29, 0x65, 0x64, 0x61, 0x2d, 0x69,
+	0x6e, 0x2d, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x73, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x42, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0xca, 0x02, 0x09, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73,
+	0x70, 0x62, 0xe2, 0x02, 0x15, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -842,43 +1679,67 @@ func file_basketspb_api_proto_rawDescGZIP() []byte {
 	return file_basketspb_api_proto_rawDescData
 }
 
-var file_basketspb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_basketspb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_basketspb_api_proto_goTypes = []interface{}{
-	(*Basket)(nil),                 // 0: basketspb.Basket
-	(*Item)(nil),                   // 1: basketspb.Item
-	(*StartBasketRequest)(nil),     // 2: basketspb.StartBasketRequest
-	(*StartBasketResponse)(nil),    // 3: basketspb.StartBasketResponse
-	(*CancelBasketRequest)(nil),    // 4: basketspb.CancelBasketRequest
-	(*CancelBasketResponse)(nil),   // 5: basketspb.CancelBasketResponse
-	(*CheckoutBasketRequest)(nil),  // 6: basketspb.CheckoutBasketRequest
-	(*CheckoutBasketResponse)(nil), // 7: basketspb.CheckoutBasketResponse
-	(*AddItemRequest)(nil),         // 8: basketspb.AddItemRequest
-	(*AddItemResponse)(nil),        // 9: basketspb.AddItemResponse
-	(*RemoveItemRequest)(nil),      // 10: basketspb.RemoveItemRequest
-	(*RemoveItemResponse)(nil),     // 11: basketspb.RemoveItemResponse
-	(*GetBasketRequest)(nil),       // 12: basketspb.GetBasketRequest
-	(*GetBasketResponse)(nil),      // 13: basketspb.GetBasketResponse
+	(*Basket)(nil),                  // 0: basketspb.Basket
+	(*Item)(nil),                    // 1: basketspb.Item
+	(*Warning)(nil),                 // 2: basketspb.Warning
+	(*AppliedPromotion)(nil),        // 3: basketspb.AppliedPromotion
+	(*Promotion)(nil),               // 4: basketspb.Promotion
+	(*StartBasketRequest)(nil),      // 5: basketspb.StartBasketRequest
+	(*StartBasketResponse)(nil),     // 6: basketspb.StartBasketResponse
+	(*CancelBasketRequest)(nil),     // 7: basketspb.CancelBasketRequest
+	(*CancelBasketResponse)(nil),    // 8: basketspb.CancelBasketResponse
+	(*CheckoutBasketRequest)(nil),   // 9: basketspb.CheckoutBasketRequest
+	(*CheckoutBasketResponse)(nil),  // 10: basketspb.CheckoutBasketResponse
+	(*AddItemRequest)(nil),          // 11: basketspb.AddItemRequest
+	(*AddItemResponse)(nil),         // 12: basketspb.AddItemResponse
+	(*RemoveItemRequest)(nil),       // 13: basketspb.RemoveItemRequest
+	(*RemoveItemResponse)(nil),      // 14: basketspb.RemoveItemResponse
+	(*GetBasketRequest)(nil),        // 15: basketspb.GetBasketRequest
+	(*GetBasketResponse)(nil),       // 16: basketspb.GetBasketResponse
+	(*ApplyCouponRequest)(nil),      // 17: basketspb.ApplyCouponRequest
+	(*ApplyCouponResponse)(nil),     // 18: basketspb.ApplyCouponResponse
+	(*RemoveCouponRequest)(nil),     // 19: basketspb.RemoveCouponRequest
+	(*RemoveCouponResponse)(nil),    // 20: basketspb.RemoveCouponResponse
+	(*AddPromotionRequest)(nil),     // 21: basketspb.AddPromotionRequest
+	(*AddPromotionResponse)(nil),    // 22: basketspb.AddPromotionResponse
+	(*RemovePromotionRequest)(nil),  // 23: basketspb.RemovePromotionRequest
+	(*RemovePromotionResponse)(nil), // 24: basketspb.RemovePromotionResponse
+	nil,                             // 25: basketspb.Item.AttributesEntry
 }
 var file_basketspb_api_proto_depIdxs = []int32{
 	1,  // 0: basketspb.Basket.items:type_name -> basketspb.Item
-	0,  // 1: basketspb.GetBasketResponse.basket:type_name -> basketspb.Basket
-	2,  // 2: basketspb.BasketService.StartBasket:input_type -> basketspb.StartBasketRequest
-	4,  // 3: basketspb.BasketService.CancelBasket:input_type -> basketspb.CancelBasketRequest
-	6,  // 4: basketspb.BasketService.CheckoutBasket:input_type -> basketspb.CheckoutBasketRequest
-	8,  // 5: basketspb.BasketService.AddItem:input_type -> basketspb.AddItemRequest
-	10, // 6: basketspb.BasketService.RemoveItem:input_type -> basketspb.RemoveItemRequest
-	12, // 7: basketspb.BasketService.GetBasket:input_type -> basketspb.GetBasketRequest
-	3,  // 8: basketspb.BasketService.StartBasket:output_type -> basketspb.StartBasketResponse
-	5,  // 9: basketspb.BasketService.CancelBasket:output_type -> basketspb.CancelBasketResponse
-	7,  // 10: basketspb.BasketService.CheckoutBasket:output_type -> basketspb.CheckoutBasketResponse
-	9,  // 11: basketspb.BasketService.AddItem:output_type -> basketspb.AddItemResponse
-	11, // 12: basketspb.BasketService.RemoveItem:output_type -> basketspb.RemoveItemResponse
-	13, // 13: basketspb.BasketService.GetBasket:output_type -> basketspb.GetBasketResponse
-	8,  // [8:14] is the sub-list for method output_type
-	2,  // [2:8] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	3,  // 1: basketspb.Basket.promotions:type_name -> basketspb.AppliedPromotion
+	2,  // 2: basketspb.Basket.warnings:type_name -> basketspb.Warning
+	25, // 3: basketspb.Item.attributes:type_name -> basketspb.Item.AttributesEntry
+	0,  // 4: basketspb.GetBasketResponse.basket:type_name -> basketspb.Basket
+	4,  // 5: basketspb.AddPromotionRequest.promotion:type_name -> basketspb.Promotion
+	5,  // 6: basketspb.BasketService.StartBasket:input_type -> basketspb.StartBasketRequest
+	7,  // 7: basketspb.BasketService.CancelBasket:input_type -> basketspb.CancelBasketRequest
+	9,  // 8: basketspb.BasketService.CheckoutBasket:input_type -> basketspb.CheckoutBasketRequest
+	11, // 9: basketspb.BasketService.AddItem:input_type -> basketspb.AddItemRequest
+	13, // 10: basketspb.BasketService.RemoveItem:input_type -> basketspb.RemoveItemRequest
+	15, // 11: basketspb.BasketService.GetBasket:input_type -> basketspb.GetBasketRequest
+	17, // 12: basketspb.BasketService.ApplyCoupon:input_type -> basketspb.ApplyCouponRequest
+	19, // 13: basketspb.BasketService.RemoveCoupon:input_type -> basketspb.RemoveCouponRequest
+	21, // 14: basketspb.BasketService.AddPromotion:input_type -> basketspb.AddPromotionRequest
+	23, // 15: basketspb.BasketService.RemovePromotion:input_type -> basketspb.RemovePromotionRequest
+	6,  // 16: basketspb.BasketService.StartBasket:output_type -> basketspb.StartBasketResponse
+	8,  // 17: basketspb.BasketService.CancelBasket:output_type -> basketspb.CancelBasketResponse
+	10, // 18: basketspb.BasketService.CheckoutBasket:output_type -> basketspb.CheckoutBasketResponse
+	12, // 19: basketspb.BasketService.AddItem:output_type -> basketspb.AddItemResponse
+	14, // 20: basketspb.BasketService.RemoveItem:output_type -> basketspb.RemoveItemResponse
+	16, // 21: basketspb.BasketService.GetBasket:output_type -> basketspb.GetBasketResponse
+	18, // 22: basketspb.BasketService.ApplyCoupon:output_type -> basketspb.ApplyCouponResponse
+	20, // 23: basketspb.BasketService.RemoveCoupon:output_type -> basketspb.RemoveCouponResponse
+	22, // 24: basketspb.BasketService.AddPromotion:output_type -> basketspb.AddPromotionResponse
+	24, // 25: basketspb.BasketService.RemovePromotion:output_type -> basketspb.RemovePromotionResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_basketspb_api_proto_init() }
@@ -912,7 +1773,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartBasketRequest); i {
+			switch v := v.(*Warning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -924,7 +1785,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartBasketResponse); i {
+			switch v := v.(*AppliedPromotion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -936,7 +1797,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBasketRequest); i {
+			switch v := v.(*Promotion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -948,7 +1809,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBasketResponse); i {
+			switch v := v.(*StartBasketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -960,7 +1821,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckoutBasketRequest); i {
+			switch v := v.(*StartBasketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -972,7 +1833,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckoutBasketResponse); i {
+			switch v := v.(*CancelBasketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +1845,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemRequest); i {
+			switch v := v.(*CancelBasketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -996,7 +1857,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemResponse); i {
+			switch v := v.(*CheckoutBasketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1008,7 +1869,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveItemRequest); i {
+			switch v := v.(*CheckoutBasketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1020,7 +1881,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveItemResponse); i {
+			switch v := v.(*AddItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1032,7 +1893,7 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBasketRequest); i {
+			switch v := v.(*AddItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,6 +1905,42 @@ func file_basketspb_api_proto_init() {
 			}
 		}
 		file_basketspb_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveItemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBasketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBasketResponse); i {
 			case 0:
 				return &v.state
@@ -1055,6 +1952,102 @@ func file_basketspb_api_proto_init() {
 				return nil
 			}
 		}
+		file_basketspb_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCouponRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCouponResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basketspb_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePromotionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1062,7 +2055,7 @@ func file_basketspb_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_basketspb_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
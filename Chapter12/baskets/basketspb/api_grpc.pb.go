@@ -28,6 +28,10 @@ type BasketServiceClient interface {
 	AddItem(ctx context.Context, in *AddItemRequest, opts ...grpc.CallOption) (*AddItemResponse, error)
 	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error)
 	GetBasket(ctx context.Context, in *GetBasketRequest, opts ...grpc.CallOption) (*GetBasketResponse, error)
+	ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*ApplyCouponResponse, error)
+	RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*RemoveCouponResponse, error)
+	AddPromotion(ctx context.Context, in *AddPromotionRequest, opts ...grpc.CallOption) (*AddPromotionResponse, error)
+	RemovePromotion(ctx context.Context, in *RemovePromotionRequest, opts ...grpc.CallOption) (*RemovePromotionResponse, error)
 }
 
 type basketServiceClient struct {
@@ -92,6 +96,42 @@ func (c *basketServiceClient) GetBasket(ctx context.Context, in *GetBasketReques
 	return out, nil
 }
 
+func (c *basketServiceClient) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*ApplyCouponResponse, error) {
+	out := new(ApplyCouponResponse)
+	err := c.cc.Invoke(ctx, "/basketspb.BasketService/ApplyCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*RemoveCouponResponse, error) {
+	out := new(RemoveCouponResponse)
+	err := c.cc.Invoke(ctx, "/basketspb.BasketService/RemoveCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) AddPromotion(ctx context.Context, in *AddPromotionRequest, opts ...grpc.CallOption) (*AddPromotionResponse, error) {
+	out := new(AddPromotionResponse)
+	err := c.cc.Invoke(ctx, "/basketspb.BasketService/AddPromotion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basketServiceClient) RemovePromotion(ctx context.Context, in *RemovePromotionRequest, opts ...grpc.CallOption) (*RemovePromotionResponse, error) {
+	out := new(RemovePromotionResponse)
+	err := c.cc.Invoke(ctx, "/basketspb.BasketService/RemovePromotion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BasketServiceServer is the server API for BasketService service.
 // All implementations must embed UnimplementedBasketServiceServer
 // for forward compatibility
@@ -102,6 +142,10 @@ type BasketServiceServer interface {
 	AddItem(context.Context, *AddItemRequest) (*AddItemResponse, error)
 	RemoveItem(context.Context, *RemoveItemRequest) (*RemoveItemResponse, error)
 	GetBasket(context.Context, *GetBasketRequest) (*GetBasketResponse, error)
+	ApplyCoupon(context.Context, *ApplyCouponRequest) (*ApplyCouponResponse, error)
+	RemoveCoupon(context.Context, *RemoveCouponRequest) (*RemoveCouponResponse, error)
+	AddPromotion(context.Context, *AddPromotionRequest) (*AddPromotionResponse, error)
+	RemovePromotion(context.Context, *RemovePromotionRequest) (*RemovePromotionResponse, error)
 	mustEmbedUnimplementedBasketServiceServer()
 }
 
@@ -127,6 +171,18 @@ func (UnimplementedBasketServiceServer) RemoveItem(context.Context, *RemoveItemR
 func (UnimplementedBasketServiceServer) GetBasket(context.Context, *GetBasketRequest) (*GetBasketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBasket not implemented")
 }
+func (UnimplementedBasketServiceServer) ApplyCoupon(context.Context, *ApplyCouponRequest) (*ApplyCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCoupon not implemented")
+}
+func (UnimplementedBasketServiceServer) RemoveCoupon(context.Context, *RemoveCouponRequest) (*RemoveCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoupon not implemented")
+}
+func (UnimplementedBasketServiceServer) AddPromotion(context.Context, *AddPromotionRequest) (*AddPromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPromotion not implemented")
+}
+func (UnimplementedBasketServiceServer) RemovePromotion(context.Context, *RemovePromotionRequest) (*RemovePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePromotion not implemented")
+}
 func (UnimplementedBasketServiceServer) mustEmbedUnimplementedBasketServiceServer() {}
 
 // UnsafeBasketServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +304,78 @@ func _BasketService_GetBasket_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BasketService_ApplyCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).ApplyCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/basketspb.BasketService/ApplyCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).ApplyCoupon(ctx, req.(*ApplyCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_RemoveCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).RemoveCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/basketspb.BasketService/RemoveCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).RemoveCoupon(ctx, req.(*RemoveCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_AddPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).AddPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/basketspb.BasketService/AddPromotion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).AddPromotion(ctx, req.(*AddPromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BasketService_RemovePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasketServiceServer).RemovePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/basketspb.BasketService/RemovePromotion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasketServiceServer).RemovePromotion(ctx, req.(*RemovePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BasketService_ServiceDesc is the grpc.ServiceDesc for BasketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +407,22 @@ var BasketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBasket",
 			Handler:    _BasketService_GetBasket_Handler,
 		},
+		{
+			MethodName: "ApplyCoupon",
+			Handler:    _BasketService_ApplyCoupon_Handler,
+		},
+		{
+			MethodName: "RemoveCoupon",
+			Handler:    _BasketService_RemoveCoupon_Handler,
+		},
+		{
+			MethodName: "AddPromotion",
+			Handler:    _BasketService_AddPromotion_Handler,
+		},
+		{
+			MethodName: "RemovePromotion",
+			Handler:    _BasketService_RemovePromotion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "basketspb/api.proto",
@@ -11,6 +11,8 @@ const (
 	BasketStartedEvent    = "basketsapi.BasketStarted"
 	BasketCanceledEvent   = "basketsapi.BasketCanceled"
 	BasketCheckedOutEvent = "basketsapi.BasketCheckedOut"
+	BasketAbandonedEvent  = "basketsapi.BasketAbandoned"
+	BasketExpiredEvent    = "basketsapi.BasketExpired"
 )
 
 func Registrations(reg registry.Registry) error {
@@ -26,6 +28,12 @@ func Registrations(reg registry.Registry) error {
 	if err := serde.Register(&BasketCheckedOut{}); err != nil {
 		return err
 	}
+	if err := serde.Register(&BasketAbandoned{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&BasketExpired{}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -33,3 +41,5 @@ func Registrations(reg registry.Registry) error {
 func (*BasketStarted) Key() string    { return BasketStartedEvent }
 func (*BasketCanceled) Key() string   { return BasketCanceledEvent }
 func (*BasketCheckedOut) Key() string { return BasketCheckedOutEvent }
+func (*BasketAbandoned) Key() string  { return BasketAbandonedEvent }
+func (*BasketExpired) Key() string    { return BasketExpiredEvent }
@@ -45,6 +45,66 @@ func (_m *MockBasketServiceClient) AddItem(ctx context.Context, in *AddItemReque
 	return r0, r1
 }
 
+// AddPromotion provides a mock function with given fields: ctx, in, opts
+func (_m *MockBasketServiceClient) AddPromotion(ctx context.Context, in *AddPromotionRequest, opts ...grpc.CallOption) (*AddPromotionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *AddPromotionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AddPromotionRequest, ...grpc.CallOption) *AddPromotionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AddPromotionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AddPromotionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyCoupon provides a mock function with given fields: ctx, in, opts
+func (_m *MockBasketServiceClient) ApplyCoupon(ctx context.Context, in *ApplyCouponRequest, opts ...grpc.CallOption) (*ApplyCouponResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ApplyCouponResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ApplyCouponRequest, ...grpc.CallOption) *ApplyCouponResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ApplyCouponResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ApplyCouponRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelBasket provides a mock function with given fields: ctx, in, opts
 func (_m *MockBasketServiceClient) CancelBasket(ctx context.Context, in *CancelBasketRequest, opts ...grpc.CallOption) (*CancelBasketResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -135,6 +195,36 @@ func (_m *MockBasketServiceClient) GetBasket(ctx context.Context, in *GetBasketR
 	return r0, r1
 }
 
+// RemoveCoupon provides a mock function with given fields: ctx, in, opts
+func (_m *MockBasketServiceClient) RemoveCoupon(ctx context.Context, in *RemoveCouponRequest, opts ...grpc.CallOption) (*RemoveCouponResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *RemoveCouponResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemoveCouponRequest, ...grpc.CallOption) *RemoveCouponResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemoveCouponResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemoveCouponRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveItem provides a mock function with given fields: ctx, in, opts
 func (_m *MockBasketServiceClient) RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*RemoveItemResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -165,6 +255,36 @@ func (_m *MockBasketServiceClient) RemoveItem(ctx context.Context, in *RemoveIte
 	return r0, r1
 }
 
+// RemovePromotion provides a mock function with given fields: ctx, in, opts
+func (_m *MockBasketServiceClient) RemovePromotion(ctx context.Context, in *RemovePromotionRequest, opts ...grpc.CallOption) (*RemovePromotionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *RemovePromotionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemovePromotionRequest, ...grpc.CallOption) *RemovePromotionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemovePromotionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemovePromotionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StartBasket provides a mock function with given fields: ctx, in, opts
 func (_m *MockBasketServiceClient) StartBasket(ctx context.Context, in *StartBasketRequest, opts ...grpc.CallOption) (*StartBasketResponse, error) {
 	_va := make([]interface{}, len(opts))
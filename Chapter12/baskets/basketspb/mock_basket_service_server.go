@@ -36,6 +36,52 @@ func (_m *MockBasketServiceServer) AddItem(_a0 context.Context, _a1 *AddItemRequ
 	return r0, r1
 }
 
+// AddPromotion provides a mock function with given fields: _a0, _a1
+func (_m *MockBasketServiceServer) AddPromotion(_a0 context.Context, _a1 *AddPromotionRequest) (*AddPromotionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *AddPromotionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AddPromotionRequest) *AddPromotionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AddPromotionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AddPromotionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyCoupon provides a mock function with given fields: _a0, _a1
+func (_m *MockBasketServiceServer) ApplyCoupon(_a0 context.Context, _a1 *ApplyCouponRequest) (*ApplyCouponResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ApplyCouponResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ApplyCouponRequest) *ApplyCouponResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ApplyCouponResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ApplyCouponRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelBasket provides a mock function with given fields: _a0, _a1
 func (_m *MockBasketServiceServer) CancelBasket(_a0 context.Context, _a1 *CancelBasketRequest) (*CancelBasketResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -105,6 +151,29 @@ func (_m *MockBasketServiceServer) GetBasket(_a0 context.Context, _a1 *GetBasket
 	return r0, r1
 }
 
+// RemoveCoupon provides a mock function with given fields: _a0, _a1
+func (_m *MockBasketServiceServer) RemoveCoupon(_a0 context.Context, _a1 *RemoveCouponRequest) (*RemoveCouponResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RemoveCouponResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemoveCouponRequest) *RemoveCouponResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemoveCouponResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemoveCouponRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveItem provides a mock function with given fields: _a0, _a1
 func (_m *MockBasketServiceServer) RemoveItem(_a0 context.Context, _a1 *RemoveItemRequest) (*RemoveItemResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -128,6 +197,29 @@ func (_m *MockBasketServiceServer) RemoveItem(_a0 context.Context, _a1 *RemoveIt
 	return r0, r1
 }
 
+// RemovePromotion provides a mock function with given fields: _a0, _a1
+func (_m *MockBasketServiceServer) RemovePromotion(_a0 context.Context, _a1 *RemovePromotionRequest) (*RemovePromotionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RemovePromotionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemovePromotionRequest) *RemovePromotionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemovePromotionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemovePromotionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StartBasket provides a mock function with given fields: _a0, _a1
 func (_m *MockBasketServiceServer) StartBasket(_a0 context.Context, _a1 *StartBasketRequest) (*StartBasketResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/stackus/errors"
 
@@ -27,44 +28,126 @@ type (
 	AddItem struct {
 		ID        string
 		ProductID string
+		VariantID string
 		Quantity  int
 	}
 
 	RemoveItem struct {
 		ID        string
 		ProductID string
+		VariantID string
 		Quantity  int
 	}
 
+	ApplyCoupon struct {
+		ID   string
+		Code string
+	}
+
+	RemoveCoupon struct {
+		ID   string
+		Code string
+	}
+
+	AddPromotion struct {
+		ID           string
+		Name         string
+		StoreID      string
+		ProductID    string
+		Code         string
+		Kind         string
+		Percent      float64
+		Amount       float64
+		BuyQuantity  int
+		GetQuantity  int
+		MinimumSpend float64
+	}
+
+	RemovePromotion struct {
+		ID string
+	}
+
+	RemindIdleBasket struct {
+		ID          string
+		IdleSince   time.Time
+		ExpireAfter time.Duration
+	}
+
+	ExpireIdleBasket struct {
+		ID        string
+		IdleSince time.Time
+	}
+
+	RepriceBasketItems struct {
+		ProductID string
+	}
+
+	// FlagUnavailableItems flags every item of the product unless a variant is given
+	FlagUnavailableItems struct {
+		ProductID string
+		VariantID string
+	}
+
 	GetBasket struct {
 		ID string
 	}
 
+	PriceBasket struct {
+		ID string
+	}
+
+	GetUnremindedBaskets struct {
+		IdleSince time.Time
+	}
+
+	GetIdleBaskets struct {
+		IdleSince time.Time
+	}
+
 	App interface {
 		StartBasket(ctx context.Context, start StartBasket) error
 		CancelBasket(ctx context.Context, cancel CancelBasket) error
 		CheckoutBasket(ctx context.Context, checkout CheckoutBasket) error
 		AddItem(ctx context.Context, add AddItem) error
 		RemoveItem(ctx context.Context, remove RemoveItem) error
+		ApplyCoupon(ctx context.Context, apply ApplyCoupon) error
+		RemoveCoupon(ctx context.Context, remove RemoveCoupon) error
+		AddPromotion(ctx context.Context, add AddPromotion) error
+		RemovePromotion(ctx context.Context, remove RemovePromotion) error
+		RemindIdleBasket(ctx context.Context, remind RemindIdleBasket) error
+		ExpireIdleBasket(ctx context.Context, expire ExpireIdleBasket) error
+		RepriceBasketItems(ctx context.Context, reprice RepriceBasketItems) error
+		FlagUnavailableItems(ctx context.Context, flag FlagUnavailableItems) error
 		GetBasket(ctx context.Context, get GetBasket) (*domain.Basket, error)
+		PriceBasket(ctx context.Context, price PriceBasket) (*domain.BasketPricing, error)
+		GetUnremindedBaskets(ctx context.Context, get GetUnremindedBaskets) ([]*domain.BasketActivity, error)
+		GetIdleBaskets(ctx context.Context, get GetIdleBaskets) ([]*domain.BasketActivity, error)
 	}
 
 	Application struct {
-		baskets   domain.BasketRepository
-		stores    domain.StoreRepository
-		products  domain.ProductRepository
-		publisher ddd.EventPublisher[ddd.Event]
+		baskets        domain.BasketRepository
+		stores         domain.StoreRepository
+		products       domain.ProductRepository
+		promotions     domain.PromotionRepository
+		activity       domain.BasketActivityRepository
+		basketProducts domain.BasketProductRepository
+		publisher      ddd.EventPublisher[ddd.Event]
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(baskets domain.BasketRepository, stores domain.StoreRepository, products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) *Application {
+func New(baskets domain.BasketRepository, stores domain.StoreRepository, products domain.ProductRepository, promotions domain.PromotionRepository,
+	activity domain.BasketActivityRepository, basketProducts domain.BasketProductRepository, publisher ddd.EventPublisher[ddd.Event],
+) *Application {
 	return &Application{
-		baskets:   baskets,
-		stores:    stores,
-		products:  products,
-		publisher: publisher,
+		baskets:        baskets,
+		stores:         stores,
+		products:       products,
+		promotions:     promotions,
+		activity:       activity,
+		basketProducts: basketProducts,
+		publisher:      publisher,
 	}
 }
 
@@ -110,7 +193,12 @@ func (a Application) CheckoutBasket(ctx context.Context, checkout CheckoutBasket
 		return err
 	}
 
-	event, err := basket.Checkout(checkout.PaymentID)
+	pricing, err := a.price(ctx, basket)
+	if err != nil {
+		return errors.Wrap(err, "basket checkout")
+	}
+
+	event, err := basket.Checkout(checkout.PaymentID, *pricing)
 	if err != nil {
 		return errors.Wrap(err, "baskets checkout")
 	}
@@ -138,7 +226,7 @@ func (a Application) AddItem(ctx context.Context, add AddItem) error {
 		return err
 	}
 
-	err = basket.AddItem(store, product, add.Quantity)
+	event, err := basket.AddItem(store, product, add.VariantID, add.Quantity)
 	if err != nil {
 		return err
 	}
@@ -147,7 +235,7 @@ func (a Application) AddItem(ctx context.Context, add AddItem) error {
 		return err
 	}
 
-	return nil
+	return a.publisher.Publish(ctx, event)
 }
 
 func (a Application) RemoveItem(ctx context.Context, remove RemoveItem) error {
@@ -161,18 +249,245 @@ func (a Application) RemoveItem(ctx context.Context, remove RemoveItem) error {
 		return err
 	}
 
-	err = basket.RemoveItem(product, remove.Quantity)
+	event, err := basket.RemoveItem(product, remove.VariantID, remove.Quantity)
+	if err != nil || event == nil {
+		return err
+	}
+
+	if err = a.baskets.Save(ctx, basket); err != nil {
+		return err
+	}
+
+	return a.publisher.Publish(ctx, event)
+}
+
+func (a Application) ApplyCoupon(ctx context.Context, apply ApplyCoupon) error {
+	basket, err := a.baskets.Load(ctx, apply.ID)
+	if err != nil {
+		return err
+	}
+
+	promotions, err := a.promotions.FindByCode(ctx, domain.NormalizeCouponCode(apply.Code))
 	if err != nil {
 		return err
 	}
 
+	if len(promotions) == 0 {
+		return domain.ErrCouponDoesNotExist
+	}
+
+	event, err := basket.ApplyCoupon(apply.Code)
+	if err != nil || event == nil {
+		return err
+	}
+
 	if err = a.baskets.Save(ctx, basket); err != nil {
 		return err
 	}
 
-	return nil
+	return a.publisher.Publish(ctx, event)
+}
+
+func (a Application) RemoveCoupon(ctx context.Context, remove RemoveCoupon) error {
+	basket, err := a.baskets.Load(ctx, remove.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := basket.RemoveCoupon(remove.Code)
+	if err != nil || event == nil {
+		return err
+	}
+
+	if err = a.baskets.Save(ctx, basket); err != nil {
+		return err
+	}
+
+	return a.publisher.Publish(ctx, event)
+}
+
+func (a Application) AddPromotion(ctx context.Context, add AddPromotion) error {
+	promotion := &domain.Promotion{
+		ID:           add.ID,
+		Name:         add.Name,
+		StoreID:      add.StoreID,
+		ProductID:    add.ProductID,
+		Code:         domain.NormalizeCouponCode(add.Code),
+		Kind:         domain.ToPromotionKind(add.Kind),
+		Percent:      add.Percent,
+		Amount:       add.Amount,
+		BuyQuantity:  add.BuyQuantity,
+		GetQuantity:  add.GetQuantity,
+		MinimumSpend: add.MinimumSpend,
+	}
+
+	if err := promotion.Validate(); err != nil {
+		return err
+	}
+
+	return a.promotions.Add(ctx, promotion)
+}
+
+func (a Application) RemovePromotion(ctx context.Context, remove RemovePromotion) error {
+	return a.promotions.Remove(ctx, remove.ID)
+}
+
+func (a Application) RemindIdleBasket(ctx context.Context, remind RemindIdleBasket) error {
+	activity, err := a.activity.Lock(ctx, remind.ID)
+	if err != nil {
+		return err
+	}
+
+	// the basket was closed, is being handled elsewhere, or the customer has
+	// come back to it since it was found
+	if activity == nil || activity.Reminded || !activity.LastActiveAt.Before(remind.IdleSince) {
+		return nil
+	}
+
+	basket, err := a.baskets.Load(ctx, remind.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := basket.Abandon(activity.LastActiveAt.Add(remind.ExpireAfter))
+	if errors.Is(err, domain.ErrBasketCannotBeModified) {
+		// the basket was closed without the activity being cleared
+		return a.activity.Remove(ctx, remind.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = a.activity.MarkReminded(ctx, remind.ID); err != nil {
+		return err
+	}
+
+	if event == nil {
+		return nil
+	}
+
+	if err = a.baskets.Save(ctx, basket); err != nil {
+		return err
+	}
+
+	return a.publisher.Publish(ctx, event)
+}
+
+func (a Application) ExpireIdleBasket(ctx context.Context, expire ExpireIdleBasket) error {
+	activity, err := a.activity.Lock(ctx, expire.ID)
+	if err != nil {
+		return err
+	}
+
+	// the basket was closed, is being handled elsewhere, or the customer has
+	// come back to it since it was found
+	if activity == nil || !activity.LastActiveAt.Before(expire.IdleSince) {
+		return nil
+	}
+
+	basket, err := a.baskets.Load(ctx, expire.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := basket.Expire()
+	if errors.Is(err, domain.ErrBasketCannotBeModified) {
+		// the basket was closed without the activity being cleared
+		return a.activity.Remove(ctx, expire.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = a.baskets.Save(ctx, basket); err != nil {
+		return err
+	}
+
+	return a.publisher.Publish(ctx, event)
+}
+
+func (a Application) RepriceBasketItems(ctx context.Context, reprice RepriceBasketItems) error {
+	product, err := a.products.Find(ctx, reprice.ProductID)
+	if err != nil {
+		return err
+	}
+
+	return a.updateBaskets(ctx, reprice.ProductID, func(basket *domain.Basket) (ddd.Event, error) {
+		return basket.RepriceItem(product.ID, product.Price)
+	})
+}
+
+func (a Application) FlagUnavailableItems(ctx context.Context, flag FlagUnavailableItems) error {
+	return a.updateBaskets(ctx, flag.ProductID, func(basket *domain.Basket) (ddd.Event, error) {
+		return basket.FlagItemUnavailable(flag.ProductID, flag.VariantID)
+	})
 }
 
 func (a Application) GetBasket(ctx context.Context, get GetBasket) (*domain.Basket, error) {
 	return a.baskets.Load(ctx, get.ID)
 }
+
+func (a Application) PriceBasket(ctx context.Context, price PriceBasket) (*domain.BasketPricing, error) {
+	basket, err := a.baskets.Load(ctx, price.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.price(ctx, basket)
+}
+
+func (a Application) GetUnremindedBaskets(ctx context.Context, get GetUnremindedBaskets) ([]*domain.BasketActivity, error) {
+	return a.activity.FindUnreminded(ctx, get.IdleSince)
+}
+
+func (a Application) GetIdleBaskets(ctx context.Context, get GetIdleBaskets) ([]*domain.BasketActivity, error) {
+	return a.activity.FindIdle(ctx, get.IdleSince)
+}
+
+func (a Application) price(ctx context.Context, basket *domain.Basket) (*domain.BasketPricing, error) {
+	promotions, err := a.promotions.FindForStores(ctx, basket.StoreIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	pricing := domain.NewPricingEngine(promotions).Price(basket.Items, basket.Coupons)
+
+	return &pricing, nil
+}
+
+// updateBaskets applies a change to every open basket holding the product
+func (a Application) updateBaskets(ctx context.Context, productID string, update func(*domain.Basket) (ddd.Event, error)) error {
+	basketIDs, err := a.basketProducts.FindBaskets(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	for _, basketID := range basketIDs {
+		basket, err := a.baskets.Load(ctx, basketID)
+		if err != nil {
+			return err
+		}
+
+		event, err := update(basket)
+		if errors.Is(err, domain.ErrBasketCannotBeModified) {
+			// the basket was closed before the index caught up
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			continue
+		}
+
+		if err = a.baskets.Save(ctx, basket); err != nil {
+			return err
+		}
+
+		if err = a.publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
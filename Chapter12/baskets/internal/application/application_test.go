@@ -26,10 +26,12 @@ func TestApplication_AddItem(t *testing.T) {
 	}
 
 	type mocks struct {
-		baskets   *domain.MockBasketRepository
-		stores    *domain.MockStoreRepository
-		products  *domain.MockProductRepository
-		publisher *ddd.MockEventPublisher[ddd.Event]
+		baskets    *domain.MockBasketRepository
+		stores     *domain.MockStoreRepository
+		products   *domain.MockProductRepository
+		promotions *domain.MockPromotionRepository
+		activity   *domain.MockBasketActivityRepository
+		publisher  *ddd.MockEventPublisher[ddd.Event]
 	}
 	type args struct {
 		ctx context.Context
@@ -60,6 +62,7 @@ func TestApplication_AddItem(t *testing.T) {
 				f.products.On("Find", context.Background(), "product-id").Return(product, nil)
 				f.stores.On("Find", context.Background(), "store-id").Return(store, nil)
 				f.baskets.On("Save", context.Background(), mock.AnythingOfType("*domain.Basket")).Return(nil)
+				f.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil)
 			},
 		},
 		"NoBasket": {
@@ -146,12 +149,14 @@ func TestApplication_AddItem(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			m := mocks{
-				baskets:   domain.NewMockBasketRepository(t),
-				stores:    domain.NewMockStoreRepository(t),
-				products:  domain.NewMockProductRepository(t),
-				publisher: ddd.NewMockEventPublisher[ddd.Event](t),
+				baskets:    domain.NewMockBasketRepository(t),
+				stores:     domain.NewMockStoreRepository(t),
+				products:   domain.NewMockProductRepository(t),
+				promotions: domain.NewMockPromotionRepository(t),
+				activity:   domain.NewMockBasketActivityRepository(t),
+				publisher:  ddd.NewMockEventPublisher[ddd.Event](t),
 			}
-			a := New(m.baskets, m.stores, m.products, m.publisher)
+			a := New(m.baskets, m.stores, m.products, m.promotions, m.activity, nil, m.publisher)
 			if tt.on != nil {
 				tt.on(m)
 			}
@@ -296,10 +301,11 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 	}
 
 	type fields struct {
-		baskets   *domain.MockBasketRepository
-		stores    *domain.MockStoreRepository
-		products  *domain.MockProductRepository
-		publisher *ddd.MockEventPublisher[ddd.Event]
+		baskets    *domain.MockBasketRepository
+		stores     *domain.MockStoreRepository
+		products   *domain.MockProductRepository
+		promotions *domain.MockPromotionRepository
+		publisher  *ddd.MockEventPublisher[ddd.Event]
 	}
 	type args struct {
 		ctx      context.Context
@@ -328,6 +334,7 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 					},
 					Status: domain.BasketIsOpen,
 				}, nil)
+				f.promotions.On("FindForStores", context.Background(), []string{"store-id"}).Return([]*domain.Promotion{}, nil)
 				f.baskets.On("Save", context.Background(), mock.AnythingOfType("*domain.Basket")).Return(nil)
 				f.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil)
 			},
@@ -350,6 +357,7 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 					},
 					Status: domain.BasketIsOpen,
 				}, nil)
+				f.promotions.On("FindForStores", context.Background(), []string{"store-id"}).Return([]*domain.Promotion{}, nil)
 			},
 			wantErr: true,
 		},
@@ -384,6 +392,7 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 					},
 					Status: domain.BasketIsOpen,
 				}, nil)
+				f.promotions.On("FindForStores", context.Background(), []string{"store-id"}).Return([]*domain.Promotion{}, nil)
 				f.baskets.On("Save", context.Background(), mock.AnythingOfType("*domain.Basket")).Return(fmt.Errorf("save failed"))
 			},
 			wantErr: true,
@@ -406,6 +415,7 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 					},
 					Status: domain.BasketIsOpen,
 				}, nil)
+				f.promotions.On("FindForStores", context.Background(), []string{"store-id"}).Return([]*domain.Promotion{}, nil)
 				f.baskets.On("Save", context.Background(), mock.AnythingOfType("*domain.Basket")).Return(nil)
 				f.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(fmt.Errorf("publish failed"))
 			},
@@ -415,16 +425,18 @@ func TestApplication_CheckoutBasket(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			f := fields{
-				baskets:   domain.NewMockBasketRepository(t),
-				stores:    domain.NewMockStoreRepository(t),
-				products:  domain.NewMockProductRepository(t),
-				publisher: ddd.NewMockEventPublisher[ddd.Event](t),
+				baskets:    domain.NewMockBasketRepository(t),
+				stores:     domain.NewMockStoreRepository(t),
+				products:   domain.NewMockProductRepository(t),
+				promotions: domain.NewMockPromotionRepository(t),
+				publisher:  ddd.NewMockEventPublisher[ddd.Event](t),
 			}
 			a := Application{
-				baskets:   f.baskets,
-				stores:    f.stores,
-				products:  f.products,
-				publisher: f.publisher,
+				baskets:    f.baskets,
+				stores:     f.stores,
+				products:   f.products,
+				promotions: f.promotions,
+				publisher:  f.publisher,
 			}
 			if tt.on != nil {
 				tt.on(f)
@@ -594,6 +606,7 @@ func TestApplication_RemoveItem(t *testing.T) {
 					Status: domain.BasketIsOpen,
 				}, nil)
 				m.baskets.On("Save", context.Background(), mock.AnythingOfType("*domain.Basket")).Return(nil)
+				m.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil)
 			},
 		},
 		"NoProduct": {
@@ -28,6 +28,34 @@ func (_m *MockApp) AddItem(ctx context.Context, add AddItem) error {
 	return r0
 }
 
+// AddPromotion provides a mock function with given fields: ctx, add
+func (_m *MockApp) AddPromotion(ctx context.Context, add AddPromotion) error {
+	ret := _m.Called(ctx, add)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AddPromotion) error); ok {
+		r0 = rf(ctx, add)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyCoupon provides a mock function with given fields: ctx, apply
+func (_m *MockApp) ApplyCoupon(ctx context.Context, apply ApplyCoupon) error {
+	ret := _m.Called(ctx, apply)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ApplyCoupon) error); ok {
+		r0 = rf(ctx, apply)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CancelBasket provides a mock function with given fields: ctx, cancel
 func (_m *MockApp) CancelBasket(ctx context.Context, cancel CancelBasket) error {
 	ret := _m.Called(ctx, cancel)
@@ -56,6 +84,34 @@ func (_m *MockApp) CheckoutBasket(ctx context.Context, checkout CheckoutBasket)
 	return r0
 }
 
+// ExpireIdleBasket provides a mock function with given fields: ctx, expire
+func (_m *MockApp) ExpireIdleBasket(ctx context.Context, expire ExpireIdleBasket) error {
+	ret := _m.Called(ctx, expire)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ExpireIdleBasket) error); ok {
+		r0 = rf(ctx, expire)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FlagUnavailableItems provides a mock function with given fields: ctx, flag
+func (_m *MockApp) FlagUnavailableItems(ctx context.Context, flag FlagUnavailableItems) error {
+	ret := _m.Called(ctx, flag)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, FlagUnavailableItems) error); ok {
+		r0 = rf(ctx, flag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBasket provides a mock function with given fields: ctx, get
 func (_m *MockApp) GetBasket(ctx context.Context, get GetBasket) (*domain.Basket, error) {
 	ret := _m.Called(ctx, get)
@@ -79,6 +135,103 @@ func (_m *MockApp) GetBasket(ctx context.Context, get GetBasket) (*domain.Basket
 	return r0, r1
 }
 
+// GetIdleBaskets provides a mock function with given fields: ctx, get
+func (_m *MockApp) GetIdleBaskets(ctx context.Context, get GetIdleBaskets) ([]*domain.BasketActivity, error) {
+	ret := _m.Called(ctx, get)
+
+	var r0 []*domain.BasketActivity
+	if rf, ok := ret.Get(0).(func(context.Context, GetIdleBaskets) []*domain.BasketActivity); ok {
+		r0 = rf(ctx, get)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.BasketActivity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, GetIdleBaskets) error); ok {
+		r1 = rf(ctx, get)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnremindedBaskets provides a mock function with given fields: ctx, get
+func (_m *MockApp) GetUnremindedBaskets(ctx context.Context, get GetUnremindedBaskets) ([]*domain.BasketActivity, error) {
+	ret := _m.Called(ctx, get)
+
+	var r0 []*domain.BasketActivity
+	if rf, ok := ret.Get(0).(func(context.Context, GetUnremindedBaskets) []*domain.BasketActivity); ok {
+		r0 = rf(ctx, get)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.BasketActivity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, GetUnremindedBaskets) error); ok {
+		r1 = rf(ctx, get)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceBasket provides a mock function with given fields: ctx, price
+func (_m *MockApp) PriceBasket(ctx context.Context, price PriceBasket) (*domain.BasketPricing, error) {
+	ret := _m.Called(ctx, price)
+
+	var r0 *domain.BasketPricing
+	if rf, ok := ret.Get(0).(func(context.Context, PriceBasket) *domain.BasketPricing); ok {
+		r0 = rf(ctx, price)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BasketPricing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, PriceBasket) error); ok {
+		r1 = rf(ctx, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemindIdleBasket provides a mock function with given fields: ctx, remind
+func (_m *MockApp) RemindIdleBasket(ctx context.Context, remind RemindIdleBasket) error {
+	ret := _m.Called(ctx, remind)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RemindIdleBasket) error); ok {
+		r0 = rf(ctx, remind)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveCoupon provides a mock function with given fields: ctx, remove
+func (_m *MockApp) RemoveCoupon(ctx context.Context, remove RemoveCoupon) error {
+	ret := _m.Called(ctx, remove)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RemoveCoupon) error); ok {
+		r0 = rf(ctx, remove)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveItem provides a mock function with given fields: ctx, remove
 func (_m *MockApp) RemoveItem(ctx context.Context, remove RemoveItem) error {
 	ret := _m.Called(ctx, remove)
@@ -93,6 +246,34 @@ func (_m *MockApp) RemoveItem(ctx context.Context, remove RemoveItem) error {
 	return r0
 }
 
+// RemovePromotion provides a mock function with given fields: ctx, remove
+func (_m *MockApp) RemovePromotion(ctx context.Context, remove RemovePromotion) error {
+	ret := _m.Called(ctx, remove)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RemovePromotion) error); ok {
+		r0 = rf(ctx, remove)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepriceBasketItems provides a mock function with given fields: ctx, reprice
+func (_m *MockApp) RepriceBasketItems(ctx context.Context, reprice RepriceBasketItems) error {
+	ret := _m.Called(ctx, reprice)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RepriceBasketItems) error); ok {
+		r0 = rf(ctx, reprice)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartBasket provides a mock function with given fields: ctx, start
 func (_m *MockApp) StartBasket(ctx context.Context, start StartBasket) error {
 	ret := _m.Called(ctx, start)
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Config holds the settings used only by the baskets module; the settings are
+// read from the BASKETS_ prefixed environment variables
+type Config struct {
+	IdleTimeout        time.Duration            `envconfig:"IDLE_TIMEOUT" default:"24h"`
+	RemindBefore       time.Duration            `envconfig:"REMIND_BEFORE" default:"2h"`
+	MonitorInterval    time.Duration            `envconfig:"MONITOR_INTERVAL" default:"1m"`
+	TenantIdleTimeouts map[string]time.Duration `envconfig:"TENANT_IDLE_TIMEOUTS"`
+	TenantRemindBefore map[string]time.Duration `envconfig:"TENANT_REMIND_BEFORE"`
+}
+
+func InitConfig() (cfg Config, err error) {
+	err = envconfig.Process("baskets", &cfg)
+
+	return
+}
+
+// ForTenant returns the settings of the tenant
+func (c Config) ForTenant(tenantID string) Config {
+	if idleTimeout, exists := c.TenantIdleTimeouts[tenantID]; exists {
+		c.IdleTimeout = idleTimeout
+	}
+	if remindBefore, exists := c.TenantRemindBefore[tenantID]; exists {
+		c.RemindBefore = remindBefore
+	}
+
+	return c
+}
@@ -24,12 +24,17 @@ const (
 	ApplicationKey              = "app"
 	DomainEventHandlersKey      = "domainEventHandlers"
 	IntegrationEventHandlersKey = "integrationEventHandlers"
+	ActivityHandlersKey         = "activityHandlers"
+	BasketProductHandlersKey    = "basketProductHandlers"
 	CommandHandlersKey          = "commandHandlers"
 	ReplyHandlersKey            = "replyHandlers"
 
-	BasketsRepoKey  = "basketsRepo"
-	StoresRepoKey   = "storesRepo"
-	ProductsRepoKey = "productsRepo"
+	BasketsRepoKey        = "basketsRepo"
+	StoresRepoKey         = "storesRepo"
+	ProductsRepoKey       = "productsRepo"
+	PromotionsRepoKey     = "promotionsRepo"
+	ActivityRepoKey       = "activityRepo"
+	BasketProductsRepoKey = "basketProductsRepo"
 )
 
 // Repository Table Names
@@ -40,8 +45,11 @@ const (
 	SnapshotsTableName = ServiceName + ".snapshots"
 	SagasTableName     = ServiceName + ".sagas"
 
-	StoresCacheTableName   = ServiceName + ".stores_cache"
-	ProductsCacheTableName = ServiceName + ".products_cache"
+	StoresCacheTableName    = ServiceName + ".stores_cache"
+	ProductsCacheTableName  = ServiceName + ".products_cache"
+	PromotionsTableName     = ServiceName + ".promotions"
+	ActivityTableName       = ServiceName + ".basket_activity"
+	BasketProductsTableName = ServiceName + ".basket_products"
 )
 
 // Metric Names
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"sort"
+	"time"
+
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/ddd"
@@ -10,13 +13,19 @@ import (
 const BasketAggregate = "baskets.Basket"
 
 var (
-	ErrBasketHasNoItems         = errors.Wrap(errors.ErrBadRequest, "the basket has no items")
-	ErrBasketCannotBeModified   = errors.Wrap(errors.ErrBadRequest, "the basket cannot be modified")
-	ErrBasketCannotBeCancelled  = errors.Wrap(errors.ErrBadRequest, "the basket cannot be cancelled")
-	ErrQuantityCannotBeNegative = errors.Wrap(errors.ErrBadRequest, "the item quantity cannot be negative")
-	ErrBasketIDCannotBeBlank    = errors.Wrap(errors.ErrBadRequest, "the basket id cannot be blank")
-	ErrPaymentIDCannotBeBlank   = errors.Wrap(errors.ErrBadRequest, "the payment id cannot be blank")
-	ErrCustomerIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
+	ErrBasketHasNoItems          = errors.Wrap(errors.ErrBadRequest, "the basket has no items")
+	ErrBasketCannotBeModified    = errors.Wrap(errors.ErrBadRequest, "the basket cannot be modified")
+	ErrBasketCannotBeCancelled   = errors.Wrap(errors.ErrBadRequest, "the basket cannot be cancelled")
+	ErrQuantityCannotBeNegative  = errors.Wrap(errors.ErrBadRequest, "the item quantity cannot be negative")
+	ErrBasketIDCannotBeBlank     = errors.Wrap(errors.ErrBadRequest, "the basket id cannot be blank")
+	ErrPaymentIDCannotBeBlank    = errors.Wrap(errors.ErrBadRequest, "the payment id cannot be blank")
+	ErrCustomerIDCannotBeBlank   = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
+	ErrCouponCodeCannotBeBlank   = errors.Wrap(errors.ErrBadRequest, "the coupon code cannot be blank")
+	ErrCouponDoesNotExist        = errors.Wrap(errors.ErrNotFound, "the coupon does not exist")
+	ErrBasketHasUnavailableItems = errors.Wrap(errors.ErrBadRequest, "the basket has items that are no longer available")
+	ErrStoreIsClosed             = errors.Wrap(errors.ErrFailedPrecondition, "the store is closed")
+	ErrProductVariantRequired    = errors.Wrap(errors.ErrBadRequest, "the product is sold in variants and one must be chosen")
+	ErrProductVariantNotFound    = errors.Wrap(errors.ErrNotFound, "the product variant does not exist")
 )
 
 type Basket struct {
@@ -24,6 +33,9 @@ type Basket struct {
 	CustomerID string
 	PaymentID  string
 	Items      map[string]Item
+	Coupons    []string
+	Promotions []AppliedPromotion
+	ExpiresAt  time.Time
 	Status     BasketStatus
 }
 
@@ -73,7 +85,7 @@ func (b *Basket) Cancel() (ddd.Event, error) {
 	return ddd.NewEvent(BasketCanceledEvent, b), nil
 }
 
-func (b *Basket) Checkout(paymentID string) (ddd.Event, error) {
+func (b *Basket) Checkout(paymentID string, pricing BasketPricing) (ddd.Event, error) {
 	if !b.IsOpen() {
 		return nil, ErrBasketCannotBeModified
 	}
@@ -82,57 +94,251 @@ func (b *Basket) Checkout(paymentID string) (ddd.Event, error) {
 		return nil, ErrBasketHasNoItems
 	}
 
+	for _, item := range b.Items {
+		if item.Unavailable {
+			return nil, ErrBasketHasUnavailableItems
+		}
+	}
+
 	if paymentID == "" {
 		return nil, ErrPaymentIDCannotBeBlank
 	}
 
 	b.AddEvent(BasketCheckedOutEvent, &BasketCheckedOut{
-		PaymentID: paymentID,
+		PaymentID:  paymentID,
+		Discounts:  pricing.Discounts,
+		Promotions: pricing.Promotions,
 	})
 
 	return ddd.NewEvent(BasketCheckedOutEvent, b), nil
 }
 
-func (b *Basket) AddItem(store *Store, product *Product, quantity int) error {
+// AddItem adds the product to the basket; products that are sold in variants
+// must be added as one of them
+func (b *Basket) AddItem(store *Store, product *Product, variantID string, quantity int) (ddd.Event, error) {
 	if !b.IsOpen() {
-		return ErrBasketCannotBeModified
+		return nil, ErrBasketCannotBeModified
 	}
 
 	if quantity < 0 {
-		return ErrQuantityCannotBeNegative
+		return nil, ErrQuantityCannotBeNegative
+	}
+
+	if store.Closed {
+		return nil, errors.Wrapf(ErrStoreIsClosed, "store: %s", store.ID)
+	}
+
+	item := Item{
+		StoreID:      store.ID,
+		ProductID:    product.ID,
+		StoreName:    store.Name,
+		ProductName:  product.Name,
+		ProductPrice: product.Price,
+		Quantity:     quantity,
+	}
+
+	switch {
+	case variantID != "":
+		variant, exists := product.Variant(variantID)
+		if !exists {
+			return nil, errors.Wrapf(ErrProductVariantNotFound, "variant: %s", variantID)
+		}
+		item.VariantID = variant.ID
+		item.SKU = variant.SKU
+		item.Attributes = variant.Attributes
+		item.ProductPrice = variant.Price
+	case len(product.Variants) > 0:
+		return nil, ErrProductVariantRequired
 	}
 
 	b.AddEvent(BasketItemAddedEvent, &BasketItemAdded{
-		Item: Item{
-			StoreID:      store.ID,
-			ProductID:    product.ID,
-			StoreName:    store.Name,
-			ProductName:  product.Name,
-			ProductPrice: product.Price,
-			Quantity:     quantity,
-		},
+		Item: item,
 	})
 
-	return nil
+	return ddd.NewEvent(BasketItemAddedEvent, b), nil
 }
 
-func (b *Basket) RemoveItem(product *Product, quantity int) error {
+// RemoveItem returns a nil event when the product, or the variant of it, is
+// not in the basket
+func (b *Basket) RemoveItem(product *Product, variantID string, quantity int) (ddd.Event, error) {
 	if !b.IsOpen() {
-		return ErrBasketCannotBeModified
+		return nil, ErrBasketCannotBeModified
 	}
 
 	if quantity < 0 {
-		return ErrQuantityCannotBeNegative
+		return nil, ErrQuantityCannotBeNegative
 	}
 
-	if _, exists := b.Items[product.ID]; exists {
-		b.AddEvent(BasketItemRemovedEvent, &BasketItemRemoved{
-			ProductID: product.ID,
-			Quantity:  quantity,
-		})
+	if _, exists := b.Items[ItemKey(product.ID, variantID)]; !exists {
+		return nil, nil
 	}
 
-	return nil
+	b.AddEvent(BasketItemRemovedEvent, &BasketItemRemoved{
+		ProductID: product.ID,
+		VariantID: variantID,
+		Quantity:  quantity,
+	})
+
+	return ddd.NewEvent(BasketItemRemovedEvent, b), nil
+}
+
+// RepriceItem updates the price of an item after the store changes the price
+// of the product; a nil event is returned when the basket is unaffected
+//
+// Items of a variant keep their price; the price of a variant is its own and
+// does not follow the price of the product
+func (b *Basket) RepriceItem(productID string, price float64) (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	item, exists := b.Items[productID]
+	if !exists || item.ProductPrice == price {
+		return nil, nil
+	}
+
+	b.AddEvent(BasketItemRepricedEvent, &BasketItemRepriced{
+		ProductID: productID,
+		Price:     price,
+	})
+
+	return ddd.NewEvent(BasketItemRepricedEvent, b), nil
+}
+
+// FlagItemUnavailable marks the items of a product that has been removed from
+// the store, or only the item of the variant when one is given; a nil event is
+// returned when the basket is unaffected
+func (b *Basket) FlagItemUnavailable(productID, variantID string) (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	affected := false
+	for _, item := range b.Items {
+		if item.ProductID == productID && (variantID == "" || item.VariantID == variantID) && !item.Unavailable {
+			affected = true
+			break
+		}
+	}
+	if !affected {
+		return nil, nil
+	}
+
+	b.AddEvent(BasketItemUnavailableEvent, &BasketItemUnavailable{
+		ProductID: productID,
+		VariantID: variantID,
+	})
+
+	return ddd.NewEvent(BasketItemUnavailableEvent, b), nil
+}
+
+// Warnings lists the changes to items that the customer should review
+// before checking out
+func (b Basket) Warnings() []ItemWarning {
+	warnings := make([]ItemWarning, 0)
+	for _, item := range b.Items {
+		if warning, ok := item.Warning(); ok {
+			warnings = append(warnings, warning)
+		}
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		if warnings[i].ProductID == warnings[j].ProductID {
+			return warnings[i].VariantID < warnings[j].VariantID
+		}
+		return warnings[i].ProductID < warnings[j].ProductID
+	})
+
+	return warnings
+}
+
+// ApplyCoupon returns a nil event when the coupon has already been applied
+func (b *Basket) ApplyCoupon(code string) (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	code = NormalizeCouponCode(code)
+	if code == "" {
+		return nil, ErrCouponCodeCannotBeBlank
+	}
+
+	if b.HasCoupon(code) {
+		return nil, nil
+	}
+
+	b.AddEvent(BasketCouponAppliedEvent, &BasketCouponApplied{
+		Code: code,
+	})
+
+	return ddd.NewEvent(BasketCouponAppliedEvent, b), nil
+}
+
+// RemoveCoupon returns a nil event when the coupon was not applied
+func (b *Basket) RemoveCoupon(code string) (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	code = NormalizeCouponCode(code)
+	if !b.HasCoupon(code) {
+		return nil, nil
+	}
+
+	b.AddEvent(BasketCouponRemovedEvent, &BasketCouponRemoved{
+		Code: code,
+	})
+
+	return ddd.NewEvent(BasketCouponRemovedEvent, b), nil
+}
+
+// Abandon reminds the customer that the basket will expire; baskets without
+// items are not worth a reminder and return a nil event
+func (b *Basket) Abandon(expiresAt time.Time) (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	if len(b.Items) == 0 {
+		return nil, nil
+	}
+
+	b.AddEvent(BasketAbandonedEvent, &BasketAbandoned{
+		ExpiresAt: expiresAt,
+	})
+
+	return ddd.NewEvent(BasketAbandonedEvent, b), nil
+}
+
+func (b *Basket) Expire() (ddd.Event, error) {
+	if !b.IsOpen() {
+		return nil, ErrBasketCannotBeModified
+	}
+
+	b.AddEvent(BasketExpiredEvent, &BasketExpired{})
+
+	return ddd.NewEvent(BasketExpiredEvent, b), nil
+}
+
+func (b Basket) HasCoupon(code string) bool {
+	for _, coupon := range b.Coupons {
+		if coupon == code {
+			return true
+		}
+	}
+	return false
+}
+
+// StoreIDs returns the stores of the items in the basket
+func (b Basket) StoreIDs() []string {
+	seen := make(map[string]struct{}, len(b.Items))
+	storeIDs := make([]string, 0, len(b.Items))
+	for _, item := range b.Items {
+		if _, exists := seen[item.StoreID]; !exists {
+			seen[item.StoreID] = struct{}{}
+			storeIDs = append(storeIDs, item.StoreID)
+		}
+	}
+	return storeIDs
 }
 
 func (b *Basket) ApplyEvent(event ddd.Event) error {
@@ -142,29 +348,79 @@ func (b *Basket) ApplyEvent(event ddd.Event) error {
 		b.Status = BasketIsOpen
 
 	case *BasketItemAdded:
-		if item, exists := b.Items[payload.Item.ProductID]; exists {
+		key := payload.Item.Key()
+		if item, exists := b.Items[key]; exists {
 			item.Quantity += payload.Item.Quantity
-			b.Items[payload.Item.ProductID] = item
+			// adding more of the item acknowledges its current price
+			item.PreviousPrice = 0
+			b.Items[key] = item
 		} else {
-			b.Items[payload.Item.ProductID] = payload.Item
+			b.Items[key] = payload.Item
 		}
 
 	case *BasketItemRemoved:
-		if item, exists := b.Items[payload.ProductID]; exists {
+		key := ItemKey(payload.ProductID, payload.VariantID)
+		if item, exists := b.Items[key]; exists {
 			if item.Quantity-payload.Quantity <= 1 {
-				delete(b.Items, payload.ProductID)
+				delete(b.Items, key)
 			} else {
 				item.Quantity -= payload.Quantity
-				b.Items[payload.ProductID] = item
+				b.Items[key] = item
+			}
+		}
+
+	case *BasketItemRepriced:
+		if item, exists := b.Items[payload.ProductID]; exists {
+			if item.PreviousPrice == 0 {
+				item.PreviousPrice = item.ProductPrice
+			}
+			item.ProductPrice = payload.Price
+			if item.PreviousPrice == item.ProductPrice {
+				item.PreviousPrice = 0
+			}
+			b.Items[payload.ProductID] = item
+		}
+
+	case *BasketItemUnavailable:
+		for key, item := range b.Items {
+			if item.ProductID == payload.ProductID && (payload.VariantID == "" || item.VariantID == payload.VariantID) {
+				item.Unavailable = true
+				b.Items[key] = item
+			}
+		}
+
+	case *BasketCouponApplied:
+		b.Coupons = append(b.Coupons, payload.Code)
+
+	case *BasketCouponRemoved:
+		coupons := make([]string, 0, len(b.Coupons))
+		for _, coupon := range b.Coupons {
+			if coupon != payload.Code {
+				coupons = append(coupons, coupon)
 			}
 		}
+		b.Coupons = coupons
+
+	case *BasketAbandoned:
+		b.ExpiresAt = payload.ExpiresAt
 
 	case *BasketCanceled:
 		b.Items = make(map[string]Item)
 		b.Status = BasketIsCanceled
 
+	case *BasketExpired:
+		b.Items = make(map[string]Item)
+		b.Status = BasketIsCanceled
+
 	case *BasketCheckedOut:
 		b.PaymentID = payload.PaymentID
+		for key, discount := range payload.Discounts {
+			if item, exists := b.Items[key]; exists {
+				item.Discount = discount
+				b.Items[key] = item
+			}
+		}
+		b.Promotions = payload.Promotions
 		b.Status = BasketIsCheckedOut
 
 	default:
@@ -180,6 +436,9 @@ func (b *Basket) ApplySnapshot(snapshot es.Snapshot) error {
 		b.CustomerID = ss.CustomerID
 		b.PaymentID = ss.PaymentID
 		b.Items = ss.Items
+		b.Coupons = ss.Coupons
+		b.Promotions = ss.Promotions
+		b.ExpiresAt = ss.ExpiresAt
 		b.Status = ss.Status
 
 	default:
@@ -194,6 +453,9 @@ func (b *Basket) ToSnapshot() es.Snapshot {
 		CustomerID: b.CustomerID,
 		PaymentID:  b.PaymentID,
 		Items:      b.Items,
+		Coupons:    b.Coupons,
+		Promotions: b.Promotions,
+		ExpiresAt:  b.ExpiresAt,
 		Status:     b.Status,
 	}
 }
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"time"
+)
+
+// BasketActivity tracks when an open basket was last changed by its customer
+type BasketActivity struct {
+	BasketID     string
+	CustomerID   string
+	LastActiveAt time.Time
+	Reminded     bool
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type BasketActivityRepository interface {
+	Track(ctx context.Context, basketID, customerID string, activeAt time.Time) error
+	Remove(ctx context.Context, basketID string) error
+	MarkReminded(ctx context.Context, basketID string) error
+	// Lock returns the activity of the basket locked until the end of the
+	// transaction; nil when the basket is no longer tracked or is locked by
+	// another transaction
+	Lock(ctx context.Context, basketID string) (*BasketActivity, error)
+	FindUnreminded(ctx context.Context, idleSince time.Time) ([]*BasketActivity, error)
+	FindIdle(ctx context.Context, idleSince time.Time) ([]*BasketActivity, error)
+}
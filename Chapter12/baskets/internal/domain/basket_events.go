@@ -1,5 +1,9 @@
 package domain
 
+import (
+	"time"
+)
+
 type BasketStarted struct {
 	CustomerID string
 }
@@ -10,11 +14,39 @@ type BasketItemAdded struct {
 
 type BasketItemRemoved struct {
 	ProductID string
+	VariantID string
 	Quantity  int
 }
 
+type BasketAbandoned struct {
+	ExpiresAt time.Time
+}
+
+type BasketExpired struct{}
+
 type BasketCanceled struct{}
 
+type BasketItemRepriced struct {
+	ProductID string
+	Price     float64
+}
+
+// BasketItemUnavailable covers every item of the product when no variant is given
+type BasketItemUnavailable struct {
+	ProductID string
+	VariantID string
+}
+
+type BasketCouponApplied struct {
+	Code string
+}
+
+type BasketCouponRemoved struct {
+	Code string
+}
+
 type BasketCheckedOut struct {
-	PaymentID string
+	PaymentID  string
+	Discounts  map[string]float64
+	Promotions []AppliedPromotion
 }
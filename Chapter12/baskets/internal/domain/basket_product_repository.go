@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"context"
+)
+
+// BasketProductRepository indexes the products held in open baskets
+type BasketProductRepository interface {
+	Sync(ctx context.Context, basketID string, productIDs []string) error
+	FindBaskets(ctx context.Context, productID string) ([]string, error)
+}
@@ -1,9 +1,16 @@
 package domain
 
+import (
+	"time"
+)
+
 type BasketV1 struct {
 	CustomerID string
 	PaymentID  string
 	Items      map[string]Item
+	Coupons    []string
+	Promotions []AppliedPromotion
+	ExpiresAt  time.Time
 	Status     BasketStatus
 }
 
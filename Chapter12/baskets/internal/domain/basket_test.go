@@ -3,6 +3,7 @@ package domain
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -21,6 +22,19 @@ func TestBasket_AddItem(t *testing.T) {
 		Name:    "product-name",
 		Price:   10.00,
 	}
+	variant := ProductVariant{
+		ID:         "variant-id",
+		SKU:        "variant-sku",
+		Price:      12.00,
+		Attributes: map[string]string{"size": "M"},
+	}
+	variantProduct := &Product{
+		ID:       "product-id",
+		StoreID:  "store-id",
+		Name:     "product-name",
+		Price:    10.00,
+		Variants: []ProductVariant{variant},
+	}
 
 	type fields struct {
 		CustomerID string
@@ -29,9 +43,10 @@ func TestBasket_AddItem(t *testing.T) {
 		Status     BasketStatus
 	}
 	type args struct {
-		store    *Store
-		product  *Product
-		quantity int
+		store     *Store
+		product   *Product
+		variantID string
+		quantity  int
 	}
 	tests := map[string]struct {
 		fields  fields
@@ -87,6 +102,75 @@ func TestBasket_AddItem(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"ProductVariant": {
+			fields: fields{
+				Items:  make(map[string]Item),
+				Status: BasketIsOpen,
+			},
+			args: args{
+				store:     store,
+				product:   variantProduct,
+				variantID: variant.ID,
+				quantity:  1,
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", BasketItemAddedEvent, &BasketItemAdded{
+					Item: Item{
+						StoreID:      store.ID,
+						ProductID:    variantProduct.ID,
+						VariantID:    variant.ID,
+						SKU:          variant.SKU,
+						Attributes:   variant.Attributes,
+						StoreName:    store.Name,
+						ProductName:  variantProduct.Name,
+						ProductPrice: variant.Price,
+						Quantity:     1,
+					},
+				})
+			},
+			wantErr: false,
+		},
+		"VariantRequired": {
+			fields: fields{
+				Items:  make(map[string]Item),
+				Status: BasketIsOpen,
+			},
+			args: args{
+				store:    store,
+				product:  variantProduct,
+				quantity: 1,
+			},
+			wantErr: true,
+		},
+		"UnknownVariant": {
+			fields: fields{
+				Items:  make(map[string]Item),
+				Status: BasketIsOpen,
+			},
+			args: args{
+				store:     store,
+				product:   variantProduct,
+				variantID: "unknown-id",
+				quantity:  1,
+			},
+			wantErr: true,
+		},
+		"ClosedStore": {
+			fields: fields{
+				Items:  make(map[string]Item),
+				Status: BasketIsOpen,
+			},
+			args: args{
+				store: &Store{
+					ID:     "store-id",
+					Name:   "store-name",
+					Closed: true,
+				},
+				product:  product,
+				quantity: 1,
+			},
+			wantErr: true,
+		},
 		"ZeroQuantity": {
 			fields: fields{
 				Items:  make(map[string]Item),
@@ -114,7 +198,7 @@ func TestBasket_AddItem(t *testing.T) {
 				tt.on(aggregate)
 			}
 
-			if err := b.AddItem(tt.args.store, tt.args.product, tt.args.quantity); (err != nil) != tt.wantErr {
+			if _, err := b.AddItem(tt.args.store, tt.args.product, tt.args.variantID, tt.args.quantity); (err != nil) != tt.wantErr {
 				t.Errorf("AddItem() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -427,6 +511,83 @@ func TestBasket_ApplySnapshot(t *testing.T) {
 	}
 }
 
+func TestBasket_Abandon(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	item := Item{
+		StoreID:      "store-id",
+		ProductID:    "product-id",
+		StoreName:    "store-name",
+		ProductName:  "product-name",
+		ProductPrice: 10.00,
+		Quantity:     1,
+	}
+
+	type fields struct {
+		CustomerID string
+		Items      map[string]Item
+		Status     BasketStatus
+	}
+	tests := map[string]struct {
+		fields  fields
+		on      func(a *es.MockAggregate)
+		want    ddd.Event
+		wantErr bool
+	}{
+		"OpenBasket": {
+			fields: fields{
+				CustomerID: "customer-id",
+				Items:      map[string]Item{item.ProductID: item},
+				Status:     BasketIsOpen,
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", BasketAbandonedEvent, &BasketAbandoned{ExpiresAt: expiresAt})
+			},
+			want: ddd.NewEvent(BasketAbandonedEvent, &Basket{}),
+		},
+		"OpenBasket.NoItems": {
+			fields: fields{
+				CustomerID: "customer-id",
+				Items:      make(map[string]Item),
+				Status:     BasketIsOpen,
+			},
+		},
+		"CheckedOutBasket": {
+			fields: fields{
+				CustomerID: "customer-id",
+				Items:      map[string]Item{item.ProductID: item},
+				Status:     BasketIsCheckedOut,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			b := &Basket{
+				Aggregate:  aggregate,
+				CustomerID: tt.fields.CustomerID,
+				Items:      tt.fields.Items,
+				Status:     tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			got, err := b.Abandon(expiresAt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Abandon() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want.EventName(), got.EventName())
+				assert.IsType(t, tt.want.Payload(), got.Payload())
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
 func TestBasket_Cancel(t *testing.T) {
 	type fields struct {
 		CustomerID string
@@ -534,6 +695,7 @@ func TestBasket_Checkout(t *testing.T) {
 	}
 	type args struct {
 		paymentID string
+		pricing   BasketPricing
 	}
 	tests := map[string]struct {
 		fields  fields
@@ -551,10 +713,26 @@ func TestBasket_Checkout(t *testing.T) {
 				},
 				Status: BasketIsOpen,
 			},
-			args: args{paymentID: "payment-id"},
+			args: args{
+				paymentID: "payment-id",
+				pricing: BasketPricing{
+					Discounts: map[string]float64{product.ID: 1.00},
+					Promotions: []AppliedPromotion{{
+						PromotionID: "promotion-id",
+						Name:        "promotion-name",
+						Discount:    1.00,
+					}},
+				},
+			},
 			on: func(a *es.MockAggregate) {
 				a.On("AddEvent", BasketCheckedOutEvent, &BasketCheckedOut{
 					PaymentID: "payment-id",
+					Discounts: map[string]float64{product.ID: 1.00},
+					Promotions: []AppliedPromotion{{
+						PromotionID: "promotion-id",
+						Name:        "promotion-name",
+						Discount:    1.00,
+					}},
 				})
 			},
 			want: ddd.NewEvent(BasketCheckedOutEvent, &Basket{
@@ -574,6 +752,24 @@ func TestBasket_Checkout(t *testing.T) {
 			args:    args{paymentID: "payment-id"},
 			wantErr: true,
 		},
+		"OpenBasket.UnavailableItem": {
+			fields: fields{
+				CustomerID: "customer-id",
+				PaymentID:  "payment-id",
+				Items: map[string]Item{
+					product.ID: {
+						StoreID:      store.ID,
+						ProductID:    product.ID,
+						ProductPrice: product.Price,
+						Quantity:     1,
+						Unavailable:  true,
+					},
+				},
+				Status: BasketIsOpen,
+			},
+			args:    args{paymentID: "payment-id"},
+			wantErr: true,
+		},
 		"CheckedOutBasket": {
 			fields: fields{
 				CustomerID: "customer-id",
@@ -611,7 +807,7 @@ func TestBasket_Checkout(t *testing.T) {
 			}
 
 			// Act
-			got, err := b.Checkout(tt.args.paymentID)
+			got, err := b.Checkout(tt.args.paymentID, tt.args.pricing)
 
 			// Assert
 			if (err != nil) != tt.wantErr {
@@ -629,6 +825,85 @@ func TestBasket_Checkout(t *testing.T) {
 	}
 }
 
+func TestBasket_Expire(t *testing.T) {
+	type fields struct {
+		CustomerID string
+		PaymentID  string
+		Items      map[string]Item
+		Status     BasketStatus
+	}
+	tests := map[string]struct {
+		fields  fields
+		on      func(a *es.MockAggregate)
+		want    ddd.Event
+		wantErr bool
+	}{
+		"OpenBasket": {
+			fields: fields{
+				CustomerID: "customer-id",
+				PaymentID:  "payment-id",
+				Items:      make(map[string]Item),
+				Status:     BasketIsOpen,
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", BasketExpiredEvent, &BasketExpired{})
+			},
+			want: ddd.NewEvent(BasketExpiredEvent, &Basket{
+				CustomerID: "customer-id",
+				PaymentID:  "payment-id",
+				Items:      make(map[string]Item),
+				Status:     BasketIsCanceled,
+			}),
+		},
+		"CheckedOutBasket": {
+			fields: fields{
+				CustomerID: "customer-id",
+				PaymentID:  "payment-id",
+				Items:      make(map[string]Item),
+				Status:     BasketIsCheckedOut,
+			},
+			wantErr: true,
+		},
+		"CanceledBasket": {
+			fields: fields{
+				CustomerID: "customer-id",
+				PaymentID:  "payment-id",
+				Items:      make(map[string]Item),
+				Status:     BasketIsCanceled,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			b := &Basket{
+				Aggregate:  aggregate,
+				CustomerID: tt.fields.CustomerID,
+				PaymentID:  tt.fields.PaymentID,
+				Items:      tt.fields.Items,
+				Status:     tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			got, err := b.Expire()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expire() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want.EventName(), got.EventName())
+				assert.IsType(t, tt.want.Payload(), got.Payload())
+				assert.Equal(t, tt.want.Metadata(), got.Metadata())
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
 func TestBasket_RemoveItem(t *testing.T) {
 	store := &Store{
 		ID:   "store-id",
@@ -738,13 +1013,161 @@ func TestBasket_RemoveItem(t *testing.T) {
 				tt.on(aggregate)
 			}
 
-			if err := b.RemoveItem(tt.args.product, tt.args.quantity); (err != nil) != tt.wantErr {
+			if _, err := b.RemoveItem(tt.args.product, "", tt.args.quantity); (err != nil) != tt.wantErr {
 				t.Errorf("RemoveItem() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestBasket_RepriceItem(t *testing.T) {
+	item := Item{
+		StoreID:      "store-id",
+		ProductID:    "product-id",
+		ProductName:  "product-name",
+		ProductPrice: 10.00,
+		Quantity:     1,
+	}
+
+	type fields struct {
+		Items  map[string]Item
+		Status BasketStatus
+	}
+	type args struct {
+		productID string
+		price     float64
+	}
+	tests := map[string]struct {
+		fields  fields
+		args    args
+		on      func(a *es.MockAggregate)
+		want    ddd.Event
+		wantErr bool
+	}{
+		"OpenBasket": {
+			fields: fields{
+				Items:  map[string]Item{item.ProductID: item},
+				Status: BasketIsOpen,
+			},
+			args: args{productID: "product-id", price: 12.00},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", BasketItemRepricedEvent, &BasketItemRepriced{
+					ProductID: "product-id",
+					Price:     12.00,
+				})
+			},
+			want: ddd.NewEvent(BasketItemRepricedEvent, &Basket{}),
+		},
+		"OpenBasket.SamePrice": {
+			fields: fields{
+				Items:  map[string]Item{item.ProductID: item},
+				Status: BasketIsOpen,
+			},
+			args: args{productID: "product-id", price: 10.00},
+		},
+		"OpenBasket.NoItem": {
+			fields: fields{
+				Items:  make(map[string]Item),
+				Status: BasketIsOpen,
+			},
+			args: args{productID: "product-id", price: 12.00},
+		},
+		"CheckedOutBasket": {
+			fields: fields{
+				Items:  map[string]Item{item.ProductID: item},
+				Status: BasketIsCheckedOut,
+			},
+			args:    args{productID: "product-id", price: 12.00},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			b := &Basket{
+				Aggregate: aggregate,
+				Items:     tt.fields.Items,
+				Status:    tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			got, err := b.RepriceItem(tt.args.productID, tt.args.price)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RepriceItem() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				assert.Equal(t, tt.want.EventName(), got.EventName())
+				assert.IsType(t, tt.want.Payload(), got.Payload())
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestBasket_Warnings(t *testing.T) {
+	b := &Basket{
+		Aggregate: es.NewAggregate("basket-id", BasketAggregate),
+		Items: map[string]Item{
+			"apples": {ProductID: "apples", ProductName: "Apples", ProductPrice: 2.00, Quantity: 1},
+			"bread":  {ProductID: "bread", ProductName: "Bread", ProductPrice: 4.00, Quantity: 1},
+			"milk":   {ProductID: "milk", ProductName: "Milk", ProductPrice: 3.00, Quantity: 1},
+		},
+		Status: BasketIsOpen,
+	}
+
+	// repricing back to the original price clears the change
+	for _, payload := range []ddd.EventPayload{
+		&BasketItemRepriced{ProductID: "apples", Price: 2.50},
+		&BasketItemRepriced{ProductID: "bread", Price: 5.00},
+		&BasketItemRepriced{ProductID: "bread", Price: 3.50},
+		&BasketItemRepriced{ProductID: "milk", Price: 3.50},
+		&BasketItemRepriced{ProductID: "milk", Price: 3.00},
+		&BasketItemUnavailable{ProductID: "apples"},
+	} {
+		if err := b.ApplyEvent(ddd.NewEvent("", payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Equal(t, []ItemWarning{
+		{ProductID: "apples", Kind: ItemIsUnavailable, Message: "Apples is no longer available and must be removed before checking out"},
+		{ProductID: "bread", Kind: ItemPriceDecreased, Message: "the price of Bread has decreased from 4.00 to 3.50"},
+	}, b.Warnings())
+}
+
+func TestBasket_VariantItems(t *testing.T) {
+	b := &Basket{
+		Aggregate: es.NewAggregate("basket-id", BasketAggregate),
+		Items:     make(map[string]Item),
+		Status:    BasketIsOpen,
+	}
+
+	// each variant is kept apart and only the removed variant is unavailable
+	for _, payload := range []ddd.EventPayload{
+		&BasketItemAdded{Item: Item{ProductID: "shirt", VariantID: "small", ProductName: "Shirt", ProductPrice: 10.00, Quantity: 1}},
+		&BasketItemAdded{Item: Item{ProductID: "shirt", VariantID: "large", ProductName: "Shirt", ProductPrice: 12.00, Quantity: 1}},
+		&BasketItemAdded{Item: Item{ProductID: "shirt", VariantID: "small", ProductName: "Shirt", ProductPrice: 10.00, Quantity: 2}},
+		&BasketItemUnavailable{ProductID: "shirt", VariantID: "large"},
+	} {
+		if err := b.ApplyEvent(ddd.NewEvent("", payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assert.Len(t, b.Items, 2)
+	assert.Equal(t, 3, b.Items[ItemKey("shirt", "small")].Quantity)
+	assert.False(t, b.Items[ItemKey("shirt", "small")].Unavailable)
+	assert.True(t, b.Items[ItemKey("shirt", "large")].Unavailable)
+
+	event, err := b.FlagItemUnavailable("shirt", "large")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
+
 func TestBasket_Start(t *testing.T) {
 	type fields struct {
 		CustomerID string
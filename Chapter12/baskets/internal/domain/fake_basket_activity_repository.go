@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+type FakeBasketActivityRepository struct {
+	activities map[string]*BasketActivity
+}
+
+var _ BasketActivityRepository = (*FakeBasketActivityRepository)(nil)
+
+func NewFakeBasketActivityRepository() *FakeBasketActivityRepository {
+	return &FakeBasketActivityRepository{activities: map[string]*BasketActivity{}}
+}
+
+func (r *FakeBasketActivityRepository) Track(ctx context.Context, basketID, customerID string, activeAt time.Time) error {
+	r.activities[basketID] = &BasketActivity{
+		BasketID:     basketID,
+		CustomerID:   customerID,
+		LastActiveAt: activeAt,
+	}
+
+	return nil
+}
+
+func (r *FakeBasketActivityRepository) Remove(ctx context.Context, basketID string) error {
+	delete(r.activities, basketID)
+
+	return nil
+}
+
+func (r *FakeBasketActivityRepository) MarkReminded(ctx context.Context, basketID string) error {
+	if activity, exists := r.activities[basketID]; exists {
+		activity.Reminded = true
+	}
+
+	return nil
+}
+
+func (r *FakeBasketActivityRepository) Lock(ctx context.Context, basketID string) (*BasketActivity, error) {
+	if activity, exists := r.activities[basketID]; exists {
+		found := *activity
+		return &found, nil
+	}
+
+	return nil, nil
+}
+
+func (r *FakeBasketActivityRepository) FindUnreminded(ctx context.Context, idleSince time.Time) ([]*BasketActivity, error) {
+	return r.find(func(activity *BasketActivity) bool {
+		return !activity.Reminded && activity.LastActiveAt.Before(idleSince)
+	}), nil
+}
+
+func (r *FakeBasketActivityRepository) FindIdle(ctx context.Context, idleSince time.Time) ([]*BasketActivity, error) {
+	return r.find(func(activity *BasketActivity) bool {
+		return activity.LastActiveAt.Before(idleSince)
+	}), nil
+}
+
+func (r *FakeBasketActivityRepository) find(match func(activity *BasketActivity) bool) []*BasketActivity {
+	var activities []*BasketActivity
+	for _, activity := range r.activities {
+		if match(activity) {
+			found := *activity
+			activities = append(activities, &found)
+		}
+	}
+
+	sort.Slice(activities, func(i, j int) bool {
+		return activities[i].LastActiveAt.Before(activities[j].LastActiveAt)
+	})
+
+	return activities
+}
+
+func (r *FakeBasketActivityRepository) Reset(activities ...*BasketActivity) {
+	r.activities = make(map[string]*BasketActivity)
+
+	for _, activity := range activities {
+		found := *activity
+		r.activities[activity.BasketID] = &found
+	}
+}
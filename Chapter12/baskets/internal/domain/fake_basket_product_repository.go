@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"sort"
+)
+
+type FakeBasketProductRepository struct {
+	products map[string][]string
+}
+
+var _ BasketProductRepository = (*FakeBasketProductRepository)(nil)
+
+func NewFakeBasketProductRepository() *FakeBasketProductRepository {
+	return &FakeBasketProductRepository{products: map[string][]string{}}
+}
+
+func (r *FakeBasketProductRepository) Sync(ctx context.Context, basketID string, productIDs []string) error {
+	if len(productIDs) == 0 {
+		delete(r.products, basketID)
+		return nil
+	}
+
+	r.products[basketID] = append([]string(nil), productIDs...)
+
+	return nil
+}
+
+func (r *FakeBasketProductRepository) FindBaskets(ctx context.Context, productID string) ([]string, error) {
+	var basketIDs []string
+	for basketID, productIDs := range r.products {
+		for _, id := range productIDs {
+			if id == productID {
+				basketIDs = append(basketIDs, basketID)
+				break
+			}
+		}
+	}
+
+	sort.Strings(basketIDs)
+
+	return basketIDs, nil
+}
+
+func (r *FakeBasketProductRepository) Reset() {
+	r.products = make(map[string][]string)
+}
@@ -43,6 +43,28 @@ func (r *FakeProductCacheRepository) UpdatePrice(ctx context.Context, productID
 	return nil
 }
 
+func (r *FakeProductCacheRepository) AddVariant(ctx context.Context, productID string, variant ProductVariant) error {
+	if product, exists := r.products[productID]; exists {
+		product.Variants = append(product.Variants, variant)
+	}
+
+	return nil
+}
+
+func (r *FakeProductCacheRepository) RemoveVariant(ctx context.Context, productID, variantID string) error {
+	if product, exists := r.products[productID]; exists {
+		variants := make([]ProductVariant, 0, len(product.Variants))
+		for _, variant := range product.Variants {
+			if variant.ID != variantID {
+				variants = append(variants, variant)
+			}
+		}
+		product.Variants = variants
+	}
+
+	return nil
+}
+
 func (r *FakeProductCacheRepository) Remove(ctx context.Context, productID string) error {
 	delete(r.products, productID)
 
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+)
+
+type FakePromotionRepository struct {
+	promotions map[string]*Promotion
+}
+
+var _ PromotionRepository = (*FakePromotionRepository)(nil)
+
+func NewFakePromotionRepository() *FakePromotionRepository {
+	return &FakePromotionRepository{promotions: map[string]*Promotion{}}
+}
+
+func (r *FakePromotionRepository) Add(ctx context.Context, promotion *Promotion) error {
+	r.promotions[promotion.ID] = promotion
+
+	return nil
+}
+
+func (r *FakePromotionRepository) Remove(ctx context.Context, promotionID string) error {
+	delete(r.promotions, promotionID)
+
+	return nil
+}
+
+func (r *FakePromotionRepository) FindByCode(ctx context.Context, code string) ([]*Promotion, error) {
+	var promotions []*Promotion
+	for _, promotion := range r.promotions {
+		if promotion.Code == code {
+			promotions = append(promotions, promotion)
+		}
+	}
+
+	return promotions, nil
+}
+
+func (r *FakePromotionRepository) FindForStores(ctx context.Context, storeIDs []string) ([]*Promotion, error) {
+	var promotions []*Promotion
+	for _, promotion := range r.promotions {
+		if promotion.StoreID == "" {
+			promotions = append(promotions, promotion)
+			continue
+		}
+		for _, storeID := range storeIDs {
+			if promotion.StoreID == storeID {
+				promotions = append(promotions, promotion)
+				break
+			}
+		}
+	}
+
+	return promotions, nil
+}
+
+func (r *FakePromotionRepository) Reset(promotions ...*Promotion) {
+	r.promotions = make(map[string]*Promotion)
+
+	for _, promotion := range promotions {
+		r.promotions[promotion.ID] = promotion
+	}
+}
@@ -3,8 +3,29 @@ package domain
 type Item struct {
 	StoreID      string
 	ProductID    string
+	VariantID    string
+	SKU          string
+	Attributes   map[string]string
 	StoreName    string
 	ProductName  string
 	ProductPrice float64
 	Quantity     int
+	Discount     float64
+	// PreviousPrice is the price the customer saw before the product was repriced
+	PreviousPrice float64
+	Unavailable   bool
+}
+
+// Key identifies the item in the basket; each variant of a product is kept as
+// an item of its own
+func (i Item) Key() string {
+	return ItemKey(i.ProductID, i.VariantID)
+}
+
+func ItemKey(productID, variantID string) string {
+	if variantID == "" {
+		return productID
+	}
+
+	return productID + "/" + variantID
 }
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+)
+
+type ItemWarningKind string
+
+const (
+	ItemPriceIncreased ItemWarningKind = "price_increased"
+	ItemPriceDecreased ItemWarningKind = "price_decreased"
+	ItemIsUnavailable  ItemWarningKind = "unavailable"
+)
+
+type ItemWarning struct {
+	ProductID string
+	VariantID string
+	Kind      ItemWarningKind
+	Message   string
+}
+
+func (k ItemWarningKind) String() string {
+	switch k {
+	case ItemPriceIncreased, ItemPriceDecreased, ItemIsUnavailable:
+		return string(k)
+	default:
+		return ""
+	}
+}
+
+// Warning returns the change to the item the customer should be told about
+func (i Item) Warning() (ItemWarning, bool) {
+	switch {
+	case i.Unavailable:
+		return ItemWarning{
+			ProductID: i.ProductID,
+			VariantID: i.VariantID,
+			Kind:      ItemIsUnavailable,
+			Message:   fmt.Sprintf("%s is no longer available and must be removed before checking out", i.ProductName),
+		}, true
+	case i.PreviousPrice != 0 && i.ProductPrice > i.PreviousPrice:
+		return ItemWarning{
+			ProductID: i.ProductID,
+			VariantID: i.VariantID,
+			Kind:      ItemPriceIncreased,
+			Message:   fmt.Sprintf("the price of %s has increased from %.2f to %.2f", i.ProductName, i.PreviousPrice, i.ProductPrice),
+		}, true
+	case i.PreviousPrice != 0 && i.ProductPrice < i.PreviousPrice:
+		return ItemWarning{
+			ProductID: i.ProductID,
+			VariantID: i.VariantID,
+			Kind:      ItemPriceDecreased,
+			Message:   fmt.Sprintf("the price of %s has decreased from %.2f to %.2f", i.ProductName, i.PreviousPrice, i.ProductPrice),
+		}, true
+	}
+
+	return ItemWarning{}, false
+}
@@ -27,6 +27,20 @@ func (_m *MockProductCacheRepository) Add(ctx context.Context, productID string,
 	return r0
 }
 
+// AddVariant provides a mock function with given fields: ctx, productID, variant
+func (_m *MockProductCacheRepository) AddVariant(ctx context.Context, productID string, variant ProductVariant) error {
+	ret := _m.Called(ctx, productID, variant)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ProductVariant) error); ok {
+		r0 = rf(ctx, productID, variant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Find provides a mock function with given fields: ctx, productID
 func (_m *MockProductCacheRepository) Find(ctx context.Context, productID string) (*Product, error) {
 	ret := _m.Called(ctx, productID)
@@ -78,6 +92,20 @@ func (_m *MockProductCacheRepository) Remove(ctx context.Context, productID stri
 	return r0
 }
 
+// RemoveVariant provides a mock function with given fields: ctx, productID, variantID
+func (_m *MockProductCacheRepository) RemoveVariant(ctx context.Context, productID string, variantID string) error {
+	ret := _m.Called(ctx, productID, variantID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, productID, variantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePrice provides a mock function with given fields: ctx, productID, delta
 func (_m *MockProductCacheRepository) UpdatePrice(ctx context.Context, productID string, delta float64) error {
 	ret := _m.Called(ctx, productID, delta)
@@ -64,6 +64,20 @@ func (_m *MockStoreCacheRepository) Rename(ctx context.Context, storeID string,
 	return r0
 }
 
+// UpdateClosed provides a mock function with given fields: ctx, storeID, closed
+func (_m *MockStoreCacheRepository) UpdateClosed(ctx context.Context, storeID string, closed bool) error {
+	ret := _m.Called(ctx, storeID, closed)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, storeID, closed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockStoreCacheRepository interface {
 	mock.TestingT
 	Cleanup(func())
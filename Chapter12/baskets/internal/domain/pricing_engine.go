@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// AppliedPromotion records a promotion that discounted a basket
+type AppliedPromotion struct {
+	PromotionID string
+	Name        string
+	Code        string
+	Discount    float64
+}
+
+// BasketPricing is the result of running a basket through the PricingEngine
+type BasketPricing struct {
+	// Discounts are kept by the Key of the discounted items
+	Discounts  map[string]float64
+	Promotions []AppliedPromotion
+	Subtotal   float64
+	Discount   float64
+	Total      float64
+}
+
+// PricingEngine calculates the line and basket totals for a basket
+//
+// Promotions do not stack within the same scope; the best promotion for each
+// product is applied first, then the best for each store and finally the best
+// for the basket as a whole. Store and basket discounts are spread over the
+// lines in that scope so every line carries its share of the discount.
+type PricingEngine struct {
+	promotions []*Promotion
+}
+
+type pricedLine struct {
+	item     Item
+	total    float64
+	discount float64
+}
+
+func NewPricingEngine(promotions []*Promotion) PricingEngine {
+	sorted := make([]*Promotion, len(promotions))
+	copy(sorted, promotions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	return PricingEngine{promotions: sorted}
+}
+
+func (e PricingEngine) Price(items map[string]Item, coupons []string) BasketPricing {
+	pricing := BasketPricing{
+		Discounts: make(map[string]float64),
+	}
+
+	lines := make([]*pricedLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, &pricedLine{
+			item:  item,
+			total: roundCents(item.ProductPrice * float64(item.Quantity)),
+		})
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].item.Key() < lines[j].item.Key()
+	})
+
+	promotions := e.eligible(coupons)
+
+	// product promotions
+	for _, line := range lines {
+		promotion, discount := e.best(promotions, line.total, func(p *Promotion) bool {
+			return p.ProductID == line.item.ProductID
+		}, func(p *Promotion) float64 {
+			return lineDiscount(p, line)
+		})
+		if promotion != nil {
+			line.discount = discount
+			pricing.apply(promotion, discount)
+		}
+	}
+
+	// store promotions
+	storeLines := make(map[string][]*pricedLine)
+	storeIDs := make([]string, 0)
+	for _, line := range lines {
+		if _, exists := storeLines[line.item.StoreID]; !exists {
+			storeIDs = append(storeIDs, line.item.StoreID)
+		}
+		storeLines[line.item.StoreID] = append(storeLines[line.item.StoreID], line)
+	}
+	for _, storeID := range storeIDs {
+		scope := storeLines[storeID]
+		promotion, discount := e.best(promotions, remaining(scope), func(p *Promotion) bool {
+			return p.StoreID == storeID && p.ProductID == ""
+		}, func(p *Promotion) float64 {
+			return scopeDiscount(p, remaining(scope))
+		})
+		if promotion != nil {
+			allocate(scope, discount)
+			pricing.apply(promotion, discount)
+		}
+	}
+
+	// basket promotions
+	promotion, discount := e.best(promotions, remaining(lines), func(p *Promotion) bool {
+		return p.StoreID == "" && p.ProductID == ""
+	}, func(p *Promotion) float64 {
+		return scopeDiscount(p, remaining(lines))
+	})
+	if promotion != nil {
+		allocate(lines, discount)
+		pricing.apply(promotion, discount)
+	}
+
+	for _, line := range lines {
+		pricing.Subtotal += line.total
+		if line.discount > 0 {
+			pricing.Discounts[line.item.Key()] = roundCents(line.discount)
+			pricing.Discount += line.discount
+		}
+	}
+	pricing.Subtotal = roundCents(pricing.Subtotal)
+	pricing.Discount = roundCents(pricing.Discount)
+	pricing.Total = roundCents(pricing.Subtotal - pricing.Discount)
+
+	return pricing
+}
+
+func (e PricingEngine) eligible(coupons []string) []*Promotion {
+	codes := make(map[string]struct{}, len(coupons))
+	for _, code := range coupons {
+		codes[NormalizeCouponCode(code)] = struct{}{}
+	}
+
+	promotions := make([]*Promotion, 0, len(e.promotions))
+	for _, promotion := range e.promotions {
+		if promotion.IsCoupon() {
+			if _, exists := codes[NormalizeCouponCode(promotion.Code)]; !exists {
+				continue
+			}
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	return promotions
+}
+
+func (e PricingEngine) best(promotions []*Promotion, spend float64, inScope func(*Promotion) bool, discountFn func(*Promotion) float64) (*Promotion, float64) {
+	var best *Promotion
+	var bestDiscount float64
+
+	for _, promotion := range promotions {
+		if !inScope(promotion) || spend < promotion.MinimumSpend {
+			continue
+		}
+		if discount := discountFn(promotion); discount > bestDiscount {
+			best = promotion
+			bestDiscount = discount
+		}
+	}
+
+	return best, bestDiscount
+}
+
+func (p *BasketPricing) apply(promotion *Promotion, discount float64) {
+	p.Promotions = append(p.Promotions, AppliedPromotion{
+		PromotionID: promotion.ID,
+		Name:        promotion.Name,
+		Code:        promotion.Code,
+		Discount:    discount,
+	})
+}
+
+func lineDiscount(promotion *Promotion, line *pricedLine) float64 {
+	var discount float64
+
+	switch promotion.Kind {
+	case PercentDiscount:
+		discount = line.total * promotion.Percent / 100
+	case AmountDiscount:
+		discount = promotion.Amount * float64(line.item.Quantity)
+	case BuyXGetY:
+		free := line.item.Quantity / (promotion.BuyQuantity + promotion.GetQuantity) * promotion.GetQuantity
+		discount = line.item.ProductPrice * float64(free)
+	}
+
+	return roundCents(math.Min(discount, line.total))
+}
+
+func scopeDiscount(promotion *Promotion, spend float64) float64 {
+	var discount float64
+
+	switch promotion.Kind {
+	case PercentDiscount:
+		discount = spend * promotion.Percent / 100
+	case AmountDiscount:
+		discount = promotion.Amount
+	}
+
+	return roundCents(math.Min(discount, spend))
+}
+
+func remaining(lines []*pricedLine) float64 {
+	var total float64
+	for _, line := range lines {
+		total += line.total - line.discount
+	}
+	return roundCents(total)
+}
+
+// allocate spreads a discount over the lines in proportion to what is left
+// to pay on each line; the last line absorbs any rounding difference
+func allocate(lines []*pricedLine, discount float64) {
+	spend := remaining(lines)
+	if spend <= 0 {
+		return
+	}
+
+	left := discount
+	for i, line := range lines {
+		share := roundCents(discount * (line.total - line.discount) / spend)
+		if i == len(lines)-1 || share > left {
+			share = left
+		}
+		share = math.Min(share, roundCents(line.total-line.discount))
+		line.discount = roundCents(line.discount + share)
+		left = roundCents(left - share)
+	}
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
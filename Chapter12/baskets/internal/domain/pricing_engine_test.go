@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingEngine_Price(t *testing.T) {
+	items := map[string]Item{
+		"apples": {
+			StoreID:      "store-a",
+			ProductID:    "apples",
+			ProductPrice: 2.00,
+			Quantity:     6,
+		},
+		"bread": {
+			StoreID:      "store-a",
+			ProductID:    "bread",
+			ProductPrice: 4.00,
+			Quantity:     1,
+		},
+		"candles": {
+			StoreID:      "store-b",
+			ProductID:    "candles",
+			ProductPrice: 10.00,
+			Quantity:     2,
+		},
+	}
+
+	type args struct {
+		items   map[string]Item
+		coupons []string
+	}
+	tests := map[string]struct {
+		promotions []*Promotion
+		args       args
+		want       BasketPricing
+	}{
+		"NoPromotions": {
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{},
+				Subtotal:  36.00,
+				Total:     36.00,
+			},
+		},
+		"PercentDiscount": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "10% off candles", StoreID: "store-b", ProductID: "candles", Kind: PercentDiscount, Percent: 10},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{"candles": 2.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p1", Name: "10% off candles", Discount: 2.00},
+				},
+				Subtotal: 36.00,
+				Discount: 2.00,
+				Total:    34.00,
+			},
+		},
+		"BuyXGetY": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "buy 2 apples get 1 free", StoreID: "store-a", ProductID: "apples", Kind: BuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{"apples": 4.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p1", Name: "buy 2 apples get 1 free", Discount: 4.00},
+				},
+				Subtotal: 36.00,
+				Discount: 4.00,
+				Total:    32.00,
+			},
+		},
+		"BestProductPromotionWins": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "buy 2 apples get 1 free", StoreID: "store-a", ProductID: "apples", Kind: BuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+				{ID: "p2", Name: "50% off apples", StoreID: "store-a", ProductID: "apples", Kind: PercentDiscount, Percent: 50},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{"apples": 6.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p2", Name: "50% off apples", Discount: 6.00},
+				},
+				Subtotal: 36.00,
+				Discount: 6.00,
+				Total:    30.00,
+			},
+		},
+		"StoreCouponWithoutCode": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "$4 off store a", StoreID: "store-a", Code: "SAVE4", Kind: AmountDiscount, Amount: 4},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{},
+				Subtotal:  36.00,
+				Total:     36.00,
+			},
+		},
+		"StoreCouponWithCode": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "$4 off store a", StoreID: "store-a", Code: "SAVE4", Kind: AmountDiscount, Amount: 4},
+			},
+			args: args{items: items, coupons: []string{"save4"}},
+			want: BasketPricing{
+				Discounts: map[string]float64{"apples": 3.00, "bread": 1.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p1", Name: "$4 off store a", Code: "SAVE4", Discount: 4.00},
+				},
+				Subtotal: 36.00,
+				Discount: 4.00,
+				Total:    32.00,
+			},
+		},
+		"BasketThresholdMet": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "10% off over $30", Kind: PercentDiscount, Percent: 10, MinimumSpend: 30},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{"apples": 1.20, "bread": 0.40, "candles": 2.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p1", Name: "10% off over $30", Discount: 3.60},
+				},
+				Subtotal: 36.00,
+				Discount: 3.60,
+				Total:    32.40,
+			},
+		},
+		"BasketThresholdNotMetAfterDiscounts": {
+			promotions: []*Promotion{
+				{ID: "p1", Name: "50% off candles", StoreID: "store-b", ProductID: "candles", Kind: PercentDiscount, Percent: 50},
+				{ID: "p2", Name: "$5 off over $35", Kind: AmountDiscount, Amount: 5, MinimumSpend: 35},
+			},
+			args: args{items: items},
+			want: BasketPricing{
+				Discounts: map[string]float64{"candles": 10.00},
+				Promotions: []AppliedPromotion{
+					{PromotionID: "p1", Name: "50% off candles", Discount: 10.00},
+				},
+				Subtotal: 36.00,
+				Discount: 10.00,
+				Total:    26.00,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := NewPricingEngine(tt.promotions)
+			got := e.Price(tt.args.items, tt.args.coupons)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
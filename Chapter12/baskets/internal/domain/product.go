@@ -1,8 +1,28 @@
 package domain
 
 type Product struct {
-	ID      string
-	StoreID string
-	Name    string
-	Price   float64
+	ID       string
+	StoreID  string
+	Name     string
+	Price    float64
+	Variants []ProductVariant
+}
+
+// ProductVariant is a version of a product, such as a size or a color, that is
+// sold under its own SKU and price
+type ProductVariant struct {
+	ID         string
+	SKU        string
+	Price      float64
+	Attributes map[string]string
+}
+
+func (p Product) Variant(variantID string) (ProductVariant, bool) {
+	for _, variant := range p.Variants {
+		if variant.ID == variantID {
+			return variant, true
+		}
+	}
+
+	return ProductVariant{}, false
 }
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/stackus/errors"
+)
+
+type PromotionKind string
+
+const (
+	PromotionIsUnknown PromotionKind = ""
+	PercentDiscount    PromotionKind = "percent"
+	AmountDiscount     PromotionKind = "amount"
+	BuyXGetY           PromotionKind = "buy_x_get_y"
+)
+
+var (
+	ErrPromotionIDCannotBeBlank   = errors.Wrap(errors.ErrBadRequest, "the promotion id cannot be blank")
+	ErrPromotionNameCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the promotion name cannot be blank")
+	ErrPromotionKindIsInvalid     = errors.Wrap(errors.ErrBadRequest, "the promotion kind is invalid")
+	ErrPromotionPercentIsInvalid  = errors.Wrap(errors.ErrBadRequest, "the promotion percent must be greater than 0 and at most 100")
+	ErrPromotionAmountIsInvalid   = errors.Wrap(errors.ErrBadRequest, "the promotion amount must be greater than 0")
+	ErrPromotionBuyXGetYIsInvalid = errors.Wrap(errors.ErrBadRequest, "buy x get y promotions require a product and quantities greater than 0")
+	ErrPromotionStoreIsRequired   = errors.Wrap(errors.ErrBadRequest, "product promotions require the store of the product")
+	ErrMinimumSpendIsInvalid      = errors.Wrap(errors.ErrBadRequest, "the minimum spend cannot be negative")
+)
+
+// Promotion is a discount offered by a merchant
+//
+// The scope of a promotion is narrowed by the StoreID and ProductID; a
+// promotion without either applies to the whole basket. Promotions that
+// have a Code are coupons and only apply once the code is added to a basket.
+type Promotion struct {
+	ID           string
+	Name         string
+	StoreID      string
+	ProductID    string
+	Code         string
+	Kind         PromotionKind
+	Percent      float64
+	Amount       float64
+	BuyQuantity  int
+	GetQuantity  int
+	MinimumSpend float64
+}
+
+func (k PromotionKind) String() string {
+	switch k {
+	case PercentDiscount, AmountDiscount, BuyXGetY:
+		return string(k)
+	default:
+		return ""
+	}
+}
+
+func ToPromotionKind(kind string) PromotionKind {
+	switch kind {
+	case PercentDiscount.String():
+		return PercentDiscount
+	case AmountDiscount.String():
+		return AmountDiscount
+	case BuyXGetY.String():
+		return BuyXGetY
+	default:
+		return PromotionIsUnknown
+	}
+}
+
+// NormalizeCouponCode makes coupon codes case and whitespace insensitive
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p Promotion) Validate() error {
+	if p.ID == "" {
+		return ErrPromotionIDCannotBeBlank
+	}
+
+	if p.Name == "" {
+		return ErrPromotionNameCannotBeBlank
+	}
+
+	if p.ProductID != "" && p.StoreID == "" {
+		return ErrPromotionStoreIsRequired
+	}
+
+	if p.MinimumSpend < 0 {
+		return ErrMinimumSpendIsInvalid
+	}
+
+	switch p.Kind {
+	case PercentDiscount:
+		if p.Percent <= 0 || p.Percent > 100 {
+			return ErrPromotionPercentIsInvalid
+		}
+	case AmountDiscount:
+		if p.Amount <= 0 {
+			return ErrPromotionAmountIsInvalid
+		}
+	case BuyXGetY:
+		if p.ProductID == "" || p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return ErrPromotionBuyXGetYIsInvalid
+		}
+	default:
+		return ErrPromotionKindIsInvalid
+	}
+
+	return nil
+}
+
+// IsCoupon reports whether the promotion requires a coupon code
+func (p Promotion) IsCoupon() bool {
+	return p.Code != ""
+}
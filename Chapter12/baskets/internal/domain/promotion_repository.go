@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"context"
+)
+
+type PromotionRepository interface {
+	Add(ctx context.Context, promotion *Promotion) error
+	Remove(ctx context.Context, promotionID string) error
+	FindByCode(ctx context.Context, code string) ([]*Promotion, error)
+	FindForStores(ctx context.Context, storeIDs []string) ([]*Promotion, error)
+}
@@ -7,11 +7,17 @@ import (
 )
 
 const (
-	BasketStartedEvent     = "baskets.BasketStarted"
-	BasketItemAddedEvent   = "baskets.BasketItemAdded"
-	BasketItemRemovedEvent = "baskets.BasketItemRemoved"
-	BasketCanceledEvent    = "baskets.BasketCanceled"
-	BasketCheckedOutEvent  = "baskets.BasketCheckedOut"
+	BasketStartedEvent         = "baskets.BasketStarted"
+	BasketItemAddedEvent       = "baskets.BasketItemAdded"
+	BasketItemRemovedEvent     = "baskets.BasketItemRemoved"
+	BasketItemRepricedEvent    = "baskets.BasketItemRepriced"
+	BasketItemUnavailableEvent = "baskets.BasketItemUnavailable"
+	BasketCouponAppliedEvent   = "baskets.BasketCouponApplied"
+	BasketCouponRemovedEvent   = "baskets.BasketCouponRemoved"
+	BasketAbandonedEvent       = "baskets.BasketAbandoned"
+	BasketExpiredEvent         = "baskets.BasketExpired"
+	BasketCanceledEvent        = "baskets.BasketCanceled"
+	BasketCheckedOutEvent      = "baskets.BasketCheckedOut"
 )
 
 func Registrations(reg registry.Registry) error {
@@ -42,6 +48,24 @@ func Registrations(reg registry.Registry) error {
 	if err := serde.Register(BasketItemRemoved{}); err != nil {
 		return err
 	}
+	if err := serde.Register(BasketItemRepriced{}); err != nil {
+		return err
+	}
+	if err := serde.Register(BasketItemUnavailable{}); err != nil {
+		return err
+	}
+	if err := serde.Register(BasketCouponApplied{}); err != nil {
+		return err
+	}
+	if err := serde.Register(BasketCouponRemoved{}); err != nil {
+		return err
+	}
+	if err := serde.Register(BasketAbandoned{}); err != nil {
+		return err
+	}
+	if err := serde.Register(BasketExpired{}); err != nil {
+		return err
+	}
 	// basket snapshots
 	if err := serde.RegisterKey(BasketV1{}.SnapshotName(), BasketV1{}); err != nil {
 		return err
@@ -52,8 +76,14 @@ func Registrations(reg registry.Registry) error {
 
 func (Basket) Key() string { return BasketAggregate }
 
-func (BasketStarted) Key() string     { return BasketStartedEvent }
-func (BasketItemAdded) Key() string   { return BasketItemAddedEvent }
-func (BasketItemRemoved) Key() string { return BasketItemRemovedEvent }
-func (BasketCanceled) Key() string    { return BasketCanceledEvent }
-func (BasketCheckedOut) Key() string  { return BasketCheckedOutEvent }
+func (BasketStarted) Key() string         { return BasketStartedEvent }
+func (BasketItemAdded) Key() string       { return BasketItemAddedEvent }
+func (BasketItemRemoved) Key() string     { return BasketItemRemovedEvent }
+func (BasketItemRepriced) Key() string    { return BasketItemRepricedEvent }
+func (BasketItemUnavailable) Key() string { return BasketItemUnavailableEvent }
+func (BasketCouponApplied) Key() string   { return BasketCouponAppliedEvent }
+func (BasketCouponRemoved) Key() string   { return BasketCouponRemovedEvent }
+func (BasketAbandoned) Key() string       { return BasketAbandonedEvent }
+func (BasketExpired) Key() string         { return BasketExpiredEvent }
+func (BasketCanceled) Key() string        { return BasketCanceledEvent }
+func (BasketCheckedOut) Key() string      { return BasketCheckedOutEvent }
@@ -3,4 +3,13 @@ package domain
 type Store struct {
 	ID   string
 	Name string
+	// Closed is set while the schedule of the store keeps it out of the mall
+	Closed bool
+}
+
+// IsStoreClosed is the one rule for closing a store; only a store whose opening
+// hours have taken it out of the mall is closed, stores toggled by hand are not
+// so that stores that never took part in the mall keep their products on sale
+func IsStoreClosed(participating, scheduled bool) bool {
+	return scheduled && !participating
 }
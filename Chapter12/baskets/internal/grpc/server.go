@@ -93,11 +93,13 @@ func (s server) AddItem(ctx context.Context, request *basketspb.AddItemRequest)
 	span.SetAttributes(
 		attribute.String("BasketID", request.GetId()),
 		attribute.String("ProductID", request.GetProductId()),
+		attribute.String("VariantID", request.GetVariantId()),
 	)
 
 	err := s.app.AddItem(ctx, application.AddItem{
 		ID:        request.GetId(),
 		ProductID: request.GetProductId(),
+		VariantID: request.GetVariantId(),
 		Quantity:  int(request.GetQuantity()),
 	})
 	if err != nil {
@@ -114,11 +116,13 @@ func (s server) RemoveItem(ctx context.Context, request *basketspb.RemoveItemReq
 	span.SetAttributes(
 		attribute.String("BasketID", request.GetId()),
 		attribute.String("ProductID", request.GetProductId()),
+		attribute.String("VariantID", request.GetVariantId()),
 	)
 
 	err := s.app.RemoveItem(ctx, application.RemoveItem{
 		ID:        request.GetId(),
 		ProductID: request.GetProductId(),
+		VariantID: request.GetVariantId(),
 		Quantity:  int(request.GetQuantity()),
 	})
 	if err != nil {
@@ -145,14 +149,117 @@ func (s server) GetBasket(ctx context.Context, request *basketspb.GetBasketReque
 		return nil, err
 	}
 
+	pricing, err := s.app.PriceBasket(ctx, application.PriceBasket{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
 	return &basketspb.GetBasketResponse{
-		Basket: s.basketFromDomain(basket),
+		Basket: s.basketFromDomain(basket, pricing),
 	}, nil
 }
 
-func (s server) basketFromDomain(basket *domain.Basket) *basketspb.Basket {
+func (s server) ApplyCoupon(ctx context.Context, request *basketspb.ApplyCouponRequest) (*basketspb.ApplyCouponResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("BasketID", request.GetId()),
+		attribute.String("Code", request.GetCode()),
+	)
+
+	err := s.app.ApplyCoupon(ctx, application.ApplyCoupon{
+		ID:   request.GetId(),
+		Code: request.GetCode(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &basketspb.ApplyCouponResponse{}, err
+}
+
+func (s server) RemoveCoupon(ctx context.Context, request *basketspb.RemoveCouponRequest) (*basketspb.RemoveCouponResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("BasketID", request.GetId()),
+		attribute.String("Code", request.GetCode()),
+	)
+
+	err := s.app.RemoveCoupon(ctx, application.RemoveCoupon{
+		ID:   request.GetId(),
+		Code: request.GetCode(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &basketspb.RemoveCouponResponse{}, err
+}
+
+func (s server) AddPromotion(ctx context.Context, request *basketspb.AddPromotionRequest) (*basketspb.AddPromotionResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	promotionID := uuid.New().String()
+	promotion := request.GetPromotion()
+
+	span.SetAttributes(
+		attribute.String("PromotionID", promotionID),
+		attribute.String("StoreID", promotion.GetStoreId()),
+	)
+
+	err := s.app.AddPromotion(ctx, application.AddPromotion{
+		ID:           promotionID,
+		Name:         promotion.GetName(),
+		StoreID:      promotion.GetStoreId(),
+		ProductID:    promotion.GetProductId(),
+		Code:         promotion.GetCode(),
+		Kind:         promotion.GetKind(),
+		Percent:      promotion.GetPercent(),
+		Amount:       promotion.GetAmount(),
+		BuyQuantity:  int(promotion.GetBuyQuantity()),
+		GetQuantity:  int(promotion.GetGetQuantity()),
+		MinimumSpend: promotion.GetMinimumSpend(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &basketspb.AddPromotionResponse{Id: promotionID}, err
+}
+
+func (s server) RemovePromotion(ctx context.Context, request *basketspb.RemovePromotionRequest) (*basketspb.RemovePromotionResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("PromotionID", request.GetId()),
+	)
+
+	err := s.app.RemovePromotion(ctx, application.RemovePromotion{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &basketspb.RemovePromotionResponse{}, err
+}
+
+func (s server) basketFromDomain(basket *domain.Basket, pricing *domain.BasketPricing) *basketspb.Basket {
 	protoBasket := &basketspb.Basket{
-		Id: basket.ID(),
+		Id:       basket.ID(),
+		Coupons:  basket.Coupons,
+		Subtotal: pricing.Subtotal,
+		Discount: pricing.Discount,
+		Total:    pricing.Total,
 	}
 
 	protoBasket.Items = make([]*basketspb.Item, 0, len(basket.Items))
@@ -165,6 +272,33 @@ func (s server) basketFromDomain(basket *domain.Basket) *basketspb.Basket {
 			ProductName:  item.ProductName,
 			ProductPrice: item.ProductPrice,
 			Quantity:     int32(item.Quantity),
+			Discount:     pricing.Discounts[item.Key()],
+			VariantId:    item.VariantID,
+			Sku:          item.SKU,
+			Attributes:   item.Attributes,
+		})
+	}
+
+	protoBasket.Promotions = make([]*basketspb.AppliedPromotion, 0, len(pricing.Promotions))
+
+	for _, promotion := range pricing.Promotions {
+		protoBasket.Promotions = append(protoBasket.Promotions, &basketspb.AppliedPromotion{
+			PromotionId: promotion.PromotionID,
+			Name:        promotion.Name,
+			Code:        promotion.Code,
+			Discount:    promotion.Discount,
+		})
+	}
+
+	warnings := basket.Warnings()
+	protoBasket.Warnings = make([]*basketspb.Warning, 0, len(warnings))
+
+	for _, warning := range warnings {
+		protoBasket.Warnings = append(protoBasket.Warnings, &basketspb.Warning{
+			ProductId: warning.ProductID,
+			Kind:      warning.Kind.String(),
+			Message:   warning.Message,
+			VariantId: warning.VariantID,
 		})
 	}
 
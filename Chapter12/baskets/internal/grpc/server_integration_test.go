@@ -21,10 +21,13 @@ import (
 
 type serverSuite struct {
 	mocks struct {
-		baskets   *domain.MockBasketRepository
-		stores    *domain.MockStoreRepository
-		products  *domain.MockProductRepository
-		publisher *ddd.MockEventPublisher[ddd.Event]
+		baskets        *domain.MockBasketRepository
+		stores         *domain.MockStoreRepository
+		products       *domain.MockProductRepository
+		promotions     *domain.MockPromotionRepository
+		activity       *domain.MockBasketActivityRepository
+		basketProducts *domain.MockBasketProductRepository
+		publisher      *ddd.MockEventPublisher[ddd.Event]
 	}
 	server *grpc.Server
 	client basketspb.BasketServiceClient
@@ -52,19 +55,25 @@ func (s *serverSuite) SetupTest() {
 
 	// create mocks
 	s.mocks = struct {
-		baskets   *domain.MockBasketRepository
-		stores    *domain.MockStoreRepository
-		products  *domain.MockProductRepository
-		publisher *ddd.MockEventPublisher[ddd.Event]
+		baskets        *domain.MockBasketRepository
+		stores         *domain.MockStoreRepository
+		products       *domain.MockProductRepository
+		promotions     *domain.MockPromotionRepository
+		activity       *domain.MockBasketActivityRepository
+		basketProducts *domain.MockBasketProductRepository
+		publisher      *ddd.MockEventPublisher[ddd.Event]
 	}{
-		baskets:   domain.NewMockBasketRepository(s.T()),
-		stores:    domain.NewMockStoreRepository(s.T()),
-		products:  domain.NewMockProductRepository(s.T()),
-		publisher: ddd.NewMockEventPublisher[ddd.Event](s.T()),
+		baskets:        domain.NewMockBasketRepository(s.T()),
+		stores:         domain.NewMockStoreRepository(s.T()),
+		products:       domain.NewMockProductRepository(s.T()),
+		promotions:     domain.NewMockPromotionRepository(s.T()),
+		activity:       domain.NewMockBasketActivityRepository(s.T()),
+		basketProducts: domain.NewMockBasketProductRepository(s.T()),
+		publisher:      ddd.NewMockEventPublisher[ddd.Event](s.T()),
 	}
 
 	// create app
-	app := application.New(s.mocks.baskets, s.mocks.stores, s.mocks.products, s.mocks.publisher)
+	app := application.New(s.mocks.baskets, s.mocks.stores, s.mocks.products, s.mocks.promotions, s.mocks.activity, s.mocks.basketProducts, s.mocks.publisher)
 
 	// register app with server
 	if err = RegisterServer(app, s.server); err != nil {
@@ -130,6 +139,7 @@ func (s *serverSuite) TestBasketService_CheckoutBasket() {
 		Status: domain.BasketIsOpen,
 	}, nil)
 	s.mocks.baskets.On("Save", mock.Anything, mock.AnythingOfType("*domain.Basket")).Return(nil)
+	s.mocks.promotions.On("FindForStores", mock.Anything, []string{"store-id"}).Return([]*domain.Promotion{}, nil)
 	s.mocks.publisher.On("Publish", mock.Anything, mock.AnythingOfType("ddd.event")).Return(nil)
 
 	_, err := s.client.CheckoutBasket(context.Background(), &basketspb.CheckoutBasketRequest{
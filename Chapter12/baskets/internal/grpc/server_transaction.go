@@ -92,6 +92,50 @@ func (s serverTx) GetBasket(ctx context.Context, request *basketspb.GetBasketReq
 	return next.GetBasket(ctx, request)
 }
 
+func (s serverTx) ApplyCoupon(ctx context.Context, request *basketspb.ApplyCouponRequest) (resp *basketspb.ApplyCouponResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ApplyCoupon(ctx, request)
+}
+
+func (s serverTx) RemoveCoupon(ctx context.Context, request *basketspb.RemoveCouponRequest) (resp *basketspb.RemoveCouponResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.RemoveCoupon(ctx, request)
+}
+
+func (s serverTx) AddPromotion(ctx context.Context, request *basketspb.AddPromotionRequest) (resp *basketspb.AddPromotionResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.AddPromotion(ctx, request)
+}
+
+func (s serverTx) RemovePromotion(ctx context.Context, request *basketspb.RemovePromotionRequest) (resp *basketspb.RemovePromotionResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.RemovePromotion(ctx, request)
+}
+
 func (s serverTx) closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
@@ -45,8 +45,9 @@ func (r StoreRepository) Find(ctx context.Context, storeID string) (store *domai
 
 func (r StoreRepository) storeToDomain(store *storespb.Store) *domain.Store {
 	return &domain.Store{
-		ID:   store.GetId(),
-		Name: store.GetName(),
+		ID:     store.GetId(),
+		Name:   store.GetName(),
+		Closed: domain.IsStoreClosed(store.GetParticipating(), len(store.GetSchedule().GetHours()) > 0),
 	}
 }
 
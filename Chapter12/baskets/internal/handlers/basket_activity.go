@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type activityHandlers[T ddd.Event] struct {
+	activity domain.BasketActivityRepository
+}
+
+var _ ddd.EventHandler[ddd.Event] = (*activityHandlers[ddd.Event])(nil)
+
+func NewActivityHandlers(activity domain.BasketActivityRepository) ddd.EventHandler[ddd.Event] {
+	return activityHandlers[ddd.Event]{
+		activity: activity,
+	}
+}
+
+func RegisterActivityHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.BasketStartedEvent,
+		domain.BasketItemAddedEvent,
+		domain.BasketItemRemovedEvent,
+		domain.BasketCouponAppliedEvent,
+		domain.BasketCouponRemovedEvent,
+		domain.BasketCanceledEvent,
+		domain.BasketCheckedOutEvent,
+		domain.BasketExpiredEvent,
+	)
+}
+
+func (h activityHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	basket := event.Payload().(*domain.Basket)
+
+	switch event.EventName() {
+	case domain.BasketCanceledEvent, domain.BasketCheckedOutEvent, domain.BasketExpiredEvent:
+		return h.activity.Remove(ctx, basket.ID())
+	}
+
+	return h.activity.Track(ctx, basket.ID(), basket.CustomerID, event.OccurredAt())
+}
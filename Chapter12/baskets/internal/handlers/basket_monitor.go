@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/baskets/internal/application"
+	"eda-in-golang/baskets/internal/config"
+	"eda-in-golang/baskets/internal/constants"
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+)
+
+// StartBasketMonitor periodically reminds customers about the baskets they
+// have left idle and then expires the baskets that stay idle for too long; each
+// tenant is monitored on its own using its own idle settings
+//
+// Each basket is reminded or expired in its own transaction so one basket
+// that fails is logged and tried again on the next tick without holding back
+// the others
+func StartBasketMonitor(ctx context.Context, container di.Container, cfg config.Config, tenants tenant.Tenants, logger zerolog.Logger) {
+	go func() {
+		ticker := time.NewTicker(cfg.MonitorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, tenantID := range tenants.IDs() {
+					monitorIdleBaskets(tenant.WithID(ctx, tenantID), container, cfg.ForTenant(tenantID), logger.With().Str("Tenant", tenantID).Logger())
+				}
+			}
+		}
+	}()
+}
+
+func monitorIdleBaskets(ctx context.Context, container di.Container, cfg config.Config, logger zerolog.Logger) {
+	now := time.Now()
+
+	expireIdleSince := now.Add(-cfg.IdleTimeout)
+	idle, err := findIdleBaskets(ctx, container, func(ctx context.Context, app application.App) ([]*domain.BasketActivity, error) {
+		return app.GetIdleBaskets(ctx, application.GetIdleBaskets{IdleSince: expireIdleSince})
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("baskets basket monitor failed to find idle baskets")
+		return
+	}
+	for _, activity := range idle {
+		err = withApplication(ctx, container, func(ctx context.Context, app application.App) error {
+			return app.ExpireIdleBasket(ctx, application.ExpireIdleBasket{
+				ID:        activity.BasketID,
+				IdleSince: expireIdleSince,
+			})
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("BasketID", activity.BasketID).Msg("baskets basket monitor failed to expire a basket")
+		}
+	}
+
+	remindIdleSince := now.Add(-(cfg.IdleTimeout - cfg.RemindBefore))
+	unreminded, err := findIdleBaskets(ctx, container, func(ctx context.Context, app application.App) ([]*domain.BasketActivity, error) {
+		return app.GetUnremindedBaskets(ctx, application.GetUnremindedBaskets{IdleSince: remindIdleSince})
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("baskets basket monitor failed to find unreminded baskets")
+		return
+	}
+	for _, activity := range unreminded {
+		err = withApplication(ctx, container, func(ctx context.Context, app application.App) error {
+			return app.RemindIdleBasket(ctx, application.RemindIdleBasket{
+				ID:          activity.BasketID,
+				IdleSince:   remindIdleSince,
+				ExpireAfter: cfg.IdleTimeout,
+			})
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("BasketID", activity.BasketID).Msg("baskets basket monitor failed to remind a basket")
+		}
+	}
+}
+
+func findIdleBaskets(ctx context.Context, container di.Container, find func(context.Context, application.App) ([]*domain.BasketActivity, error)) (idle []*domain.BasketActivity, err error) {
+	err = withApplication(ctx, container, func(ctx context.Context, app application.App) error {
+		idle, err = find(ctx, app)
+		return err
+	})
+
+	return idle, err
+}
+
+func withApplication(ctx context.Context, container di.Container, fn func(context.Context, application.App) error) (err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	return fn(ctx, di.Get(ctx, constants.ApplicationKey).(application.App))
+}
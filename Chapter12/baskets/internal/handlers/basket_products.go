@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type basketProductHandlers[T ddd.Event] struct {
+	basketProducts domain.BasketProductRepository
+}
+
+var _ ddd.EventHandler[ddd.Event] = (*basketProductHandlers[ddd.Event])(nil)
+
+func NewBasketProductHandlers(basketProducts domain.BasketProductRepository) ddd.EventHandler[ddd.Event] {
+	return basketProductHandlers[ddd.Event]{
+		basketProducts: basketProducts,
+	}
+}
+
+func RegisterBasketProductHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
+	subscriber.Subscribe(handlers,
+		domain.BasketItemAddedEvent,
+		domain.BasketItemRemovedEvent,
+		domain.BasketCanceledEvent,
+		domain.BasketCheckedOutEvent,
+		domain.BasketExpiredEvent,
+	)
+}
+
+func (h basketProductHandlers[T]) HandleEvent(ctx context.Context, event T) error {
+	basket := event.Payload().(*domain.Basket)
+
+	productIDs := make([]string, 0, len(basket.Items))
+	if basket.IsOpen() {
+		seen := make(map[string]struct{}, len(basket.Items))
+		for _, item := range basket.Items {
+			if _, exists := seen[item.ProductID]; !exists {
+				seen[item.ProductID] = struct{}{}
+				productIDs = append(productIDs, item.ProductID)
+			}
+		}
+	}
+
+	return h.basketProducts.Sync(ctx, basket.ID(), productIDs)
+}
@@ -6,6 +6,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"eda-in-golang/baskets/basketspb"
 	"eda-in-golang/baskets/internal/domain"
@@ -31,6 +32,8 @@ func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], hand
 		domain.BasketStartedEvent,
 		domain.BasketCanceledEvent,
 		domain.BasketCheckedOutEvent,
+		domain.BasketAbandonedEvent,
+		domain.BasketExpiredEvent,
 	)
 }
 
@@ -59,6 +62,10 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 		return h.onBasketCanceled(ctx, event)
 	case domain.BasketCheckedOutEvent:
 		return h.onBasketCheckedOut(ctx, event)
+	case domain.BasketAbandonedEvent:
+		return h.onBasketAbandoned(ctx, event)
+	case domain.BasketExpiredEvent:
+		return h.onBasketExpired(ctx, event)
 	}
 	return nil
 }
@@ -93,6 +100,18 @@ func (h domainHandlers[T]) onBasketCheckedOut(ctx context.Context, event ddd.Eve
 			ProductName: item.ProductName,
 			Price:       item.ProductPrice,
 			Quantity:    int32(item.Quantity),
+			Discount:    item.Discount,
+			VariantId:   item.VariantID,
+			Sku:         item.SKU,
+		})
+	}
+	promotions := make([]*basketspb.BasketCheckedOut_Promotion, 0, len(basket.Promotions))
+	for _, promotion := range basket.Promotions {
+		promotions = append(promotions, &basketspb.BasketCheckedOut_Promotion{
+			PromotionId: promotion.PromotionID,
+			Name:        promotion.Name,
+			Code:        promotion.Code,
+			Discount:    promotion.Discount,
 		})
 	}
 	return h.publisher.Publish(ctx, basketspb.BasketAggregateChannel,
@@ -101,6 +120,28 @@ func (h domainHandlers[T]) onBasketCheckedOut(ctx context.Context, event ddd.Eve
 			CustomerId: basket.CustomerID,
 			PaymentId:  basket.PaymentID,
 			Items:      items,
+			Promotions: promotions,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onBasketAbandoned(ctx context.Context, event ddd.Event) error {
+	basket := event.Payload().(*domain.Basket)
+	return h.publisher.Publish(ctx, basketspb.BasketAggregateChannel,
+		ddd.NewEvent(basketspb.BasketAbandonedEvent, &basketspb.BasketAbandoned{
+			Id:         basket.ID(),
+			CustomerId: basket.CustomerID,
+			ExpiresAt:  timestamppb.New(basket.ExpiresAt),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onBasketExpired(ctx context.Context, event ddd.Event) error {
+	basket := event.Payload().(*domain.Basket)
+	return h.publisher.Publish(ctx, basketspb.BasketAggregateChannel,
+		ddd.NewEvent(basketspb.BasketExpiredEvent, &basketspb.BasketExpired{
+			Id:         basket.ID(),
+			CustomerId: basket.CustomerID,
 		}),
 	)
 }
@@ -19,3 +19,27 @@ func RegisterDomainEventHandlersTx(container di.Container) {
 
 	RegisterDomainEventHandlers(subscriber, handlers)
 }
+
+func RegisterActivityHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		activityHandlers := di.Get(ctx, constants.ActivityHandlersKey).(ddd.EventHandler[ddd.Event])
+
+		return activityHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterActivityHandlers(subscriber, handlers)
+}
+
+func RegisterBasketProductHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.Event](func(ctx context.Context, event ddd.Event) error {
+		basketProductHandlers := di.Get(ctx, constants.BasketProductHandlersKey).(ddd.EventHandler[ddd.Event])
+
+		return basketProductHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.Event])
+
+	RegisterBasketProductHandlers(subscriber, handlers)
+}
@@ -7,6 +7,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"eda-in-golang/baskets/internal/application"
 	"eda-in-golang/baskets/internal/domain"
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
@@ -16,14 +17,16 @@ import (
 )
 
 type integrationHandlers[T ddd.Event] struct {
+	app      application.App
 	stores   domain.StoreCacheRepository
 	products domain.ProductCacheRepository
 }
 
 var _ ddd.EventHandler[ddd.Event] = (*integrationHandlers[ddd.Event])(nil)
 
-func NewIntegrationEventHandlers(reg registry.Registry, stores domain.StoreCacheRepository, products domain.ProductCacheRepository, mws ...am.MessageHandlerMiddleware) am.MessageHandler {
+func NewIntegrationEventHandlers(reg registry.Registry, app application.App, stores domain.StoreCacheRepository, products domain.ProductCacheRepository, mws ...am.MessageHandlerMiddleware) am.MessageHandler {
 	return am.NewEventHandler(reg, integrationHandlers[ddd.Event]{
+		app:      app,
 		stores:   stores,
 		products: products,
 	}, mws...)
@@ -33,6 +36,7 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	_, err = subscriber.Subscribe(storespb.StoreAggregateChannel, handlers, am.MessageFilter{
 		storespb.StoreCreatedEvent,
 		storespb.StoreRebrandedEvent,
+		storespb.StoreParticipatingToggledEvent,
 	}, am.GroupName("baskets-stores"))
 	if err != nil {
 		return err
@@ -44,6 +48,10 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 		storespb.ProductPriceIncreasedEvent,
 		storespb.ProductPriceDecreasedEvent,
 		storespb.ProductRemovedEvent,
+		storespb.ProductVariantAddedEvent,
+		storespb.ProductVariantRemovedEvent,
+		storespb.ProductScheduledPriceActivatedEvent,
+		storespb.ProductScheduledPriceRevertedEvent,
 	}, am.GroupName("baskets-products"))
 
 	return err
@@ -72,6 +80,8 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 		return h.onStoreCreated(ctx, event)
 	case storespb.StoreRebrandedEvent:
 		return h.onStoreRebranded(ctx, event)
+	case storespb.StoreParticipatingToggledEvent:
+		return h.onStoreParticipationToggled(ctx, event)
 	case storespb.ProductAddedEvent:
 		return h.onProductAdded(ctx, event)
 	case storespb.ProductRebrandedEvent:
@@ -80,6 +90,12 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 		return h.onProductPriceChanged(ctx, event)
 	case storespb.ProductRemovedEvent:
 		return h.onProductRemoved(ctx, event)
+	case storespb.ProductVariantAddedEvent:
+		return h.onProductVariantAdded(ctx, event)
+	case storespb.ProductVariantRemovedEvent:
+		return h.onProductVariantRemoved(ctx, event)
+	case storespb.ProductScheduledPriceActivatedEvent, storespb.ProductScheduledPriceRevertedEvent:
+		return h.onProductScheduledPriceChanged(ctx, event)
 	}
 
 	return nil
@@ -95,6 +111,11 @@ func (h integrationHandlers[T]) onStoreRebranded(ctx context.Context, event ddd.
 	return h.stores.Rename(ctx, payload.GetId(), payload.GetName())
 }
 
+func (h integrationHandlers[T]) onStoreParticipationToggled(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.StoreParticipationToggled)
+	return h.stores.UpdateClosed(ctx, payload.GetId(), domain.IsStoreClosed(payload.GetParticipating(), payload.GetScheduled()))
+}
+
 func (h integrationHandlers[T]) onProductAdded(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductAdded)
 	return h.products.Add(ctx, payload.GetId(), payload.GetStoreId(), payload.GetName(), payload.GetPrice())
@@ -107,10 +128,55 @@ func (h integrationHandlers[T]) onProductRebranded(ctx context.Context, event dd
 
 func (h integrationHandlers[T]) onProductPriceChanged(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductPriceChanged)
-	return h.products.UpdatePrice(ctx, payload.GetId(), payload.GetDelta())
+	if err := h.products.UpdatePrice(ctx, payload.GetId(), payload.GetDelta()); err != nil {
+		return err
+	}
+
+	return h.app.RepriceBasketItems(ctx, application.RepriceBasketItems{
+		ProductID: payload.GetId(),
+	})
+}
+
+func (h integrationHandlers[T]) onProductScheduledPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductScheduledPriceChanged)
+	if err := h.products.UpdatePrice(ctx, payload.GetId(), payload.GetDelta()); err != nil {
+		return err
+	}
+
+	return h.app.RepriceBasketItems(ctx, application.RepriceBasketItems{
+		ProductID: payload.GetId(),
+	})
 }
 
 func (h integrationHandlers[T]) onProductRemoved(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductRemoved)
-	return h.products.Remove(ctx, payload.GetId())
+	if err := h.products.Remove(ctx, payload.GetId()); err != nil {
+		return err
+	}
+
+	return h.app.FlagUnavailableItems(ctx, application.FlagUnavailableItems{
+		ProductID: payload.GetId(),
+	})
+}
+
+func (h integrationHandlers[T]) onProductVariantAdded(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductVariantAdded)
+	return h.products.AddVariant(ctx, payload.GetId(), domain.ProductVariant{
+		ID:         payload.GetVariantId(),
+		SKU:        payload.GetSku(),
+		Price:      payload.GetPrice(),
+		Attributes: payload.GetAttributes(),
+	})
+}
+
+func (h integrationHandlers[T]) onProductVariantRemoved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductVariantRemoved)
+	if err := h.products.RemoveVariant(ctx, payload.GetId(), payload.GetVariantId()); err != nil {
+		return err
+	}
+
+	return h.app.FlagUnavailableItems(ctx, application.FlagUnavailableItems{
+		ProductID: payload.GetId(),
+		VariantID: payload.GetVariantId(),
+	})
 }
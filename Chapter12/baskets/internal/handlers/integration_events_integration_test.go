@@ -15,6 +15,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 
+	"eda-in-golang/baskets/internal/application"
 	"eda-in-golang/baskets/internal/domain"
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
@@ -33,6 +34,7 @@ type integrationEventsTestSuite struct {
 	publisher  am.EventPublisher
 	subscriber am.MessageStream
 	mocks      struct {
+		app      *application.MockApp
 		products *domain.MockProductCacheRepository
 		stores   *domain.MockStoreCacheRepository
 	}
@@ -107,9 +109,11 @@ func (s *integrationEventsTestSuite) TearDownSuite() {
 
 func (s *integrationEventsTestSuite) SetupTest() {
 	s.mocks = struct {
+		app      *application.MockApp
 		products *domain.MockProductCacheRepository
 		stores   *domain.MockStoreCacheRepository
 	}{
+		app:      application.NewMockApp(s.T()),
 		products: domain.NewMockProductCacheRepository(s.T()),
 		stores:   domain.NewMockStoreCacheRepository(s.T()),
 	}
@@ -123,6 +127,7 @@ func (s *integrationEventsTestSuite) SetupTest() {
 	s.publisher = am.NewEventPublisher(s.reg, stream)
 	s.subscriber = stream
 	handler := am.NewEventHandler(s.reg, integrationHandlers[ddd.Event]{
+		app:      s.mocks.app,
 		products: s.mocks.products,
 		stores:   s.mocks.stores,
 	})
@@ -203,7 +208,10 @@ func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductRebrande
 
 func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductPriceIncreased() {
 	s.wait(func(done chan struct{}) {
-		s.mocks.products.On("UpdatePrice", mock.Anything, "product-id", 1.00).Return(nil).Run(func(_ mock.Arguments) {
+		s.mocks.products.On("UpdatePrice", mock.Anything, "product-id", 1.00).Return(nil)
+		s.mocks.app.On("RepriceBasketItems", mock.Anything, application.RepriceBasketItems{
+			ProductID: "product-id",
+		}).Return(nil).Run(func(_ mock.Arguments) {
 			close(done)
 		})
 
@@ -218,7 +226,10 @@ func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductPriceInc
 
 func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductPriceDecreased() {
 	s.wait(func(done chan struct{}) {
-		s.mocks.products.On("UpdatePrice", mock.Anything, "product-id", -1.00).Return(nil).Run(func(_ mock.Arguments) {
+		s.mocks.products.On("UpdatePrice", mock.Anything, "product-id", -1.00).Return(nil)
+		s.mocks.app.On("RepriceBasketItems", mock.Anything, application.RepriceBasketItems{
+			ProductID: "product-id",
+		}).Return(nil).Run(func(_ mock.Arguments) {
 			close(done)
 		})
 
@@ -233,7 +244,10 @@ func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductPriceDec
 
 func (s *integrationEventsTestSuite) TestProductAggregateChannel_ProductRemoved() {
 	s.wait(func(done chan struct{}) {
-		s.mocks.products.On("Remove", mock.Anything, "product-id").Return(nil).Run(func(_ mock.Arguments) {
+		s.mocks.products.On("Remove", mock.Anything, "product-id").Return(nil)
+		s.mocks.app.On("FlagUnavailableItems", mock.Anything, application.FlagUnavailableItems{
+			ProductID: "product-id",
+		}).Return(nil).Run(func(_ mock.Arguments) {
 			close(done)
 		})
 
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type BasketActivityRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.BasketActivityRepository = (*BasketActivityRepository)(nil)
+
+func NewBasketActivityRepository(tableName string, db postgres.DB) BasketActivityRepository {
+	return BasketActivityRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r BasketActivityRepository) Track(ctx context.Context, basketID, customerID string, activeAt time.Time) error {
+	const query = `INSERT INTO %s (id, customer_id, last_active_at, tenant_id) VALUES ($1, $2, $3, $4)
+ON CONFLICT (tenant_id, id) DO UPDATE SET last_active_at = EXCLUDED.last_active_at, reminded = FALSE`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), basketID, customerID, activeAt, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r BasketActivityRepository) Remove(ctx context.Context, basketID string) error {
+	const query = "DELETE FROM %s WHERE id = $1 AND tenant_id = $2"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), basketID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r BasketActivityRepository) MarkReminded(ctx context.Context, basketID string) error {
+	const query = "UPDATE %s SET reminded = TRUE WHERE id = $1 AND tenant_id = $2"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), basketID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r BasketActivityRepository) Lock(ctx context.Context, basketID string) (*domain.BasketActivity, error) {
+	const query = `SELECT id, customer_id, last_active_at, reminded FROM %s
+WHERE id = $1 AND tenant_id = $2 FOR UPDATE SKIP LOCKED`
+
+	activity := &domain.BasketActivity{}
+	err := r.db.QueryRowContext(ctx, r.table(query), basketID, tenant.FromContext(ctx)).
+		Scan(&activity.BasketID, &activity.CustomerID, &activity.LastActiveAt, &activity.Reminded)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "scanning basket activity")
+	}
+
+	return activity, nil
+}
+
+func (r BasketActivityRepository) FindUnreminded(ctx context.Context, idleSince time.Time) ([]*domain.BasketActivity, error) {
+	const query = `SELECT id, customer_id, last_active_at, reminded FROM %s
+WHERE last_active_at < $1 AND reminded = FALSE AND tenant_id = $2 ORDER BY last_active_at ASC`
+
+	return r.find(ctx, r.table(query), idleSince, tenant.FromContext(ctx))
+}
+
+func (r BasketActivityRepository) FindIdle(ctx context.Context, idleSince time.Time) ([]*domain.BasketActivity, error) {
+	const query = `SELECT id, customer_id, last_active_at, reminded FROM %s
+WHERE last_active_at < $1 AND tenant_id = $2 ORDER BY last_active_at ASC`
+
+	return r.find(ctx, r.table(query), idleSince, tenant.FromContext(ctx))
+}
+
+func (r BasketActivityRepository) find(ctx context.Context, query string, args ...any) ([]*domain.BasketActivity, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying basket activity")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing basket activity rows")
+		}
+	}(rows)
+
+	var activities []*domain.BasketActivity
+
+	for rows.Next() {
+		activity := &domain.BasketActivity{}
+		err := rows.Scan(&activity.BasketID, &activity.CustomerID, &activity.LastActiveAt, &activity.Reminded)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning basket activity")
+		}
+		activities = append(activities, activity)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing basket activity rows")
+	}
+
+	return activities, nil
+}
+
+func (r BasketActivityRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
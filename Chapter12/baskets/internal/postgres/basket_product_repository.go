@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type BasketProductRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.BasketProductRepository = (*BasketProductRepository)(nil)
+
+func NewBasketProductRepository(tableName string, db postgres.DB) BasketProductRepository {
+	return BasketProductRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r BasketProductRepository) Sync(ctx context.Context, basketID string, productIDs []string) error {
+	const deleteQuery = "DELETE FROM %s WHERE basket_id = $1 AND NOT (product_id = ANY($2)) AND tenant_id = $3"
+	const insertQuery = `INSERT INTO %s (basket_id, product_id, tenant_id) SELECT $1, UNNEST($2::text[]), $3
+ON CONFLICT DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, r.table(deleteQuery), basketID, productIDs, tenant.FromContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "removing basket products")
+	}
+
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(insertQuery), basketID, productIDs, tenant.FromContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "adding basket products")
+	}
+
+	return nil
+}
+
+func (r BasketProductRepository) FindBaskets(ctx context.Context, productID string) ([]string, error) {
+	const query = "SELECT basket_id FROM %s WHERE product_id = $1 AND tenant_id = $2"
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), productID, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying basket products")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing basket product rows")
+		}
+	}(rows)
+
+	var basketIDs []string
+
+	for rows.Next() {
+		var basketID string
+		if err := rows.Scan(&basketID); err != nil {
+			return nil, errors.Wrap(err, "scanning basket product")
+		}
+		basketIDs = append(basketIDs, basketID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing basket product rows")
+	}
+
+	return basketIDs, nil
+}
+
+func (r BasketProductRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -3,12 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/baskets/internal/domain"
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 )
 
 type ProductCacheRepository struct {
@@ -28,45 +30,69 @@ func NewProductCacheRepository(tableName string, db postgres.DB, fallback domain
 }
 
 func (r ProductCacheRepository) Add(ctx context.Context, productID, storeID, name string, price float64) error {
-	const query = `INSERT INTO %s (id, store_id, NAME, price) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	const query = `INSERT INTO %s (id, store_id, NAME, price, tenant_id) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name, price)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name, price, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) Rebrand(ctx context.Context, productID, name string) error {
-	const query = `UPDATE %s SET NAME = $2 WHERE id = $1`
+	const query = `UPDATE %s SET NAME = $2 WHERE id = $1 AND tenant_id = $3`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, name, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) UpdatePrice(ctx context.Context, productID string, delta float64) error {
-	const query = `UPDATE %s SET price = price + $2 WHERE id = $1`
+	const query = `UPDATE %s SET price = price + $2 WHERE id = $1 AND tenant_id = $3`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, delta)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, delta, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r ProductCacheRepository) AddVariant(ctx context.Context, productID string, variant domain.ProductVariant) error {
+	const query = `UPDATE %s SET variants = variants || $2::jsonb WHERE id = $1 AND tenant_id = $3`
+
+	data, err := json.Marshal([]domain.ProductVariant{variant})
+	if err != nil {
+		return errors.Wrap(err, "encoding product variant")
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), productID, data, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r ProductCacheRepository) RemoveVariant(ctx context.Context, productID, variantID string) error {
+	const query = `UPDATE %s SET variants = (
+  SELECT COALESCE(jsonb_agg(v), '[]') FROM jsonb_array_elements(variants) v WHERE v ->> 'ID' <> $2
+) WHERE id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, variantID, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) Remove(ctx context.Context, productID string) error {
-	const query = `DELETE FROM %s WHERE id = $1`
+	const query = `DELETE FROM %s WHERE id = $1 AND tenant_id = $2`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) Find(ctx context.Context, productID string) (*domain.Product, error) {
-	const query = `SELECT store_id, name, price FROM %s WHERE id = $1 LIMIT 1`
+	const query = `SELECT store_id, name, price, variants FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
 
 	product := &domain.Product{
 		ID: productID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), productID).Scan(&product.StoreID, &product.Name, &product.Price)
+	var variants []byte
+	err := r.db.QueryRowContext(ctx, r.table(query), productID, tenant.FromContext(ctx)).Scan(&product.StoreID, &product.Name, &product.Price, &variants)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning product")
@@ -76,7 +102,19 @@ func (r ProductCacheRepository) Find(ctx context.Context, productID string) (*do
 			return nil, errors.Wrap(err, "product fallback failed")
 		}
 		// attempt to add it to the cache
-		return product, r.Add(ctx, product.ID, product.StoreID, product.Name, product.Price)
+		if err = r.Add(ctx, product.ID, product.StoreID, product.Name, product.Price); err != nil {
+			return product, err
+		}
+		for _, variant := range product.Variants {
+			if err = r.AddVariant(ctx, product.ID, variant); err != nil {
+				return product, err
+			}
+		}
+		return product, nil
+	}
+
+	if err = json.Unmarshal(variants, &product.Variants); err != nil {
+		return nil, errors.Wrap(err, "decoding product variants")
 	}
 
 	return product, nil
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/baskets/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type PromotionRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.PromotionRepository = (*PromotionRepository)(nil)
+
+func NewPromotionRepository(tableName string, db postgres.DB) PromotionRepository {
+	return PromotionRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r PromotionRepository) Add(ctx context.Context, promotion *domain.Promotion) error {
+	const query = `INSERT INTO %s (id, name, store_id, product_id, code, kind, percent, amount, buy_quantity, get_quantity, minimum_spend, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		promotion.ID, promotion.Name, promotion.StoreID, promotion.ProductID, promotion.Code, promotion.Kind.String(),
+		promotion.Percent, promotion.Amount, promotion.BuyQuantity, promotion.GetQuantity, promotion.MinimumSpend, tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+func (r PromotionRepository) Remove(ctx context.Context, promotionID string) error {
+	const query = "DELETE FROM %s WHERE id = $1 AND tenant_id = $2"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), promotionID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r PromotionRepository) FindByCode(ctx context.Context, code string) ([]*domain.Promotion, error) {
+	const query = `SELECT id, name, store_id, product_id, code, kind, percent, amount, buy_quantity, get_quantity, minimum_spend
+FROM %s WHERE code = $1 AND tenant_id = $2`
+
+	return r.find(ctx, r.table(query), code, tenant.FromContext(ctx))
+}
+
+func (r PromotionRepository) FindForStores(ctx context.Context, storeIDs []string) ([]*domain.Promotion, error) {
+	const query = `SELECT id, name, store_id, product_id, code, kind, percent, amount, buy_quantity, get_quantity, minimum_spend
+FROM %s WHERE (store_id = '' OR store_id = ANY($1)) AND tenant_id = $2`
+
+	return r.find(ctx, r.table(query), storeIDs, tenant.FromContext(ctx))
+}
+
+func (r PromotionRepository) find(ctx context.Context, query string, args ...any) ([]*domain.Promotion, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying promotions")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing promotion rows")
+		}
+	}(rows)
+
+	var promotions []*domain.Promotion
+
+	for rows.Next() {
+		var kind string
+		promotion := &domain.Promotion{}
+		err := rows.Scan(&promotion.ID, &promotion.Name, &promotion.StoreID, &promotion.ProductID, &promotion.Code, &kind,
+			&promotion.Percent, &promotion.Amount, &promotion.BuyQuantity, &promotion.GetQuantity, &promotion.MinimumSpend,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning promotion")
+		}
+		promotion.Kind = domain.ToPromotionKind(kind)
+		promotions = append(promotions, promotion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing promotion rows")
+	}
+
+	return promotions, nil
+}
+
+func (r PromotionRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
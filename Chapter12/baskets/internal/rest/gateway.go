@@ -9,12 +9,13 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"eda-in-golang/baskets/basketspb"
+	"eda-in-golang/internal/tenant"
 )
 
 func RegisterGateway(ctx context.Context, mux *chi.Mux, grpcAddr string) error {
 	const apiRoot = "/api/baskets"
 
-	gateway := runtime.NewServeMux()
+	gateway := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(tenant.HeaderMatcher))
 	err := basketspb.RegisterBasketServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	})
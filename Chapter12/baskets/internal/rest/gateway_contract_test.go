@@ -57,10 +57,13 @@ func TestProvider(t *testing.T) {
 	baskets := domain.NewFakeBasketRepository()
 	stores := domain.NewFakeStoreCacheRepository()
 	products := domain.NewFakeProductCacheRepository()
+	promotions := domain.NewFakePromotionRepository()
+	activity := domain.NewFakeBasketActivityRepository()
+	basketProducts := domain.NewFakeBasketProductRepository()
 	dispatcher := ddd.NewEventDispatcher[ddd.Event]()
 
 	// init app
-	app := application.New(baskets, stores, products, dispatcher)
+	app := application.New(baskets, stores, products, promotions, activity, basketProducts, dispatcher)
 
 	// start grpc
 	rpcConfig := rpc.RpcConfig{
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE basket_activity (
+  id             text        NOT NULL,
+  customer_id    text        NOT NULL,
+  last_active_at timestamptz NOT NULL,
+  reminded       bool        NOT NULL DEFAULT FALSE,
+  created_at     timestamptz NOT NULL DEFAULT NOW(),
+  updated_at     timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX basket_activity_idle_idx ON basket_activity (last_active_at);
+
+CREATE TRIGGER created_at_basket_activity_trgr
+  BEFORE UPDATE
+  ON basket_activity
+  FOR EACH ROW EXECUTE PROCEDURE created_at_trigger();
+CREATE TRIGGER updated_at_basket_activity_trgr
+  BEFORE UPDATE
+  ON basket_activity
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- +goose Down
+DROP TABLE IF EXISTS basket_activity;
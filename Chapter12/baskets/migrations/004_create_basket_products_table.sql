@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE basket_products (
+  basket_id  text        NOT NULL,
+  product_id text        NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (basket_id, product_id)
+);
+
+CREATE INDEX basket_products_product_idx ON basket_products (product_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS basket_products;
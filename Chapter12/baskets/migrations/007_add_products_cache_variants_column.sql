@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE products_cache
+  ADD COLUMN variants jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE products_cache
+  DROP COLUMN variants;
@@ -10,6 +10,7 @@ import (
 
 	"eda-in-golang/baskets/basketspb"
 	"eda-in-golang/baskets/internal/application"
+	"eda-in-golang/baskets/internal/config"
 	"eda-in-golang/baskets/internal/constants"
 	"eda-in-golang/baskets/internal/domain"
 	"eda-in-golang/baskets/internal/grpc"
@@ -19,14 +20,17 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/stores/storespb"
 )
@@ -38,6 +42,11 @@ func (m *Module) Startup(ctx context.Context, mono system.Service) (err error) {
 }
 
 func Root(ctx context.Context, svc system.Service) (err error) {
+	cfg, err := config.InitConfig()
+	if err != nil {
+		return err
+	}
+
 	container := di.New()
 	// setup Driven adapters
 	container.AddSingleton(constants.RegistryKey, func(c di.Container) (any, error) {
@@ -53,7 +62,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.Event](), nil
 	})
@@ -66,16 +76,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -114,6 +132,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			grpc.NewProductRepository(svc.Config().Rpc.Service(constants.StoresServiceName)),
 		), nil
 	})
+	container.AddScoped(constants.PromotionsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewPromotionRepository(
+			constants.PromotionsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddScoped(constants.ActivityRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewBasketActivityRepository(
+			constants.ActivityTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddScoped(constants.BasketProductsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewBasketProductRepository(
+			constants.BasketProductsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
 	// Prometheus counters
 	basketsStarted := promauto.NewCounter(prometheus.CounterOpts{
 		Name: constants.BasketsStartedCount,
@@ -131,15 +167,25 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			c.Get(constants.BasketsRepoKey).(domain.BasketRepository),
 			c.Get(constants.StoresRepoKey).(domain.StoreCacheRepository),
 			c.Get(constants.ProductsRepoKey).(domain.ProductCacheRepository),
+			c.Get(constants.PromotionsRepoKey).(domain.PromotionRepository),
+			c.Get(constants.ActivityRepoKey).(domain.BasketActivityRepository),
+			c.Get(constants.BasketProductsRepoKey).(domain.BasketProductRepository),
 			c.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.Event]),
 		), basketsStarted, basketsCheckedOut, basketsCanceled), nil
 	})
 	container.AddScoped(constants.DomainEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewDomainEventHandlers(c.Get(constants.EventPublisherKey).(am.EventPublisher)), nil
 	})
+	container.AddScoped(constants.ActivityHandlersKey, func(c di.Container) (any, error) {
+		return handlers.NewActivityHandlers(c.Get(constants.ActivityRepoKey).(domain.BasketActivityRepository)), nil
+	})
+	container.AddScoped(constants.BasketProductHandlersKey, func(c di.Container) (any, error) {
+		return handlers.NewBasketProductHandlers(c.Get(constants.BasketProductsRepoKey).(domain.BasketProductRepository)), nil
+	})
 	container.AddScoped(constants.IntegrationEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewIntegrationEventHandlers(
 			c.Get(constants.RegistryKey).(registry.Registry),
+			c.Get(constants.ApplicationKey).(application.App),
 			c.Get(constants.StoresRepoKey).(domain.StoreCacheRepository),
 			c.Get(constants.ProductsRepoKey).(domain.ProductCacheRepository),
 			tm.InboxHandler(c.Get(constants.InboxStoreKey).(tm.InboxStore)),
@@ -161,10 +207,14 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	handlers.RegisterDomainEventHandlersTx(container)
+	handlers.RegisterActivityHandlersTx(container)
+	handlers.RegisterBasketProductHandlersTx(container)
 	if err = handlers.RegisterIntegrationEventHandlersTx(container); err != nil {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	handlers.StartBasketMonitor(ctx, container, cfg, svc.Tenants(), svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 	return
 }
 
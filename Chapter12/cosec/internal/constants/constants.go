@@ -29,6 +29,10 @@ const (
 
 	SagaKey         = "saga"
 	OrchestratorKey = "orchestrator"
+
+	ReturnOrderSagaStoreKey    = "returnOrderSagaStore"
+	ReturnOrderSagaKey         = "returnOrderSaga"
+	ReturnOrderOrchestratorKey = "returnOrderOrchestrator"
 )
 
 // Repository Table Names
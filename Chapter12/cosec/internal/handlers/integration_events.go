@@ -17,20 +17,23 @@ import (
 )
 
 type integrationHandlers[T ddd.Event] struct {
-	orchestrator sec.Orchestrator[*models.CreateOrderData]
+	createOrder sec.Orchestrator[*models.CreateOrderData]
+	returnOrder sec.Orchestrator[*models.ReturnOrderData]
 }
 
 var _ ddd.EventHandler[ddd.Event] = (*integrationHandlers[ddd.Event])(nil)
 
-func NewIntegrationEventHandlers(reg registry.Registry, orchestrator sec.Orchestrator[*models.CreateOrderData], mws ...am.MessageHandlerMiddleware) am.MessageHandler {
+func NewIntegrationEventHandlers(reg registry.Registry, createOrder sec.Orchestrator[*models.CreateOrderData], returnOrder sec.Orchestrator[*models.ReturnOrderData], mws ...am.MessageHandlerMiddleware) am.MessageHandler {
 	return am.NewEventHandler(reg, integrationHandlers[ddd.Event]{
-		orchestrator: orchestrator,
+		createOrder: createOrder,
+		returnOrder: returnOrder,
 	}, mws...)
 }
 
 func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) (err error) {
 	_, err = subscriber.Subscribe(orderingpb.OrderAggregateChannel, handlers, am.MessageFilter{
 		orderingpb.OrderCreatedEvent,
+		orderingpb.OrderReturnApprovedEvent,
 	}, am.GroupName("cosec-ordering"))
 	return
 }
@@ -56,6 +59,8 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 	switch event.EventName() {
 	case orderingpb.OrderCreatedEvent:
 		return h.onOrderCreated(ctx, event)
+	case orderingpb.OrderReturnApprovedEvent:
+		return h.onOrderReturnApproved(ctx, event)
 	}
 
 	return nil
@@ -69,6 +74,7 @@ func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event ddd.Ev
 	for i, item := range payload.GetItems() {
 		items[i] = models.Item{
 			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
 			StoreID:   item.GetStoreId(),
 			Price:     item.GetPrice(),
 			Quantity:  int(item.GetQuantity()),
@@ -85,5 +91,31 @@ func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event ddd.Ev
 	}
 
 	// Start the CreateOrderSaga
-	return h.orchestrator.Start(ctx, event.ID(), data)
+	return h.createOrder.Start(ctx, event.ID(), data)
+}
+
+func (h integrationHandlers[T]) onOrderReturnApproved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*orderingpb.OrderReturnApproved)
+
+	items := make([]models.ReturnItem, len(payload.GetItems()))
+	for i, item := range payload.GetItems() {
+		items[i] = models.ReturnItem{
+			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
+			StoreID:   item.GetStoreId(),
+			Quantity:  int(item.GetQuantity()),
+		}
+	}
+
+	data := &models.ReturnOrderData{
+		ReturnID:     event.ID(),
+		OrderID:      payload.GetId(),
+		CustomerID:   payload.GetCustomerId(),
+		PaymentID:    payload.GetPaymentId(),
+		Items:        items,
+		RefundAmount: payload.GetRefundAmount(),
+	}
+
+	// Start the ReturnOrderSaga
+	return h.returnOrder.Start(ctx, event.ID(), data)
 }
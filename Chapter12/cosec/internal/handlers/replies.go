@@ -1,18 +1,47 @@
 package handlers
 
 import (
+	"context"
+
 	"eda-in-golang/cosec/internal"
 	"eda-in-golang/cosec/internal/models"
 	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/sec"
 )
 
-func NewReplyHandlers(reg registry.Registry, orchestrator sec.Orchestrator[*models.CreateOrderData], mws ...am.MessageHandlerMiddleware) am.MessageHandler {
-	return am.NewReplyHandler(reg, orchestrator, mws...)
+type replyHandlers struct {
+	createOrder sec.Orchestrator[*models.CreateOrderData]
+	returnOrder sec.Orchestrator[*models.ReturnOrderData]
+}
+
+var _ ddd.ReplyHandler[ddd.Reply] = (*replyHandlers)(nil)
+
+func NewReplyHandlers(reg registry.Registry, createOrder sec.Orchestrator[*models.CreateOrderData], returnOrder sec.Orchestrator[*models.ReturnOrderData], mws ...am.MessageHandlerMiddleware) am.MessageHandler {
+	return am.NewReplyHandler(reg, replyHandlers{
+		createOrder: createOrder,
+		returnOrder: returnOrder,
+	}, mws...)
 }
 
 func RegisterReplyHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) error {
 	_, err := subscriber.Subscribe(internal.CreateOrderReplyChannel, handlers, am.GroupName("cosec-replies"))
+	if err != nil {
+		return err
+	}
+	_, err = subscriber.Subscribe(internal.ReturnOrderReplyChannel, handlers, am.GroupName("cosec-return-replies"))
 	return err
 }
+
+func (h replyHandlers) HandleReply(ctx context.Context, reply ddd.Reply) error {
+	switch reply.Metadata().Get(sec.SagaReplyNameHdr) {
+	case internal.CreateOrderSagaName:
+		return h.createOrder.HandleReply(ctx, reply)
+	case internal.ReturnOrderSagaName:
+		return h.returnOrder.HandleReply(ctx, reply)
+	}
+
+	// returning nil to drop bad replies
+	return nil
+}
@@ -7,11 +7,31 @@ type CreateOrderData struct {
 	ShoppingID string
 	Items      []Item
 	Total      float64
+	// RejectReason is the reason code given when the customer was not authorized
+	RejectReason string
 }
 
 type Item struct {
 	ProductID string
+	VariantID string
 	StoreID   string
 	Price     float64
 	Quantity  int
 }
+
+type ReturnOrderData struct {
+	ReturnID     string
+	OrderID      string
+	CustomerID   string
+	PaymentID    string
+	PickupID     string
+	Items        []ReturnItem
+	RefundAmount float64
+}
+
+type ReturnItem struct {
+	ProductID string
+	VariantID string
+	StoreID   string
+	Quantity  int
+}
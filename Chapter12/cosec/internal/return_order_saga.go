@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+
+	"eda-in-golang/cosec/internal/models"
+	"eda-in-golang/depot/depotpb"
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/sec"
+	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
+)
+
+const ReturnOrderSagaName = "cosec.ReturnOrder"
+const ReturnOrderReplyChannel = "mallbots.cosec.replies.ReturnOrder"
+
+type returnOrderSaga struct {
+	sec.Saga[*models.ReturnOrderData]
+}
+
+func NewReturnOrderSaga() sec.Saga[*models.ReturnOrderData] {
+	saga := returnOrderSaga{
+		Saga: sec.NewSaga[*models.ReturnOrderData](ReturnOrderSagaName, ReturnOrderReplyChannel),
+	}
+
+	// 0. -RejectReturn
+	saga.AddStep().
+		Compensation(saga.rejectReturn)
+
+	// 1. ScheduleReturnPickup, -CancelReturnPickup
+	saga.AddStep().
+		Action(saga.scheduleReturnPickup).
+		OnActionReply(depotpb.ScheduledReturnPickupReply, saga.onScheduledReturnPickupReply).
+		Compensation(saga.cancelReturnPickup)
+
+	// 2. CompleteReturn
+	saga.AddStep().
+		Action(saga.completeReturn)
+
+	// 3. IssueRefund
+	//
+	// the refund cannot be taken back so it is issued after every step that
+	// may fail; a refund that fails rejects the completed return
+	saga.AddStep().
+		Action(saga.issueRefund)
+
+	return saga
+}
+
+func (s returnOrderSaga) rejectReturn(ctx context.Context, data *models.ReturnOrderData) (string, ddd.Command, error) {
+	return orderingpb.CommandChannel, ddd.NewCommand(orderingpb.RejectReturnCommand, &orderingpb.RejectReturn{Id: data.OrderID}), nil
+}
+
+func (s returnOrderSaga) scheduleReturnPickup(ctx context.Context, data *models.ReturnOrderData) (string, ddd.Command, error) {
+	items := make([]*depotpb.ScheduleReturnPickup_Item, len(data.Items))
+	for i, item := range data.Items {
+		items[i] = &depotpb.ScheduleReturnPickup_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(item.Quantity),
+			VariantId: item.VariantID,
+		}
+	}
+
+	return depotpb.CommandChannel, ddd.NewCommand(depotpb.ScheduleReturnPickupCommand, &depotpb.ScheduleReturnPickup{
+		OrderId: data.OrderID,
+		Items:   items,
+	}), nil
+}
+
+func (s returnOrderSaga) onScheduledReturnPickupReply(ctx context.Context, data *models.ReturnOrderData, reply ddd.Reply) error {
+	payload := reply.Payload().(*depotpb.ScheduledReturnPickup)
+
+	data.PickupID = payload.GetId()
+
+	return nil
+}
+
+func (s returnOrderSaga) cancelReturnPickup(ctx context.Context, data *models.ReturnOrderData) (string, ddd.Command, error) {
+	return depotpb.CommandChannel, ddd.NewCommand(depotpb.CancelReturnPickupCommand, &depotpb.CancelReturnPickup{Id: data.PickupID}), nil
+}
+
+func (s returnOrderSaga) completeReturn(ctx context.Context, data *models.ReturnOrderData) (string, ddd.Command, error) {
+	return orderingpb.CommandChannel, ddd.NewCommand(orderingpb.CompleteReturnCommand, &orderingpb.CompleteReturn{Id: data.OrderID}), nil
+}
+
+func (s returnOrderSaga) issueRefund(ctx context.Context, data *models.ReturnOrderData) (string, ddd.Command, error) {
+	return paymentspb.CommandChannel, ddd.NewCommand(paymentspb.IssueRefundCommand, &paymentspb.IssueRefund{
+		Id:        data.ReturnID,
+		OrderId:   data.OrderID,
+		PaymentId: data.PaymentID,
+		Amount:    data.RefundAmount,
+	}), nil
+}
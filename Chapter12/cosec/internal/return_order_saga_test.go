@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/cosec/internal/models"
+	"eda-in-golang/depot/depotpb"
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
+	"eda-in-golang/internal/sec"
+	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
+)
+
+type testSagaStore map[string]*sec.SagaContext[[]byte]
+
+func (s testSagaStore) Load(_ context.Context, _, sagaID string) (*sec.SagaContext[[]byte], error) {
+	return s[sagaID], nil
+}
+
+func (s testSagaStore) Save(_ context.Context, _ string, sagaCtx *sec.SagaContext[[]byte]) error {
+	s[sagaCtx.ID] = sagaCtx
+	return nil
+}
+
+func TestReturnOrderSaga(t *testing.T) {
+	data := &models.ReturnOrderData{
+		ReturnID:     "return-id",
+		OrderID:      "order-id",
+		PaymentID:    "payment-id",
+		Items:        []models.ReturnItem{{ProductID: "product-id", VariantID: "variant-id", StoreID: "store-id", Quantity: 1}},
+		RefundAmount: 9.5,
+	}
+	scheduledReply := ddd.NewReply(depotpb.ScheduledReturnPickupReply, &depotpb.ScheduledReturnPickup{Id: "pickup-id"})
+
+	tests := map[string]struct {
+		// replies answers each command in turn
+		replies      []ddd.Reply
+		failed       int
+		wantCommands []string
+	}{
+		"Refunded": {
+			replies: []ddd.Reply{scheduledReply, nil, nil},
+			failed:  -1,
+			wantCommands: []string{
+				depotpb.ScheduleReturnPickupCommand,
+				orderingpb.CompleteReturnCommand,
+				paymentspb.IssueRefundCommand,
+			},
+		},
+		"PickupFailed": {
+			replies: []ddd.Reply{nil, nil},
+			failed:  0,
+			wantCommands: []string{
+				depotpb.ScheduleReturnPickupCommand,
+				orderingpb.RejectReturnCommand,
+			},
+		},
+		"CompleteFailed": {
+			replies: []ddd.Reply{scheduledReply, nil, nil, nil},
+			failed:  1,
+			wantCommands: []string{
+				depotpb.ScheduleReturnPickupCommand,
+				orderingpb.CompleteReturnCommand,
+				depotpb.CancelReturnPickupCommand,
+				orderingpb.RejectReturnCommand,
+			},
+		},
+		// a refund that fails cancels the pickup and rejects the completed return
+		"RefundFailed": {
+			replies: []ddd.Reply{scheduledReply, nil, nil, nil, nil},
+			failed:  2,
+			wantCommands: []string{
+				depotpb.ScheduleReturnPickupCommand,
+				orderingpb.CompleteReturnCommand,
+				paymentspb.IssueRefundCommand,
+				depotpb.CancelReturnPickupCommand,
+				orderingpb.RejectReturnCommand,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reg := registry.New()
+			if err := serdes.NewJsonSerde(reg).RegisterKey(ReturnOrderSagaName, models.ReturnOrderData{}); err != nil {
+				t.Fatal(err)
+			}
+			store := testSagaStore{}
+			publisher := am.NewMockCommandPublisher(t)
+
+			var commands []ddd.Command
+			publisher.On("Publish", context.Background(), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				commands = append(commands, args.Get(2).(ddd.Command))
+			}).Return(nil)
+
+			saga := NewReturnOrderSaga()
+			orchestrator := sec.NewOrchestrator[*models.ReturnOrderData](saga, sec.NewSagaRepository[*models.ReturnOrderData](reg, store), publisher)
+
+			if err := orchestrator.Start(context.Background(), "return-id", data); err != nil {
+				t.Fatal(err)
+			}
+			for i, reply := range tc.replies {
+				if reply == nil {
+					reply = ddd.NewReply(am.SuccessReply, nil)
+				}
+				reply.Metadata().Set(am.ReplyOutcomeHdr, am.OutcomeSuccess)
+				if i == tc.failed {
+					reply = ddd.NewReply(am.FailureReply, nil)
+					reply.Metadata().Set(am.ReplyOutcomeHdr, am.OutcomeFailure)
+				}
+				reply.Metadata().Set(sec.SagaReplyIDHdr, "return-id")
+				reply.Metadata().Set(sec.SagaReplyNameHdr, ReturnOrderSagaName)
+
+				if err := orchestrator.HandleReply(context.Background(), reply); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			names := make([]string, len(commands))
+			for i, cmd := range commands {
+				names[i] = cmd.CommandName()
+			}
+			assert.Equal(t, tc.wantCommands, names)
+			assert.True(t, store["return-id"].Done)
+			assert.Equal(t, tc.failed >= 0, store["return-id"].Compensating)
+
+			// the pickup that was scheduled is the one that is cancelled
+			for _, cmd := range commands {
+				if cancel, ok := cmd.Payload().(*depotpb.CancelReturnPickup); ok {
+					assert.Equal(t, "pickup-id", cancel.GetId())
+				}
+			}
+		})
+	}
+}
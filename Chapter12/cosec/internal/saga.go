@@ -10,6 +10,7 @@ import (
 	"eda-in-golang/internal/sec"
 	"eda-in-golang/ordering/orderingpb"
 	"eda-in-golang/payments/paymentspb"
+	"eda-in-golang/stores/storespb"
 )
 
 const CreateOrderSagaName = "cosec.CreateOrder"
@@ -28,25 +29,36 @@ func NewCreateOrderSaga() sec.Saga[*models.CreateOrderData] {
 	saga.AddStep().
 		Compensation(saga.rejectOrder)
 
-	// 1. AuthorizeCustomer
+	// 1. AuthorizeCustomer, -ReleaseCustomerAuthorization
 	saga.AddStep().
-		Action(saga.authorizeCustomer)
+		Action(saga.authorizeCustomer).
+		OnActionReply(customerspb.CustomerAuthorizationDeniedReply, saga.onCustomerAuthorizationDeniedReply).
+		Compensation(saga.releaseCustomerAuthorization)
 
-	// 2. CreateShoppingList, -CancelShoppingList
+	// 2. ReserveStock, -ReleaseStock
+	saga.AddStep().
+		Action(saga.reserveStock).
+		Compensation(saga.releaseStock)
+
+	// 3. CreateShoppingList, -CancelShoppingList
 	saga.AddStep().
 		Action(saga.createShoppingList).
 		OnActionReply(depotpb.CreatedShoppingListReply, saga.onCreatedShoppingListReply).
 		Compensation(saga.cancelShoppingList)
 
-	// 3. ConfirmPayment
+	// 4. ConfirmPayment
 	saga.AddStep().
 		Action(saga.confirmPayment)
 
-	// 4. InitiateShopping
+	// 5. InitiateShopping
 	saga.AddStep().
 		Action(saga.initiateShopping)
 
-	// 5. ApproveOrder
+	// 6. CommitStock
+	saga.AddStep().
+		Action(saga.commitStock)
+
+	// 7. ApproveOrder
 	saga.AddStep().
 		Action(saga.approveOrder)
 
@@ -54,11 +66,66 @@ func NewCreateOrderSaga() sec.Saga[*models.CreateOrderData] {
 }
 
 func (s createOrderSaga) rejectOrder(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
-	return orderingpb.CommandChannel, ddd.NewCommand(orderingpb.RejectOrderCommand, &orderingpb.RejectOrder{Id: data.OrderID}), nil
+	return orderingpb.CommandChannel, ddd.NewCommand(orderingpb.RejectOrderCommand, &orderingpb.RejectOrder{
+		Id:     data.OrderID,
+		Reason: data.RejectReason,
+	}), nil
 }
 
 func (s createOrderSaga) authorizeCustomer(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
-	return customerspb.CommandChannel, ddd.NewCommand(customerspb.AuthorizeCustomerCommand, &customerspb.AuthorizeCustomer{Id: data.CustomerID}), nil
+	items := make([]*customerspb.AuthorizeCustomer_Item, len(data.Items))
+	for i, item := range data.Items {
+		items[i] = &customerspb.AuthorizeCustomer_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Price:     item.Price,
+			Quantity:  int32(item.Quantity),
+		}
+	}
+
+	return customerspb.CommandChannel, ddd.NewCommand(customerspb.AuthorizeCustomerCommand, &customerspb.AuthorizeCustomer{
+		Id:      data.CustomerID,
+		OrderId: data.OrderID,
+		Total:   data.Total,
+		Items:   items,
+	}), nil
+}
+
+func (s createOrderSaga) onCustomerAuthorizationDeniedReply(ctx context.Context, data *models.CreateOrderData, reply ddd.Reply) error {
+	payload := reply.Payload().(*customerspb.CustomerAuthorizationDenied)
+
+	data.RejectReason = payload.GetReason()
+
+	return nil
+}
+
+func (s createOrderSaga) releaseCustomerAuthorization(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
+	return customerspb.CommandChannel, ddd.NewCommand(customerspb.ReleaseCustomerAuthorizationCommand, &customerspb.ReleaseCustomerAuthorization{
+		Id:      data.CustomerID,
+		OrderId: data.OrderID,
+	}), nil
+}
+
+func (s createOrderSaga) reserveStock(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
+	items := make([]*storespb.ReserveStock_Item, len(data.Items))
+	for i, item := range data.Items {
+		items[i] = &storespb.ReserveStock_Item{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		}
+	}
+
+	return storespb.CommandChannel, ddd.NewCommand(storespb.ReserveStockCommand, &storespb.ReserveStock{
+		ReservationId: data.OrderID,
+		Items:         items,
+	}), nil
+}
+
+func (s createOrderSaga) releaseStock(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
+	return storespb.CommandChannel, ddd.NewCommand(storespb.ReleaseStockCommand, &storespb.ReleaseStock{
+		ReservationId: data.OrderID,
+		ProductIds:    s.productIDs(data),
+	}), nil
 }
 
 func (s createOrderSaga) createShoppingList(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
@@ -68,6 +135,7 @@ func (s createOrderSaga) createShoppingList(ctx context.Context, data *models.Cr
 			ProductId: item.ProductID,
 			StoreId:   item.StoreID,
 			Quantity:  int32(item.Quantity),
+			VariantId: item.VariantID,
 		}
 	}
 
@@ -100,9 +168,24 @@ func (s createOrderSaga) initiateShopping(ctx context.Context, data *models.Crea
 	return depotpb.CommandChannel, ddd.NewCommand(depotpb.InitiateShoppingCommand, &depotpb.InitiateShopping{Id: data.ShoppingID}), nil
 }
 
+func (s createOrderSaga) commitStock(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
+	return storespb.CommandChannel, ddd.NewCommand(storespb.CommitStockCommand, &storespb.CommitStock{
+		ReservationId: data.OrderID,
+		ProductIds:    s.productIDs(data),
+	}), nil
+}
+
 func (s createOrderSaga) approveOrder(ctx context.Context, data *models.CreateOrderData) (string, ddd.Command, error) {
 	return orderingpb.CommandChannel, ddd.NewCommand(orderingpb.ApproveOrderCommand, &orderingpb.ApproveOrder{
 		Id:         data.OrderID,
 		ShoppingId: data.ShoppingID,
 	}), nil
 }
+
+func (s createOrderSaga) productIDs(data *models.CreateOrderData) []string {
+	productIDs := make([]string, len(data.Items))
+	for i, item := range data.Items {
+		productIDs[i] = item.ProductID
+	}
+	return productIDs
+}
@@ -15,7 +15,9 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
@@ -23,9 +25,11 @@ import (
 	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/sec"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/ordering/orderingpb"
 	"eda-in-golang/payments/paymentspb"
+	"eda-in-golang/stores/storespb"
 )
 
 type Module struct{}
@@ -45,18 +49,22 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		if err := orderingpb.Registrations(reg); err != nil {
 			return nil, err
 		}
-		if err := customerspb.Registrations(reg); err != nil {
+		if err := customerspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
 			return nil, err
 		}
 		if err := depotpb.Registrations(reg); err != nil {
 			return nil, err
 		}
-		if err := paymentspb.Registrations(reg); err != nil {
+		if err := paymentspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
+			return nil, err
+		}
+		if err := storespb.Registrations(reg); err != nil {
 			return nil, err
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddScoped(constants.DatabaseTransactionKey, func(c di.Container) (any, error) {
 		return svc.DB().Begin()
 	})
@@ -66,16 +74,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.CommandPublisherKey, func(c di.Container) (any, error) {
@@ -102,6 +118,20 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	container.AddSingleton(constants.SagaKey, func(c di.Container) (any, error) {
 		return internal.NewCreateOrderSaga(), nil
 	})
+	container.AddScoped(constants.ReturnOrderSagaStoreKey, func(c di.Container) (any, error) {
+		reg := c.Get(constants.RegistryKey).(registry.Registry)
+		return sec.NewSagaRepository[*models.ReturnOrderData](
+			reg,
+			pg.NewSagaStore(
+				constants.SagasTableName,
+				postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+				reg,
+			),
+		), nil
+	})
+	container.AddSingleton(constants.ReturnOrderSagaKey, func(c di.Container) (any, error) {
+		return internal.NewReturnOrderSaga(), nil
+	})
 
 	// setup application
 	container.AddScoped(constants.OrchestratorKey, func(c di.Container) (any, error) {
@@ -111,10 +141,18 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			c.Get(constants.CommandPublisherKey).(am.CommandPublisher),
 		), nil
 	})
+	container.AddScoped(constants.ReturnOrderOrchestratorKey, func(c di.Container) (any, error) {
+		return sec.NewOrchestrator[*models.ReturnOrderData](
+			c.Get(constants.ReturnOrderSagaKey).(sec.Saga[*models.ReturnOrderData]),
+			c.Get(constants.ReturnOrderSagaStoreKey).(sec.SagaRepository[*models.ReturnOrderData]),
+			c.Get(constants.CommandPublisherKey).(am.CommandPublisher),
+		), nil
+	})
 	container.AddScoped(constants.IntegrationEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewIntegrationEventHandlers(
 			c.Get(constants.RegistryKey).(registry.Registry),
 			c.Get(constants.OrchestratorKey).(sec.Orchestrator[*models.CreateOrderData]),
+			c.Get(constants.ReturnOrderOrchestratorKey).(sec.Orchestrator[*models.ReturnOrderData]),
 			tm.InboxHandler(c.Get(constants.InboxStoreKey).(tm.InboxStore)),
 		), nil
 	})
@@ -122,6 +160,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return handlers.NewReplyHandlers(
 			c.Get(constants.RegistryKey).(registry.Registry),
 			c.Get(constants.OrchestratorKey).(sec.Orchestrator[*models.CreateOrderData]),
+			c.Get(constants.ReturnOrderOrchestratorKey).(sec.Orchestrator[*models.ReturnOrderData]),
 			tm.InboxHandler(c.Get(constants.InboxStoreKey).(tm.InboxStore)),
 		), nil
 	})
@@ -138,6 +177,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return
 }
@@ -149,6 +189,9 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.RegisterKey(internal.CreateOrderSagaName, models.CreateOrderData{}); err != nil {
 		return err
 	}
+	if err = serde.RegisterKey(internal.ReturnOrderSagaName, models.ReturnOrderData{}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -28,6 +28,9 @@ type CustomersServiceClient interface {
 	ChangeSmsNumber(ctx context.Context, in *ChangeSmsNumberRequest, opts ...grpc.CallOption) (*ChangeSmsNumberResponse, error)
 	AuthorizeCustomer(ctx context.Context, in *AuthorizeCustomerRequest, opts ...grpc.CallOption) (*AuthorizeCustomerResponse, error)
 	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error)
+	ForgetCustomer(ctx context.Context, in *ForgetCustomerRequest, opts ...grpc.CallOption) (*ForgetCustomerResponse, error)
+	ChangeNotificationPreferences(ctx context.Context, in *ChangeNotificationPreferencesRequest, opts ...grpc.CallOption) (*ChangeNotificationPreferencesResponse, error)
+	ChangeAuthorizationPolicy(ctx context.Context, in *ChangeAuthorizationPolicyRequest, opts ...grpc.CallOption) (*ChangeAuthorizationPolicyResponse, error)
 }
 
 type customersServiceClient struct {
@@ -92,6 +95,33 @@ func (c *customersServiceClient) GetCustomer(ctx context.Context, in *GetCustome
 	return out, nil
 }
 
+func (c *customersServiceClient) ForgetCustomer(ctx context.Context, in *ForgetCustomerRequest, opts ...grpc.CallOption) (*ForgetCustomerResponse, error) {
+	out := new(ForgetCustomerResponse)
+	err := c.cc.Invoke(ctx, "/customerspb.CustomersService/ForgetCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersServiceClient) ChangeNotificationPreferences(ctx context.Context, in *ChangeNotificationPreferencesRequest, opts ...grpc.CallOption) (*ChangeNotificationPreferencesResponse, error) {
+	out := new(ChangeNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, "/customerspb.CustomersService/ChangeNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customersServiceClient) ChangeAuthorizationPolicy(ctx context.Context, in *ChangeAuthorizationPolicyRequest, opts ...grpc.CallOption) (*ChangeAuthorizationPolicyResponse, error) {
+	out := new(ChangeAuthorizationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/customerspb.CustomersService/ChangeAuthorizationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomersServiceServer is the server API for CustomersService service.
 // All implementations must embed UnimplementedCustomersServiceServer
 // for forward compatibility
@@ -102,6 +132,9 @@ type CustomersServiceServer interface {
 	ChangeSmsNumber(context.Context, *ChangeSmsNumberRequest) (*ChangeSmsNumberResponse, error)
 	AuthorizeCustomer(context.Context, *AuthorizeCustomerRequest) (*AuthorizeCustomerResponse, error)
 	GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error)
+	ForgetCustomer(context.Context, *ForgetCustomerRequest) (*ForgetCustomerResponse, error)
+	ChangeNotificationPreferences(context.Context, *ChangeNotificationPreferencesRequest) (*ChangeNotificationPreferencesResponse, error)
+	ChangeAuthorizationPolicy(context.Context, *ChangeAuthorizationPolicyRequest) (*ChangeAuthorizationPolicyResponse, error)
 	mustEmbedUnimplementedCustomersServiceServer()
 }
 
@@ -127,6 +160,15 @@ func (UnimplementedCustomersServiceServer) AuthorizeCustomer(context.Context, *A
 func (UnimplementedCustomersServiceServer) GetCustomer(context.Context, *GetCustomerRequest) (*GetCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomer not implemented")
 }
+func (UnimplementedCustomersServiceServer) ForgetCustomer(context.Context, *ForgetCustomerRequest) (*ForgetCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetCustomer not implemented")
+}
+func (UnimplementedCustomersServiceServer) ChangeNotificationPreferences(context.Context, *ChangeNotificationPreferencesRequest) (*ChangeNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeNotificationPreferences not implemented")
+}
+func (UnimplementedCustomersServiceServer) ChangeAuthorizationPolicy(context.Context, *ChangeAuthorizationPolicyRequest) (*ChangeAuthorizationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAuthorizationPolicy not implemented")
+}
 func (UnimplementedCustomersServiceServer) mustEmbedUnimplementedCustomersServiceServer() {}
 
 // UnsafeCustomersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +290,60 @@ func _CustomersService_GetCustomer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomersService_ForgetCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServiceServer).ForgetCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customerspb.CustomersService/ForgetCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServiceServer).ForgetCustomer(ctx, req.(*ForgetCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomersService_ChangeNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServiceServer).ChangeNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customerspb.CustomersService/ChangeNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServiceServer).ChangeNotificationPreferences(ctx, req.(*ChangeNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomersService_ChangeAuthorizationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAuthorizationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomersServiceServer).ChangeAuthorizationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customerspb.CustomersService/ChangeAuthorizationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomersServiceServer).ChangeAuthorizationPolicy(ctx, req.(*ChangeAuthorizationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CustomersService_ServiceDesc is the grpc.ServiceDesc for CustomersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +375,18 @@ var CustomersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomer",
 			Handler:    _CustomersService_GetCustomer_Handler,
 		},
+		{
+			MethodName: "ForgetCustomer",
+			Handler:    _CustomersService_ForgetCustomer_Handler,
+		},
+		{
+			MethodName: "ChangeNotificationPreferences",
+			Handler:    _CustomersService_ChangeNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ChangeAuthorizationPolicy",
+			Handler:    _CustomersService_ChangeAuthorizationPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "customerspb/api.proto",
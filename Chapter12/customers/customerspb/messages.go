@@ -12,15 +12,24 @@ const (
 	CustomerSmsChangedEvent = "customersapi.CustomerSmsChanged"
 	CustomerEnabledEvent    = "customersapi.CustomerEnabled"
 	CustomerDisabledEvent   = "customersapi.CustomerDisabled"
+	CustomerForgottenEvent  = "customersapi.CustomerForgotten"
+
+	CustomerNotificationPreferencesChangedEvent = "customersapi.CustomerNotificationPreferencesChanged"
 
 	CommandChannel = "mallbots.customers.commands"
 
-	AuthorizeCustomerCommand = "customersapi.AuthorizeCustomer"
+	AuthorizeCustomerCommand            = "customersapi.AuthorizeCustomer"
+	ReleaseCustomerAuthorizationCommand = "customersapi.ReleaseCustomerAuthorization"
+
+	CustomerAuthorizedReply          = "customersapi.CustomerAuthorized"
+	CustomerAuthorizationDeniedReply = "customersapi.CustomerAuthorizationDenied"
 )
 
 func Registrations(reg registry.Registry) error {
-	serde := serdes.NewProtoSerde(reg)
+	return RegistrationsWithSerde(serdes.NewProtoSerde(reg))
+}
 
+func RegistrationsWithSerde(serde registry.Serde) error {
 	// Customer events
 	if err := serde.Register(&CustomerRegistered{}); err != nil {
 		return err
@@ -34,11 +43,28 @@ func Registrations(reg registry.Registry) error {
 	if err := serde.Register(&CustomerDisabled{}); err != nil {
 		return err
 	}
+	if err := serde.Register(&CustomerForgotten{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&CustomerNotificationPreferencesChanged{}); err != nil {
+		return err
+	}
 
 	// commands
 	if err := serde.Register(&AuthorizeCustomer{}); err != nil {
 		return err
 	}
+	if err := serde.Register(&ReleaseCustomerAuthorization{}); err != nil {
+		return err
+	}
+
+	// replies
+	if err := serde.Register(&CustomerAuthorized{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&CustomerAuthorizationDenied{}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -46,5 +72,13 @@ func (*CustomerRegistered) Key() string { return CustomerRegisteredEvent }
 func (*CustomerSmsChanged) Key() string { return CustomerSmsChangedEvent }
 func (*CustomerEnabled) Key() string    { return CustomerEnabledEvent }
 func (*CustomerDisabled) Key() string   { return CustomerDisabledEvent }
+func (*CustomerForgotten) Key() string  { return CustomerForgottenEvent }
+func (*CustomerNotificationPreferencesChanged) Key() string {
+	return CustomerNotificationPreferencesChangedEvent
+}
+
+func (*AuthorizeCustomer) Key() string            { return AuthorizeCustomerCommand }
+func (*ReleaseCustomerAuthorization) Key() string { return ReleaseCustomerAuthorizationCommand }
 
-func (*AuthorizeCustomer) Key() string { return AuthorizeCustomerCommand }
+func (*CustomerAuthorized) Key() string          { return CustomerAuthorizedReply }
+func (*CustomerAuthorizationDenied) Key() string { return CustomerAuthorizationDeniedReply }
@@ -45,6 +45,66 @@ func (_m *MockCustomersServiceClient) AuthorizeCustomer(ctx context.Context, in
 	return r0, r1
 }
 
+// ChangeAuthorizationPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockCustomersServiceClient) ChangeAuthorizationPolicy(ctx context.Context, in *ChangeAuthorizationPolicyRequest, opts ...grpc.CallOption) (*ChangeAuthorizationPolicyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ChangeAuthorizationPolicyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ChangeAuthorizationPolicyRequest, ...grpc.CallOption) *ChangeAuthorizationPolicyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ChangeAuthorizationPolicyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ChangeAuthorizationPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeNotificationPreferences provides a mock function with given fields: ctx, in, opts
+func (_m *MockCustomersServiceClient) ChangeNotificationPreferences(ctx context.Context, in *ChangeNotificationPreferencesRequest, opts ...grpc.CallOption) (*ChangeNotificationPreferencesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ChangeNotificationPreferencesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ChangeNotificationPreferencesRequest, ...grpc.CallOption) *ChangeNotificationPreferencesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ChangeNotificationPreferencesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ChangeNotificationPreferencesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChangeSmsNumber provides a mock function with given fields: ctx, in, opts
 func (_m *MockCustomersServiceClient) ChangeSmsNumber(ctx context.Context, in *ChangeSmsNumberRequest, opts ...grpc.CallOption) (*ChangeSmsNumberResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -135,6 +195,36 @@ func (_m *MockCustomersServiceClient) EnableCustomer(ctx context.Context, in *En
 	return r0, r1
 }
 
+// ForgetCustomer provides a mock function with given fields: ctx, in, opts
+func (_m *MockCustomersServiceClient) ForgetCustomer(ctx context.Context, in *ForgetCustomerRequest, opts ...grpc.CallOption) (*ForgetCustomerResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ForgetCustomerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ForgetCustomerRequest, ...grpc.CallOption) *ForgetCustomerResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ForgetCustomerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ForgetCustomerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCustomer provides a mock function with given fields: ctx, in, opts
 func (_m *MockCustomersServiceClient) GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*GetCustomerResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -36,6 +36,52 @@ func (_m *MockCustomersServiceServer) AuthorizeCustomer(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// ChangeAuthorizationPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockCustomersServiceServer) ChangeAuthorizationPolicy(_a0 context.Context, _a1 *ChangeAuthorizationPolicyRequest) (*ChangeAuthorizationPolicyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ChangeAuthorizationPolicyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ChangeAuthorizationPolicyRequest) *ChangeAuthorizationPolicyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ChangeAuthorizationPolicyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ChangeAuthorizationPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeNotificationPreferences provides a mock function with given fields: _a0, _a1
+func (_m *MockCustomersServiceServer) ChangeNotificationPreferences(_a0 context.Context, _a1 *ChangeNotificationPreferencesRequest) (*ChangeNotificationPreferencesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ChangeNotificationPreferencesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ChangeNotificationPreferencesRequest) *ChangeNotificationPreferencesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ChangeNotificationPreferencesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ChangeNotificationPreferencesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChangeSmsNumber provides a mock function with given fields: _a0, _a1
 func (_m *MockCustomersServiceServer) ChangeSmsNumber(_a0 context.Context, _a1 *ChangeSmsNumberRequest) (*ChangeSmsNumberResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -105,6 +151,29 @@ func (_m *MockCustomersServiceServer) EnableCustomer(_a0 context.Context, _a1 *E
 	return r0, r1
 }
 
+// ForgetCustomer provides a mock function with given fields: _a0, _a1
+func (_m *MockCustomersServiceServer) ForgetCustomer(_a0 context.Context, _a1 *ForgetCustomerRequest) (*ForgetCustomerResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ForgetCustomerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ForgetCustomerRequest) *ForgetCustomerResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ForgetCustomerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ForgetCustomerRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCustomer provides a mock function with given fields: _a0, _a1
 func (_m *MockCustomersServiceServer) GetCustomer(_a0 context.Context, _a1 *GetCustomerRequest) (*GetCustomerResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"eda-in-golang/customers/internal/domain"
-	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/encryption"
 )
 
 type (
@@ -15,7 +15,15 @@ type (
 	}
 
 	AuthorizeCustomer struct {
-		ID string
+		ID      string
+		OrderID string
+		Total   float64
+		Items   []domain.AuthorizationItem
+	}
+
+	ReleaseCustomerAuthorization struct {
+		ID      string
+		OrderID string
 	}
 
 	GetCustomer struct {
@@ -30,67 +38,101 @@ type (
 		ID string
 	}
 
+	ForgetCustomer struct {
+		ID string
+	}
+
+	ChangeNotificationPreferences struct {
+		ID          string
+		Preferences domain.NotificationPreferences
+	}
+
+	ChangeAuthorizationPolicy struct {
+		ID     string
+		Policy domain.AuthorizationPolicy
+	}
+
 	App interface {
 		RegisterCustomer(ctx context.Context, register RegisterCustomer) error
 		AuthorizeCustomer(ctx context.Context, authorize AuthorizeCustomer) error
+		ReleaseCustomerAuthorization(ctx context.Context, release ReleaseCustomerAuthorization) error
 		GetCustomer(ctx context.Context, get GetCustomer) (*domain.Customer, error)
 		EnableCustomer(ctx context.Context, enable EnableCustomer) error
 		DisableCustomer(ctx context.Context, disable DisableCustomer) error
+		ForgetCustomer(ctx context.Context, forget ForgetCustomer) error
+		ChangeNotificationPreferences(ctx context.Context, change ChangeNotificationPreferences) error
+		ChangeAuthorizationPolicy(ctx context.Context, change ChangeAuthorizationPolicy) error
 	}
 
 	Application struct {
-		customers       domain.CustomerRepository
-		domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+		customers domain.CustomerRepository
+		keys      encryption.KeyVault
+		fraud     FraudScorer
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(customers domain.CustomerRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) *Application {
+func New(customers domain.CustomerRepository, keys encryption.KeyVault, fraud FraudScorer) *Application {
 	return &Application{
-		customers:       customers,
-		domainPublisher: domainPublisher,
+		customers: customers,
+		keys:      keys,
+		fraud:     fraud,
 	}
 }
 
 func (a Application) RegisterCustomer(ctx context.Context, register RegisterCustomer) error {
-	customer, err := domain.RegisterCustomer(register.ID, register.Name, register.SmsNumber)
+	customer, err := a.customers.Load(ctx, register.ID)
 	if err != nil {
 		return err
 	}
 
-	if err = a.customers.Save(ctx, customer); err != nil {
+	if err = customer.Register(register.Name, register.SmsNumber); err != nil {
 		return err
 	}
 
-	// publish domain events
-	if err = a.domainPublisher.Publish(ctx, customer.Events()...); err != nil {
+	return a.customers.Save(ctx, customer)
+}
+
+func (a Application) AuthorizeCustomer(ctx context.Context, authorize AuthorizeCustomer) error {
+	customer, err := a.find(ctx, authorize.ID)
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	request := domain.AuthorizationRequest{
+		OrderID: authorize.OrderID,
+		Total:   authorize.Total,
+		Items:   authorize.Items,
+	}
 
-func (a Application) AuthorizeCustomer(ctx context.Context, authorize AuthorizeCustomer) error {
-	customer, err := a.customers.Find(ctx, authorize.ID)
+	score, err := a.fraud.Score(ctx, customer, request)
 	if err != nil {
 		return err
 	}
 
-	if err = customer.Authorize(); err != nil {
+	if err = customer.Authorize(request, score); err != nil {
 		return err
 	}
 
-	// publish domain events
-	if err = a.domainPublisher.Publish(ctx, customer.Events()...); err != nil {
+	return a.customers.Save(ctx, customer)
+}
+
+func (a Application) ReleaseCustomerAuthorization(ctx context.Context, release ReleaseCustomerAuthorization) error {
+	customer, err := a.find(ctx, release.ID)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	if err = customer.ReleaseAuthorization(release.OrderID); err != nil {
+		return err
+	}
+
+	return a.customers.Save(ctx, customer)
 }
 
 func (a Application) EnableCustomer(ctx context.Context, enable EnableCustomer) error {
-	customer, err := a.customers.Find(ctx, enable.ID)
+	customer, err := a.find(ctx, enable.ID)
 	if err != nil {
 		return err
 	}
@@ -99,40 +141,80 @@ func (a Application) EnableCustomer(ctx context.Context, enable EnableCustomer)
 		return err
 	}
 
-	if err = a.customers.Update(ctx, customer); err != nil {
+	return a.customers.Save(ctx, customer)
+}
+
+func (a Application) DisableCustomer(ctx context.Context, disable DisableCustomer) error {
+	customer, err := a.find(ctx, disable.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = customer.Disable(); err != nil {
+		return err
+	}
+
+	return a.customers.Save(ctx, customer)
+}
+
+func (a Application) ForgetCustomer(ctx context.Context, forget ForgetCustomer) error {
+	customer, err := a.find(ctx, forget.ID)
+	if err != nil {
 		return err
 	}
 
-	// publish domain events
-	if err = a.domainPublisher.Publish(ctx, customer.Events()...); err != nil {
+	if err = customer.Forget(); err != nil {
 		return err
 	}
 
-	return nil
+	if err = a.customers.Save(ctx, customer); err != nil {
+		return err
+	}
+
+	// without the key the personal data in past events and snapshots
+	// can no longer be read
+	return a.keys.Destroy(ctx, customer.ID())
 }
 
-func (a Application) DisableCustomer(ctx context.Context, disable DisableCustomer) error {
-	customer, err := a.customers.Find(ctx, disable.ID)
+func (a Application) ChangeNotificationPreferences(ctx context.Context, change ChangeNotificationPreferences) error {
+	customer, err := a.find(ctx, change.ID)
 	if err != nil {
 		return err
 	}
 
-	if err = customer.Disable(); err != nil {
+	if err = customer.ChangeNotificationPreferences(change.Preferences); err != nil {
 		return err
 	}
 
-	if err = a.customers.Update(ctx, customer); err != nil {
+	return a.customers.Save(ctx, customer)
+}
+
+func (a Application) ChangeAuthorizationPolicy(ctx context.Context, change ChangeAuthorizationPolicy) error {
+	customer, err := a.find(ctx, change.ID)
+	if err != nil {
 		return err
 	}
 
-	// publish domain events
-	if err = a.domainPublisher.Publish(ctx, customer.Events()...); err != nil {
+	if err = customer.ChangeAuthorizationPolicy(change.Policy); err != nil {
 		return err
 	}
 
-	return nil
+	return a.customers.Save(ctx, customer)
 }
 
 func (a Application) GetCustomer(ctx context.Context, get GetCustomer) (*domain.Customer, error) {
-	return a.customers.Find(ctx, get.ID)
+	return a.find(ctx, get.ID)
+}
+
+func (a Application) find(ctx context.Context, customerID string) (*domain.Customer, error) {
+	customer, err := a.customers.Load(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !customer.Exists() {
+		return nil, domain.ErrCustomerNotFound
+	}
+
+	return customer, nil
 }
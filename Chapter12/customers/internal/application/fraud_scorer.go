@@ -0,0 +1,12 @@
+package application
+
+import (
+	"context"
+
+	"eda-in-golang/customers/internal/domain"
+)
+
+// FraudScorer rates how likely an order is to be fraudulent, from 0 (not at all) to 1
+type FraudScorer interface {
+	Score(ctx context.Context, customer *domain.Customer, request domain.AuthorizationRequest) (float64, error)
+}
@@ -28,6 +28,34 @@ func (_m *MockApp) AuthorizeCustomer(ctx context.Context, authorize AuthorizeCus
 	return r0
 }
 
+// ChangeAuthorizationPolicy provides a mock function with given fields: ctx, change
+func (_m *MockApp) ChangeAuthorizationPolicy(ctx context.Context, change ChangeAuthorizationPolicy) error {
+	ret := _m.Called(ctx, change)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ChangeAuthorizationPolicy) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangeNotificationPreferences provides a mock function with given fields: ctx, change
+func (_m *MockApp) ChangeNotificationPreferences(ctx context.Context, change ChangeNotificationPreferences) error {
+	ret := _m.Called(ctx, change)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ChangeNotificationPreferences) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DisableCustomer provides a mock function with given fields: ctx, disable
 func (_m *MockApp) DisableCustomer(ctx context.Context, disable DisableCustomer) error {
 	ret := _m.Called(ctx, disable)
@@ -56,6 +84,20 @@ func (_m *MockApp) EnableCustomer(ctx context.Context, enable EnableCustomer) er
 	return r0
 }
 
+// ForgetCustomer provides a mock function with given fields: ctx, forget
+func (_m *MockApp) ForgetCustomer(ctx context.Context, forget ForgetCustomer) error {
+	ret := _m.Called(ctx, forget)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ForgetCustomer) error); ok {
+		r0 = rf(ctx, forget)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetCustomer provides a mock function with given fields: ctx, get
 func (_m *MockApp) GetCustomer(ctx context.Context, get GetCustomer) (*domain.Customer, error) {
 	ret := _m.Called(ctx, get)
@@ -93,6 +135,20 @@ func (_m *MockApp) RegisterCustomer(ctx context.Context, register RegisterCustom
 	return r0
 }
 
+// ReleaseCustomerAuthorization provides a mock function with given fields: ctx, release
+func (_m *MockApp) ReleaseCustomerAuthorization(ctx context.Context, release ReleaseCustomerAuthorization) error {
+	ret := _m.Called(ctx, release)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ReleaseCustomerAuthorization) error); ok {
+		r0 = rf(ctx, release)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockApp interface {
 	mock.TestingT
 	Cleanup(func())
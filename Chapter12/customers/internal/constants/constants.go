@@ -27,7 +27,11 @@ const (
 	CommandHandlersKey          = "commandHandlers"
 	ReplyHandlersKey            = "replyHandlers"
 
-	CustomersRepoKey = "customersRepo"
+	CustomersRepoKey        = "customersRepo"
+	CustomerBackfillRepoKey = "customerBackfillRepo"
+	LegacyCustomersRepoKey  = "legacyCustomersRepo"
+	KeyVaultKey             = "keyVault"
+	FraudScorerKey          = "fraudScorer"
 )
 
 // Repository Table Names
@@ -38,7 +42,8 @@ const (
 	SnapshotsTableName = ServiceName + ".snapshots"
 	SagasTableName     = ServiceName + ".sagas"
 
-	CustomersTableName = ServiceName + ".customers"
+	KeyVaultTableName        = ServiceName + ".key_vault"
+	LegacyCustomersTableName = ServiceName + ".customers"
 )
 
 // Metric Names
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+const (
+	PolicyPeriodDay   = "day"
+	PolicyPeriodWeek  = "week"
+	PolicyPeriodMonth = "month"
+
+	// the reason codes given back when an authorization is denied
+	DenialCustomerDisabled      = "customer_disabled"
+	DenialOrderTotalExceeded    = "order_total_exceeded"
+	DenialSpendingLimitExceeded = "spending_limit_exceeded"
+	DenialOrderLimitExceeded    = "order_limit_exceeded"
+	DenialStoreBlocked          = "store_blocked"
+	DenialFraudSuspected        = "fraud_suspected"
+
+	// authorizations are kept for the longest period a policy can have
+	authorizationRetention = 30 * 24 * time.Hour
+)
+
+var (
+	ErrOrderTotalExceeded           = errors.Wrap(errors.ErrUnauthorized, "the order total is over the customer limit for a single order")
+	ErrSpendingLimitExceeded        = errors.Wrap(errors.ErrUnauthorized, "the order would put the customer over their spending limit")
+	ErrOrderLimitExceeded           = errors.Wrap(errors.ErrUnauthorized, "the customer has placed as many orders as they may this period")
+	ErrStoreBlocked                 = errors.Wrap(errors.ErrUnauthorized, "the customer may not order from the store")
+	ErrFraudSuspected               = errors.Wrap(errors.ErrUnauthorized, "the order is suspected of being fraudulent")
+	ErrUnknownPolicyPeriod          = errors.Wrap(errors.ErrBadRequest, "the policy period must be one of day, week or month")
+	ErrPolicyLimitCannotBeNegative  = errors.Wrap(errors.ErrBadRequest, "the policy limits cannot be negative")
+	ErrInvalidMaxFraudScore         = errors.Wrap(errors.ErrBadRequest, "the maximum fraud score must be between 0 and 1")
+	ErrBlockedStoreIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the blocked store id cannot be blank")
+	ErrAuthorizationOrderIDRequired = errors.Wrap(errors.ErrBadRequest, "the order id is required to authorize a customer")
+)
+
+// denials pairs the errors returned by Customer.Authorize with their reason codes
+var denials = []struct {
+	err    error
+	reason string
+}{
+	{ErrCustomerNotAuthorized, DenialCustomerDisabled},
+	{ErrOrderTotalExceeded, DenialOrderTotalExceeded},
+	{ErrSpendingLimitExceeded, DenialSpendingLimitExceeded},
+	{ErrOrderLimitExceeded, DenialOrderLimitExceeded},
+	{ErrStoreBlocked, DenialStoreBlocked},
+	{ErrFraudSuspected, DenialFraudSuspected},
+}
+
+// AuthorizationPolicy limits the orders a customer may place; the zero value
+// of each limit leaves it unchecked
+type AuthorizationPolicy struct {
+	// MaxOrderTotal is the largest total a single order may have
+	MaxOrderTotal float64
+	// SpendingLimit and OrderLimit cap what is spent and how many orders are
+	// placed within the rolling Period
+	SpendingLimit float64
+	OrderLimit    int
+	Period        string
+	// BlockedStoreIDs are the stores the customer may not order from
+	BlockedStoreIDs []string
+	// MaxFraudScore is the highest fraud score, from 0 to 1, an order may have
+	MaxFraudScore float64
+}
+
+// AuthorizationRequest is the order a customer is being authorized to place
+type AuthorizationRequest struct {
+	OrderID string
+	Total   float64
+	Items   []AuthorizationItem
+}
+
+type AuthorizationItem struct {
+	ProductID string
+	StoreID   string
+	Price     float64
+	Quantity  int
+}
+
+// Authorization is an order the customer was authorized to place
+type Authorization struct {
+	OrderID      string
+	Total        float64
+	AuthorizedAt time.Time
+}
+
+// DefaultAuthorizationPolicy places no limits on a customer
+func DefaultAuthorizationPolicy() AuthorizationPolicy {
+	return AuthorizationPolicy{
+		Period: PolicyPeriodDay,
+	}
+}
+
+// DenialReason returns the reason code for an error returned by
+// Customer.Authorize or blank when the error is not a denial
+func DenialReason(err error) string {
+	for _, denial := range denials {
+		if errors.Is(err, denial.err) {
+			return denial.reason
+		}
+	}
+
+	return ""
+}
+
+func (p AuthorizationPolicy) validate() error {
+	switch p.Period {
+	case PolicyPeriodDay, PolicyPeriodWeek, PolicyPeriodMonth:
+	default:
+		return ErrUnknownPolicyPeriod
+	}
+
+	if p.MaxOrderTotal < 0 || p.SpendingLimit < 0 || p.OrderLimit < 0 {
+		return ErrPolicyLimitCannotBeNegative
+	}
+
+	if p.MaxFraudScore < 0 || p.MaxFraudScore > 1 {
+		return ErrInvalidMaxFraudScore
+	}
+
+	for _, storeID := range p.BlockedStoreIDs {
+		if storeID == "" {
+			return ErrBlockedStoreIDCannotBeBlank
+		}
+	}
+
+	return nil
+}
+
+func (p AuthorizationPolicy) period() time.Duration {
+	switch p.Period {
+	case PolicyPeriodWeek:
+		return 7 * 24 * time.Hour
+	case PolicyPeriodMonth:
+		return authorizationRetention
+	default:
+		return 24 * time.Hour
+	}
+}
+
+func (p AuthorizationPolicy) blocks(storeID string) bool {
+	for _, blockedID := range p.BlockedStoreIDs {
+		if blockedID == storeID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check returns the first limit the request breaks given the orders
+// authorized so far
+func (p AuthorizationPolicy) check(request AuthorizationRequest, authorizations []Authorization, fraudScore float64, at time.Time) error {
+	if p.MaxOrderTotal > 0 && request.Total > p.MaxOrderTotal {
+		return ErrOrderTotalExceeded
+	}
+
+	for _, item := range request.Items {
+		if p.blocks(item.StoreID) {
+			return errors.Wrapf(ErrStoreBlocked, "store: %s", item.StoreID)
+		}
+	}
+
+	since := at.Add(-p.period())
+	var orders int
+	var spent float64
+	for _, authorization := range authorizations {
+		if authorization.AuthorizedAt.After(since) {
+			orders++
+			spent += authorization.Total
+		}
+	}
+
+	if p.OrderLimit > 0 && orders >= p.OrderLimit {
+		return ErrOrderLimitExceeded
+	}
+
+	if p.SpendingLimit > 0 && spent+request.Total > p.SpendingLimit {
+		return ErrSpendingLimitExceeded
+	}
+
+	if p.MaxFraudScore > 0 && fraudScore > p.MaxFraudScore {
+		return ErrFraudSuspected
+	}
+
+	return nil
+}
+
+// recent drops the authorizations no policy period can reach back to
+func recent(authorizations []Authorization, at time.Time) []Authorization {
+	since := at.Add(-authorizationRetention)
+	kept := authorizations[:0]
+	for _, authorization := range authorizations {
+		if authorization.AuthorizedAt.After(since) {
+			kept = append(kept, authorization)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizationPolicy_validate(t *testing.T) {
+	tests := map[string]struct {
+		policy  AuthorizationPolicy
+		wantErr error
+	}{
+		"Default": {
+			policy: DefaultAuthorizationPolicy(),
+		},
+		"Limits": {
+			policy: AuthorizationPolicy{
+				MaxOrderTotal:   100,
+				SpendingLimit:   500,
+				OrderLimit:      5,
+				Period:          PolicyPeriodWeek,
+				BlockedStoreIDs: []string{"store-id"},
+				MaxFraudScore:   0.8,
+			},
+		},
+		"NoPeriod": {
+			policy:  AuthorizationPolicy{},
+			wantErr: ErrUnknownPolicyPeriod,
+		},
+		"UnknownPeriod": {
+			policy:  AuthorizationPolicy{Period: "year"},
+			wantErr: ErrUnknownPolicyPeriod,
+		},
+		"NegativeSpendingLimit": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, SpendingLimit: -1},
+			wantErr: ErrPolicyLimitCannotBeNegative,
+		},
+		"NegativeOrderLimit": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, OrderLimit: -1},
+			wantErr: ErrPolicyLimitCannotBeNegative,
+		},
+		"FraudScoreOverOne": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, MaxFraudScore: 1.5},
+			wantErr: ErrInvalidMaxFraudScore,
+		},
+		"BlankBlockedStore": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, BlockedStoreIDs: []string{""}},
+			wantErr: ErrBlockedStoreIDCannotBeBlank,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.validate()
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthorizationPolicy_check(t *testing.T) {
+	at := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	request := AuthorizationRequest{
+		OrderID: "order-id",
+		Total:   50,
+		Items:   []AuthorizationItem{{ProductID: "product-id", StoreID: "store-id", Price: 25, Quantity: 2}},
+	}
+	// two orders in the last day and one more in the last week
+	authorizations := []Authorization{
+		{OrderID: "order-1", Total: 100, AuthorizedAt: at.Add(-time.Hour)},
+		{OrderID: "order-2", Total: 100, AuthorizedAt: at.Add(-20 * time.Hour)},
+		{OrderID: "order-3", Total: 100, AuthorizedAt: at.Add(-3 * 24 * time.Hour)},
+	}
+
+	tests := map[string]struct {
+		policy     AuthorizationPolicy
+		fraudScore float64
+		wantErr    error
+	}{
+		"NoLimits": {
+			policy: DefaultAuthorizationPolicy(),
+		},
+		"OrderTotal": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, MaxOrderTotal: 49.99},
+			wantErr: ErrOrderTotalExceeded,
+		},
+		"BlockedStore": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodDay, BlockedStoreIDs: []string{"other-id", "store-id"}},
+			wantErr: ErrStoreBlocked,
+		},
+		"UnderDailySpendingLimit": {
+			policy: AuthorizationPolicy{Period: PolicyPeriodDay, SpendingLimit: 250},
+		},
+		"OverWeeklySpendingLimit": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodWeek, SpendingLimit: 250},
+			wantErr: ErrSpendingLimitExceeded,
+		},
+		"UnderDailyOrderLimit": {
+			policy: AuthorizationPolicy{Period: PolicyPeriodDay, OrderLimit: 3},
+		},
+		"AtWeeklyOrderLimit": {
+			policy:  AuthorizationPolicy{Period: PolicyPeriodWeek, OrderLimit: 3},
+			wantErr: ErrOrderLimitExceeded,
+		},
+		"FraudScore": {
+			policy:     AuthorizationPolicy{Period: PolicyPeriodDay, MaxFraudScore: 0.5},
+			fraudScore: 0.51,
+			wantErr:    ErrFraudSuspected,
+		},
+		"FraudScoreUnchecked": {
+			policy:     DefaultAuthorizationPolicy(),
+			fraudScore: 0.99,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.policy.check(request, authorizations, tc.fraudScore, at)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDenialReason(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"Disabled":      {err: ErrCustomerNotAuthorized, want: DenialCustomerDisabled},
+		"SpendingLimit": {err: ErrSpendingLimitExceeded, want: DenialSpendingLimitExceeded},
+		"WrappedStore":  {err: fmt.Errorf("authorizing: %w", ErrStoreBlocked), want: DenialStoreBlocked},
+		"NotADenial":    {err: ErrAuthorizationOrderIDRequired},
+		"Other":         {err: fmt.Errorf("connection refused")},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DenialReason(tc.err))
+		})
+	}
+}
+
+func TestCustomer_Authorize(t *testing.T) {
+	customer := NewCustomer("customer-id")
+	customer.Enabled = true
+	customer.AuthorizationPolicy = AuthorizationPolicy{Period: PolicyPeriodDay, OrderLimit: 1}
+
+	request := AuthorizationRequest{OrderID: "order-id", Total: 50}
+	if assert.NoError(t, customer.Authorize(request, 0)) {
+		assert.Len(t, customer.Events(), 1)
+	}
+	commitCustomer(t, customer)
+
+	// the same order is not counted twice
+	assert.NoError(t, customer.Authorize(request, 0))
+	assert.Empty(t, customer.Events())
+
+	assert.ErrorIs(t, customer.Authorize(AuthorizationRequest{OrderID: "other-id", Total: 50}, 0), ErrOrderLimitExceeded)
+}
+
+func TestCustomer_ReleaseAuthorization(t *testing.T) {
+	customer := NewCustomer("customer-id")
+	customer.Enabled = true
+	customer.AuthorizationPolicy = AuthorizationPolicy{Period: PolicyPeriodDay, OrderLimit: 1}
+	customer.Authorizations = []Authorization{
+		{OrderID: "order-id", Total: 50, AuthorizedAt: time.Now().Add(-time.Minute)},
+	}
+
+	// releasing an order that is not held changes nothing
+	assert.NoError(t, customer.ReleaseAuthorization("other-id"))
+	assert.Empty(t, customer.Events())
+
+	assert.ErrorIs(t, customer.ReleaseAuthorization(""), ErrAuthorizationOrderIDRequired)
+
+	if assert.NoError(t, customer.ReleaseAuthorization("order-id")) {
+		assert.Len(t, customer.Events(), 1)
+	}
+	commitCustomer(t, customer)
+	assert.Empty(t, customer.Authorizations)
+
+	// the released order no longer counts against the order limit
+	assert.NoError(t, customer.Authorize(AuthorizationRequest{OrderID: "other-id", Total: 50}, 0))
+}
+
+func commitCustomer(t *testing.T, customer *Customer) {
+	t.Helper()
+
+	for _, event := range customer.Events() {
+		if err := customer.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	customer.CommitEvents()
+}
@@ -1,84 +1,145 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/es"
 )
 
 const CustomerAggregate = "customers.CustomerAggregate"
 
 type Customer struct {
-	ddd.Aggregate
+	es.Aggregate
 	Name      string
 	SmsNumber string
 	Enabled   bool
+	Forgotten bool
+
+	NotificationPreferences NotificationPreferences
+	AuthorizationPolicy     AuthorizationPolicy
+	// Authorizations are the orders authorized within the longest policy period
+	Authorizations []Authorization
 }
 
+var _ interface {
+	es.EventApplier
+	es.Snapshotter
+} = (*Customer)(nil)
+
 var (
-	ErrNameCannotBeBlank       = errors.Wrap(errors.ErrBadRequest, "the customer name cannot be blank")
-	ErrCustomerIDCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
-	ErrSmsNumberCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the SMS number cannot be blank")
-	ErrCustomerAlreadyEnabled  = errors.Wrap(errors.ErrBadRequest, "the customer is already enabled")
-	ErrCustomerAlreadyDisabled = errors.Wrap(errors.ErrBadRequest, "the customer is already disabled")
-	ErrCustomerNotAuthorized   = errors.Wrap(errors.ErrUnauthorized, "customer is not authorized")
+	ErrNameCannotBeBlank        = errors.Wrap(errors.ErrBadRequest, "the customer name cannot be blank")
+	ErrCustomerIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
+	ErrSmsNumberCannotBeBlank   = errors.Wrap(errors.ErrBadRequest, "the SMS number cannot be blank")
+	ErrCustomerAlreadyExists    = errors.Wrap(errors.ErrBadRequest, "the customer already exists")
+	ErrCustomerAlreadyEnabled   = errors.Wrap(errors.ErrBadRequest, "the customer is already enabled")
+	ErrCustomerAlreadyDisabled  = errors.Wrap(errors.ErrBadRequest, "the customer is already disabled")
+	ErrCustomerAlreadyForgotten = errors.Wrap(errors.ErrBadRequest, "the customer has already been forgotten")
+	ErrCustomerNotFound         = errors.Wrap(errors.ErrNotFound, "the customer was not found")
+	ErrCustomerNotAuthorized    = errors.Wrap(errors.ErrUnauthorized, "customer is not authorized")
 )
 
 func NewCustomer(id string) *Customer {
 	return &Customer{
-		Aggregate: ddd.NewAggregate(id, CustomerAggregate),
+		Aggregate: es.NewAggregate(id, CustomerAggregate),
 	}
 }
 
-func RegisterCustomer(id, name, smsNumber string) (*Customer, error) {
-	if id == "" {
-		return nil, ErrCustomerIDCannotBeBlank
+func (c *Customer) Register(name, smsNumber string) error {
+	if c.ID() == "" {
+		return ErrCustomerIDCannotBeBlank
+	}
+
+	if c.Version() != 0 {
+		return ErrCustomerAlreadyExists
 	}
 
 	if name == "" {
-		return nil, ErrNameCannotBeBlank
+		return ErrNameCannotBeBlank
 	}
 
 	if smsNumber == "" {
-		return nil, ErrSmsNumberCannotBeBlank
+		return ErrSmsNumberCannotBeBlank
 	}
 
-	customer := NewCustomer(id)
-	customer.Name = name
-	customer.SmsNumber = smsNumber
-	customer.Enabled = true
-
-	customer.AddEvent(CustomerRegisteredEvent, &CustomerRegistered{
-		Customer: customer,
+	c.AddEvent(CustomerRegisteredEvent, &CustomerRegistered{
+		Name:      name,
+		SmsNumber: smsNumber,
 	})
 
-	return customer, nil
+	return nil
 }
 
 func (Customer) Key() string { return CustomerAggregate }
 
-func (c *Customer) Authorize( /* TODO authorize what? */ ) error {
+// Exists is false for customers that were never registered
+func (c Customer) Exists() bool {
+	return c.Version() != 0
+}
+
+// Authorize checks the order against the authorization policy; fraudScore is
+// the score the order was given by the fraud scorer
+func (c *Customer) Authorize(request AuthorizationRequest, fraudScore float64) error {
 	if !c.Enabled {
 		return ErrCustomerNotAuthorized
 	}
 
+	if request.OrderID == "" {
+		return ErrAuthorizationOrderIDRequired
+	}
+
+	// an order is only counted against the limits once
+	for _, authorization := range c.Authorizations {
+		if authorization.OrderID == request.OrderID {
+			return nil
+		}
+	}
+
+	now := time.Now()
+	if err := c.AuthorizationPolicy.check(request, c.Authorizations, fraudScore, now); err != nil {
+		return err
+	}
+
 	c.AddEvent(CustomerAuthorizedEvent, &CustomerAuthorized{
-		Customer: c,
+		OrderID:      request.OrderID,
+		Total:        request.Total,
+		AuthorizedAt: now,
 	})
 
 	return nil
 }
 
+// ReleaseAuthorization stops an order that was never placed from counting
+// against the limits; releasing an order that is not held does nothing
+func (c *Customer) ReleaseAuthorization(orderID string) error {
+	if orderID == "" {
+		return ErrAuthorizationOrderIDRequired
+	}
+
+	for _, authorization := range c.Authorizations {
+		if authorization.OrderID == orderID {
+			c.AddEvent(CustomerAuthorizationReleasedEvent, &CustomerAuthorizationReleased{
+				OrderID: orderID,
+			})
+			return nil
+		}
+	}
+
+	return nil
+}
+
 func (c *Customer) Enable() error {
+	if c.Forgotten {
+		return ErrCustomerAlreadyForgotten
+	}
+
 	if c.Enabled {
 		return ErrCustomerAlreadyEnabled
 	}
 
-	c.Enabled = true
-
-	c.AddEvent(CustomerEnabledEvent, &CustomerEnabled{
-		Customer: c,
-	})
+	c.AddEvent(CustomerEnabledEvent, &CustomerEnabled{})
 
 	return nil
 }
@@ -88,11 +149,199 @@ func (c *Customer) Disable() error {
 		return ErrCustomerAlreadyDisabled
 	}
 
-	c.Enabled = false
+	c.AddEvent(CustomerDisabledEvent, &CustomerDisabled{})
+
+	return nil
+}
+
+// Forget disables the customer for good; their personal data is erased by
+// destroying the key it was encrypted with
+func (c *Customer) Forget() error {
+	if c.Forgotten {
+		return ErrCustomerAlreadyForgotten
+	}
+
+	c.AddEvent(CustomerForgottenEvent, &CustomerForgotten{})
+
+	return nil
+}
+
+// ChangeNotificationPreferences replaces the notification preferences as a whole
+func (c *Customer) ChangeNotificationPreferences(preferences NotificationPreferences) error {
+	if c.Forgotten {
+		return ErrCustomerAlreadyForgotten
+	}
 
-	c.AddEvent(CustomerDisabledEvent, &CustomerDisabled{
-		Customer: c,
+	preferences = preferences.deduplicated()
+	if err := preferences.validate(); err != nil {
+		return err
+	}
+
+	c.AddEvent(CustomerNotificationPreferencesChangedEvent, &CustomerNotificationPreferencesChanged{
+		Channels:   preferences.Channels,
+		Email:      preferences.Email,
+		WebhookURL: preferences.WebhookURL,
+		QuietStart: preferences.QuietStart,
+		QuietEnd:   preferences.QuietEnd,
+		TimeZone:   preferences.TimeZone,
+		Kinds:      preferences.Kinds,
+	})
+
+	return nil
+}
+
+// ChangeAuthorizationPolicy replaces the authorization policy as a whole
+func (c *Customer) ChangeAuthorizationPolicy(policy AuthorizationPolicy) error {
+	if c.Forgotten {
+		return ErrCustomerAlreadyForgotten
+	}
+
+	if err := policy.validate(); err != nil {
+		return err
+	}
+
+	c.AddEvent(CustomerAuthorizationPolicyChangedEvent, &CustomerAuthorizationPolicyChanged{
+		MaxOrderTotal:   policy.MaxOrderTotal,
+		SpendingLimit:   policy.SpendingLimit,
+		OrderLimit:      policy.OrderLimit,
+		Period:          policy.Period,
+		BlockedStoreIDs: policy.BlockedStoreIDs,
+		MaxFraudScore:   policy.MaxFraudScore,
 	})
 
 	return nil
 }
+
+func (c *Customer) ApplyEvent(event ddd.Event) error {
+	switch payload := event.Payload().(type) {
+	case *CustomerRegistered:
+		c.Name = payload.Name
+		c.SmsNumber = payload.SmsNumber
+		c.Enabled = true
+		c.NotificationPreferences = DefaultNotificationPreferences()
+		c.AuthorizationPolicy = DefaultAuthorizationPolicy()
+
+	case *CustomerSmsChanged:
+		c.SmsNumber = payload.SmsNumber
+
+	case *CustomerNotificationPreferencesChanged:
+		c.NotificationPreferences = NotificationPreferences{
+			Channels:   payload.Channels,
+			Email:      payload.Email,
+			WebhookURL: payload.WebhookURL,
+			QuietStart: payload.QuietStart,
+			QuietEnd:   payload.QuietEnd,
+			TimeZone:   payload.TimeZone,
+			Kinds:      payload.Kinds,
+		}
+
+	case *CustomerAuthorizationPolicyChanged:
+		c.AuthorizationPolicy = AuthorizationPolicy{
+			MaxOrderTotal:   payload.MaxOrderTotal,
+			SpendingLimit:   payload.SpendingLimit,
+			OrderLimit:      payload.OrderLimit,
+			Period:          payload.Period,
+			BlockedStoreIDs: payload.BlockedStoreIDs,
+			MaxFraudScore:   payload.MaxFraudScore,
+		}
+
+	case *CustomerAuthorized:
+		// authorizations from before policies existed did not name the order
+		if payload.OrderID != "" {
+			c.Authorizations = append(recent(c.Authorizations, payload.AuthorizedAt), Authorization{
+				OrderID:      payload.OrderID,
+				Total:        payload.Total,
+				AuthorizedAt: payload.AuthorizedAt,
+			})
+		}
+
+	case *CustomerAuthorizationReleased:
+		for i, authorization := range c.Authorizations {
+			if authorization.OrderID == payload.OrderID {
+				c.Authorizations = append(c.Authorizations[:i], c.Authorizations[i+1:]...)
+				break
+			}
+		}
+
+	case *CustomerEnabled:
+		c.Enabled = true
+
+	case *CustomerDisabled:
+		c.Enabled = false
+
+	case *CustomerForgotten:
+		c.Enabled = false
+		c.Forgotten = true
+
+	default:
+		return errors.ErrInternal.Msgf("%T received the event %s with unexpected payload %T", c, event.EventName(), payload)
+	}
+
+	return nil
+}
+
+func (c *Customer) ApplySnapshot(snapshot es.Snapshot) error {
+	switch ss := snapshot.(type) {
+	case *CustomerV1:
+		c.Name = ss.Name
+		c.SmsNumber = ss.SmsNumber
+		c.Enabled = ss.Enabled
+		c.Forgotten = ss.Forgotten
+		c.NotificationPreferences = NotificationPreferences{
+			Channels:   ss.Channels,
+			Email:      ss.Email,
+			WebhookURL: ss.WebhookURL,
+			QuietStart: ss.QuietStart,
+			QuietEnd:   ss.QuietEnd,
+			TimeZone:   ss.TimeZone,
+			Kinds:      ss.Kinds,
+		}
+		// snapshots taken before preferences existed
+		if len(ss.Channels) == 0 {
+			c.NotificationPreferences = DefaultNotificationPreferences()
+		}
+		c.AuthorizationPolicy = AuthorizationPolicy{
+			MaxOrderTotal:   ss.MaxOrderTotal,
+			SpendingLimit:   ss.SpendingLimit,
+			OrderLimit:      ss.OrderLimit,
+			Period:          ss.Period,
+			BlockedStoreIDs: ss.BlockedStoreIDs,
+			MaxFraudScore:   ss.MaxFraudScore,
+		}
+		// snapshots taken before authorization policies existed
+		if ss.Period == "" {
+			c.AuthorizationPolicy = DefaultAuthorizationPolicy()
+		}
+		c.Authorizations = ss.Authorizations
+
+	default:
+		return errors.ErrInternal.Msgf("%T received the unexpected snapshot %T", c, snapshot)
+	}
+
+	return nil
+}
+
+func (c *Customer) ToSnapshot() es.Snapshot {
+	return &CustomerV1{
+		Name:      c.Name,
+		SmsNumber: c.SmsNumber,
+		Enabled:   c.Enabled,
+		Forgotten: c.Forgotten,
+
+		Channels:   c.NotificationPreferences.Channels,
+		Email:      c.NotificationPreferences.Email,
+		WebhookURL: c.NotificationPreferences.WebhookURL,
+		QuietStart: c.NotificationPreferences.QuietStart,
+		QuietEnd:   c.NotificationPreferences.QuietEnd,
+		TimeZone:   c.NotificationPreferences.TimeZone,
+		Kinds:      c.NotificationPreferences.Kinds,
+
+		MaxOrderTotal:   c.AuthorizationPolicy.MaxOrderTotal,
+		SpendingLimit:   c.AuthorizationPolicy.SpendingLimit,
+		OrderLimit:      c.AuthorizationPolicy.OrderLimit,
+		Period:          c.AuthorizationPolicy.Period,
+		BlockedStoreIDs: c.AuthorizationPolicy.BlockedStoreIDs,
+		MaxFraudScore:   c.AuthorizationPolicy.MaxFraudScore,
+		Authorizations:  c.Authorizations,
+	}
+}
@@ -1,39 +1,88 @@
 package domain
 
+import (
+	"time"
+)
+
 const (
 	CustomerRegisteredEvent = "customers.CustomerRegistered"
 	CustomerSmsChangedEvent = "customers.CustomerSmsChanged"
 	CustomerAuthorizedEvent = "customers.CustomerAuthorized"
 	CustomerEnabledEvent    = "customers.CustomerEnabled"
 	CustomerDisabledEvent   = "customers.CustomerDisabled"
+	CustomerForgottenEvent  = "customers.CustomerForgotten"
+
+	CustomerAuthorizationReleasedEvent = "customers.CustomerAuthorizationReleased"
+
+	CustomerNotificationPreferencesChangedEvent = "customers.CustomerNotificationPreferencesChanged"
+	CustomerAuthorizationPolicyChangedEvent     = "customers.CustomerAuthorizationPolicyChanged"
 )
 
+// Fields tagged with `pii:"true"` are encrypted with a key that is destroyed
+// when the customer is forgotten
+
 type CustomerRegistered struct {
-	Customer *Customer
+	Name      string `pii:"true"`
+	SmsNumber string `pii:"true"`
 }
 
 func (CustomerRegistered) Key() string { return CustomerRegisteredEvent }
 
 type CustomerSmsChanged struct {
-	Customer *Customer
+	SmsNumber string `pii:"true"`
 }
 
 func (CustomerSmsChanged) Key() string { return CustomerSmsChangedEvent }
 
 type CustomerAuthorized struct {
-	Customer *Customer
+	OrderID      string
+	Total        float64
+	AuthorizedAt time.Time
 }
 
 func (CustomerAuthorized) Key() string { return CustomerAuthorizedEvent }
 
-type CustomerEnabled struct {
-	Customer *Customer
+type CustomerAuthorizationReleased struct {
+	OrderID string
 }
 
+func (CustomerAuthorizationReleased) Key() string { return CustomerAuthorizationReleasedEvent }
+
+type CustomerEnabled struct{}
+
 func (CustomerEnabled) Key() string { return CustomerEnabledEvent }
 
-type CustomerDisabled struct {
-	Customer *Customer
-}
+type CustomerDisabled struct{}
 
 func (CustomerDisabled) Key() string { return CustomerDisabledEvent }
+
+type CustomerForgotten struct{}
+
+func (CustomerForgotten) Key() string { return CustomerForgottenEvent }
+
+type CustomerNotificationPreferencesChanged struct {
+	Channels   []string
+	Email      string `pii:"true"`
+	WebhookURL string `pii:"true"`
+	QuietStart string
+	QuietEnd   string
+	TimeZone   string
+	Kinds      map[string]bool
+}
+
+func (CustomerNotificationPreferencesChanged) Key() string {
+	return CustomerNotificationPreferencesChangedEvent
+}
+
+type CustomerAuthorizationPolicyChanged struct {
+	MaxOrderTotal   float64
+	SpendingLimit   float64
+	OrderLimit      int
+	Period          string
+	BlockedStoreIDs []string
+	MaxFraudScore   float64
+}
+
+func (CustomerAuthorizationPolicyChanged) Key() string {
+	return CustomerAuthorizationPolicyChangedEvent
+}
@@ -5,7 +5,6 @@ import (
 )
 
 type CustomerRepository interface {
+	Load(ctx context.Context, customerID string) (*Customer, error)
 	Save(ctx context.Context, customer *Customer) error
-	Find(ctx context.Context, customerID string) (*Customer, error)
-	Update(ctx context.Context, customer *Customer) error
 }
@@ -0,0 +1,26 @@
+package domain
+
+type CustomerV1 struct {
+	Name      string `pii:"true"`
+	SmsNumber string `pii:"true"`
+	Enabled   bool
+	Forgotten bool
+
+	Channels   []string
+	Email      string `pii:"true"`
+	WebhookURL string `pii:"true"`
+	QuietStart string
+	QuietEnd   string
+	TimeZone   string
+	Kinds      map[string]bool
+
+	MaxOrderTotal   float64
+	SpendingLimit   float64
+	OrderLimit      int
+	Period          string
+	BlockedStoreIDs []string
+	MaxFraudScore   float64
+	Authorizations  []Authorization
+}
+
+func (CustomerV1) SnapshotName() string { return "customers.CustomerV1" }
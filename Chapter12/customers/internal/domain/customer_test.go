@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/encryption"
+)
+
+// testKeyVault keeps the keys in memory; destroyed keys cannot be found again
+type testKeyVault map[string]*encryption.Key
+
+func (v testKeyVault) Create(ctx context.Context, subjectID string) (encryption.Key, error) {
+	if _, exists := v[subjectID]; !exists {
+		key, err := encryption.NewSubjectKey(subjectID)
+		if err != nil {
+			return encryption.Key{}, err
+		}
+		v[subjectID] = &key
+	}
+	return v.Find(ctx, subjectID)
+}
+
+func (v testKeyVault) Find(_ context.Context, subjectID string) (encryption.Key, error) {
+	key, exists := v[subjectID]
+	if !exists {
+		return encryption.Key{}, encryption.ErrKeyNotFound(subjectID)
+	}
+	if key == nil {
+		return encryption.Key{}, encryption.ErrSubjectForgotten(subjectID)
+	}
+	return *key, nil
+}
+
+func (v testKeyVault) Destroy(_ context.Context, subjectID string) error {
+	v[subjectID] = nil
+	return nil
+}
+
+func TestCustomer_Forget(t *testing.T) {
+	customer := NewCustomer("customer-id")
+	if err := customer.Register("name", "555-1212"); err != nil {
+		t.Fatal(err)
+	}
+	commitCustomer(t, customer)
+
+	if err := customer.Forget(); !assert.NoError(t, err) {
+		return
+	}
+	commitCustomer(t, customer)
+
+	assert.True(t, customer.Forgotten)
+	assert.False(t, customer.Enabled)
+	assert.ErrorIs(t, customer.Forget(), ErrCustomerAlreadyForgotten)
+	assert.ErrorIs(t, customer.Enable(), ErrCustomerAlreadyForgotten)
+	assert.ErrorIs(t, customer.ChangeNotificationPreferences(DefaultNotificationPreferences()), ErrCustomerAlreadyForgotten)
+	assert.ErrorIs(t, customer.ChangeAuthorizationPolicy(DefaultAuthorizationPolicy()), ErrCustomerAlreadyForgotten)
+}
+
+func TestCustomer_LoadForgotten(t *testing.T) {
+	ctx := context.Background()
+	vault := testKeyVault{}
+	protector := encryption.NewPIIProtector(vault)
+
+	// the personal data is stored encrypted with the key of the customer
+	protect := func(payload ddd.EventPayload) ddd.EventPayload {
+		t.Helper()
+		v, err := protector.Protect(ctx, "customer-id", payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	events := []ddd.EventPayload{
+		protect(&CustomerRegistered{Name: "name", SmsNumber: "555-1212"}),
+		protect(&CustomerSmsChanged{SmsNumber: "555-1313"}),
+		protect(&CustomerNotificationPreferencesChanged{
+			Channels:   []string{NotificationChannelEmail, NotificationChannelWebhook},
+			Email:      "name@example.com",
+			WebhookURL: "https://example.com/hook",
+		}),
+		&CustomerForgotten{},
+	}
+	snapshot, err := protector.Protect(ctx, "customer-id", &CustomerV1{
+		Name:       "name",
+		SmsNumber:  "555-1313",
+		Forgotten:  true,
+		Channels:   []string{NotificationChannelEmail, NotificationChannelWebhook},
+		Email:      "name@example.com",
+		WebhookURL: "https://example.com/hook",
+		Period:     PolicyPeriodDay,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = vault.Destroy(ctx, "customer-id"); err != nil {
+		t.Fatal(err)
+	}
+
+	assertForgotten := func(t *testing.T, customer *Customer) {
+		t.Helper()
+		assert.Equal(t, encryption.RedactedValue, customer.Name)
+		assert.Equal(t, encryption.RedactedValue, customer.SmsNumber)
+		assert.Equal(t, encryption.RedactedValue, customer.NotificationPreferences.Email)
+		assert.Equal(t, encryption.RedactedValue, customer.NotificationPreferences.WebhookURL)
+		assert.True(t, customer.Forgotten)
+		assert.False(t, customer.Enabled)
+	}
+
+	t.Run("Events", func(t *testing.T) {
+		customer := NewCustomer("customer-id")
+		for _, payload := range events {
+			if err := protector.Reveal(ctx, customer.ID(), payload); !assert.NoError(t, err) {
+				return
+			}
+			event := ddd.NewEvent(payload.(interface{ Key() string }).Key(), payload)
+			if err := customer.ApplyEvent(event); !assert.NoError(t, err) {
+				return
+			}
+		}
+		assertForgotten(t, customer)
+	})
+
+	t.Run("Snapshot", func(t *testing.T) {
+		customer := NewCustomer("customer-id")
+		if err := protector.Reveal(ctx, customer.ID(), snapshot); !assert.NoError(t, err) {
+			return
+		}
+		if err := customer.ApplySnapshot(snapshot.(*CustomerV1)); !assert.NoError(t, err) {
+			return
+		}
+		assertForgotten(t, customer)
+	})
+}
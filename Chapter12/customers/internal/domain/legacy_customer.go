@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+)
+
+// LegacyCustomer is a customer kept in the table that was used before the
+// customers were event sourced
+type LegacyCustomer struct {
+	ID        string
+	Name      string
+	SmsNumber string
+	Enabled   bool
+}
+
+type LegacyCustomerRepository interface {
+	FindIDs(ctx context.Context) ([]string, error)
+	// Find locks the customer until the transaction ends
+	Find(ctx context.Context, customerID string) (*LegacyCustomer, error)
+	Remove(ctx context.Context, customerID string) error
+}
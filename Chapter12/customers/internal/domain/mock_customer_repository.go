@@ -13,8 +13,8 @@ type MockCustomerRepository struct {
 	mock.Mock
 }
 
-// Find provides a mock function with given fields: ctx, customerID
-func (_m *MockCustomerRepository) Find(ctx context.Context, customerID string) (*Customer, error) {
+// Load provides a mock function with given fields: ctx, customerID
+func (_m *MockCustomerRepository) Load(ctx context.Context, customerID string) (*Customer, error) {
 	ret := _m.Called(ctx, customerID)
 
 	var r0 *Customer
@@ -50,20 +50,6 @@ func (_m *MockCustomerRepository) Save(ctx context.Context, customer *Customer)
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, customer
-func (_m *MockCustomerRepository) Update(ctx context.Context, customer *Customer) error {
-	ret := _m.Called(ctx, customer)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *Customer) error); ok {
-		r0 = rf(ctx, customer)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 type mockConstructorTestingTNewMockCustomerRepository interface {
 	mock.TestingT
 	Cleanup(func())
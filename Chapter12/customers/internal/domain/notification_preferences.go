@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/web"
+)
+
+const (
+	NotificationChannelSms     = "sms"
+	NotificationChannelEmail   = "email"
+	NotificationChannelWebhook = "webhook"
+
+	quietHoursLayout = "15:04"
+)
+
+var (
+	ErrNotificationChannelsCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "at least one notification channel is required")
+	ErrUnknownNotificationChannel        = errors.Wrap(errors.ErrBadRequest, "the notification channel is not supported")
+	ErrEmailCannotBeBlank                = errors.Wrap(errors.ErrBadRequest, "the email address cannot be blank when notifying by email")
+	ErrWebhookURLCannotBeBlank           = errors.Wrap(errors.ErrBadRequest, "the webhook URL cannot be blank when notifying by webhook")
+	ErrInvalidWebhookURL                 = errors.Wrap(errors.ErrBadRequest, "the webhook URL must be an https URL of a public host")
+	ErrInvalidQuietHours                 = errors.Wrap(errors.ErrBadRequest, "quiet hours need both a start and an end formatted as HH:MM")
+	ErrInvalidTimeZone                   = errors.Wrap(errors.ErrBadRequest, "the time zone is not a known location")
+	ErrNotificationKindCannotBeBlank     = errors.Wrap(errors.ErrBadRequest, "the notification kind cannot be blank")
+)
+
+// NotificationPreferences decide how, when and about what a customer is notified
+type NotificationPreferences struct {
+	Channels   []string
+	Email      string
+	WebhookURL string
+	// QuietStart and QuietEnd are wall clock times in TimeZone; notifications
+	// falling between them are held back until the quiet hours end
+	QuietStart string
+	QuietEnd   string
+	TimeZone   string
+	// Kinds holds the explicit opt-ins (true) and opt-outs (false) per kind
+	// of notification; kinds without an entry are sent
+	Kinds map[string]bool
+}
+
+// DefaultNotificationPreferences are used until a customer sets their own
+func DefaultNotificationPreferences() NotificationPreferences {
+	return NotificationPreferences{
+		Channels: []string{NotificationChannelSms},
+	}
+}
+
+func (p NotificationPreferences) validate() error {
+	if len(p.Channels) == 0 {
+		return ErrNotificationChannelsCannotBeBlank
+	}
+
+	for _, channel := range p.Channels {
+		switch channel {
+		case NotificationChannelSms:
+		case NotificationChannelEmail:
+			if p.Email == "" {
+				return ErrEmailCannotBeBlank
+			}
+		case NotificationChannelWebhook:
+			if p.WebhookURL == "" {
+				return ErrWebhookURLCannotBeBlank
+			}
+			if err := web.CheckWebhookURL(p.WebhookURL); err != nil {
+				return errors.Wrap(ErrInvalidWebhookURL, err.Error())
+			}
+		default:
+			return errors.Wrapf(ErrUnknownNotificationChannel, "channel: %s", channel)
+		}
+	}
+
+	if p.QuietStart != "" || p.QuietEnd != "" {
+		if _, err := time.Parse(quietHoursLayout, p.QuietStart); err != nil {
+			return ErrInvalidQuietHours
+		}
+		if _, err := time.Parse(quietHoursLayout, p.QuietEnd); err != nil {
+			return ErrInvalidQuietHours
+		}
+	}
+
+	if _, err := time.LoadLocation(p.TimeZone); err != nil {
+		return ErrInvalidTimeZone
+	}
+
+	for kind := range p.Kinds {
+		if kind == "" {
+			return ErrNotificationKindCannotBeBlank
+		}
+	}
+
+	return nil
+}
+
+// deduplicated keeps the first mention of each channel
+func (p NotificationPreferences) deduplicated() NotificationPreferences {
+	seen := make(map[string]struct{}, len(p.Channels))
+	channels := make([]string, 0, len(p.Channels))
+	for _, channel := range p.Channels {
+		if _, exists := seen[channel]; exists {
+			continue
+		}
+		seen[channel] = struct{}{}
+		channels = append(channels, channel)
+	}
+	p.Channels = channels
+
+	return p
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationPreferences_validate(t *testing.T) {
+	tests := map[string]struct {
+		preferences NotificationPreferences
+		wantErr     error
+	}{
+		"Default": {
+			preferences: DefaultNotificationPreferences(),
+		},
+		"Webhook": {
+			preferences: NotificationPreferences{
+				Channels:   []string{NotificationChannelWebhook},
+				WebhookURL: "https://hooks.example.com/mallbots",
+			},
+		},
+		"NoWebhookURL": {
+			preferences: NotificationPreferences{Channels: []string{NotificationChannelWebhook}},
+			wantErr:     ErrWebhookURLCannotBeBlank,
+		},
+		"PlainHttpWebhook": {
+			preferences: NotificationPreferences{
+				Channels:   []string{NotificationChannelWebhook},
+				WebhookURL: "http://hooks.example.com/mallbots",
+			},
+			wantErr: ErrInvalidWebhookURL,
+		},
+		"InternalWebhook": {
+			preferences: NotificationPreferences{
+				Channels:   []string{NotificationChannelWebhook},
+				WebhookURL: "https://169.254.169.254/latest/meta-data",
+			},
+			wantErr: ErrInvalidWebhookURL,
+		},
+		"NoEmail": {
+			preferences: NotificationPreferences{Channels: []string{NotificationChannelEmail}},
+			wantErr:     ErrEmailCannotBeBlank,
+		},
+		"OvernightQuietHours": {
+			preferences: NotificationPreferences{
+				Channels:   []string{NotificationChannelSms},
+				QuietStart: "22:00",
+				QuietEnd:   "07:00",
+				TimeZone:   "Europe/Berlin",
+			},
+		},
+		"HalfQuietHours": {
+			preferences: NotificationPreferences{
+				Channels:   []string{NotificationChannelSms},
+				QuietStart: "22:00",
+			},
+			wantErr: ErrInvalidQuietHours,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.preferences.validate()
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"eda-in-golang/internal/es"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
+)
+
+func Registrations(reg registry.Registry) error {
+	serde := serdes.NewJsonSerde(reg)
+
+	// Customer
+	if err := serde.Register(Customer{}, func(v interface{}) error {
+		customer := v.(*Customer)
+		customer.Aggregate = es.NewAggregate("", CustomerAggregate)
+		return nil
+	}); err != nil {
+		return err
+	}
+	// customer events
+	if err := serde.Register(CustomerRegistered{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerSmsChanged{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerAuthorized{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerAuthorizationReleased{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerEnabled{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerDisabled{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerForgotten{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerNotificationPreferencesChanged{}); err != nil {
+		return err
+	}
+	if err := serde.Register(CustomerAuthorizationPolicyChanged{}); err != nil {
+		return err
+	}
+	// customer snapshots
+	if err := serde.RegisterKey(CustomerV1{}.SnapshotName(), CustomerV1{}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -56,10 +56,14 @@ func (s server) AuthorizeCustomer(ctx context.Context, request *customerspb.Auth
 
 	span.SetAttributes(
 		attribute.String("CustomerID", request.GetId()),
+		attribute.String("OrderID", request.GetOrderId()),
 	)
 
 	err = s.app.AuthorizeCustomer(ctx, application.AuthorizeCustomer{
-		ID: request.GetId(),
+		ID:      request.GetId(),
+		OrderID: request.GetOrderId(),
+		Total:   request.GetTotal(),
+		Items:   s.itemsToDomain(request.GetItems()),
 	})
 	if err != nil {
 		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
@@ -122,11 +126,128 @@ func (s server) DisableCustomer(ctx context.Context, request *customerspb.Disabl
 	return &customerspb.DisableCustomerResponse{}, err
 }
 
+func (s server) ForgetCustomer(ctx context.Context, request *customerspb.ForgetCustomerRequest) (resp *customerspb.ForgetCustomerResponse, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("CustomerID", request.GetId()),
+	)
+
+	err = s.app.ForgetCustomer(ctx, application.ForgetCustomer{ID: request.GetId()})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &customerspb.ForgetCustomerResponse{}, err
+}
+
+func (s server) ChangeNotificationPreferences(ctx context.Context, request *customerspb.ChangeNotificationPreferencesRequest) (resp *customerspb.ChangeNotificationPreferencesResponse, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("CustomerID", request.GetId()),
+	)
+
+	err = s.app.ChangeNotificationPreferences(ctx, application.ChangeNotificationPreferences{
+		ID:          request.GetId(),
+		Preferences: s.preferencesToDomain(request.GetPreferences()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &customerspb.ChangeNotificationPreferencesResponse{}, err
+}
+
+func (s server) ChangeAuthorizationPolicy(ctx context.Context, request *customerspb.ChangeAuthorizationPolicyRequest) (resp *customerspb.ChangeAuthorizationPolicyResponse, err error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("CustomerID", request.GetId()),
+	)
+
+	err = s.app.ChangeAuthorizationPolicy(ctx, application.ChangeAuthorizationPolicy{
+		ID:     request.GetId(),
+		Policy: s.policyToDomain(request.GetPolicy()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &customerspb.ChangeAuthorizationPolicyResponse{}, err
+}
+
 func (s server) customerFromDomain(customer *domain.Customer) *customerspb.Customer {
 	return &customerspb.Customer{
 		Id:        customer.ID(),
 		Name:      customer.Name,
 		SmsNumber: customer.SmsNumber,
 		Enabled:   customer.Enabled,
+
+		NotificationPreferences: s.preferencesFromDomain(customer.NotificationPreferences),
+		AuthorizationPolicy:     s.policyFromDomain(customer.AuthorizationPolicy),
 	}
 }
+
+func (s server) preferencesToDomain(preferences *customerspb.NotificationPreferences) domain.NotificationPreferences {
+	return domain.NotificationPreferences{
+		Channels:   preferences.GetChannels(),
+		Email:      preferences.GetEmail(),
+		WebhookURL: preferences.GetWebhookUrl(),
+		QuietStart: preferences.GetQuietStart(),
+		QuietEnd:   preferences.GetQuietEnd(),
+		TimeZone:   preferences.GetTimeZone(),
+		Kinds:      preferences.GetKinds(),
+	}
+}
+
+func (s server) preferencesFromDomain(preferences domain.NotificationPreferences) *customerspb.NotificationPreferences {
+	return &customerspb.NotificationPreferences{
+		Channels:   preferences.Channels,
+		Email:      preferences.Email,
+		WebhookUrl: preferences.WebhookURL,
+		QuietStart: preferences.QuietStart,
+		QuietEnd:   preferences.QuietEnd,
+		TimeZone:   preferences.TimeZone,
+		Kinds:      preferences.Kinds,
+	}
+}
+
+func (s server) policyToDomain(policy *customerspb.AuthorizationPolicy) domain.AuthorizationPolicy {
+	return domain.AuthorizationPolicy{
+		MaxOrderTotal:   policy.GetMaxOrderTotal(),
+		SpendingLimit:   policy.GetSpendingLimit(),
+		OrderLimit:      int(policy.GetOrderLimit()),
+		Period:          policy.GetPeriod(),
+		BlockedStoreIDs: policy.GetBlockedStoreIds(),
+		MaxFraudScore:   policy.GetMaxFraudScore(),
+	}
+}
+
+func (s server) policyFromDomain(policy domain.AuthorizationPolicy) *customerspb.AuthorizationPolicy {
+	return &customerspb.AuthorizationPolicy{
+		MaxOrderTotal:   policy.MaxOrderTotal,
+		SpendingLimit:   policy.SpendingLimit,
+		OrderLimit:      int32(policy.OrderLimit),
+		Period:          policy.Period,
+		BlockedStoreIds: policy.BlockedStoreIDs,
+		MaxFraudScore:   policy.MaxFraudScore,
+	}
+}
+
+func (s server) itemsToDomain(items []*customerspb.AuthorizeCustomer_Item) []domain.AuthorizationItem {
+	domainItems := make([]domain.AuthorizationItem, len(items))
+	for i, item := range items {
+		domainItems[i] = domain.AuthorizationItem{
+			ProductID: item.GetProductId(),
+			StoreID:   item.GetStoreId(),
+			Price:     item.GetPrice(),
+			Quantity:  int(item.GetQuantity()),
+		}
+	}
+
+	return domainItems
+}
@@ -81,6 +81,39 @@ func (s serverTx) DisableCustomer(ctx context.Context, request *customerspb.Disa
 	return next.DisableCustomer(ctx, request)
 }
 
+func (s serverTx) ForgetCustomer(ctx context.Context, request *customerspb.ForgetCustomerRequest) (resp *customerspb.ForgetCustomerResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ForgetCustomer(ctx, request)
+}
+
+func (s serverTx) ChangeNotificationPreferences(ctx context.Context, request *customerspb.ChangeNotificationPreferencesRequest) (resp *customerspb.ChangeNotificationPreferencesResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ChangeNotificationPreferences(ctx, request)
+}
+
+func (s serverTx) ChangeAuthorizationPolicy(ctx context.Context, request *customerspb.ChangeAuthorizationPolicyRequest) (resp *customerspb.ChangeAuthorizationPolicyResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ChangeAuthorizationPolicy(ctx, request)
+}
+
 func (s serverTx) closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
@@ -9,6 +9,7 @@ import (
 
 	"eda-in-golang/customers/customerspb"
 	"eda-in-golang/customers/internal/application"
+	"eda-in-golang/customers/internal/domain"
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/errorsotel"
@@ -28,6 +29,7 @@ func NewCommandHandlers(reg registry.Registry, app application.App, replyPublish
 func RegisterCommandHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) error {
 	_, err := subscriber.Subscribe(customerspb.CommandChannel, handlers, am.MessageFilter{
 		customerspb.AuthorizeCustomerCommand,
+		customerspb.ReleaseCustomerAuthorizationCommand,
 	}, am.GroupName("customer-commands"))
 	return err
 }
@@ -53,6 +55,8 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 	switch cmd.CommandName() {
 	case customerspb.AuthorizeCustomerCommand:
 		return h.doAuthorizeCustomer(ctx, cmd)
+	case customerspb.ReleaseCustomerAuthorizationCommand:
+		return h.doReleaseCustomerAuthorization(ctx, cmd)
 	}
 
 	return nil, nil
@@ -61,5 +65,46 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 func (h commandHandlers) doAuthorizeCustomer(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
 	payload := cmd.Payload().(*customerspb.AuthorizeCustomer)
 
-	return nil, h.app.AuthorizeCustomer(ctx, application.AuthorizeCustomer{ID: payload.GetId()})
+	items := make([]domain.AuthorizationItem, len(payload.GetItems()))
+	for i, item := range payload.GetItems() {
+		items[i] = domain.AuthorizationItem{
+			ProductID: item.GetProductId(),
+			StoreID:   item.GetStoreId(),
+			Price:     item.GetPrice(),
+			Quantity:  int(item.GetQuantity()),
+		}
+	}
+
+	err := h.app.AuthorizeCustomer(ctx, application.AuthorizeCustomer{
+		ID:      payload.GetId(),
+		OrderID: payload.GetOrderId(),
+		Total:   payload.GetTotal(),
+		Items:   items,
+	})
+	if err != nil {
+		// denials are answered with their reason; any other error is a plain failure
+		if reason := domain.DenialReason(err); reason != "" {
+			return ddd.NewReply(customerspb.CustomerAuthorizationDeniedReply, &customerspb.CustomerAuthorizationDenied{
+				Id:      payload.GetId(),
+				OrderId: payload.GetOrderId(),
+				Reason:  reason,
+				Message: err.Error(),
+			}), err
+		}
+		return nil, err
+	}
+
+	return ddd.NewReply(customerspb.CustomerAuthorizedReply, &customerspb.CustomerAuthorized{
+		Id:      payload.GetId(),
+		OrderId: payload.GetOrderId(),
+	}), nil
+}
+
+func (h commandHandlers) doReleaseCustomerAuthorization(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*customerspb.ReleaseCustomerAuthorization)
+
+	return nil, h.app.ReleaseCustomerAuthorization(ctx, application.ReleaseCustomerAuthorization{
+		ID:      payload.GetId(),
+		OrderID: payload.GetOrderId(),
+	})
 }
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/customers/internal/constants"
+	"eda-in-golang/customers/internal/domain"
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+)
+
+// BackfillCustomers moves the customers of every tenant out of the table that
+// was used before the customers were event sourced
+//
+// Each customer is moved in its own transaction and removed from the old
+// table once it is in the event store; a customer that cannot be moved is
+// logged and tried again the next time the module starts
+func BackfillCustomers(ctx context.Context, container di.Container, tenants tenant.Tenants, logger zerolog.Logger) error {
+	for _, tenantID := range tenants.IDs() {
+		tenantCtx := tenant.WithID(ctx, tenantID)
+
+		customerIDs, err := findLegacyCustomers(tenantCtx, container)
+		if err != nil {
+			return err
+		}
+
+		moved := 0
+		for _, customerID := range customerIDs {
+			if err = backfillCustomer(tenantCtx, container, customerID); err != nil {
+				logger.Error().Err(err).Str("Tenant", tenantID).Str("CustomerID", customerID).Msg("customers backfill failed to move a customer")
+				continue
+			}
+			moved++
+		}
+
+		if len(customerIDs) > 0 {
+			logger.Info().Str("Tenant", tenantID).Int("Moved", moved).Int("Found", len(customerIDs)).Msg("customers backfill finished")
+		}
+	}
+
+	return nil
+}
+
+func findLegacyCustomers(ctx context.Context, container di.Container) (customerIDs []string, err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	return di.Get(ctx, constants.LegacyCustomersRepoKey).(domain.LegacyCustomerRepository).FindIDs(ctx)
+}
+
+func backfillCustomer(ctx context.Context, container di.Container, customerID string) (err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	return moveCustomer(ctx,
+		di.Get(ctx, constants.LegacyCustomersRepoKey).(domain.LegacyCustomerRepository),
+		di.Get(ctx, constants.CustomerBackfillRepoKey).(domain.CustomerRepository),
+		customerID,
+	)
+}
+
+func moveCustomer(ctx context.Context, legacy domain.LegacyCustomerRepository, customers domain.CustomerRepository, customerID string) error {
+	old, err := legacy.Find(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	customer, err := customers.Load(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	// a previous attempt may have saved the customer without removing the row
+	if !customer.Exists() {
+		if err = customer.Register(old.Name, old.SmsNumber); err != nil {
+			return err
+		}
+		if err = customers.Save(ctx, customer); err != nil {
+			return err
+		}
+
+		if !old.Enabled {
+			if err = customer.Disable(); err != nil {
+				return err
+			}
+			if err = customers.Save(ctx, customer); err != nil {
+				return err
+			}
+		}
+	}
+
+	return legacy.Remove(ctx, customerID)
+}
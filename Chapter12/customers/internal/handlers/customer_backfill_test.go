@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/customers/internal/domain"
+)
+
+func TestMoveCustomer(t *testing.T) {
+	errSave := errors.New("save failed")
+
+	tests := map[string]struct {
+		legacy      *domain.LegacyCustomer
+		customer    func(t *testing.T) *domain.Customer
+		saveErr     error
+		wantSaves   int
+		wantRemoved bool
+		wantEnabled bool
+		wantErr     error
+	}{
+		"Enabled": {
+			legacy:      &domain.LegacyCustomer{ID: "customer-id", Name: "name", SmsNumber: "555-1212", Enabled: true},
+			customer:    newCustomer,
+			wantSaves:   1,
+			wantRemoved: true,
+			wantEnabled: true,
+		},
+		"Disabled": {
+			legacy:      &domain.LegacyCustomer{ID: "customer-id", Name: "name", SmsNumber: "555-1212"},
+			customer:    newCustomer,
+			wantSaves:   2,
+			wantRemoved: true,
+		},
+		// a previous attempt saved the customer but failed to remove the row
+		"AlreadyMoved": {
+			legacy:      &domain.LegacyCustomer{ID: "customer-id", Name: "name", SmsNumber: "555-1212", Enabled: true},
+			customer:    registeredCustomer,
+			wantRemoved: true,
+			wantEnabled: true,
+		},
+		"SaveFailed": {
+			legacy:    &domain.LegacyCustomer{ID: "customer-id", Name: "name", SmsNumber: "555-1212", Enabled: true},
+			customer:  newCustomer,
+			saveErr:   errSave,
+			wantSaves: 1,
+			wantErr:   errSave,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			legacy := domain.NewMockLegacyCustomerRepository(t)
+			customers := domain.NewMockCustomerRepository(t)
+			customer := tc.customer(t)
+
+			legacy.On("Find", context.Background(), "customer-id").Return(tc.legacy, nil)
+			customers.On("Load", context.Background(), "customer-id").Return(customer, nil)
+			if tc.wantSaves > 0 {
+				customers.On("Save", context.Background(), customer).Run(func(args mock.Arguments) {
+					if tc.saveErr == nil {
+						commitCustomer(t, args.Get(1).(*domain.Customer))
+					}
+				}).Return(tc.saveErr).Times(tc.wantSaves)
+			}
+			if tc.wantRemoved {
+				legacy.On("Remove", context.Background(), "customer-id").Return(nil)
+			}
+
+			err := moveCustomer(context.Background(), legacy, customers, "customer-id")
+			if tc.wantErr != nil {
+				// the row is kept so the customer is moved again the next time
+				assert.ErrorIs(t, err, tc.wantErr)
+				legacy.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.True(t, customer.Exists())
+				assert.Equal(t, "name", customer.Name)
+				assert.Equal(t, tc.wantEnabled, customer.Enabled)
+			}
+		})
+	}
+}
+
+func newCustomer(*testing.T) *domain.Customer {
+	return domain.NewCustomer("customer-id")
+}
+
+func registeredCustomer(t *testing.T) *domain.Customer {
+	t.Helper()
+
+	customer := domain.NewCustomer("customer-id")
+	if err := customer.Register("name", "555-1212"); err != nil {
+		t.Fatal(err)
+	}
+	commitCustomer(t, customer)
+
+	return customer
+}
+
+func commitCustomer(t *testing.T, customer *domain.Customer) {
+	t.Helper()
+
+	for _, event := range customer.Events() {
+		if err := customer.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	customer.CommitEvents()
+}
@@ -32,6 +32,8 @@ func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.AggregateEve
 		domain.CustomerSmsChangedEvent,
 		domain.CustomerEnabledEvent,
 		domain.CustomerDisabledEvent,
+		domain.CustomerForgottenEvent,
+		domain.CustomerNotificationPreferencesChangedEvent,
 	)
 }
 
@@ -62,6 +64,10 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 		return h.onCustomerEnabled(ctx, event)
 	case domain.CustomerDisabledEvent:
 		return h.onCustomerDisabled(ctx, event)
+	case domain.CustomerForgottenEvent:
+		return h.onCustomerForgotten(ctx, event)
+	case domain.CustomerNotificationPreferencesChangedEvent:
+		return h.onCustomerNotificationPreferencesChanged(ctx, event)
 	}
 	return nil
 }
@@ -70,19 +76,19 @@ func (h domainHandlers[T]) onCustomerRegistered(ctx context.Context, event ddd.A
 	payload := event.Payload().(*domain.CustomerRegistered)
 	return h.publisher.Publish(ctx, customerspb.CustomerAggregateChannel,
 		ddd.NewEvent(customerspb.CustomerRegisteredEvent, &customerspb.CustomerRegistered{
-			Id:        payload.Customer.ID(),
-			Name:      payload.Customer.Name,
-			SmsNumber: payload.Customer.SmsNumber,
+			Id:        event.AggregateID(),
+			Name:      payload.Name,
+			SmsNumber: payload.SmsNumber,
 		}),
 	)
 }
 
 func (h domainHandlers[T]) onCustomerSmsChanged(ctx context.Context, event ddd.AggregateEvent) error {
-	payload := event.Payload().(*domain.CustomerRegistered)
+	payload := event.Payload().(*domain.CustomerSmsChanged)
 	return h.publisher.Publish(ctx, customerspb.CustomerAggregateChannel,
 		ddd.NewEvent(customerspb.CustomerSmsChangedEvent, &customerspb.CustomerSmsChanged{
-			Id:        payload.Customer.ID(),
-			SmsNumber: payload.Customer.SmsNumber,
+			Id:        event.AggregateID(),
+			SmsNumber: payload.SmsNumber,
 		}),
 	)
 }
@@ -102,3 +108,29 @@ func (h domainHandlers[T]) onCustomerDisabled(ctx context.Context, event ddd.Agg
 		}),
 	)
 }
+
+func (h domainHandlers[T]) onCustomerForgotten(ctx context.Context, event ddd.AggregateEvent) error {
+	return h.publisher.Publish(ctx, customerspb.CustomerAggregateChannel,
+		ddd.NewEvent(customerspb.CustomerForgottenEvent, &customerspb.CustomerForgotten{
+			Id: event.AggregateID(),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onCustomerNotificationPreferencesChanged(ctx context.Context, event ddd.AggregateEvent) error {
+	payload := event.Payload().(*domain.CustomerNotificationPreferencesChanged)
+	return h.publisher.Publish(ctx, customerspb.CustomerAggregateChannel,
+		ddd.NewEvent(customerspb.CustomerNotificationPreferencesChangedEvent, &customerspb.CustomerNotificationPreferencesChanged{
+			Id: event.AggregateID(),
+			Preferences: &customerspb.NotificationPreferences{
+				Channels:   payload.Channels,
+				Email:      payload.Email,
+				WebhookUrl: payload.WebhookURL,
+				QuietStart: payload.QuietStart,
+				QuietEnd:   payload.QuietEnd,
+				TimeZone:   payload.TimeZone,
+				Kinds:      payload.Kinds,
+			},
+		}),
+	)
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/customers/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type LegacyCustomerRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.LegacyCustomerRepository = (*LegacyCustomerRepository)(nil)
+
+func NewLegacyCustomerRepository(tableName string, db postgres.DB) LegacyCustomerRepository {
+	return LegacyCustomerRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r LegacyCustomerRepository) FindIDs(ctx context.Context) ([]string, error) {
+	const query = "SELECT id FROM %s WHERE tenant_id = $1 ORDER BY created_at ASC"
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), tenant.FromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying legacy customers")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing legacy customer rows")
+		}
+	}(rows)
+
+	var customerIDs []string
+
+	for rows.Next() {
+		var customerID string
+		if err := rows.Scan(&customerID); err != nil {
+			return nil, errors.Wrap(err, "scanning legacy customer id")
+		}
+		customerIDs = append(customerIDs, customerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing legacy customer rows")
+	}
+
+	return customerIDs, nil
+}
+
+func (r LegacyCustomerRepository) Find(ctx context.Context, customerID string) (*domain.LegacyCustomer, error) {
+	const query = "SELECT name, sms_number, enabled FROM %s WHERE id = $1 AND tenant_id = $2 FOR UPDATE SKIP LOCKED"
+
+	customer := &domain.LegacyCustomer{ID: customerID}
+
+	err := r.db.QueryRowContext(ctx, r.table(query), customerID, tenant.FromContext(ctx)).Scan(&customer.Name, &customer.SmsNumber, &customer.Enabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msgf("legacy customer with id: `%s` does not exist", customerID)
+		}
+		return nil, errors.Wrap(err, "querying legacy customer")
+	}
+
+	return customer, nil
+}
+
+func (r LegacyCustomerRepository) Remove(ctx context.Context, customerID string) error {
+	const query = "DELETE FROM %s WHERE id = $1 AND tenant_id = $2"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r LegacyCustomerRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
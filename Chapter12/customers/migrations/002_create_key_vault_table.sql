@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE key_vault (
+  subject_id   text        NOT NULL,
+  key_material bytea,
+  created_at   timestamptz NOT NULL DEFAULT NOW(),
+  destroyed_at timestamptz,
+  PRIMARY KEY (subject_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS key_vault;
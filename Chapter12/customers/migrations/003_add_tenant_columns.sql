@@ -0,0 +1,67 @@
+-- +goose Up
+ALTER TABLE customers
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT customers_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE key_vault
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT key_vault_pkey,
+  ADD PRIMARY KEY (tenant_id, subject_id);
+
+ALTER TABLE events
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name, stream_version);
+
+ALTER TABLE snapshots
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+ALTER TABLE sagas
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (tenant_id, id, name);
+
+-- +goose Down
+ALTER TABLE customers
+  DROP CONSTRAINT customers_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE key_vault
+  DROP CONSTRAINT key_vault_pkey,
+  ADD PRIMARY KEY (subject_id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE events
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name, stream_version),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE snapshots
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
+
+ALTER TABLE sagas
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (id, name),
+  DROP COLUMN tenant_id;
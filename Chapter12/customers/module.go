@@ -12,6 +12,7 @@ import (
 	"eda-in-golang/customers/internal/application"
 	"eda-in-golang/customers/internal/constants"
 	"eda-in-golang/customers/internal/domain"
+	"eda-in-golang/customers/internal/fraud"
 	"eda-in-golang/customers/internal/grpc"
 	"eda-in-golang/customers/internal/handlers"
 	"eda-in-golang/customers/internal/postgres"
@@ -19,13 +20,18 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 )
 
@@ -40,40 +46,86 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	// setup Driven adapters
 	container.AddSingleton(constants.RegistryKey, func(c di.Container) (any, error) {
 		reg := registry.New()
-		if err := customerspb.Registrations(reg); err != nil {
+		if err := domain.Registrations(reg); err != nil {
+			return nil, err
+		}
+		if err := customerspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
 			return nil, err
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.AggregateEvent](), nil
 	})
 	container.AddScoped(constants.DatabaseTransactionKey, func(c di.Container) (any, error) {
 		return svc.DB().Begin()
 	})
+	container.AddScoped(constants.KeyVaultKey, func(c di.Container) (any, error) {
+		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
+		return pg.NewKeyVault(constants.KeyVaultTableName, tx), nil
+	})
 	container.AddScoped(constants.CustomersRepoKey, func(c di.Container) (any, error) {
-		return postgres.NewCustomerRepository(
-			constants.CustomersTableName,
-			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
+		reg := c.Get(constants.RegistryKey).(registry.Registry)
+		pii := pg.WithPII(encryption.NewPIIProtector(c.Get(constants.KeyVaultKey).(encryption.KeyVault)))
+		return es.NewAggregateRepository[*domain.Customer](
+			domain.CustomerAggregate,
+			reg,
+			es.AggregateStoreWithMiddleware(
+				pg.NewEventStore(constants.EventsTableName, tx, reg, pii),
+				es.NewEventPublisher(c.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.AggregateEvent])),
+				pg.NewSnapshotStore(constants.SnapshotsTableName, tx, reg, pii),
+			),
+		), nil
+	})
+	// customers moved out of the legacy table are saved without publishing
+	// their events again; the other services already know about them
+	container.AddScoped(constants.CustomerBackfillRepoKey, func(c di.Container) (any, error) {
+		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
+		reg := c.Get(constants.RegistryKey).(registry.Registry)
+		pii := pg.WithPII(encryption.NewPIIProtector(c.Get(constants.KeyVaultKey).(encryption.KeyVault)))
+		return es.NewAggregateRepository[*domain.Customer](
+			domain.CustomerAggregate,
+			reg,
+			es.AggregateStoreWithMiddleware(
+				pg.NewEventStore(constants.EventsTableName, tx, reg, pii),
+				pg.NewSnapshotStore(constants.SnapshotsTableName, tx, reg, pii),
+			),
 		), nil
 	})
+	container.AddScoped(constants.LegacyCustomersRepoKey, func(c di.Container) (any, error) {
+		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
+		return postgres.NewLegacyCustomerRepository(constants.LegacyCustomersTableName, tx), nil
+	})
+	container.AddSingleton(constants.FraudScorerKey, func(c di.Container) (any, error) {
+		return fraud.NewLocalScorer(), nil
+	})
 	sentCounter := amprom.SentMessagesCounter(constants.ServiceName)
 	container.AddScoped(constants.MessagePublisherKey, func(c di.Container) (any, error) {
 		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -101,7 +153,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	container.AddScoped(constants.ApplicationKey, func(c di.Container) (any, error) {
 		return application.NewInstrumentedApp(application.New(
 			c.Get(constants.CustomersRepoKey).(domain.CustomerRepository),
-			c.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.AggregateEvent]),
+			c.Get(constants.KeyVaultKey).(encryption.KeyVault),
+			c.Get(constants.FraudScorerKey).(application.FraudScorer),
 		), customersRegistered), nil
 	})
 	container.AddScoped(constants.DomainEventHandlersKey, func(c di.Container) (any, error) {
@@ -120,6 +173,10 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		pg.NewOutboxStore(constants.OutboxTableName, svc.DB()),
 	)
 
+	if err = handlers.BackfillCustomers(ctx, container, svc.Tenants(), svc.Logger()); err != nil {
+		return err
+	}
+
 	// setup Driver adapters
 	if err = grpc.RegisterServerTx(container, svc.RPC()); err != nil {
 		return err
@@ -135,6 +192,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return nil
 }
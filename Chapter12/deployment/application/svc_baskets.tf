@@ -75,6 +75,11 @@ resource kubernetes_deployment_v1 baskets {
               name = "baskets-secrets"
             }
           }
+          env_from {
+            secret_ref {
+              name = "shared-secrets"
+            }
+          }
           port {
             protocol       = "TCP"
             container_port = 80
@@ -75,6 +75,11 @@ resource kubernetes_deployment_v1 customers {
               name = "customers-secrets"
             }
           }
+          env_from {
+            secret_ref {
+              name = "shared-secrets"
+            }
+          }
           port {
             protocol       = "TCP"
             container_port = 80
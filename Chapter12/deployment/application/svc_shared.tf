@@ -0,0 +1,40 @@
+// https://registry.terraform.io/providers/hashicorp/random/latest/docs/resources/password
+resource random_password shared {
+  length = 16
+}
+
+// the shared database holds the data the services exchange with each other,
+// such as claim check blobs and the data keys of encrypted messages
+// https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource
+// https://www.terraform.io/language/resources/provisioners/local-exec
+resource null_resource init_shared_db {
+  provisioner "local-exec" {
+    command     = "psql --file sql/init_service_db.psql -v db=$DB -v user=$USER -v pass=$PASS ${local.db_conn}/postgres && psql --file sql/init_shared_db.psql -v user=$USER ${local.db_conn}/$DB"
+    environment = {
+      DB   = "shared"
+      USER = "shared_user"
+      PASS = random_password.shared.result
+    }
+  }
+  depends_on = [
+    null_resource.init_db,
+    random_password.shared
+  ]
+}
+
+// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs/resources/secret_v1
+resource kubernetes_secret_v1 shared {
+  metadata {
+    name      = "shared-secrets"
+    namespace = local.project
+  }
+
+  data = {
+    CLAIMCHECK_PG_CONN = "host=${local.db_host} port=${local.db_port} dbname=shared user=shared_user password=${random_password.shared.result} search_path=shared,public"
+    ENCRYPTION_PG_CONN = "host=${local.db_host} port=${local.db_port} dbname=shared user=shared_user password=${random_password.shared.result} search_path=shared,public"
+  }
+  depends_on = [
+    kubernetes_namespace_v1.namespace,
+    null_resource.init_shared_db
+  ]
+}
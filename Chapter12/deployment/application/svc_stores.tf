@@ -75,6 +75,11 @@ resource kubernetes_deployment_v1 stores {
               name = "stores-secrets"
             }
           }
+          env_from {
+            secret_ref {
+              name = "shared-secrets"
+            }
+          }
           port {
             protocol       = "TCP"
             container_port = 80
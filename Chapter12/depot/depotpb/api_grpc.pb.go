@@ -26,6 +26,10 @@ type DepotServiceClient interface {
 	CancelShoppingList(ctx context.Context, in *CancelShoppingListRequest, opts ...grpc.CallOption) (*CancelShoppingListResponse, error)
 	AssignShoppingList(ctx context.Context, in *AssignShoppingListRequest, opts ...grpc.CallOption) (*AssignShoppingListResponse, error)
 	CompleteShoppingList(ctx context.Context, in *CompleteShoppingListRequest, opts ...grpc.CallOption) (*CompleteShoppingListResponse, error)
+	PickItem(ctx context.Context, in *PickItemRequest, opts ...grpc.CallOption) (*PickItemResponse, error)
+	SubstituteItem(ctx context.Context, in *SubstituteItemRequest, opts ...grpc.CallOption) (*SubstituteItemResponse, error)
+	RegisterBot(ctx context.Context, in *RegisterBotRequest, opts ...grpc.CallOption) (*RegisterBotResponse, error)
+	RecordBotHeartbeat(ctx context.Context, in *RecordBotHeartbeatRequest, opts ...grpc.CallOption) (*RecordBotHeartbeatResponse, error)
 }
 
 type depotServiceClient struct {
@@ -72,6 +76,42 @@ func (c *depotServiceClient) CompleteShoppingList(ctx context.Context, in *Compl
 	return out, nil
 }
 
+func (c *depotServiceClient) PickItem(ctx context.Context, in *PickItemRequest, opts ...grpc.CallOption) (*PickItemResponse, error) {
+	out := new(PickItemResponse)
+	err := c.cc.Invoke(ctx, "/depotpb.DepotService/PickItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depotServiceClient) SubstituteItem(ctx context.Context, in *SubstituteItemRequest, opts ...grpc.CallOption) (*SubstituteItemResponse, error) {
+	out := new(SubstituteItemResponse)
+	err := c.cc.Invoke(ctx, "/depotpb.DepotService/SubstituteItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depotServiceClient) RegisterBot(ctx context.Context, in *RegisterBotRequest, opts ...grpc.CallOption) (*RegisterBotResponse, error) {
+	out := new(RegisterBotResponse)
+	err := c.cc.Invoke(ctx, "/depotpb.DepotService/RegisterBot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depotServiceClient) RecordBotHeartbeat(ctx context.Context, in *RecordBotHeartbeatRequest, opts ...grpc.CallOption) (*RecordBotHeartbeatResponse, error) {
+	out := new(RecordBotHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/depotpb.DepotService/RecordBotHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DepotServiceServer is the server API for DepotService service.
 // All implementations must embed UnimplementedDepotServiceServer
 // for forward compatibility
@@ -80,6 +120,10 @@ type DepotServiceServer interface {
 	CancelShoppingList(context.Context, *CancelShoppingListRequest) (*CancelShoppingListResponse, error)
 	AssignShoppingList(context.Context, *AssignShoppingListRequest) (*AssignShoppingListResponse, error)
 	CompleteShoppingList(context.Context, *CompleteShoppingListRequest) (*CompleteShoppingListResponse, error)
+	PickItem(context.Context, *PickItemRequest) (*PickItemResponse, error)
+	SubstituteItem(context.Context, *SubstituteItemRequest) (*SubstituteItemResponse, error)
+	RegisterBot(context.Context, *RegisterBotRequest) (*RegisterBotResponse, error)
+	RecordBotHeartbeat(context.Context, *RecordBotHeartbeatRequest) (*RecordBotHeartbeatResponse, error)
 	mustEmbedUnimplementedDepotServiceServer()
 }
 
@@ -99,6 +143,18 @@ func (UnimplementedDepotServiceServer) AssignShoppingList(context.Context, *Assi
 func (UnimplementedDepotServiceServer) CompleteShoppingList(context.Context, *CompleteShoppingListRequest) (*CompleteShoppingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteShoppingList not implemented")
 }
+func (UnimplementedDepotServiceServer) PickItem(context.Context, *PickItemRequest) (*PickItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickItem not implemented")
+}
+func (UnimplementedDepotServiceServer) SubstituteItem(context.Context, *SubstituteItemRequest) (*SubstituteItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubstituteItem not implemented")
+}
+func (UnimplementedDepotServiceServer) RegisterBot(context.Context, *RegisterBotRequest) (*RegisterBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBot not implemented")
+}
+func (UnimplementedDepotServiceServer) RecordBotHeartbeat(context.Context, *RecordBotHeartbeatRequest) (*RecordBotHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordBotHeartbeat not implemented")
+}
 func (UnimplementedDepotServiceServer) mustEmbedUnimplementedDepotServiceServer() {}
 
 // UnsafeDepotServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +240,78 @@ func _DepotService_CompleteShoppingList_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DepotService_PickItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepotServiceServer).PickItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depotpb.DepotService/PickItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepotServiceServer).PickItem(ctx, req.(*PickItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepotService_SubstituteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubstituteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepotServiceServer).SubstituteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depotpb.DepotService/SubstituteItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepotServiceServer).SubstituteItem(ctx, req.(*SubstituteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepotService_RegisterBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepotServiceServer).RegisterBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depotpb.DepotService/RegisterBot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepotServiceServer).RegisterBot(ctx, req.(*RegisterBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepotService_RecordBotHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordBotHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepotServiceServer).RecordBotHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/depotpb.DepotService/RecordBotHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepotServiceServer).RecordBotHeartbeat(ctx, req.(*RecordBotHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DepotService_ServiceDesc is the grpc.ServiceDesc for DepotService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +335,22 @@ var DepotService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteShoppingList",
 			Handler:    _DepotService_CompleteShoppingList_Handler,
 		},
+		{
+			MethodName: "PickItem",
+			Handler:    _DepotService_PickItem_Handler,
+		},
+		{
+			MethodName: "SubstituteItem",
+			Handler:    _DepotService_SubstituteItem_Handler,
+		},
+		{
+			MethodName: "RegisterBot",
+			Handler:    _DepotService_RegisterBot_Handler,
+		},
+		{
+			MethodName: "RecordBotHeartbeat",
+			Handler:    _DepotService_RecordBotHeartbeat_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "depotpb/api.proto",
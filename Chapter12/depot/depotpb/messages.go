@@ -8,20 +8,35 @@ import (
 const (
 	ShoppingListAggregateChannel = "mallbots.depot.events.ShoppingList"
 
-	ShoppingListCompletedEvent = "depotapi.ShoppingListCompleted"
+	ShoppingListAssignedEvent        = "depotapi.ShoppingListAssigned"
+	ShoppingListItemShortPickedEvent = "depotapi.ShoppingListItemShortPicked"
+	ShoppingListItemSubstitutedEvent = "depotapi.ShoppingListItemSubstituted"
+	ShoppingListCompletedEvent       = "depotapi.ShoppingListCompleted"
 
 	CommandChannel = "mallbots.depot.commands"
 
-	CreateShoppingListCommand = "depotapi.CreateShoppingListCommand"
-	CancelShoppingListCommand = "depotapi.CancelShoppingListCommand"
-	InitiateShoppingCommand   = "depotapi.InitiateShoppingCommand"
+	CreateShoppingListCommand   = "depotapi.CreateShoppingListCommand"
+	CancelShoppingListCommand   = "depotapi.CancelShoppingListCommand"
+	InitiateShoppingCommand     = "depotapi.InitiateShoppingCommand"
+	ScheduleReturnPickupCommand = "depotapi.ScheduleReturnPickupCommand"
+	CancelReturnPickupCommand   = "depotapi.CancelReturnPickupCommand"
 
-	CreatedShoppingListReply = "depotapi.CreatedShoppingListReply"
+	CreatedShoppingListReply   = "depotapi.CreatedShoppingListReply"
+	ScheduledReturnPickupReply = "depotapi.ScheduledReturnPickupReply"
 )
 
 func Registrations(reg registry.Registry) (err error) {
 	serde := serdes.NewProtoSerde(reg)
 
+	if err = serde.Register(&ShoppingListAssigned{}); err != nil {
+		return
+	}
+	if err = serde.Register(&ShoppingListItemShortPicked{}); err != nil {
+		return
+	}
+	if err = serde.Register(&ShoppingListItemSubstituted{}); err != nil {
+		return
+	}
 	if err = serde.Register(&ShoppingListCompleted{}); err != nil {
 		return
 	}
@@ -35,21 +50,36 @@ func Registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(&InitiateShopping{}); err != nil {
 		return err
 	}
+	if err = serde.Register(&ScheduleReturnPickup{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&CancelReturnPickup{}); err != nil {
+		return err
+	}
 
 	if err = serde.Register(&CreatedShoppingList{}); err != nil {
 		return err
 	}
+	if err = serde.Register(&ScheduledReturnPickup{}); err != nil {
+		return err
+	}
 
 	return nil
 }
 
 // Events
-func (*ShoppingListCompleted) Key() string { return ShoppingListCompletedEvent }
+func (*ShoppingListAssigned) Key() string        { return ShoppingListAssignedEvent }
+func (*ShoppingListItemShortPicked) Key() string { return ShoppingListItemShortPickedEvent }
+func (*ShoppingListItemSubstituted) Key() string { return ShoppingListItemSubstitutedEvent }
+func (*ShoppingListCompleted) Key() string       { return ShoppingListCompletedEvent }
 
 // Commands
-func (*CreateShoppingList) Key() string { return CreateShoppingListCommand }
-func (*CancelShoppingList) Key() string { return CancelShoppingListCommand }
-func (*InitiateShopping) Key() string   { return InitiateShoppingCommand }
+func (*CreateShoppingList) Key() string   { return CreateShoppingListCommand }
+func (*CancelShoppingList) Key() string   { return CancelShoppingListCommand }
+func (*InitiateShopping) Key() string     { return InitiateShoppingCommand }
+func (*ScheduleReturnPickup) Key() string { return ScheduleReturnPickupCommand }
+func (*CancelReturnPickup) Key() string   { return CancelReturnPickupCommand }
 
 // Replies
-func (*CreatedShoppingList) Key() string { return CreatedShoppingListReply }
+func (*CreatedShoppingList) Key() string   { return CreatedShoppingListReply }
+func (*ScheduledReturnPickup) Key() string { return ScheduledReturnPickupReply }
@@ -20,6 +20,276 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ShoppingListAssigned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BotId   string `protobuf:"bytes,3,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	// the stops in the order the bot should visit them
+	Route []*ShoppingListAssigned_Stop `protobuf:"bytes,4,rep,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *ShoppingListAssigned) Reset() {
+	*x = ShoppingListAssigned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingListAssigned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListAssigned) ProtoMessage() {}
+
+func (x *ShoppingListAssigned) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListAssigned.ProtoReflect.Descriptor instead.
+func (*ShoppingListAssigned) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShoppingListAssigned) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned) GetRoute() []*ShoppingListAssigned_Stop {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type ShoppingListItemShortPicked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId   string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	StoreId   string `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId string `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,6,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+}
+
+func (x *ShoppingListItemShortPicked) Reset() {
+	*x = ShoppingListItemShortPicked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingListItemShortPicked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListItemShortPicked) ProtoMessage() {}
+
+func (x *ShoppingListItemShortPicked) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListItemShortPicked.ProtoReflect.Descriptor instead.
+func (*ShoppingListItemShortPicked) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShoppingListItemShortPicked) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListItemShortPicked) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemShortPicked) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemShortPicked) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemShortPicked) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingListItemShortPicked) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ShoppingListItemSubstituted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId         string  `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	StoreId         string  `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	ProductId       string  `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SubstituteId    string  `protobuf:"bytes,5,opt,name=substitute_id,json=substituteId,proto3" json:"substitute_id,omitempty"`
+	SubstituteName  string  `protobuf:"bytes,6,opt,name=substitute_name,json=substituteName,proto3" json:"substitute_name,omitempty"`
+	Quantity        int32   `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId       string  `protobuf:"bytes,8,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	SubstitutePrice float64 `protobuf:"fixed64,9,opt,name=substitute_price,json=substitutePrice,proto3" json:"substitute_price,omitempty"`
+}
+
+func (x *ShoppingListItemSubstituted) Reset() {
+	*x = ShoppingListItemSubstituted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShoppingListItemSubstituted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListItemSubstituted) ProtoMessage() {}
+
+func (x *ShoppingListItemSubstituted) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListItemSubstituted.ProtoReflect.Descriptor instead.
+func (*ShoppingListItemSubstituted) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShoppingListItemSubstituted) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetSubstituteId() string {
+	if x != nil {
+		return x.SubstituteId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetSubstituteName() string {
+	if x != nil {
+		return x.SubstituteName
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingListItemSubstituted) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ShoppingListItemSubstituted) GetSubstitutePrice() float64 {
+	if x != nil {
+		return x.SubstitutePrice
+	}
+	return 0
+}
+
 type ShoppingListCompleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -32,7 +302,7 @@ type ShoppingListCompleted struct {
 func (x *ShoppingListCompleted) Reset() {
 	*x = ShoppingListCompleted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[0]
+		mi := &file_depotpb_messages_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -45,7 +315,7 @@ func (x *ShoppingListCompleted) String() string {
 func (*ShoppingListCompleted) ProtoMessage() {}
 
 func (x *ShoppingListCompleted) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[0]
+	mi := &file_depotpb_messages_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +328,7 @@ func (x *ShoppingListCompleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShoppingListCompleted.ProtoReflect.Descriptor instead.
 func (*ShoppingListCompleted) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{0}
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ShoppingListCompleted) GetId() string {
@@ -80,27 +350,324 @@ type CreateShoppingList struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId string                     `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Items   []*CreateShoppingList_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	OrderId string                     `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items   []*CreateShoppingList_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CreateShoppingList) Reset() {
+	*x = CreateShoppingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShoppingList) ProtoMessage() {}
+
+func (x *CreateShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShoppingList.ProtoReflect.Descriptor instead.
+func (*CreateShoppingList) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateShoppingList) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateShoppingList) GetItems() []*CreateShoppingList_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CancelShoppingList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelShoppingList) Reset() {
+	*x = CancelShoppingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShoppingList) ProtoMessage() {}
+
+func (x *CancelShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShoppingList.ProtoReflect.Descriptor instead.
+func (*CancelShoppingList) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelShoppingList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type InitiateShopping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *InitiateShopping) Reset() {
+	*x = InitiateShopping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitiateShopping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateShopping) ProtoMessage() {}
+
+func (x *InitiateShopping) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateShopping.ProtoReflect.Descriptor instead.
+func (*InitiateShopping) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InitiateShopping) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ScheduleReturnPickup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string                       `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items   []*ScheduleReturnPickup_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ScheduleReturnPickup) Reset() {
+	*x = ScheduleReturnPickup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleReturnPickup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleReturnPickup) ProtoMessage() {}
+
+func (x *ScheduleReturnPickup) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleReturnPickup.ProtoReflect.Descriptor instead.
+func (*ScheduleReturnPickup) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduleReturnPickup) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ScheduleReturnPickup) GetItems() []*ScheduleReturnPickup_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CancelReturnPickup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelReturnPickup) Reset() {
+	*x = CancelReturnPickup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReturnPickup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReturnPickup) ProtoMessage() {}
+
+func (x *CancelReturnPickup) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReturnPickup.ProtoReflect.Descriptor instead.
+func (*CancelReturnPickup) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelReturnPickup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreatedShoppingList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreatedShoppingList) Reset() {
+	*x = CreatedShoppingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depotpb_messages_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatedShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedShoppingList) ProtoMessage() {}
+
+func (x *CreatedShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedShoppingList.ProtoReflect.Descriptor instead.
+func (*CreatedShoppingList) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatedShoppingList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ScheduledReturnPickup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateShoppingList) Reset() {
-	*x = CreateShoppingList{}
+func (x *ScheduledReturnPickup) Reset() {
+	*x = ScheduledReturnPickup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[1]
+		mi := &file_depotpb_messages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateShoppingList) String() string {
+func (x *ScheduledReturnPickup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShoppingList) ProtoMessage() {}
+func (*ScheduledReturnPickup) ProtoMessage() {}
 
-func (x *CreateShoppingList) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[1]
+func (x *ScheduledReturnPickup) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,50 +678,47 @@ func (x *CreateShoppingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShoppingList.ProtoReflect.Descriptor instead.
-func (*CreateShoppingList) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ScheduledReturnPickup.ProtoReflect.Descriptor instead.
+func (*ScheduledReturnPickup) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateShoppingList) GetOrderId() string {
+func (x *ScheduledReturnPickup) GetId() string {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateShoppingList) GetItems() []*CreateShoppingList_Item {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-type CancelShoppingList struct {
+type ShoppingListAssigned_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku       string `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
 }
 
-func (x *CancelShoppingList) Reset() {
-	*x = CancelShoppingList{}
+func (x *ShoppingListAssigned_Item) Reset() {
+	*x = ShoppingListAssigned_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[2]
+		mi := &file_depotpb_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelShoppingList) String() string {
+func (x *ShoppingListAssigned_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelShoppingList) ProtoMessage() {}
+func (*ShoppingListAssigned_Item) ProtoMessage() {}
 
-func (x *CancelShoppingList) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[2]
+func (x *ShoppingListAssigned_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,43 +729,74 @@ func (x *CancelShoppingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelShoppingList.ProtoReflect.Descriptor instead.
-func (*CancelShoppingList) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ShoppingListAssigned_Item.ProtoReflect.Descriptor instead.
+func (*ShoppingListAssigned_Item) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *CancelShoppingList) GetId() string {
+func (x *ShoppingListAssigned_Item) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-type InitiateShopping struct {
+func (x *ShoppingListAssigned_Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned_Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingListAssigned_Item) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned_Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type ShoppingListAssigned_Stop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId       string                       `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName     string                       `protobuf:"bytes,2,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	StoreLocation string                       `protobuf:"bytes,3,opt,name=store_location,json=storeLocation,proto3" json:"store_location,omitempty"`
+	Items         []*ShoppingListAssigned_Item `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *InitiateShopping) Reset() {
-	*x = InitiateShopping{}
+func (x *ShoppingListAssigned_Stop) Reset() {
+	*x = ShoppingListAssigned_Stop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[3]
+		mi := &file_depotpb_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InitiateShopping) String() string {
+func (x *ShoppingListAssigned_Stop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiateShopping) ProtoMessage() {}
+func (*ShoppingListAssigned_Stop) ProtoMessage() {}
 
-func (x *InitiateShopping) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[3]
+func (x *ShoppingListAssigned_Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,43 +807,67 @@ func (x *InitiateShopping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiateShopping.ProtoReflect.Descriptor instead.
-func (*InitiateShopping) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ShoppingListAssigned_Stop.ProtoReflect.Descriptor instead.
+func (*ShoppingListAssigned_Stop) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{0, 1}
 }
 
-func (x *InitiateShopping) GetId() string {
+func (x *ShoppingListAssigned_Stop) GetStoreId() string {
 	if x != nil {
-		return x.Id
+		return x.StoreId
 	}
 	return ""
 }
 
-type CreatedShoppingList struct {
+func (x *ShoppingListAssigned_Stop) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned_Stop) GetStoreLocation() string {
+	if x != nil {
+		return x.StoreLocation
+	}
+	return ""
+}
+
+func (x *ShoppingListAssigned_Stop) GetItems() []*ShoppingListAssigned_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateShoppingList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	StoreId   string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 }
 
-func (x *CreatedShoppingList) Reset() {
-	*x = CreatedShoppingList{}
+func (x *CreateShoppingList_Item) Reset() {
+	*x = CreateShoppingList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[4]
+		mi := &file_depotpb_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreatedShoppingList) String() string {
+func (x *CreateShoppingList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatedShoppingList) ProtoMessage() {}
+func (*CreateShoppingList_Item) ProtoMessage() {}
 
-func (x *CreatedShoppingList) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[4]
+func (x *CreateShoppingList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,19 +878,40 @@ func (x *CreatedShoppingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatedShoppingList.ProtoReflect.Descriptor instead.
-func (*CreatedShoppingList) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateShoppingList_Item.ProtoReflect.Descriptor instead.
+func (*CreateShoppingList_Item) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *CreatedShoppingList) GetId() string {
+func (x *CreateShoppingList_Item) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-type CreateShoppingList_Item struct {
+func (x *CreateShoppingList_Item) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *CreateShoppingList_Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateShoppingList_Item) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ScheduleReturnPickup_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -279,25 +919,26 @@ type CreateShoppingList_Item struct {
 	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	StoreId   string `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId string `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 }
 
-func (x *CreateShoppingList_Item) Reset() {
-	*x = CreateShoppingList_Item{}
+func (x *ScheduleReturnPickup_Item) Reset() {
+	*x = ScheduleReturnPickup_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depotpb_messages_proto_msgTypes[5]
+		mi := &file_depotpb_messages_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateShoppingList_Item) String() string {
+func (x *ScheduleReturnPickup_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShoppingList_Item) ProtoMessage() {}
+func (*ScheduleReturnPickup_Item) ProtoMessage() {}
 
-func (x *CreateShoppingList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_depotpb_messages_proto_msgTypes[5]
+func (x *ScheduleReturnPickup_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_depotpb_messages_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,70 +949,158 @@ func (x *CreateShoppingList_Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShoppingList_Item.ProtoReflect.Descriptor instead.
-func (*CreateShoppingList_Item) Descriptor() ([]byte, []int) {
-	return file_depotpb_messages_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ScheduleReturnPickup_Item.ProtoReflect.Descriptor instead.
+func (*ScheduleReturnPickup_Item) Descriptor() ([]byte, []int) {
+	return file_depotpb_messages_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *CreateShoppingList_Item) GetProductId() string {
+func (x *ScheduleReturnPickup_Item) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateShoppingList_Item) GetStoreId() string {
+func (x *ScheduleReturnPickup_Item) GetStoreId() string {
 	if x != nil {
 		return x.StoreId
 	}
 	return ""
 }
 
-func (x *CreateShoppingList_Item) GetQuantity() int32 {
+func (x *ScheduleReturnPickup_Item) GetQuantity() int32 {
 	if x != nil {
 		return x.Quantity
 	}
 	return 0
 }
 
+func (x *ScheduleReturnPickup_Item) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
 var File_depotpb_messages_proto protoreflect.FileDescriptor
 
 var file_depotpb_messages_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70,
-	0x62, 0x22, 0x42, 0x0a, 0x15, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x62, 0x22, 0xbf, 0x03, 0x0a, 0x14, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
 	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a,
-	0x5c, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65,
+	0x70, 0x6f, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x1a, 0x86, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x6b, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x1a,
+	0xa1, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x1b, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x50, 0x69, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x1b, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x15,
+	0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xe4, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x7b, 0x0a, 0x04, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a,
+	0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xe8, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x50, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a,
+	0x7b, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
 	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
 	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
 	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x24, 0x0a,
-	0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53,
-	0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x53, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x42, 0x7d,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x42, 0x0d, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23,
-	0x65, 0x64, 0x61, 0x2d, 0x69, 0x6e, 0x2d, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x64, 0x65,
-	0x70, 0x6f, 0x74, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f,
-	0x74, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x44, 0x65, 0x70, 0x6f,
-	0x74, 0x70, 0x62, 0xca, 0x02, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0xe2, 0x02, 0x13,
-	0x44, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x12,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x69, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x68, 0x6f,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x69, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x42, 0x7d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70,
+	0x62, 0x42, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x23, 0x65, 0x64, 0x61, 0x2d, 0x69, 0x6e, 0x2d, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x2f,
+	0x64, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x44, 0x58, 0x58, 0xaa, 0x02, 0x07,
+	0x44, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0xca, 0x02, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x70,
+	0x62, 0xe2, 0x02, 0x13, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x74, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -386,22 +1115,34 @@ func file_depotpb_messages_proto_rawDescGZIP() []byte {
 	return file_depotpb_messages_proto_rawDescData
 }
 
-var file_depotpb_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_depotpb_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_depotpb_messages_proto_goTypes = []interface{}{
-	(*ShoppingListCompleted)(nil),   // 0: depotpb.ShoppingListCompleted
-	(*CreateShoppingList)(nil),      // 1: depotpb.CreateShoppingList
-	(*CancelShoppingList)(nil),      // 2: depotpb.CancelShoppingList
-	(*InitiateShopping)(nil),        // 3: depotpb.InitiateShopping
-	(*CreatedShoppingList)(nil),     // 4: depotpb.CreatedShoppingList
-	(*CreateShoppingList_Item)(nil), // 5: depotpb.CreateShoppingList.Item
+	(*ShoppingListAssigned)(nil),        // 0: depotpb.ShoppingListAssigned
+	(*ShoppingListItemShortPicked)(nil), // 1: depotpb.ShoppingListItemShortPicked
+	(*ShoppingListItemSubstituted)(nil), // 2: depotpb.ShoppingListItemSubstituted
+	(*ShoppingListCompleted)(nil),       // 3: depotpb.ShoppingListCompleted
+	(*CreateShoppingList)(nil),          // 4: depotpb.CreateShoppingList
+	(*CancelShoppingList)(nil),          // 5: depotpb.CancelShoppingList
+	(*InitiateShopping)(nil),            // 6: depotpb.InitiateShopping
+	(*ScheduleReturnPickup)(nil),        // 7: depotpb.ScheduleReturnPickup
+	(*CancelReturnPickup)(nil),          // 8: depotpb.CancelReturnPickup
+	(*CreatedShoppingList)(nil),         // 9: depotpb.CreatedShoppingList
+	(*ScheduledReturnPickup)(nil),       // 10: depotpb.ScheduledReturnPickup
+	(*ShoppingListAssigned_Item)(nil),   // 11: depotpb.ShoppingListAssigned.Item
+	(*ShoppingListAssigned_Stop)(nil),   // 12: depotpb.ShoppingListAssigned.Stop
+	(*CreateShoppingList_Item)(nil),     // 13: depotpb.CreateShoppingList.Item
+	(*ScheduleReturnPickup_Item)(nil),   // 14: depotpb.ScheduleReturnPickup.Item
 }
 var file_depotpb_messages_proto_depIdxs = []int32{
-	5, // 0: depotpb.CreateShoppingList.items:type_name -> depotpb.CreateShoppingList.Item
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	12, // 0: depotpb.ShoppingListAssigned.route:type_name -> depotpb.ShoppingListAssigned.Stop
+	13, // 1: depotpb.CreateShoppingList.items:type_name -> depotpb.CreateShoppingList.Item
+	14, // 2: depotpb.ScheduleReturnPickup.items:type_name -> depotpb.ScheduleReturnPickup.Item
+	11, // 3: depotpb.ShoppingListAssigned.Stop.items:type_name -> depotpb.ShoppingListAssigned.Item
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_depotpb_messages_proto_init() }
@@ -411,7 +1152,7 @@ func file_depotpb_messages_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_depotpb_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShoppingListCompleted); i {
+			switch v := v.(*ShoppingListAssigned); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -423,7 +1164,7 @@ func file_depotpb_messages_proto_init() {
 			}
 		}
 		file_depotpb_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShoppingList); i {
+			switch v := v.(*ShoppingListItemShortPicked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -435,7 +1176,7 @@ func file_depotpb_messages_proto_init() {
 			}
 		}
 		file_depotpb_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelShoppingList); i {
+			switch v := v.(*ShoppingListItemSubstituted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -447,7 +1188,7 @@ func file_depotpb_messages_proto_init() {
 			}
 		}
 		file_depotpb_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateShopping); i {
+			switch v := v.(*ShoppingListCompleted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -459,7 +1200,7 @@ func file_depotpb_messages_proto_init() {
 			}
 		}
 		file_depotpb_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatedShoppingList); i {
+			switch v := v.(*CreateShoppingList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -471,6 +1212,102 @@ func file_depotpb_messages_proto_init() {
 			}
 		}
 		file_depotpb_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelShoppingList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateShopping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleReturnPickup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReturnPickup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatedShoppingList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledReturnPickup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingListAssigned_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShoppingListAssigned_Stop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depotpb_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateShoppingList_Item); i {
 			case 0:
 				return &v.state
@@ -482,6 +1319,18 @@ func file_depotpb_messages_proto_init() {
 				return nil
 			}
 		}
+		file_depotpb_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleReturnPickup_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -489,7 +1338,7 @@ func file_depotpb_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_depotpb_messages_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
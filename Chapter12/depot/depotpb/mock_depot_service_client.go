@@ -135,6 +135,126 @@ func (_m *MockDepotServiceClient) CreateShoppingList(ctx context.Context, in *Cr
 	return r0, r1
 }
 
+// PickItem provides a mock function with given fields: ctx, in, opts
+func (_m *MockDepotServiceClient) PickItem(ctx context.Context, in *PickItemRequest, opts ...grpc.CallOption) (*PickItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *PickItemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *PickItemRequest, ...grpc.CallOption) *PickItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PickItemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *PickItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordBotHeartbeat provides a mock function with given fields: ctx, in, opts
+func (_m *MockDepotServiceClient) RecordBotHeartbeat(ctx context.Context, in *RecordBotHeartbeatRequest, opts ...grpc.CallOption) (*RecordBotHeartbeatResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *RecordBotHeartbeatResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RecordBotHeartbeatRequest, ...grpc.CallOption) *RecordBotHeartbeatResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RecordBotHeartbeatResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RecordBotHeartbeatRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterBot provides a mock function with given fields: ctx, in, opts
+func (_m *MockDepotServiceClient) RegisterBot(ctx context.Context, in *RegisterBotRequest, opts ...grpc.CallOption) (*RegisterBotResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *RegisterBotResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RegisterBotRequest, ...grpc.CallOption) *RegisterBotResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RegisterBotResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RegisterBotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubstituteItem provides a mock function with given fields: ctx, in, opts
+func (_m *MockDepotServiceClient) SubstituteItem(ctx context.Context, in *SubstituteItemRequest, opts ...grpc.CallOption) (*SubstituteItemResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *SubstituteItemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *SubstituteItemRequest, ...grpc.CallOption) *SubstituteItemResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SubstituteItemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *SubstituteItemRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockDepotServiceClient interface {
 	mock.TestingT
 	Cleanup(func())
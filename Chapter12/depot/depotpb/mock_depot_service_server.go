@@ -105,6 +105,98 @@ func (_m *MockDepotServiceServer) CreateShoppingList(_a0 context.Context, _a1 *C
 	return r0, r1
 }
 
+// PickItem provides a mock function with given fields: _a0, _a1
+func (_m *MockDepotServiceServer) PickItem(_a0 context.Context, _a1 *PickItemRequest) (*PickItemResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *PickItemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *PickItemRequest) *PickItemResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PickItemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *PickItemRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordBotHeartbeat provides a mock function with given fields: _a0, _a1
+func (_m *MockDepotServiceServer) RecordBotHeartbeat(_a0 context.Context, _a1 *RecordBotHeartbeatRequest) (*RecordBotHeartbeatResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RecordBotHeartbeatResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RecordBotHeartbeatRequest) *RecordBotHeartbeatResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RecordBotHeartbeatResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RecordBotHeartbeatRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterBot provides a mock function with given fields: _a0, _a1
+func (_m *MockDepotServiceServer) RegisterBot(_a0 context.Context, _a1 *RegisterBotRequest) (*RegisterBotResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RegisterBotResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RegisterBotRequest) *RegisterBotResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RegisterBotResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RegisterBotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubstituteItem provides a mock function with given fields: _a0, _a1
+func (_m *MockDepotServiceServer) SubstituteItem(_a0 context.Context, _a1 *SubstituteItemRequest) (*SubstituteItemResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *SubstituteItemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *SubstituteItemRequest) *SubstituteItemResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SubstituteItemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *SubstituteItemRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedDepotServiceServer provides a mock function with given fields:
 func (_m *MockDepotServiceServer) mustEmbedUnimplementedDepotServiceServer() {
 	_m.Called()
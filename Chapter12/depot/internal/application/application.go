@@ -20,6 +20,17 @@ type (
 		InitiateShopping(ctx context.Context, cmd commands.InitiateShopping) error
 		AssignShoppingList(ctx context.Context, cmd commands.AssignShoppingList) error
 		CompleteShoppingList(ctx context.Context, cmd commands.CompleteShoppingList) error
+		PickItem(ctx context.Context, cmd commands.PickItem) error
+		SubstituteItem(ctx context.Context, cmd commands.SubstituteItem) error
+		RegisterBot(ctx context.Context, cmd commands.RegisterBot) error
+		RecordBotHeartbeat(ctx context.Context, cmd commands.RecordBotHeartbeat) error
+		TakeBotsOffline(ctx context.Context, cmd commands.TakeBotsOffline) error
+		DispatchShoppingList(ctx context.Context, cmd commands.DispatchShoppingList) error
+		DispatchBot(ctx context.Context, cmd commands.DispatchBot) error
+		ReleaseShoppingList(ctx context.Context, cmd commands.ReleaseShoppingList) error
+		ReleaseBot(ctx context.Context, cmd commands.ReleaseBot) error
+		ScheduleReturnPickup(ctx context.Context, cmd commands.ScheduleReturnPickup) error
+		CancelReturnPickup(ctx context.Context, cmd commands.CancelReturnPickup) error
 	}
 	Queries interface {
 		GetShoppingList(ctx context.Context, query queries.GetShoppingList) (*domain.ShoppingList, error)
@@ -35,6 +46,14 @@ type (
 		commands.InitiateShoppingHandler
 		commands.AssignShoppingListHandler
 		commands.CompleteShoppingListHandler
+		commands.PickItemHandler
+		commands.SubstituteItemHandler
+		commands.RegisterBotHandler
+		commands.RecordBotHeartbeatHandler
+		commands.TakeBotsOfflineHandler
+		commands.DispatchHandler
+		commands.ScheduleReturnPickupHandler
+		commands.CancelReturnPickupHandler
 	}
 	appQueries struct {
 		queries.GetShoppingListHandler
@@ -43,14 +62,22 @@ type (
 
 var _ App = (*Application)(nil)
 
-func New(shoppingLists domain.ShoppingListRepository, stores domain.StoreRepository, products domain.ProductRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) *Application {
+func New(shoppingLists domain.ShoppingListRepository, returnPickups domain.ReturnPickupRepository, bots domain.BotRepository, stores domain.StoreRepository, products domain.ProductRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) *Application {
 	return &Application{
 		appCommands: appCommands{
-			CreateShoppingListHandler:   commands.NewCreateShoppingListHandler(shoppingLists, stores, products, domainPublisher),
+			CreateShoppingListHandler:   commands.NewCreateShoppingListHandler(shoppingLists, stores, products, domain.NewRoutePlanner(domain.MallEntrance), domainPublisher),
 			CancelShoppingListHandler:   commands.NewCancelShoppingListHandler(shoppingLists, domainPublisher),
 			InitiateShoppingHandler:     commands.NewInitiateShoppingHandler(shoppingLists, domainPublisher),
-			AssignShoppingListHandler:   commands.NewAssignShoppingListHandler(shoppingLists, domainPublisher),
+			AssignShoppingListHandler:   commands.NewAssignShoppingListHandler(shoppingLists, bots, domainPublisher),
 			CompleteShoppingListHandler: commands.NewCompleteShoppingListHandler(shoppingLists, domainPublisher),
+			PickItemHandler:             commands.NewPickItemHandler(shoppingLists, domainPublisher),
+			SubstituteItemHandler:       commands.NewSubstituteItemHandler(shoppingLists, products, domainPublisher),
+			RegisterBotHandler:          commands.NewRegisterBotHandler(bots, domainPublisher),
+			RecordBotHeartbeatHandler:   commands.NewRecordBotHeartbeatHandler(bots, domainPublisher),
+			TakeBotsOfflineHandler:      commands.NewTakeBotsOfflineHandler(bots, domainPublisher),
+			DispatchHandler:             commands.NewDispatchHandler(shoppingLists, bots, domainPublisher),
+			ScheduleReturnPickupHandler: commands.NewScheduleReturnPickupHandler(returnPickups, domainPublisher),
+			CancelReturnPickupHandler:   commands.NewCancelReturnPickupHandler(returnPickups, domainPublisher),
 		},
 		appQueries: appQueries{
 			GetShoppingListHandler: queries.NewGetShoppingListHandler(shoppingLists),
@@ -14,13 +14,15 @@ type AssignShoppingList struct {
 
 type AssignShoppingListHandler struct {
 	shoppingLists   domain.ShoppingListRepository
+	bots            domain.BotRepository
 	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
 }
 
-func NewAssignShoppingListHandler(shoppingList domain.ShoppingListRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
+func NewAssignShoppingListHandler(shoppingList domain.ShoppingListRepository, bots domain.BotRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
 ) AssignShoppingListHandler {
 	return AssignShoppingListHandler{
 		shoppingLists:   shoppingList,
+		bots:            bots,
 		domainPublisher: domainPublisher,
 	}
 }
@@ -31,19 +33,38 @@ func (h AssignShoppingListHandler) AssignShoppingList(ctx context.Context, cmd A
 		return err
 	}
 
-	err = list.Assign(cmd.BotID)
+	bot, err := h.bots.Find(ctx, cmd.BotID)
 	if err != nil {
 		return err
 	}
 
-	if err = h.shoppingLists.Update(ctx, list); err != nil {
+	return assignShoppingList(ctx, h.shoppingLists, h.bots, h.domainPublisher, list, bot)
+}
+
+// assignShoppingList pairs an available shopping list with an idle bot
+func assignShoppingList(ctx context.Context, shoppingLists domain.ShoppingListRepository, bots domain.BotRepository,
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent], list *domain.ShoppingList, bot *domain.Bot,
+) error {
+	if err := list.Assign(bot.ID()); err != nil {
+		return err
+	}
+
+	if err := bot.Activate(list.ID()); err != nil {
+		return err
+	}
+
+	if err := shoppingLists.Update(ctx, list); err != nil {
+		return err
+	}
+
+	if err := bots.Update(ctx, bot); err != nil {
 		return err
 	}
 
 	// publish domain events
-	if err = h.domainPublisher.Publish(ctx, list.Events()...); err != nil {
+	if err := domainPublisher.Publish(ctx, list.Events()...); err != nil {
 		return err
 	}
 
-	return nil
+	return domainPublisher.Publish(ctx, bot.Events()...)
 }
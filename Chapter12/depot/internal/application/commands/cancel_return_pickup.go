@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type CancelReturnPickup struct {
+	ID string
+}
+
+type CancelReturnPickupHandler struct {
+	returnPickups   domain.ReturnPickupRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewCancelReturnPickupHandler(returnPickups domain.ReturnPickupRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) CancelReturnPickupHandler {
+	return CancelReturnPickupHandler{
+		returnPickups:   returnPickups,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h CancelReturnPickupHandler) CancelReturnPickup(ctx context.Context, cmd CancelReturnPickup) error {
+	pickup, err := h.returnPickups.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = pickup.Cancel(); err != nil {
+		return err
+	}
+
+	if err = h.returnPickups.Update(ctx, pickup); err != nil {
+		return err
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, pickup.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
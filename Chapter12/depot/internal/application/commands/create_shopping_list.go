@@ -19,16 +19,18 @@ type CreateShoppingListHandler struct {
 	shoppingLists   domain.ShoppingListRepository
 	stores          domain.StoreRepository
 	products        domain.ProductRepository
+	planner         domain.RoutePlanner
 	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
 }
 
 func NewCreateShoppingListHandler(shoppingLists domain.ShoppingListRepository, stores domain.StoreRepository,
-	products domain.ProductRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
+	products domain.ProductRepository, planner domain.RoutePlanner, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
 ) CreateShoppingListHandler {
 	return CreateShoppingListHandler{
 		shoppingLists:   shoppingLists,
 		stores:          stores,
 		products:        products,
+		planner:         planner,
 		domainPublisher: domainPublisher,
 	}
 }
@@ -46,12 +48,14 @@ func (h CreateShoppingListHandler) CreateShoppingList(ctx context.Context, cmd C
 		if err != nil {
 			return errors.Wrap(err, "building shopping list")
 		}
-		err = list.AddItem(store, product, item.Quantity)
+		err = list.AddItem(store, product, item.VariantID, item.Quantity)
 		if err != nil {
 			return errors.Wrap(err, "building shopping list")
 		}
 	}
 
+	list.PlanRoute(h.planner)
+
 	if err := h.shoppingLists.Save(ctx, list); err != nil {
 		return errors.Wrap(err, "scheduling shopping")
 	}
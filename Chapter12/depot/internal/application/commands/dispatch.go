@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+// DispatchShoppingList assigns the shopping list to an idle bot; the list
+// remains available when every bot is busy
+type DispatchShoppingList struct {
+	ID string
+}
+
+// DispatchBot assigns the longest waiting available shopping list to the bot
+type DispatchBot struct {
+	ID string
+}
+
+// ReleaseShoppingList takes the shopping list away from a bot that can no
+// longer work on it so that it can be dispatched again
+type ReleaseShoppingList struct {
+	ID    string
+	BotID string
+}
+
+// ReleaseBot returns the bot to the pool once its shopping list is done with
+type ReleaseBot struct {
+	ID             string
+	ShoppingListID string
+}
+
+type DispatchHandler struct {
+	shoppingLists   domain.ShoppingListRepository
+	bots            domain.BotRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewDispatchHandler(shoppingLists domain.ShoppingListRepository, bots domain.BotRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) DispatchHandler {
+	return DispatchHandler{
+		shoppingLists:   shoppingLists,
+		bots:            bots,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h DispatchHandler) DispatchShoppingList(ctx context.Context, cmd DispatchShoppingList) error {
+	list, err := h.shoppingLists.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if list.Status != domain.ShoppingListIsAvailable {
+		return nil
+	}
+
+	bot, err := h.bots.FindIdle(ctx)
+	if err != nil || bot == nil {
+		return err
+	}
+
+	return assignShoppingList(ctx, h.shoppingLists, h.bots, h.domainPublisher, list, bot)
+}
+
+func (h DispatchHandler) DispatchBot(ctx context.Context, cmd DispatchBot) error {
+	bot, err := h.bots.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if bot.Status != domain.BotIsIdle {
+		return nil
+	}
+
+	list, err := h.shoppingLists.FindAvailable(ctx)
+	if err != nil || list == nil {
+		return err
+	}
+
+	return assignShoppingList(ctx, h.shoppingLists, h.bots, h.domainPublisher, list, bot)
+}
+
+func (h DispatchHandler) ReleaseShoppingList(ctx context.Context, cmd ReleaseShoppingList) error {
+	list, err := h.shoppingLists.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// the list may have been completed or reassigned in the meantime
+	if !list.IsAssigned() || list.AssignedBotID != cmd.BotID {
+		return nil
+	}
+
+	if err = list.Release(); err != nil {
+		return err
+	}
+
+	if err = h.shoppingLists.Update(ctx, list); err != nil {
+		return err
+	}
+
+	// publish domain events
+	return h.domainPublisher.Publish(ctx, list.Events()...)
+}
+
+func (h DispatchHandler) ReleaseBot(ctx context.Context, cmd ReleaseBot) error {
+	bot, err := h.bots.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	// the bot may have gone offline in the meantime
+	if bot.Status != domain.BotIsActive || bot.ShoppingListID != cmd.ShoppingListID {
+		return nil
+	}
+
+	if err = bot.Release(); err != nil {
+		return err
+	}
+
+	if err = h.bots.Update(ctx, bot); err != nil {
+		return err
+	}
+
+	// publish domain events
+	return h.domainPublisher.Publish(ctx, bot.Events()...)
+}
@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type dispatchMocks struct {
+	shoppingLists *domain.MockShoppingListRepository
+	bots          *domain.MockBotRepository
+	publisher     *ddd.MockEventPublisher[ddd.AggregateEvent]
+	published     *[]string
+}
+
+func newDispatchMocks(t *testing.T) dispatchMocks {
+	return dispatchMocks{
+		shoppingLists: domain.NewMockShoppingListRepository(t),
+		bots:          domain.NewMockBotRepository(t),
+		publisher:     ddd.NewMockEventPublisher[ddd.AggregateEvent](t),
+		published:     &[]string{},
+	}
+}
+
+func (m dispatchMocks) handler() DispatchHandler {
+	return NewDispatchHandler(m.shoppingLists, m.bots, m.publisher)
+}
+
+// onAssign expects the list and the bot to be saved and their events published
+func (m dispatchMocks) onAssign(list *domain.ShoppingList, bot *domain.Bot) {
+	m.shoppingLists.On("Update", context.Background(), list).Return(nil).Once()
+	m.bots.On("Update", context.Background(), bot).Return(nil).Once()
+	m.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.aggregateEvent")).Run(func(args mock.Arguments) {
+		*m.published = append(*m.published, args.Get(1).(ddd.AggregateEvent).EventName())
+	}).Return(nil).Twice()
+}
+
+func shoppingListWithStatus(status domain.ShoppingListStatus) *domain.ShoppingList {
+	list := domain.NewShoppingList("shopping-list-id")
+	list.Status = status
+	return list
+}
+
+func botWithStatus(status domain.BotStatus) *domain.Bot {
+	bot := domain.NewBot("bot-id")
+	bot.Status = status
+	return bot
+}
+
+func TestDispatchHandler_DispatchShoppingList(t *testing.T) {
+	errFind := errors.New("find failed")
+
+	tests := map[string]struct {
+		list       *domain.ShoppingList
+		on         func(m dispatchMocks, list *domain.ShoppingList)
+		wantAssign bool
+		wantErr    error
+	}{
+		"IdleBot": {
+			list: shoppingListWithStatus(domain.ShoppingListIsAvailable),
+			on: func(m dispatchMocks, list *domain.ShoppingList) {
+				bot := botWithStatus(domain.BotIsIdle)
+				m.bots.On("FindIdle", context.Background()).Return(bot, nil)
+				m.onAssign(list, bot)
+			},
+			wantAssign: true,
+		},
+		// the list stays available until a bot becomes idle
+		"NoIdleBot": {
+			list: shoppingListWithStatus(domain.ShoppingListIsAvailable),
+			on: func(m dispatchMocks, list *domain.ShoppingList) {
+				m.bots.On("FindIdle", context.Background()).Return(nil, nil)
+			},
+		},
+		"FindIdleFailed": {
+			list: shoppingListWithStatus(domain.ShoppingListIsAvailable),
+			on: func(m dispatchMocks, list *domain.ShoppingList) {
+				m.bots.On("FindIdle", context.Background()).Return(nil, errFind)
+			},
+			wantErr: errFind,
+		},
+		// the bot was taken by another list after it was found
+		"BotNoLongerIdle": {
+			list: shoppingListWithStatus(domain.ShoppingListIsAvailable),
+			on: func(m dispatchMocks, list *domain.ShoppingList) {
+				m.bots.On("FindIdle", context.Background()).Return(botWithStatus(domain.BotIsActive), nil)
+			},
+			wantErr: domain.ErrBotIsNotIdle,
+		},
+		"AlreadyAssigned": {
+			list: shoppingListWithStatus(domain.ShoppingListIsAssigned),
+		},
+		"Pending": {
+			list: shoppingListWithStatus(domain.ShoppingListIsPending),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newDispatchMocks(t)
+			m.shoppingLists.On("Find", context.Background(), "shopping-list-id").Return(tc.list, nil)
+			if tc.on != nil {
+				tc.on(m, tc.list)
+			}
+
+			err := m.handler().DispatchShoppingList(context.Background(), DispatchShoppingList{ID: "shopping-list-id"})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			if tc.wantAssign {
+				assert.Equal(t, domain.ShoppingListIsAssigned, tc.list.Status)
+				assert.Equal(t, "bot-id", tc.list.AssignedBotID)
+				assert.Equal(t, []string{domain.ShoppingListAssignedEvent, domain.BotActivatedEvent}, *m.published)
+			} else {
+				assert.Empty(t, *m.published)
+			}
+		})
+	}
+}
+
+func TestDispatchHandler_DispatchBot(t *testing.T) {
+	tests := map[string]struct {
+		bot        *domain.Bot
+		on         func(m dispatchMocks, bot *domain.Bot)
+		wantAssign bool
+	}{
+		"AvailableList": {
+			bot: botWithStatus(domain.BotIsIdle),
+			on: func(m dispatchMocks, bot *domain.Bot) {
+				list := shoppingListWithStatus(domain.ShoppingListIsAvailable)
+				m.shoppingLists.On("FindAvailable", context.Background()).Return(list, nil)
+				m.onAssign(list, bot)
+			},
+			wantAssign: true,
+		},
+		"NoAvailableList": {
+			bot: botWithStatus(domain.BotIsIdle),
+			on: func(m dispatchMocks, bot *domain.Bot) {
+				m.shoppingLists.On("FindAvailable", context.Background()).Return(nil, nil)
+			},
+		},
+		"Active": {
+			bot: botWithStatus(domain.BotIsActive),
+		},
+		"Offline": {
+			bot: botWithStatus(domain.BotIsOffline),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newDispatchMocks(t)
+			m.bots.On("Find", context.Background(), "bot-id").Return(tc.bot, nil)
+			if tc.on != nil {
+				tc.on(m, tc.bot)
+			}
+
+			err := m.handler().DispatchBot(context.Background(), DispatchBot{ID: "bot-id"})
+			if !assert.NoError(t, err) {
+				return
+			}
+			if tc.wantAssign {
+				assert.Equal(t, domain.BotIsActive, tc.bot.Status)
+				assert.Equal(t, "shopping-list-id", tc.bot.ShoppingListID)
+				assert.Equal(t, []string{domain.ShoppingListAssignedEvent, domain.BotActivatedEvent}, *m.published)
+			} else {
+				assert.Empty(t, *m.published)
+			}
+		})
+	}
+}
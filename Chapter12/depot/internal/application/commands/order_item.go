@@ -3,5 +3,6 @@ package commands
 type OrderItem struct {
 	StoreID   string
 	ProductID string
+	VariantID string
 	Quantity  int
 }
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type PickItem struct {
+	ID        string
+	ProductID string
+	VariantID string
+	Quantity  int
+}
+
+type PickItemHandler struct {
+	shoppingLists   domain.ShoppingListRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewPickItemHandler(shoppingLists domain.ShoppingListRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) PickItemHandler {
+	return PickItemHandler{
+		shoppingLists:   shoppingLists,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h PickItemHandler) PickItem(ctx context.Context, cmd PickItem) error {
+	list, err := h.shoppingLists.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = list.PickItem(cmd.ProductID, cmd.VariantID, cmd.Quantity); err != nil {
+		return err
+	}
+
+	if err = h.shoppingLists.Update(ctx, list); err != nil {
+		return err
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, list.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type RecordBotHeartbeat struct {
+	ID string
+}
+
+type RecordBotHeartbeatHandler struct {
+	bots            domain.BotRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewRecordBotHeartbeatHandler(bots domain.BotRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) RecordBotHeartbeatHandler {
+	return RecordBotHeartbeatHandler{
+		bots:            bots,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h RecordBotHeartbeatHandler) RecordBotHeartbeat(ctx context.Context, cmd RecordBotHeartbeat) error {
+	bot, err := h.bots.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	bot.Heartbeat()
+
+	if err = h.bots.Update(ctx, bot); err != nil {
+		return err
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, bot.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
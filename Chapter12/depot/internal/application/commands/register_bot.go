@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type RegisterBot struct {
+	ID   string
+	Name string
+}
+
+type RegisterBotHandler struct {
+	bots            domain.BotRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewRegisterBotHandler(bots domain.BotRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) RegisterBotHandler {
+	return RegisterBotHandler{
+		bots:            bots,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h RegisterBotHandler) RegisterBot(ctx context.Context, cmd RegisterBot) error {
+	bot, err := domain.RegisterBot(cmd.ID, cmd.Name)
+	if err != nil {
+		return err
+	}
+
+	if err = h.bots.Save(ctx, bot); err != nil {
+		return err
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, bot.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
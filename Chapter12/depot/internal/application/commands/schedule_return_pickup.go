@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type ScheduleReturnPickup struct {
+	ID      string
+	OrderID string
+	Items   []OrderItem
+}
+
+type ScheduleReturnPickupHandler struct {
+	returnPickups   domain.ReturnPickupRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewScheduleReturnPickupHandler(returnPickups domain.ReturnPickupRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) ScheduleReturnPickupHandler {
+	return ScheduleReturnPickupHandler{
+		returnPickups:   returnPickups,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h ScheduleReturnPickupHandler) ScheduleReturnPickup(ctx context.Context, cmd ScheduleReturnPickup) error {
+	items := make([]domain.ReturnItem, len(cmd.Items))
+	for i, item := range cmd.Items {
+		items[i] = domain.ReturnItem{
+			StoreID:   item.StoreID,
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		}
+	}
+
+	pickup, err := domain.ScheduleReturnPickup(cmd.ID, cmd.OrderID, items)
+	if err != nil {
+		return err
+	}
+
+	if err = h.returnPickups.Save(ctx, pickup); err != nil {
+		return errors.Wrap(err, "scheduling return pickup")
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, pickup.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
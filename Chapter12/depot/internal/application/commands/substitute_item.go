@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+type SubstituteItem struct {
+	ID           string
+	ProductID    string
+	VariantID    string
+	SubstituteID string
+	Quantity     int
+}
+
+type SubstituteItemHandler struct {
+	shoppingLists   domain.ShoppingListRepository
+	products        domain.ProductRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewSubstituteItemHandler(shoppingLists domain.ShoppingListRepository, products domain.ProductRepository,
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent],
+) SubstituteItemHandler {
+	return SubstituteItemHandler{
+		shoppingLists:   shoppingLists,
+		products:        products,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h SubstituteItemHandler) SubstituteItem(ctx context.Context, cmd SubstituteItem) error {
+	list, err := h.shoppingLists.Find(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	substitute, err := h.products.Find(ctx, cmd.SubstituteID)
+	if err != nil {
+		return errors.Wrap(err, "finding substitute")
+	}
+
+	if err = list.SubstituteItem(cmd.ProductID, cmd.VariantID, substitute, cmd.Quantity); err != nil {
+		return err
+	}
+
+	if err = h.shoppingLists.Update(ctx, list); err != nil {
+		return err
+	}
+
+	// publish domain events
+	if err = h.domainPublisher.Publish(ctx, list.Events()...); err != nil {
+		return err
+	}
+
+	return nil
+}
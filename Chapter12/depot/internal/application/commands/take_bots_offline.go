@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+)
+
+// TakeBotsOffline takes every bot that has not been heard from since LastSeenBefore offline
+type TakeBotsOffline struct {
+	LastSeenBefore time.Time
+}
+
+type TakeBotsOfflineHandler struct {
+	bots            domain.BotRepository
+	domainPublisher ddd.EventPublisher[ddd.AggregateEvent]
+}
+
+func NewTakeBotsOfflineHandler(bots domain.BotRepository, domainPublisher ddd.EventPublisher[ddd.AggregateEvent]) TakeBotsOfflineHandler {
+	return TakeBotsOfflineHandler{
+		bots:            bots,
+		domainPublisher: domainPublisher,
+	}
+}
+
+func (h TakeBotsOfflineHandler) TakeBotsOffline(ctx context.Context, cmd TakeBotsOffline) error {
+	bots, err := h.bots.FindUnresponsive(ctx, cmd.LastSeenBefore)
+	if err != nil {
+		return err
+	}
+
+	for _, unresponsive := range bots {
+		// taking a bot offline releases its shopping list, which may be
+		// dispatched to another of these bots; each bot is reloaded so the
+		// list it has been given since is released as well
+		bot, err := h.bots.Find(ctx, unresponsive.ID())
+		if err != nil {
+			return err
+		}
+		if bot.Status == domain.BotIsOffline || !bot.LastSeenAt.Before(cmd.LastSeenBefore) {
+			continue
+		}
+
+		if err = bot.GoOffline(); err != nil {
+			return err
+		}
+
+		if err = h.bots.Update(ctx, bot); err != nil {
+			return err
+		}
+
+		// publish domain events
+		if err = h.domainPublisher.Publish(ctx, bot.Events()...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
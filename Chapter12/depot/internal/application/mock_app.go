@@ -32,6 +32,20 @@ func (_m *MockApp) AssignShoppingList(ctx context.Context, cmd commands.AssignSh
 	return r0
 }
 
+// CancelReturnPickup provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) CancelReturnPickup(ctx context.Context, cmd commands.CancelReturnPickup) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CancelReturnPickup) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CancelShoppingList provides a mock function with given fields: ctx, cmd
 func (_m *MockApp) CancelShoppingList(ctx context.Context, cmd commands.CancelShoppingList) error {
 	ret := _m.Called(ctx, cmd)
@@ -74,6 +88,34 @@ func (_m *MockApp) CreateShoppingList(ctx context.Context, cmd commands.CreateSh
 	return r0
 }
 
+// DispatchBot provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) DispatchBot(ctx context.Context, cmd commands.DispatchBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.DispatchBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DispatchShoppingList provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) DispatchShoppingList(ctx context.Context, cmd commands.DispatchShoppingList) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.DispatchShoppingList) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetShoppingList provides a mock function with given fields: ctx, query
 func (_m *MockApp) GetShoppingList(ctx context.Context, query queries.GetShoppingList) (*domain.ShoppingList, error) {
 	ret := _m.Called(ctx, query)
@@ -111,6 +153,118 @@ func (_m *MockApp) InitiateShopping(ctx context.Context, cmd commands.InitiateSh
 	return r0
 }
 
+// PickItem provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) PickItem(ctx context.Context, cmd commands.PickItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.PickItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordBotHeartbeat provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) RecordBotHeartbeat(ctx context.Context, cmd commands.RecordBotHeartbeat) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RecordBotHeartbeat) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterBot provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) RegisterBot(ctx context.Context, cmd commands.RegisterBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RegisterBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseBot provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReleaseBot(ctx context.Context, cmd commands.ReleaseBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseShoppingList provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReleaseShoppingList(ctx context.Context, cmd commands.ReleaseShoppingList) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseShoppingList) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleReturnPickup provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ScheduleReturnPickup(ctx context.Context, cmd commands.ScheduleReturnPickup) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ScheduleReturnPickup) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubstituteItem provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) SubstituteItem(ctx context.Context, cmd commands.SubstituteItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.SubstituteItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TakeBotsOffline provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) TakeBotsOffline(ctx context.Context, cmd commands.TakeBotsOffline) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.TakeBotsOffline) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockApp interface {
 	mock.TestingT
 	Cleanup(func())
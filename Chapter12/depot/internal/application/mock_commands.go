@@ -28,6 +28,20 @@ func (_m *MockCommands) AssignShoppingList(ctx context.Context, cmd commands.Ass
 	return r0
 }
 
+// CancelReturnPickup provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) CancelReturnPickup(ctx context.Context, cmd commands.CancelReturnPickup) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CancelReturnPickup) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CancelShoppingList provides a mock function with given fields: ctx, cmd
 func (_m *MockCommands) CancelShoppingList(ctx context.Context, cmd commands.CancelShoppingList) error {
 	ret := _m.Called(ctx, cmd)
@@ -70,6 +84,34 @@ func (_m *MockCommands) CreateShoppingList(ctx context.Context, cmd commands.Cre
 	return r0
 }
 
+// DispatchBot provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) DispatchBot(ctx context.Context, cmd commands.DispatchBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.DispatchBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DispatchShoppingList provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) DispatchShoppingList(ctx context.Context, cmd commands.DispatchShoppingList) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.DispatchShoppingList) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InitiateShopping provides a mock function with given fields: ctx, cmd
 func (_m *MockCommands) InitiateShopping(ctx context.Context, cmd commands.InitiateShopping) error {
 	ret := _m.Called(ctx, cmd)
@@ -84,6 +126,118 @@ func (_m *MockCommands) InitiateShopping(ctx context.Context, cmd commands.Initi
 	return r0
 }
 
+// PickItem provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) PickItem(ctx context.Context, cmd commands.PickItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.PickItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordBotHeartbeat provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) RecordBotHeartbeat(ctx context.Context, cmd commands.RecordBotHeartbeat) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RecordBotHeartbeat) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegisterBot provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) RegisterBot(ctx context.Context, cmd commands.RegisterBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RegisterBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseBot provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ReleaseBot(ctx context.Context, cmd commands.ReleaseBot) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseBot) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseShoppingList provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ReleaseShoppingList(ctx context.Context, cmd commands.ReleaseShoppingList) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseShoppingList) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleReturnPickup provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ScheduleReturnPickup(ctx context.Context, cmd commands.ScheduleReturnPickup) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ScheduleReturnPickup) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubstituteItem provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) SubstituteItem(ctx context.Context, cmd commands.SubstituteItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.SubstituteItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TakeBotsOffline provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) TakeBotsOffline(ctx context.Context, cmd commands.TakeBotsOffline) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.TakeBotsOffline) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockCommands interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,5 +1,9 @@
 package constants
 
+import (
+	"time"
+)
+
 // ServiceName The name of this module/service
 const ServiceName = "depot"
 
@@ -26,8 +30,11 @@ const (
 	IntegrationEventHandlersKey = "integrationEventHandlers"
 	CommandHandlersKey          = "commandHandlers"
 	ReplyHandlersKey            = "replyHandlers"
+	DispatchHandlersKey         = "dispatchHandlers"
 
 	ShoppingListsRepoKey = "shoppingListRepo"
+	ReturnPickupsRepoKey = "returnPickupsRepo"
+	BotsRepoKey          = "botsRepo"
 	StoresCacheRepoKey   = "storesCacheRepo"
 	ProductsCacheRepoKey = "productsCacheRepo"
 )
@@ -41,6 +48,16 @@ const (
 	SagasTableName     = ServiceName + ".sagas"
 
 	ShoppingListsTableName = ServiceName + ".shopping_lists"
+	ReturnPickupsTableName = ServiceName + ".return_pickups"
+	BotsTableName          = ServiceName + ".bots"
 	StoresCacheTableName   = ServiceName + ".stores_cache"
 	ProductsCacheTableName = ServiceName + ".products_cache"
 )
+
+// Bot fleet monitoring
+const (
+	// BotOfflineAfter is how long a bot may go without a heartbeat before it is taken offline
+	BotOfflineAfter = 30 * time.Second
+	// BotMonitorInterval is how often the fleet is checked for unresponsive bots
+	BotMonitorInterval = 10 * time.Second
+)
@@ -1,7 +1,127 @@
 package domain
 
+import (
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+)
+
+const BotAggregate = "depot.Bot"
+
+var (
+	ErrBotNameCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the bot name cannot be blank")
+	ErrBotIsNotIdle         = errors.Wrap(errors.ErrBadRequest, "the bot is not idle")
+	ErrBotIsNotActive       = errors.Wrap(errors.ErrBadRequest, "the bot is not active")
+	ErrBotIsOffline         = errors.Wrap(errors.ErrBadRequest, "the bot is offline")
+	ErrBotIsAlreadyOffline  = errors.Wrap(errors.ErrBadRequest, "the bot is already offline")
+)
+
 type Bot struct {
-	ID     string
-	Name   string
-	Status BotStatus
+	ddd.Aggregate
+	Name           string
+	Status         BotStatus
+	ShoppingListID string
+	LastSeenAt     time.Time
+}
+
+func NewBot(id string) *Bot {
+	return &Bot{
+		Aggregate: ddd.NewAggregate(id, BotAggregate),
+	}
+}
+
+func RegisterBot(id, name string) (*Bot, error) {
+	if name == "" {
+		return nil, ErrBotNameCannotBeBlank
+	}
+
+	bot := NewBot(id)
+	bot.Name = name
+	bot.Status = BotIsIdle
+	bot.LastSeenAt = time.Now()
+
+	bot.AddEvent(BotRegisteredEvent, &BotRegistered{
+		Bot: bot,
+	})
+	bot.AddEvent(BotIdledEvent, &BotIdled{
+		Bot: bot,
+	})
+
+	return bot, nil
+}
+
+func (Bot) Key() string { return BotAggregate }
+
+// Heartbeat records that the bot is still around; bots that went offline
+// become idle again
+func (b *Bot) Heartbeat() {
+	b.LastSeenAt = time.Now()
+
+	if b.Status != BotIsOffline {
+		return
+	}
+
+	b.Status = BotIsIdle
+
+	b.AddEvent(BotIdledEvent, &BotIdled{
+		Bot: b,
+	})
+}
+
+func (b *Bot) Activate(shoppingListID string) error {
+	switch b.Status {
+	case BotIsIdle:
+	case BotIsOffline:
+		return ErrBotIsOffline
+	default:
+		return ErrBotIsNotIdle
+	}
+
+	b.Status = BotIsActive
+	b.ShoppingListID = shoppingListID
+
+	b.AddEvent(BotActivatedEvent, &BotActivated{
+		Bot:            b,
+		ShoppingListID: shoppingListID,
+	})
+
+	return nil
+}
+
+// Release returns an active bot to the pool of idle bots
+func (b *Bot) Release() error {
+	if b.Status != BotIsActive {
+		return ErrBotIsNotActive
+	}
+
+	b.Status = BotIsIdle
+	b.ShoppingListID = ""
+
+	b.AddEvent(BotIdledEvent, &BotIdled{
+		Bot: b,
+	})
+
+	return nil
+}
+
+// GoOffline takes the bot out of the pool; any shopping list it was working
+// on is recorded in the event so that it may be reassigned
+func (b *Bot) GoOffline() error {
+	if b.Status == BotIsOffline {
+		return ErrBotIsAlreadyOffline
+	}
+
+	shoppingListID := b.ShoppingListID
+
+	b.Status = BotIsOffline
+	b.ShoppingListID = ""
+
+	b.AddEvent(BotWentOfflineEvent, &BotWentOffline{
+		Bot:            b,
+		ShoppingListID: shoppingListID,
+	})
+
+	return nil
 }
@@ -0,0 +1,34 @@
+package domain
+
+const (
+	BotRegisteredEvent  = "depot.BotRegistered"
+	BotIdledEvent       = "depot.BotIdled"
+	BotActivatedEvent   = "depot.BotActivated"
+	BotWentOfflineEvent = "depot.BotWentOffline"
+)
+
+type BotRegistered struct {
+	Bot *Bot
+}
+
+func (BotRegistered) Key() string { return BotRegisteredEvent }
+
+type BotIdled struct {
+	Bot *Bot
+}
+
+func (BotIdled) Key() string { return BotIdledEvent }
+
+type BotActivated struct {
+	Bot            *Bot
+	ShoppingListID string
+}
+
+func (BotActivated) Key() string { return BotActivatedEvent }
+
+type BotWentOffline struct {
+	Bot            *Bot
+	ShoppingListID string
+}
+
+func (BotWentOffline) Key() string { return BotWentOfflineEvent }
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type BotRepository interface {
+	Find(ctx context.Context, botID string) (*Bot, error)
+	// FindIdle returns the most recently seen idle bot; nil when there are none
+	FindIdle(ctx context.Context) (*Bot, error)
+	// FindUnresponsive returns the bots that are not offline and have not been seen since the given time
+	FindUnresponsive(ctx context.Context, lastSeenBefore time.Time) ([]*Bot, error)
+	Save(ctx context.Context, bot *Bot) error
+	Update(ctx context.Context, bot *Bot) error
+}
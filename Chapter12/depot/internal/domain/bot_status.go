@@ -3,14 +3,15 @@ package domain
 type BotStatus string
 
 const (
-	BotUnknown  BotStatus = ""
-	BotIsIdle   BotStatus = "idle"
-	BotIsActive BotStatus = "active"
+	BotUnknown   BotStatus = ""
+	BotIsIdle    BotStatus = "idle"
+	BotIsActive  BotStatus = "active"
+	BotIsOffline BotStatus = "offline"
 )
 
 func (s BotStatus) String() string {
 	switch s {
-	case BotIsIdle, BotIsActive:
+	case BotIsIdle, BotIsActive, BotIsOffline:
 		return string(s)
 	default:
 		return ""
@@ -23,6 +24,8 @@ func ToBotStatus(status string) BotStatus {
 		return BotIsIdle
 	case BotIsActive.String():
 		return BotIsActive
+	case BotIsOffline.String():
+		return BotIsOffline
 	default:
 		return BotUnknown
 	}
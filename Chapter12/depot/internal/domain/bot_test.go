@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/ddd"
+)
+
+func eventNames(aggregate interface{ Events() []ddd.AggregateEvent }) []string {
+	events := aggregate.Events()
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = event.EventName()
+	}
+	return names
+}
+
+func TestRegisterBot(t *testing.T) {
+	bot, err := RegisterBot("bot-id", "bot-name")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "bot-name", bot.Name)
+	assert.Equal(t, BotIsIdle, bot.Status)
+	assert.WithinDuration(t, time.Now(), bot.LastSeenAt, time.Second)
+	// new bots join the pool of idle bots straight away
+	assert.Equal(t, []string{BotRegisteredEvent, BotIdledEvent}, eventNames(bot))
+
+	_, err = RegisterBot("bot-id", "")
+	assert.ErrorIs(t, err, ErrBotNameCannotBeBlank)
+}
+
+func TestBot_Heartbeat(t *testing.T) {
+	tests := map[string]struct {
+		status     BotStatus
+		wantStatus BotStatus
+		wantEvents []string
+	}{
+		"Idle": {
+			status:     BotIsIdle,
+			wantStatus: BotIsIdle,
+			wantEvents: []string{},
+		},
+		"Active": {
+			status:     BotIsActive,
+			wantStatus: BotIsActive,
+			wantEvents: []string{},
+		},
+		"Offline": {
+			status:     BotIsOffline,
+			wantStatus: BotIsIdle,
+			wantEvents: []string{BotIdledEvent},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bot := NewBot("bot-id")
+			bot.Status = tc.status
+
+			bot.Heartbeat()
+
+			assert.Equal(t, tc.wantStatus, bot.Status)
+			assert.WithinDuration(t, time.Now(), bot.LastSeenAt, time.Second)
+			assert.Equal(t, tc.wantEvents, eventNames(bot))
+		})
+	}
+}
+
+func TestBot_Activate(t *testing.T) {
+	tests := map[string]struct {
+		status  BotStatus
+		wantErr error
+	}{
+		"Idle": {
+			status: BotIsIdle,
+		},
+		"Active": {
+			status:  BotIsActive,
+			wantErr: ErrBotIsNotIdle,
+		},
+		"Offline": {
+			status:  BotIsOffline,
+			wantErr: ErrBotIsOffline,
+		},
+		"Unknown": {
+			status:  BotUnknown,
+			wantErr: ErrBotIsNotIdle,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bot := NewBot("bot-id")
+			bot.Status = tc.status
+
+			err := bot.Activate("shopping-list-id")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, tc.status, bot.Status)
+				assert.Empty(t, bot.Events())
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, BotIsActive, bot.Status)
+				assert.Equal(t, "shopping-list-id", bot.ShoppingListID)
+				if assert.Equal(t, []string{BotActivatedEvent}, eventNames(bot)) {
+					assert.Equal(t, "shopping-list-id", bot.Events()[0].Payload().(*BotActivated).ShoppingListID)
+				}
+			}
+		})
+	}
+}
+
+func TestBot_Release(t *testing.T) {
+	tests := map[string]struct {
+		status  BotStatus
+		wantErr error
+	}{
+		"Active": {
+			status: BotIsActive,
+		},
+		"Idle": {
+			status:  BotIsIdle,
+			wantErr: ErrBotIsNotActive,
+		},
+		"Offline": {
+			status:  BotIsOffline,
+			wantErr: ErrBotIsNotActive,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bot := NewBot("bot-id")
+			bot.Status = tc.status
+			bot.ShoppingListID = "shopping-list-id"
+
+			err := bot.Release()
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, bot.Events())
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, BotIsIdle, bot.Status)
+				assert.Empty(t, bot.ShoppingListID)
+				assert.Equal(t, []string{BotIdledEvent}, eventNames(bot))
+			}
+		})
+	}
+}
+
+func TestBot_GoOffline(t *testing.T) {
+	tests := map[string]struct {
+		status             BotStatus
+		shoppingListID     string
+		wantShoppingListID string
+		wantErr            error
+	}{
+		"Idle": {
+			status: BotIsIdle,
+		},
+		// the shopping list the bot was working on is released through the event
+		"Active": {
+			status:             BotIsActive,
+			shoppingListID:     "shopping-list-id",
+			wantShoppingListID: "shopping-list-id",
+		},
+		"Offline": {
+			status:  BotIsOffline,
+			wantErr: ErrBotIsAlreadyOffline,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			bot := NewBot("bot-id")
+			bot.Status = tc.status
+			bot.ShoppingListID = tc.shoppingListID
+
+			err := bot.GoOffline()
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, bot.Events())
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, BotIsOffline, bot.Status)
+				assert.Empty(t, bot.ShoppingListID)
+				if assert.Equal(t, []string{BotWentOfflineEvent}, eventNames(bot)) {
+					assert.Equal(t, tc.wantShoppingListID, bot.Events()[0].Payload().(*BotWentOffline).ShoppingListID)
+				}
+			}
+		})
+	}
+}
@@ -16,11 +16,12 @@ func NewFakeProductCacheRepository() *FakeProductCacheRepository {
 	return &FakeProductCacheRepository{products: map[string]*Product{}}
 }
 
-func (r *FakeProductCacheRepository) Add(ctx context.Context, productID, storeID, name string) error {
+func (r *FakeProductCacheRepository) Add(ctx context.Context, productID, storeID, name string, price float64) error {
 	r.products[productID] = &Product{
 		ID:      productID,
 		StoreID: storeID,
 		Name:    name,
+		Price:   price,
 	}
 
 	return nil
@@ -34,6 +35,36 @@ func (r *FakeProductCacheRepository) Rebrand(ctx context.Context, productID, nam
 	return nil
 }
 
+func (r *FakeProductCacheRepository) UpdatePrice(ctx context.Context, productID string, delta float64) error {
+	if product, exists := r.products[productID]; exists {
+		product.Price += delta
+	}
+
+	return nil
+}
+
+func (r *FakeProductCacheRepository) AddVariant(ctx context.Context, productID string, variant ProductVariant) error {
+	if product, exists := r.products[productID]; exists {
+		product.Variants = append(product.Variants, variant)
+	}
+
+	return nil
+}
+
+func (r *FakeProductCacheRepository) RemoveVariant(ctx context.Context, productID, variantID string) error {
+	if product, exists := r.products[productID]; exists {
+		variants := make([]ProductVariant, 0, len(product.Variants))
+		for _, variant := range product.Variants {
+			if variant.ID != variantID {
+				variants = append(variants, variant)
+			}
+		}
+		product.Variants = variants
+	}
+
+	return nil
+}
+
 func (r *FakeProductCacheRepository) Remove(ctx context.Context, productID string) error {
 	delete(r.products, productID)
 
@@ -34,6 +34,14 @@ func (r *FakeStoreCacheRepository) Rename(ctx context.Context, storeID, name str
 	return nil
 }
 
+func (r *FakeStoreCacheRepository) UpdateClosed(ctx context.Context, storeID string, closed bool) error {
+	if store, exists := r.stores[storeID]; exists {
+		store.Closed = closed
+	}
+
+	return nil
+}
+
 func (r *FakeStoreCacheRepository) Find(ctx context.Context, storeID string) (*Store, error) {
 	if store, exists := r.stores[storeID]; exists {
 		return store, nil
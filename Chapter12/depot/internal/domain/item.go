@@ -3,6 +3,37 @@ package domain
 type Items map[string]*Item
 
 type Item struct {
+	ProductID   string
+	VariantID   string
+	SKU         string
+	Attributes  map[string]string
 	ProductName string
 	Quantity    int
+	Pick        PickResult
+	Picked      int
+	Substitute  *Substitute
+}
+
+// Substitute is the product picked in place of one that was out of stock
+type Substitute struct {
+	ProductID   string
+	ProductName string
+}
+
+func (i Item) IsPicked() bool {
+	return i.Pick != ItemNotPicked
+}
+
+func (i Item) Key() string {
+	return ItemKey(i.ProductID, i.VariantID)
+}
+
+// ItemKey identifies an item on a stop; items of the same product that are of
+// different variants are kept apart
+func ItemKey(productID, variantID string) string {
+	if variantID == "" {
+		return productID
+	}
+
+	return productID + "/" + variantID
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// LevelChangeDistance is the cost of moving between two levels of the mall,
+// expressed in the same units as the store coordinates
+const LevelChangeDistance = 100
+
+// MallLocation is a position inside the mall
+type MallLocation struct {
+	X     float64
+	Y     float64
+	Level int
+}
+
+// MallEntrance is where the bots start every route
+var MallEntrance = MallLocation{}
+
+// ParseMallLocation reads the coordinates of a store from its location
+//
+// Locations are written as "x,y" or "x,y,level"; false is returned for any
+// location that does not contain coordinates
+func ParseMallLocation(location string) (MallLocation, bool) {
+	parts := strings.Split(location, ",")
+	if len(parts) < 2 || len(parts) > 3 {
+		return MallLocation{}, false
+	}
+
+	x, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return MallLocation{}, false
+	}
+	y, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return MallLocation{}, false
+	}
+
+	loc := MallLocation{X: x, Y: y}
+	if len(parts) == 3 {
+		if loc.Level, err = strconv.Atoi(strings.TrimSpace(parts[2])); err != nil {
+			return MallLocation{}, false
+		}
+	}
+
+	return loc, true
+}
+
+// DistanceTo is the walking distance between two locations; bots follow the
+// mall corridors so the distance is measured along them
+func (l MallLocation) DistanceTo(other MallLocation) float64 {
+	levels := l.Level - other.Level
+	if levels < 0 {
+		levels = -levels
+	}
+
+	return math.Abs(l.X-other.X) + math.Abs(l.Y-other.Y) + float64(levels*LevelChangeDistance)
+}
@@ -36,6 +36,29 @@ func (_m *MockShoppingListRepository) Find(ctx context.Context, shoppingListID s
 	return r0, r1
 }
 
+// FindAvailable provides a mock function with given fields: ctx
+func (_m *MockShoppingListRepository) FindAvailable(ctx context.Context) (*ShoppingList, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *ShoppingList
+	if rf, ok := ret.Get(0).(func(context.Context) *ShoppingList); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ShoppingList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, list
 func (_m *MockShoppingListRepository) Save(ctx context.Context, list *ShoppingList) error {
 	ret := _m.Called(ctx, list)
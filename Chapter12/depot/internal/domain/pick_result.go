@@ -0,0 +1,19 @@
+package domain
+
+type PickResult string
+
+const (
+	ItemNotPicked     PickResult = ""
+	ItemIsPicked      PickResult = "picked"
+	ItemIsShortPicked PickResult = "short_picked"
+	ItemIsSubstituted PickResult = "substituted"
+)
+
+func (r PickResult) String() string {
+	switch r {
+	case ItemIsPicked, ItemIsShortPicked, ItemIsSubstituted:
+		return string(r)
+	default:
+		return ""
+	}
+}
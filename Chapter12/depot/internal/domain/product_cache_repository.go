@@ -5,8 +5,11 @@ import (
 )
 
 type ProductCacheRepository interface {
-	Add(ctx context.Context, productID, storeID, name string) error
+	Add(ctx context.Context, productID, storeID, name string, price float64) error
 	Rebrand(ctx context.Context, productID, name string) error
+	UpdatePrice(ctx context.Context, productID string, delta float64) error
+	AddVariant(ctx context.Context, productID string, variant ProductVariant) error
+	RemoveVariant(ctx context.Context, productID, variantID string) error
 	Remove(ctx context.Context, productID string) error
 	ProductRepository
 }
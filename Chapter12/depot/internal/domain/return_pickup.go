@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+)
+
+const ReturnPickupAggregate = "depot.ReturnPickup"
+
+var (
+	ErrReturnPickupHasNoItems       = errors.Wrap(errors.ErrBadRequest, "the return pickup has no items")
+	ErrReturnPickupCannotBeCanceled = errors.Wrap(errors.ErrBadRequest, "the return pickup cannot be canceled")
+)
+
+// ReturnPickup collects items a customer is returning and brings them back
+// to the stores they were bought from
+type ReturnPickup struct {
+	ddd.Aggregate
+	OrderID string
+	Items   []ReturnItem
+	Status  ReturnPickupStatus
+}
+
+type ReturnItem struct {
+	StoreID   string
+	ProductID string
+	VariantID string
+	Quantity  int
+}
+
+func NewReturnPickup(id string) *ReturnPickup {
+	return &ReturnPickup{
+		Aggregate: ddd.NewAggregate(id, ReturnPickupAggregate),
+	}
+}
+
+func ScheduleReturnPickup(id, orderID string, items []ReturnItem) (*ReturnPickup, error) {
+	if len(items) == 0 {
+		return nil, ErrReturnPickupHasNoItems
+	}
+
+	pickup := NewReturnPickup(id)
+	pickup.OrderID = orderID
+	pickup.Items = items
+	pickup.Status = ReturnPickupIsScheduled
+
+	pickup.AddEvent(ReturnPickupScheduledEvent, &ReturnPickupScheduled{
+		ReturnPickup: pickup,
+	})
+
+	return pickup, nil
+}
+
+func (ReturnPickup) Key() string { return ReturnPickupAggregate }
+
+func (p *ReturnPickup) Cancel() error {
+	if p.Status != ReturnPickupIsScheduled {
+		return ErrReturnPickupCannotBeCanceled
+	}
+
+	p.Status = ReturnPickupIsCanceled
+
+	p.AddEvent(ReturnPickupCanceledEvent, &ReturnPickupCanceled{
+		ReturnPickup: p,
+	})
+
+	return nil
+}
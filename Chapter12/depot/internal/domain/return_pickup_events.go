@@ -0,0 +1,18 @@
+package domain
+
+const (
+	ReturnPickupScheduledEvent = "depot.ReturnPickupScheduled"
+	ReturnPickupCanceledEvent  = "depot.ReturnPickupCanceled"
+)
+
+type ReturnPickupScheduled struct {
+	ReturnPickup *ReturnPickup
+}
+
+func (ReturnPickupScheduled) Key() string { return ReturnPickupScheduledEvent }
+
+type ReturnPickupCanceled struct {
+	ReturnPickup *ReturnPickup
+}
+
+func (ReturnPickupCanceled) Key() string { return ReturnPickupCanceledEvent }
@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"context"
+)
+
+type ReturnPickupRepository interface {
+	Find(ctx context.Context, returnPickupID string) (*ReturnPickup, error)
+	Save(ctx context.Context, pickup *ReturnPickup) error
+	Update(ctx context.Context, pickup *ReturnPickup) error
+}
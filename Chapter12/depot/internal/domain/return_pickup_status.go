@@ -0,0 +1,29 @@
+package domain
+
+type ReturnPickupStatus string
+
+const (
+	ReturnPickupUnknown     ReturnPickupStatus = ""
+	ReturnPickupIsScheduled ReturnPickupStatus = "scheduled"
+	ReturnPickupIsCanceled  ReturnPickupStatus = "canceled"
+)
+
+func (s ReturnPickupStatus) String() string {
+	switch s {
+	case ReturnPickupIsScheduled, ReturnPickupIsCanceled:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func ToReturnPickupStatus(status string) ReturnPickupStatus {
+	switch status {
+	case ReturnPickupIsScheduled.String():
+		return ReturnPickupIsScheduled
+	case ReturnPickupIsCanceled.String():
+		return ReturnPickupIsCanceled
+	default:
+		return ReturnPickupUnknown
+	}
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"sort"
+)
+
+// Route is the order in which the stores of a shopping list are visited
+type Route []string
+
+type RoutePlanner struct {
+	start MallLocation
+}
+
+func NewRoutePlanner(start MallLocation) RoutePlanner {
+	return RoutePlanner{start: start}
+}
+
+// Plan orders the stops to keep the distance travelled by a bot short
+//
+// The route is built by always moving to the nearest store and is then
+// improved by reversing any section of it that shortens the trip. Stores
+// without coordinates are visited last. The same stops always produce the
+// same route.
+func (p RoutePlanner) Plan(stops Stops) Route {
+	storeIDs := make([]string, 0, len(stops))
+	for storeID := range stops {
+		storeIDs = append(storeIDs, storeID)
+	}
+	sort.Strings(storeIDs)
+
+	var located, unlocated []string
+	locations := make(map[string]MallLocation, len(stops))
+	for _, storeID := range storeIDs {
+		if loc, ok := ParseMallLocation(stops[storeID].StoreLocation); ok {
+			locations[storeID] = loc
+			located = append(located, storeID)
+		} else {
+			unlocated = append(unlocated, storeID)
+		}
+	}
+
+	route := p.nearestNeighbor(located, locations)
+	p.improve(route, locations)
+
+	return append(route, unlocated...)
+}
+
+func (p RoutePlanner) nearestNeighbor(storeIDs []string, locations map[string]MallLocation) Route {
+	route := make(Route, 0, len(storeIDs))
+	visited := make(map[string]bool, len(storeIDs))
+
+	current := p.start
+	for len(route) < len(storeIDs) {
+		var next string
+		best := -1.0
+		for _, storeID := range storeIDs {
+			if visited[storeID] {
+				continue
+			}
+			if d := current.DistanceTo(locations[storeID]); best < 0 || d < best {
+				next, best = storeID, d
+			}
+		}
+		visited[next] = true
+		route = append(route, next)
+		current = locations[next]
+	}
+
+	return route
+}
+
+// improve applies 2-opt moves until none shortens the route; the route starts
+// at the planner start and does not return to it
+func (p RoutePlanner) improve(route Route, locations map[string]MallLocation) {
+	at := func(i int) MallLocation {
+		if i < 0 {
+			return p.start
+		}
+		return locations[route[i]]
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(route)-1; i++ {
+			for j := i + 1; j < len(route); j++ {
+				before := at(i - 1).DistanceTo(at(i))
+				after := at(i - 1).DistanceTo(at(j))
+				if j+1 < len(route) {
+					before += at(j).DistanceTo(at(j + 1))
+					after += at(i).DistanceTo(at(j + 1))
+				}
+				if after < before-1e-9 {
+					for l, r := i, j; l < r; l, r = l+1, r-1 {
+						route[l], route[r] = route[r], route[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+}
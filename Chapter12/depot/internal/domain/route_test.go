@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMallLocation(t *testing.T) {
+	tests := map[string]struct {
+		location string
+		want     MallLocation
+		wantOk   bool
+	}{
+		"XY": {
+			location: "10,20",
+			want:     MallLocation{X: 10, Y: 20},
+			wantOk:   true,
+		},
+		"XYLevel": {
+			location: " 1.5 , 2 , 3 ",
+			want:     MallLocation{X: 1.5, Y: 2, Level: 3},
+			wantOk:   true,
+		},
+		"Description": {
+			location: "North wing, second floor",
+		},
+		"TooManyParts": {
+			location: "1,2,3,4",
+		},
+		"FractionalLevel": {
+			location: "1,2,1.5",
+		},
+		"Blank": {
+			location: "",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := ParseMallLocation(tc.location)
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestMallLocation_DistanceTo(t *testing.T) {
+	a := MallLocation{X: 0, Y: 0}
+	b := MallLocation{X: 3, Y: -4}
+	c := MallLocation{X: 3, Y: -4, Level: 2}
+
+	assert.Equal(t, 7.0, a.DistanceTo(b))
+	assert.Equal(t, a.DistanceTo(b), b.DistanceTo(a))
+	assert.Equal(t, 7.0+2*LevelChangeDistance, a.DistanceTo(c))
+}
+
+func TestRoutePlanner_Plan(t *testing.T) {
+	stop := func(location string) *Stop {
+		return &Stop{StoreLocation: location, Items: Items{}}
+	}
+
+	tests := map[string]struct {
+		start MallLocation
+		stops Stops
+		want  Route
+	}{
+		"NoStops": {
+			stops: Stops{},
+			want:  Route{},
+		},
+		"NearestFirst": {
+			stops: Stops{
+				"far":  stop("30,0"),
+				"near": stop("10,0"),
+				"mid":  stop("20,0"),
+			},
+			want: Route{"near", "mid", "far"},
+		},
+		"FromStart": {
+			start: MallLocation{X: 40},
+			stops: Stops{
+				"far":  stop("30,0"),
+				"near": stop("10,0"),
+				"mid":  stop("20,0"),
+			},
+			want: Route{"far", "mid", "near"},
+		},
+		"UnlocatedLast": {
+			stops: Stops{
+				"b-somewhere": stop("by the fountain"),
+				"a-somewhere": stop(""),
+				"located":     stop("5,5"),
+			},
+			want: Route{"located", "a-somewhere", "b-somewhere"},
+		},
+		"StaysOnLevel": {
+			stops: Stops{
+				"upstairs":     stop("1,0,1"),
+				"downstairs-a": stop("10,0"),
+				"downstairs-b": stop("20,0"),
+			},
+			want: Route{"downstairs-a", "downstairs-b", "upstairs"},
+		},
+		"ImprovesGreedyRoute": {
+			// the nearest store first gives a, b, c, d which is 15 long;
+			// reversing the first section walks 13
+			stops: Stops{
+				"a": stop("1,0"),
+				"b": stop("-2,0"),
+				"c": stop("-4,0"),
+				"d": stop("5,0"),
+			},
+			want: Route{"c", "b", "a", "d"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewRoutePlanner(tc.start)
+			got := p.Plan(tc.stops)
+
+			assert.Equal(t, tc.want, got)
+			// the same stops always produce the same route
+			assert.Equal(t, got, p.Plan(tc.stops))
+		})
+	}
+}
+
+func TestRoutePlanner_PlanIsNoLongerThanGreedy(t *testing.T) {
+	stops := Stops{}
+	locations := map[string]MallLocation{}
+	for _, location := range []string{"4,9", "8,1", "2,2", "9,9", "1,7", "6,5", "3,3,1", "7,7,1"} {
+		loc, _ := ParseMallLocation(location)
+		stops[location] = &Stop{StoreLocation: location, Items: Items{}}
+		locations[location] = loc
+	}
+
+	length := func(route Route) float64 {
+		total := 0.0
+		current := MallEntrance
+		for _, storeID := range route {
+			total += current.DistanceTo(locations[storeID])
+			current = locations[storeID]
+		}
+		return total
+	}
+
+	p := NewRoutePlanner(MallEntrance)
+	route := p.Plan(stops)
+
+	assert.Len(t, route, len(stops))
+	assert.ElementsMatch(t, []string{"4,9", "8,1", "2,2", "9,9", "1,7", "6,5", "3,3,1", "7,7,1"}, route)
+	assert.LessOrEqual(t, length(route), length(p.nearestNeighbor([]string{"1,7", "2,2", "3,3,1", "4,9", "6,5", "7,7,1", "8,1", "9,9"}, locations)))
+}
+
+func TestShoppingList_PlanMissingRoute(t *testing.T) {
+	planner := NewRoutePlanner(MallLocation{})
+	stops := Stops{
+		"far":  &Stop{StoreLocation: "20,0", Items: Items{}},
+		"near": &Stop{StoreLocation: "10,0", Items: Items{}},
+	}
+
+	tests := map[string]struct {
+		stops Stops
+		route Route
+		want  Route
+	}{
+		"NoRoute": {
+			stops: stops,
+			route: Route{},
+			want:  Route{"near", "far"},
+		},
+		"NilRoute": {
+			stops: stops,
+			want:  Route{"near", "far"},
+		},
+		"PlannedRoute": {
+			stops: stops,
+			route: Route{"far", "near"},
+			want:  Route{"far", "near"},
+		},
+		"NoStops": {
+			stops: Stops{},
+			route: Route{},
+			want:  Route{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			list := NewShoppingList("shopping-list-id")
+			list.Stops = tc.stops
+			list.Route = tc.route
+
+			list.PlanMissingRoute(planner)
+
+			assert.Equal(t, tc.want, list.Route)
+		})
+	}
+}
@@ -12,13 +12,22 @@ var (
 	ErrShoppingCannotBeCanceled  = errors.Wrap(errors.ErrBadRequest, "the shopping list cannot be canceled")
 	ErrShoppingCannotBeInitiated = errors.Wrap(errors.ErrBadRequest, "the shopping list cannot be initiated")
 	ErrShoppingCannotBeAssigned  = errors.Wrap(errors.ErrBadRequest, "the shopping list cannot be assigned")
+	ErrStoreIsClosed             = errors.Wrap(errors.ErrFailedPrecondition, "the store is closed")
+	ErrShoppingCannotBeReleased  = errors.Wrap(errors.ErrBadRequest, "the shopping list cannot be released")
 	ErrShoppingCannotBeCompleted = errors.Wrap(errors.ErrBadRequest, "the shopping list cannot be completed")
+	ErrShoppingCannotBePicked    = errors.Wrap(errors.ErrBadRequest, "items cannot be picked for the shopping list")
+	ErrProductVariantNotFound    = errors.Wrap(errors.ErrNotFound, "the product variant does not exist")
+	ErrItemNotOnShoppingList     = errors.Wrap(errors.ErrNotFound, "the item is not on the shopping list")
+	ErrItemAlreadyPicked         = errors.Wrap(errors.ErrBadRequest, "the item has already been picked")
+	ErrPickedQuantityIsInvalid   = errors.Wrap(errors.ErrBadRequest, "the picked quantity must be between zero and the quantity ordered")
+	ErrSubstituteIsInvalid       = errors.Wrap(errors.ErrBadRequest, "the substitute must be a different product from the same store")
 )
 
 type ShoppingList struct {
 	ddd.Aggregate
 	OrderID       string
 	Stops         Stops
+	Route         Route
 	AssignedBotID string
 	Status        ShoppingListStatus
 }
@@ -44,7 +53,19 @@ func CreateShoppingList(id, orderID string) *ShoppingList {
 
 func (ShoppingList) Key() string { return ShoppingListAggregate }
 
-func (sl *ShoppingList) AddItem(store *Store, product *Product, quantity int) error {
+func (sl *ShoppingList) AddItem(store *Store, product *Product, variantID string, quantity int) error {
+	if store.Closed {
+		return errors.Wrapf(ErrStoreIsClosed, "store: %s", store.ID)
+	}
+
+	var variant ProductVariant
+	if variantID != "" {
+		var exists bool
+		if variant, exists = product.Variant(variantID); !exists {
+			return errors.Wrapf(ErrProductVariantNotFound, "variant: %s", variantID)
+		}
+	}
+
 	if _, exists := sl.Stops[store.ID]; !exists {
 		sl.Stops[store.ID] = &Stop{
 			StoreName:     store.Name,
@@ -53,7 +74,20 @@ func (sl *ShoppingList) AddItem(store *Store, product *Product, quantity int) er
 		}
 	}
 
-	return sl.Stops[store.ID].AddItem(product, quantity)
+	return sl.Stops[store.ID].AddItem(product, variant, quantity)
+}
+
+// PlanRoute decides the order in which the stops will be visited
+func (sl *ShoppingList) PlanRoute(planner RoutePlanner) {
+	sl.Route = planner.Plan(sl.Stops)
+}
+
+// PlanMissingRoute plans the route of a list that was created before routes
+// were planned; such lists have stops but no route
+func (sl *ShoppingList) PlanMissingRoute(planner RoutePlanner) {
+	if len(sl.Route) == 0 && len(sl.Stops) != 0 {
+		sl.PlanRoute(planner)
+	}
 }
 
 func (sl ShoppingList) isCancelable() bool {
@@ -88,6 +122,8 @@ func (sl *ShoppingList) Initiate() error {
 		return ErrShoppingCannotBeInitiated
 	}
 
+	sl.Status = ShoppingListIsAvailable
+
 	sl.AddEvent(ShoppingListInitiatedEvent, &ShoppingListInitiated{
 		ShoppingList: sl,
 	})
@@ -115,6 +151,123 @@ func (sl *ShoppingList) Assign(id string) error {
 	return nil
 }
 
+func (sl ShoppingList) IsAssigned() bool {
+	return sl.Status == ShoppingListIsAssigned
+}
+
+// Release makes an assigned shopping list available to be assigned to another bot
+func (sl *ShoppingList) Release() error {
+	if !sl.IsAssigned() {
+		return ErrShoppingCannotBeReleased
+	}
+
+	botID := sl.AssignedBotID
+
+	sl.AssignedBotID = ""
+	sl.Status = ShoppingListIsAvailable
+
+	sl.AddEvent(ShoppingListReleasedEvent, &ShoppingListReleased{
+		ShoppingList: sl,
+		BotID:        botID,
+	})
+
+	return nil
+}
+
+// PickItem records how much of an item the bot found on the shelves; picking
+// fewer than ordered is a short pick
+func (sl *ShoppingList) PickItem(productID, variantID string, quantity int) error {
+	storeID, item, err := sl.pickableItem(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	if quantity < 0 || quantity > item.Quantity {
+		return ErrPickedQuantityIsInvalid
+	}
+
+	item.Picked = quantity
+
+	if quantity == item.Quantity {
+		item.Pick = ItemIsPicked
+
+		sl.AddEvent(ShoppingListItemPickedEvent, &ShoppingListItemPicked{
+			ShoppingList: sl,
+			StoreID:      storeID,
+			ProductID:    productID,
+			VariantID:    variantID,
+			Quantity:     quantity,
+		})
+
+		return nil
+	}
+
+	item.Pick = ItemIsShortPicked
+
+	sl.AddEvent(ShoppingListItemShortPickedEvent, &ShoppingListItemShortPicked{
+		ShoppingList: sl,
+		StoreID:      storeID,
+		ProductID:    productID,
+		VariantID:    variantID,
+		Ordered:      item.Quantity,
+		Quantity:     quantity,
+	})
+
+	return nil
+}
+
+// SubstituteItem records that another product from the same store was picked
+// in place of the item
+func (sl *ShoppingList) SubstituteItem(productID, variantID string, substitute *Product, quantity int) error {
+	storeID, item, err := sl.pickableItem(productID, variantID)
+	if err != nil {
+		return err
+	}
+
+	if substitute.ID == productID || substitute.StoreID != storeID {
+		return ErrSubstituteIsInvalid
+	}
+
+	if quantity <= 0 || quantity > item.Quantity {
+		return ErrPickedQuantityIsInvalid
+	}
+
+	item.Pick = ItemIsSubstituted
+	item.Picked = quantity
+	item.Substitute = &Substitute{
+		ProductID:   substitute.ID,
+		ProductName: substitute.Name,
+	}
+
+	sl.AddEvent(ShoppingListItemSubstitutedEvent, &ShoppingListItemSubstituted{
+		ShoppingList: sl,
+		StoreID:      storeID,
+		ProductID:    productID,
+		VariantID:    variantID,
+		Substitute:   substitute,
+		Quantity:     quantity,
+	})
+
+	return nil
+}
+
+func (sl ShoppingList) pickableItem(productID, variantID string) (string, *Item, error) {
+	if !sl.isCompletable() {
+		return "", nil, ErrShoppingCannotBePicked
+	}
+
+	for storeID, stop := range sl.Stops {
+		if item, exists := stop.Items[ItemKey(productID, variantID)]; exists {
+			if item.IsPicked() {
+				return "", nil, ErrItemAlreadyPicked
+			}
+			return storeID, item, nil
+		}
+	}
+
+	return "", nil, ErrItemNotOnShoppingList
+}
+
 func (sl ShoppingList) isCompletable() bool {
 	return sl.Status == ShoppingListIsAssigned
 }
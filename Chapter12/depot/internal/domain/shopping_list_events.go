@@ -5,7 +5,12 @@ const (
 	ShoppingListCanceledEvent  = "depot.ShoppingListCanceled"
 	ShoppingListInitiatedEvent = "depot.ShoppingListInitiated"
 	ShoppingListAssignedEvent  = "depot.ShoppingListAssigned"
+	ShoppingListReleasedEvent  = "depot.ShoppingListReleased"
 	ShoppingListCompletedEvent = "depot.ShoppingListCompleted"
+
+	ShoppingListItemPickedEvent      = "depot.ShoppingListItemPicked"
+	ShoppingListItemShortPickedEvent = "depot.ShoppingListItemShortPicked"
+	ShoppingListItemSubstitutedEvent = "depot.ShoppingListItemSubstituted"
 )
 
 type ShoppingListCreated struct {
@@ -33,8 +38,47 @@ type ShoppingListAssigned struct {
 
 func (ShoppingListAssigned) Key() string { return ShoppingListAssignedEvent }
 
+type ShoppingListReleased struct {
+	ShoppingList *ShoppingList
+	BotID        string
+}
+
+func (ShoppingListReleased) Key() string { return ShoppingListReleasedEvent }
+
 type ShoppingListCompleted struct {
 	ShoppingList *ShoppingList
 }
 
 func (ShoppingListCompleted) Key() string { return ShoppingListCompletedEvent }
+
+type ShoppingListItemPicked struct {
+	ShoppingList *ShoppingList
+	StoreID      string
+	ProductID    string
+	VariantID    string
+	Quantity     int
+}
+
+func (ShoppingListItemPicked) Key() string { return ShoppingListItemPickedEvent }
+
+type ShoppingListItemShortPicked struct {
+	ShoppingList *ShoppingList
+	StoreID      string
+	ProductID    string
+	VariantID    string
+	Ordered      int
+	Quantity     int
+}
+
+func (ShoppingListItemShortPicked) Key() string { return ShoppingListItemShortPickedEvent }
+
+type ShoppingListItemSubstituted struct {
+	ShoppingList *ShoppingList
+	StoreID      string
+	ProductID    string
+	VariantID    string
+	Substitute   *Product
+	Quantity     int
+}
+
+func (ShoppingListItemSubstituted) Key() string { return ShoppingListItemSubstitutedEvent }
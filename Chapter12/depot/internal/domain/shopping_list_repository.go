@@ -6,6 +6,8 @@ import (
 
 type ShoppingListRepository interface {
 	Find(ctx context.Context, shoppingListID string) (*ShoppingList, error)
+	// FindAvailable returns the shopping list that has been available the longest; nil when there are none
+	FindAvailable(ctx context.Context) (*ShoppingList, error)
 	Save(ctx context.Context, list *ShoppingList) error
 	Update(ctx context.Context, list *ShoppingList) error
 }
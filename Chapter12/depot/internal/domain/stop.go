@@ -8,9 +8,14 @@ type Stop struct {
 	Items         Items
 }
 
-func (s *Stop) AddItem(product *Product, quantity int) error {
-	if _, exists := s.Items[product.ID]; !exists {
-		s.Items[product.ID] = &Item{
+func (s *Stop) AddItem(product *Product, variant ProductVariant, quantity int) error {
+	key := ItemKey(product.ID, variant.ID)
+	if _, exists := s.Items[key]; !exists {
+		s.Items[key] = &Item{
+			ProductID:   product.ID,
+			VariantID:   variant.ID,
+			SKU:         variant.SKU,
+			Attributes:  variant.Attributes,
 			ProductName: product.Name,
 			Quantity:    quantity,
 		}
@@ -7,5 +7,6 @@ import (
 type StoreCacheRepository interface {
 	Add(ctx context.Context, storeID, name, location string) error
 	Rename(ctx context.Context, storeID, name string) error
+	UpdateClosed(ctx context.Context, storeID string, closed bool) error
 	StoreRepository
 }
@@ -43,12 +43,28 @@ func (r ProductRepository) Find(ctx context.Context, productID string) (product
 
 func (r ProductRepository) productToDomain(product *storespb.Product) *domain.Product {
 	return &domain.Product{
-		ID:      product.GetId(),
-		StoreID: product.GetStoreId(),
-		Name:    product.GetName(),
+		ID:       product.GetId(),
+		StoreID:  product.GetStoreId(),
+		Name:     product.GetName(),
+		Price:    product.GetPrice(),
+		Variants: r.variantsToDomain(product.GetVariants()),
 	}
 }
 
+func (r ProductRepository) variantsToDomain(variants []*storespb.ProductVariant) []domain.ProductVariant {
+	domainVariants := make([]domain.ProductVariant, len(variants))
+	for i, variant := range variants {
+		domainVariants[i] = domain.ProductVariant{
+			ID:         variant.GetId(),
+			SKU:        variant.GetSku(),
+			Price:      variant.GetPrice(),
+			Attributes: variant.GetAttributes(),
+		}
+	}
+
+	return domainVariants
+}
+
 func (r ProductRepository) dial(ctx context.Context) (*grpc.ClientConn, error) {
 	return rpc.Dial(ctx, r.endpoint)
 }
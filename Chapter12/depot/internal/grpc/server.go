@@ -108,10 +108,94 @@ func (s server) CompleteShoppingList(ctx context.Context, request *depotpb.Compl
 	return &depotpb.CompleteShoppingListResponse{}, err
 }
 
+func (s server) PickItem(ctx context.Context, request *depotpb.PickItemRequest) (*depotpb.PickItemResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ShoppingListID", request.GetId()),
+		attribute.String("ProductID", request.GetProductId()),
+	)
+
+	err := s.app.PickItem(ctx, commands.PickItem{
+		ID:        request.GetId(),
+		ProductID: request.GetProductId(),
+		VariantID: request.GetVariantId(),
+		Quantity:  int(request.GetQuantity()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &depotpb.PickItemResponse{}, err
+}
+
+func (s server) SubstituteItem(ctx context.Context, request *depotpb.SubstituteItemRequest) (*depotpb.SubstituteItemResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ShoppingListID", request.GetId()),
+		attribute.String("ProductID", request.GetProductId()),
+		attribute.String("SubstituteID", request.GetSubstituteId()),
+	)
+
+	err := s.app.SubstituteItem(ctx, commands.SubstituteItem{
+		ID:           request.GetId(),
+		ProductID:    request.GetProductId(),
+		VariantID:    request.GetVariantId(),
+		SubstituteID: request.GetSubstituteId(),
+		Quantity:     int(request.GetQuantity()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &depotpb.SubstituteItemResponse{}, err
+}
+
+func (s server) RegisterBot(ctx context.Context, request *depotpb.RegisterBotRequest) (*depotpb.RegisterBotResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	id := uuid.New().String()
+
+	span.SetAttributes(
+		attribute.String("BotID", id),
+	)
+
+	err := s.app.RegisterBot(ctx, commands.RegisterBot{
+		ID:   id,
+		Name: request.GetName(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &depotpb.RegisterBotResponse{Id: id}, err
+}
+
+func (s server) RecordBotHeartbeat(ctx context.Context, request *depotpb.RecordBotHeartbeatRequest) (*depotpb.RecordBotHeartbeatResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("BotID", request.GetId()),
+	)
+
+	err := s.app.RecordBotHeartbeat(ctx, commands.RecordBotHeartbeat{ID: request.GetId()})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &depotpb.RecordBotHeartbeatResponse{}, err
+}
+
 func (s server) itemToDomain(item *depotpb.OrderItem) commands.OrderItem {
 	return commands.OrderItem{
 		StoreID:   item.GetStoreId(),
 		ProductID: item.GetProductId(),
+		VariantID: item.GetVariantId(),
 		Quantity:  int(item.GetQuantity()),
 	}
 }
@@ -70,6 +70,50 @@ func (s serverTx) CompleteShoppingList(ctx context.Context, request *depotpb.Com
 	return next.CompleteShoppingList(ctx, request)
 }
 
+func (s serverTx) PickItem(ctx context.Context, request *depotpb.PickItemRequest) (resp *depotpb.PickItemResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.PickItem(ctx, request)
+}
+
+func (s serverTx) SubstituteItem(ctx context.Context, request *depotpb.SubstituteItemRequest) (resp *depotpb.SubstituteItemResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.SubstituteItem(ctx, request)
+}
+
+func (s serverTx) RegisterBot(ctx context.Context, request *depotpb.RegisterBotRequest) (resp *depotpb.RegisterBotResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.RegisterBot(ctx, request)
+}
+
+func (s serverTx) RecordBotHeartbeat(ctx context.Context, request *depotpb.RecordBotHeartbeatRequest) (resp *depotpb.RecordBotHeartbeatResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.RecordBotHeartbeat(ctx, request)
+}
+
 func (s serverTx) closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
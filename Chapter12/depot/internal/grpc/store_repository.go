@@ -46,6 +46,7 @@ func (r StoreRepository) storeToDomain(store *storespb.Store) *domain.Store {
 		ID:       store.GetId(),
 		Name:     store.GetName(),
 		Location: store.GetLocation(),
+		Closed:   domain.IsStoreClosed(store.GetParticipating(), len(store.GetSchedule().GetHours()) > 0),
 	}
 }
 
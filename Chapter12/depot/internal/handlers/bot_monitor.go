@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/depot/internal/application"
+	"eda-in-golang/depot/internal/application/commands"
+	"eda-in-golang/depot/internal/constants"
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+)
+
+// StartBotMonitor periodically takes the bots that have stopped sending
+// heartbeats offline so that their shopping lists can be reassigned; the bots
+// of each tenant are monitored on their own
+func StartBotMonitor(ctx context.Context, container di.Container, tenants tenant.Tenants, logger zerolog.Logger) {
+	go func() {
+		ticker := time.NewTicker(constants.BotMonitorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, tenantID := range tenants.IDs() {
+					if err := takeBotsOffline(tenant.WithID(ctx, tenantID), container); err != nil {
+						logger.Error().Err(err).Str("Tenant", tenantID).Msg("depot bot monitor encountered an error")
+					}
+				}
+			}
+		}
+	}()
+}
+
+func takeBotsOffline(ctx context.Context, container di.Container) (err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	app := di.Get(ctx, constants.ApplicationKey).(application.App)
+
+	return app.TakeBotsOffline(ctx, commands.TakeBotsOffline{
+		LastSeenBefore: time.Now().Add(-constants.BotOfflineAfter),
+	})
+}
@@ -32,6 +32,8 @@ func RegisterCommandHandlers(subscriber am.MessageSubscriber, handlers am.Messag
 		depotpb.CreateShoppingListCommand,
 		depotpb.CancelShoppingListCommand,
 		depotpb.InitiateShoppingCommand,
+		depotpb.ScheduleReturnPickupCommand,
+		depotpb.CancelReturnPickupCommand,
 	}, am.GroupName("depot-commands"))
 
 	return err
@@ -60,6 +62,12 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 		return h.doCreateShoppingList(ctx, cmd)
 	case depotpb.CancelShoppingListCommand:
 		return h.doCancelShoppingList(ctx, cmd)
+	case depotpb.InitiateShoppingCommand:
+		return h.doInitiateShopping(ctx, cmd)
+	case depotpb.ScheduleReturnPickupCommand:
+		return h.doScheduleReturnPickup(ctx, cmd)
+	case depotpb.CancelReturnPickupCommand:
+		return h.doCancelReturnPickup(ctx, cmd)
 	}
 
 	return nil, nil
@@ -75,6 +83,7 @@ func (h commandHandlers) doCreateShoppingList(ctx context.Context, cmd ddd.Comma
 		items = append(items, commands.OrderItem{
 			StoreID:   item.GetStoreId(),
 			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
 			Quantity:  int(item.GetQuantity()),
 		})
 	}
@@ -105,3 +114,36 @@ func (h commandHandlers) doInitiateShopping(ctx context.Context, cmd ddd.Command
 	// returning nil returns a simple Success or Failure reply; err being nil determines which
 	return nil, err
 }
+
+func (h commandHandlers) doScheduleReturnPickup(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*depotpb.ScheduleReturnPickup)
+
+	id := uuid.New().String()
+
+	items := make([]commands.OrderItem, 0, len(payload.GetItems()))
+	for _, item := range payload.GetItems() {
+		items = append(items, commands.OrderItem{
+			StoreID:   item.GetStoreId(),
+			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	err := h.app.ScheduleReturnPickup(ctx, commands.ScheduleReturnPickup{
+		ID:      id,
+		OrderID: payload.GetOrderId(),
+		Items:   items,
+	})
+
+	return ddd.NewReply(depotpb.ScheduledReturnPickupReply, &depotpb.ScheduledReturnPickup{Id: id}), err
+}
+
+func (h commandHandlers) doCancelReturnPickup(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*depotpb.CancelReturnPickup)
+
+	err := h.app.CancelReturnPickup(ctx, commands.CancelReturnPickup{ID: payload.GetId()})
+
+	// returning nil returns a simple Success or Failure reply; err being nil determines which
+	return nil, err
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"eda-in-golang/depot/internal/application"
+	"eda-in-golang/depot/internal/application/commands"
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/errorsotel"
+)
+
+type dispatchHandlers[T ddd.AggregateEvent] struct {
+	app application.App
+}
+
+var _ ddd.EventHandler[ddd.AggregateEvent] = (*dispatchHandlers[ddd.AggregateEvent])(nil)
+
+func NewDispatchHandlers(app application.App) ddd.EventHandler[ddd.AggregateEvent] {
+	return dispatchHandlers[ddd.AggregateEvent]{
+		app: app,
+	}
+}
+
+func RegisterDispatchHandlers(subscriber ddd.EventSubscriber[ddd.AggregateEvent], handlers ddd.EventHandler[ddd.AggregateEvent]) {
+	subscriber.Subscribe(handlers,
+		domain.ShoppingListInitiatedEvent,
+		domain.ShoppingListReleasedEvent,
+		domain.ShoppingListCompletedEvent,
+		domain.ShoppingListCanceledEvent,
+		domain.BotIdledEvent,
+		domain.BotWentOfflineEvent,
+	)
+}
+
+func (h dispatchHandlers[T]) HandleEvent(ctx context.Context, event T) (err error) {
+	span := trace.SpanFromContext(ctx)
+	defer func(started time.Time) {
+		if err != nil {
+			span.AddEvent(
+				"Encountered an error dispatching bots",
+				trace.WithAttributes(errorsotel.ErrAttrs(err)...),
+			)
+		}
+		span.AddEvent("Dispatched bots", trace.WithAttributes(
+			attribute.Int64("TookMS", time.Since(started).Milliseconds()),
+		))
+	}(time.Now())
+
+	span.AddEvent("Dispatching bots", trace.WithAttributes(
+		attribute.String("Event", event.EventName()),
+	))
+
+	switch event.EventName() {
+	case domain.ShoppingListInitiatedEvent, domain.ShoppingListReleasedEvent:
+		return h.onShoppingListAvailable(ctx, event)
+	case domain.ShoppingListCompletedEvent:
+		return h.onShoppingListCompleted(ctx, event)
+	case domain.ShoppingListCanceledEvent:
+		return h.onShoppingListCanceled(ctx, event)
+	case domain.BotIdledEvent:
+		return h.onBotIdled(ctx, event)
+	case domain.BotWentOfflineEvent:
+		return h.onBotWentOffline(ctx, event)
+	}
+	return nil
+}
+
+func (h dispatchHandlers[T]) onShoppingListAvailable(ctx context.Context, event ddd.AggregateEvent) error {
+	return h.app.DispatchShoppingList(ctx, commands.DispatchShoppingList{ID: event.AggregateID()})
+}
+
+func (h dispatchHandlers[T]) onShoppingListCompleted(ctx context.Context, event ddd.AggregateEvent) error {
+	completed := event.Payload().(*domain.ShoppingListCompleted)
+
+	return h.releaseBot(ctx, completed.ShoppingList)
+}
+
+func (h dispatchHandlers[T]) onShoppingListCanceled(ctx context.Context, event ddd.AggregateEvent) error {
+	canceled := event.Payload().(*domain.ShoppingListCanceled)
+
+	return h.releaseBot(ctx, canceled.ShoppingList)
+}
+
+func (h dispatchHandlers[T]) onBotIdled(ctx context.Context, event ddd.AggregateEvent) error {
+	return h.app.DispatchBot(ctx, commands.DispatchBot{ID: event.AggregateID()})
+}
+
+func (h dispatchHandlers[T]) onBotWentOffline(ctx context.Context, event ddd.AggregateEvent) error {
+	wentOffline := event.Payload().(*domain.BotWentOffline)
+
+	if wentOffline.ShoppingListID == "" {
+		return nil
+	}
+
+	return h.app.ReleaseShoppingList(ctx, commands.ReleaseShoppingList{
+		ID:    wentOffline.ShoppingListID,
+		BotID: event.AggregateID(),
+	})
+}
+
+func (h dispatchHandlers[T]) releaseBot(ctx context.Context, list *domain.ShoppingList) error {
+	if list.AssignedBotID == "" {
+		return nil
+	}
+
+	return h.app.ReleaseBot(ctx, commands.ReleaseBot{
+		ID:             list.AssignedBotID,
+		ShoppingListID: list.ID(),
+	})
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"context"
+
+	"eda-in-golang/depot/internal/constants"
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/di"
+)
+
+func RegisterDispatchHandlersTx(container di.Container) {
+	handlers := ddd.EventHandlerFunc[ddd.AggregateEvent](func(ctx context.Context, event ddd.AggregateEvent) error {
+		dispatchHandlers := di.Get(ctx, constants.DispatchHandlersKey).(ddd.EventHandler[ddd.AggregateEvent])
+
+		return dispatchHandlers.HandleEvent(ctx, event)
+	})
+
+	subscriber := container.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.AggregateEvent])
+
+	RegisterDispatchHandlers(subscriber, handlers)
+}
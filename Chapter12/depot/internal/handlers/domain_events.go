@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -27,7 +28,12 @@ func NewDomainEventHandlers(publisher am.EventPublisher) ddd.EventHandler[ddd.Ag
 }
 
 func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.AggregateEvent], handlers ddd.EventHandler[ddd.AggregateEvent]) {
-	subscriber.Subscribe(handlers, domain.ShoppingListCompletedEvent)
+	subscriber.Subscribe(handlers,
+		domain.ShoppingListAssignedEvent,
+		domain.ShoppingListItemShortPickedEvent,
+		domain.ShoppingListItemSubstitutedEvent,
+		domain.ShoppingListCompletedEvent,
+	)
 }
 
 func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error) {
@@ -49,12 +55,58 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 	))
 
 	switch event.EventName() {
+	case domain.ShoppingListAssignedEvent:
+		return h.onShoppingListAssigned(ctx, event)
+	case domain.ShoppingListItemShortPickedEvent:
+		return h.onShoppingListItemShortPicked(ctx, event)
+	case domain.ShoppingListItemSubstitutedEvent:
+		return h.onShoppingListItemSubstituted(ctx, event)
 	case domain.ShoppingListCompletedEvent:
 		return h.onShoppingListCompleted(ctx, event)
 	}
 	return nil
 }
 
+func (h domainHandlers[T]) onShoppingListAssigned(ctx context.Context, event ddd.AggregateEvent) error {
+	assigned := event.Payload().(*domain.ShoppingListAssigned)
+
+	return h.publisher.Publish(ctx, depotpb.ShoppingListAggregateChannel, ddd.NewEvent(depotpb.ShoppingListAssignedEvent, &depotpb.ShoppingListAssigned{
+		Id:      event.AggregateID(),
+		OrderId: assigned.ShoppingList.OrderID,
+		BotId:   assigned.BotID,
+		Route:   h.routeFromDomain(assigned.ShoppingList),
+	}))
+}
+
+func (h domainHandlers[T]) onShoppingListItemShortPicked(ctx context.Context, event ddd.AggregateEvent) error {
+	shortPicked := event.Payload().(*domain.ShoppingListItemShortPicked)
+
+	return h.publisher.Publish(ctx, depotpb.ShoppingListAggregateChannel, ddd.NewEvent(depotpb.ShoppingListItemShortPickedEvent, &depotpb.ShoppingListItemShortPicked{
+		Id:        event.AggregateID(),
+		OrderId:   shortPicked.ShoppingList.OrderID,
+		StoreId:   shortPicked.StoreID,
+		ProductId: shortPicked.ProductID,
+		Quantity:  int32(shortPicked.Quantity),
+		VariantId: shortPicked.VariantID,
+	}))
+}
+
+func (h domainHandlers[T]) onShoppingListItemSubstituted(ctx context.Context, event ddd.AggregateEvent) error {
+	substituted := event.Payload().(*domain.ShoppingListItemSubstituted)
+
+	return h.publisher.Publish(ctx, depotpb.ShoppingListAggregateChannel, ddd.NewEvent(depotpb.ShoppingListItemSubstitutedEvent, &depotpb.ShoppingListItemSubstituted{
+		Id:              event.AggregateID(),
+		OrderId:         substituted.ShoppingList.OrderID,
+		StoreId:         substituted.StoreID,
+		ProductId:       substituted.ProductID,
+		SubstituteId:    substituted.Substitute.ID,
+		SubstituteName:  substituted.Substitute.Name,
+		Quantity:        int32(substituted.Quantity),
+		VariantId:       substituted.VariantID,
+		SubstitutePrice: substituted.Substitute.Price,
+	}))
+}
+
 func (h domainHandlers[T]) onShoppingListCompleted(ctx context.Context, event ddd.AggregateEvent) error {
 	completed := event.Payload().(*domain.ShoppingListCompleted)
 
@@ -63,3 +115,42 @@ func (h domainHandlers[T]) onShoppingListCompleted(ctx context.Context, event dd
 		OrderId: completed.ShoppingList.OrderID,
 	}))
 }
+
+func (h domainHandlers[T]) routeFromDomain(list *domain.ShoppingList) []*depotpb.ShoppingListAssigned_Stop {
+	route := make([]*depotpb.ShoppingListAssigned_Stop, 0, len(list.Route))
+	for _, storeID := range list.Route {
+		stop := list.Stops[storeID]
+
+		keys := make([]string, 0, len(stop.Items))
+		for key := range stop.Items {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		items := make([]*depotpb.ShoppingListAssigned_Item, 0, len(keys))
+		for _, key := range keys {
+			item := stop.Items[key]
+			productID := item.ProductID
+			// lists created before variants were added only have the key
+			if productID == "" {
+				productID = key
+			}
+			items = append(items, &depotpb.ShoppingListAssigned_Item{
+				ProductId: productID,
+				Name:      item.ProductName,
+				Quantity:  int32(item.Quantity),
+				VariantId: item.VariantID,
+				Sku:       item.SKU,
+			})
+		}
+
+		route = append(route, &depotpb.ShoppingListAssigned_Stop{
+			StoreId:       storeID,
+			StoreName:     stop.StoreName,
+			StoreLocation: stop.StoreLocation,
+			Items:         items,
+		})
+	}
+
+	return route
+}
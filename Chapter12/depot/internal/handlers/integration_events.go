@@ -33,6 +33,7 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	_, err = subscriber.Subscribe(storespb.StoreAggregateChannel, handlers, am.MessageFilter{
 		storespb.StoreCreatedEvent,
 		storespb.StoreRebrandedEvent,
+		storespb.StoreParticipatingToggledEvent,
 	}, am.GroupName("depot-stores"))
 	if err != nil {
 		return err
@@ -41,7 +42,13 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	_, err = subscriber.Subscribe(storespb.ProductAggregateChannel, handlers, am.MessageFilter{
 		storespb.ProductAddedEvent,
 		storespb.ProductRebrandedEvent,
+		storespb.ProductPriceIncreasedEvent,
+		storespb.ProductPriceDecreasedEvent,
 		storespb.ProductRemovedEvent,
+		storespb.ProductVariantAddedEvent,
+		storespb.ProductVariantRemovedEvent,
+		storespb.ProductScheduledPriceActivatedEvent,
+		storespb.ProductScheduledPriceRevertedEvent,
 	}, am.GroupName("depot-products"))
 
 	return err
@@ -70,12 +77,22 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 		return h.onStoreCreated(ctx, event)
 	case storespb.StoreRebrandedEvent:
 		return h.onStoreRebranded(ctx, event)
+	case storespb.StoreParticipatingToggledEvent:
+		return h.onStoreParticipationToggled(ctx, event)
 	case storespb.ProductAddedEvent:
 		return h.onProductAdded(ctx, event)
 	case storespb.ProductRebrandedEvent:
 		return h.onProductRebranded(ctx, event)
+	case storespb.ProductPriceIncreasedEvent, storespb.ProductPriceDecreasedEvent:
+		return h.onProductPriceChanged(ctx, event)
 	case storespb.ProductRemovedEvent:
 		return h.onProductRemoved(ctx, event)
+	case storespb.ProductVariantAddedEvent:
+		return h.onProductVariantAdded(ctx, event)
+	case storespb.ProductVariantRemovedEvent:
+		return h.onProductVariantRemoved(ctx, event)
+	case storespb.ProductScheduledPriceActivatedEvent, storespb.ProductScheduledPriceRevertedEvent:
+		return h.onProductScheduledPriceChanged(ctx, event)
 	}
 
 	return nil
@@ -91,9 +108,14 @@ func (h integrationHandlers[T]) onStoreRebranded(ctx context.Context, event ddd.
 	return h.stores.Rename(ctx, payload.GetId(), payload.GetName())
 }
 
+func (h integrationHandlers[T]) onStoreParticipationToggled(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.StoreParticipationToggled)
+	return h.stores.UpdateClosed(ctx, payload.GetId(), domain.IsStoreClosed(payload.GetParticipating(), payload.GetScheduled()))
+}
+
 func (h integrationHandlers[T]) onProductAdded(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductAdded)
-	return h.products.Add(ctx, payload.GetId(), payload.GetStoreId(), payload.GetName())
+	return h.products.Add(ctx, payload.GetId(), payload.GetStoreId(), payload.GetName(), payload.GetPrice())
 }
 
 func (h integrationHandlers[T]) onProductRebranded(ctx context.Context, event ddd.Event) error {
@@ -101,7 +123,32 @@ func (h integrationHandlers[T]) onProductRebranded(ctx context.Context, event dd
 	return h.products.Rebrand(ctx, payload.GetId(), payload.GetName())
 }
 
+func (h integrationHandlers[T]) onProductPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductPriceChanged)
+	return h.products.UpdatePrice(ctx, payload.GetId(), payload.GetDelta())
+}
+
+func (h integrationHandlers[T]) onProductScheduledPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductScheduledPriceChanged)
+	return h.products.UpdatePrice(ctx, payload.GetId(), payload.GetDelta())
+}
+
 func (h integrationHandlers[T]) onProductRemoved(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductRemoved)
 	return h.products.Remove(ctx, payload.GetId())
 }
+
+func (h integrationHandlers[T]) onProductVariantAdded(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductVariantAdded)
+	return h.products.AddVariant(ctx, payload.GetId(), domain.ProductVariant{
+		ID:         payload.GetVariantId(),
+		SKU:        payload.GetSku(),
+		Price:      payload.GetPrice(),
+		Attributes: payload.GetAttributes(),
+	})
+}
+
+func (h integrationHandlers[T]) onProductVariantRemoved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductVariantRemoved)
+	return h.products.RemoveVariant(ctx, payload.GetId(), payload.GetVariantId())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/pact-foundation/pact-go/v2/matchers"
 	"github.com/pact-foundation/pact-go/v2/message/v4"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/registry/serdes"
@@ -23,6 +25,17 @@ type Map = matchers.Map
 
 var Like = matchers.Like
 
+// receivedMessage delivers a published message straight to a message handler
+type receivedMessage struct {
+	am.Message
+}
+
+func (receivedMessage) ReceivedAt() time.Time { return time.Now() }
+func (receivedMessage) Ack() error            { return nil }
+func (receivedMessage) NAck() error           { return nil }
+func (receivedMessage) Extend() error         { return nil }
+func (receivedMessage) Kill() error           { return nil }
+
 func TestStoresConsumer(t *testing.T) {
 	type mocks struct {
 		stores   *domain.MockStoreCacheRepository
@@ -89,7 +102,10 @@ func TestStoresConsumer(t *testing.T) {
 			if tc.on != nil {
 				tc.on(m)
 			}
-			handlers := NewIntegrationEventHandlers(m.stores, m.products)
+			handlers := NewIntegrationEventHandlers(reg, m.stores, m.products)
+			publisher := am.NewEventPublisher(reg, am.MessagePublisherFunc(func(ctx context.Context, _ string, msg am.Message) error {
+				return handlers.HandleMessage(ctx, receivedMessage{msg})
+			}))
 			msgHandlerFn := func(contents v4.MessageContents) error {
 				event := contents.Content.(*rawEvent)
 
@@ -99,7 +115,7 @@ func TestStoresConsumer(t *testing.T) {
 				}
 				payload := reg.MustDeserialize(event.Name, data)
 
-				return handlers.HandleEvent(context.Background(), ddd.NewEvent(event.Name, payload))
+				return publisher.Publish(context.Background(), event.Name, ddd.NewEvent(event.Name, payload))
 			}
 
 			message := pact.AddAsynchronousMessage()
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type BotRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.BotRepository = (*BotRepository)(nil)
+
+func NewBotRepository(tableName string, db postgres.DB) BotRepository {
+	return BotRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r BotRepository) Find(ctx context.Context, botID string) (*domain.Bot, error) {
+	const query = "SELECT id, name, status, shopping_list_id, last_seen_at FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
+
+	bot, err := r.scan(r.db.QueryRowContext(ctx, r.table(query), botID, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msgf("bot `%s` was not found", botID)
+		}
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	return bot, nil
+}
+
+func (r BotRepository) FindIdle(ctx context.Context) (*domain.Bot, error) {
+	const query = `SELECT id, name, status, shopping_list_id, last_seen_at FROM %s WHERE status = $1 AND tenant_id = $2 ORDER BY last_seen_at DESC LIMIT 1 FOR UPDATE SKIP LOCKED`
+
+	bot, err := r.scan(r.db.QueryRowContext(ctx, r.table(query), domain.BotIsIdle.String(), tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	return bot, nil
+}
+
+func (r BotRepository) FindUnresponsive(ctx context.Context, lastSeenBefore time.Time) ([]*domain.Bot, error) {
+	const query = `SELECT id, name, status, shopping_list_id, last_seen_at FROM %s WHERE status <> $1 AND last_seen_at < $2 AND tenant_id = $3 FOR UPDATE SKIP LOCKED`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), domain.BotIsOffline.String(), lastSeenBefore, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing bot rows")
+		}
+	}(rows)
+
+	var bots []*domain.Bot
+	for rows.Next() {
+		bot, err := r.scan(rows)
+		if err != nil {
+			return nil, errors.ErrInternalServerError.Err(err)
+		}
+		bots = append(bots, bot)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	return bots, nil
+}
+
+func (r BotRepository) Save(ctx context.Context, bot *domain.Bot) error {
+	const query = "INSERT INTO %s (id, name, status, shopping_list_id, last_seen_at, tenant_id) VALUES ($1, $2, $3, $4, $5, $6)"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), bot.ID(), bot.Name, bot.Status.String(), bot.ShoppingListID, bot.LastSeenAt, tenant.FromContext(ctx))
+
+	return errors.ErrInternalServerError.Err(err)
+}
+
+func (r BotRepository) Update(ctx context.Context, bot *domain.Bot) error {
+	const query = "UPDATE %s SET name = $2, status = $3, shopping_list_id = $4, last_seen_at = $5 WHERE id = $1 AND tenant_id = $6"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), bot.ID(), bot.Name, bot.Status.String(), bot.ShoppingListID, bot.LastSeenAt, tenant.FromContext(ctx))
+
+	return errors.ErrInternalServerError.Err(err)
+}
+
+func (r BotRepository) scan(row interface{ Scan(dest ...any) error }) (*domain.Bot, error) {
+	var id, name, status, shoppingListID string
+	var lastSeenAt time.Time
+
+	if err := row.Scan(&id, &name, &status, &shoppingListID, &lastSeenAt); err != nil {
+		return nil, err
+	}
+
+	bot := domain.NewBot(id)
+	bot.Name = name
+	bot.Status = domain.ToBotStatus(status)
+	bot.ShoppingListID = shoppingListID
+	bot.LastSeenAt = lastSeenAt
+
+	return bot, nil
+}
+
+func (r BotRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
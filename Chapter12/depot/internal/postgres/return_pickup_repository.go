@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/depot/internal/domain"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+)
+
+type ReturnPickupRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ domain.ReturnPickupRepository = (*ReturnPickupRepository)(nil)
+
+func NewReturnPickupRepository(tableName string, db postgres.DB) ReturnPickupRepository {
+	return ReturnPickupRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r ReturnPickupRepository) Find(ctx context.Context, id string) (*domain.ReturnPickup, error) {
+	const query = "SELECT order_id, items, status FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
+
+	pickup := domain.NewReturnPickup(id)
+
+	var items []byte
+	var status string
+
+	err := r.db.QueryRowContext(ctx, r.table(query), id, tenant.FromContext(ctx)).Scan(&pickup.OrderID, &items, &status)
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	pickup.Status = domain.ToReturnPickupStatus(status)
+
+	err = json.Unmarshal(items, &pickup.Items)
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	return pickup, nil
+}
+
+func (r ReturnPickupRepository) Save(ctx context.Context, pickup *domain.ReturnPickup) error {
+	const query = "INSERT INTO %s (id, order_id, items, status, tenant_id) VALUES ($1, $2, $3, $4, $5)"
+
+	items, err := json.Marshal(pickup.Items)
+	if err != nil {
+		return errors.ErrInternalServerError.Err(err)
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), pickup.ID(), pickup.OrderID, items, pickup.Status.String(), tenant.FromContext(ctx))
+
+	return errors.ErrInternalServerError.Err(err)
+}
+
+func (r ReturnPickupRepository) Update(ctx context.Context, pickup *domain.ReturnPickup) error {
+	const query = "UPDATE %s SET status = $2 WHERE id = $1 AND tenant_id = $3"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), pickup.ID(), pickup.Status.String(), tenant.FromContext(ctx))
+
+	return errors.ErrInternalServerError.Err(err)
+}
+
+func (r ReturnPickupRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
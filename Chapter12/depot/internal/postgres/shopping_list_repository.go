@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 
@@ -9,6 +10,7 @@ import (
 
 	"eda-in-golang/depot/internal/domain"
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 )
 
 type ShoppingListRepository struct {
@@ -26,14 +28,14 @@ func NewShoppingListRepository(tableName string, db postgres.DB) ShoppingListRep
 }
 
 func (r ShoppingListRepository) Find(ctx context.Context, id string) (*domain.ShoppingList, error) {
-	const query = "SELECT order_id, stops, assigned_bot_id, status FROM %s WHERE id = $1 LIMIT 1"
+	const query = "SELECT order_id, stops, route, assigned_bot_id, status FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
 
 	shoppingList := domain.NewShoppingList(id)
 
-	var stops []byte
+	var stops, route []byte
 	var status string
 
-	err := r.db.QueryRowContext(ctx, r.table(query), id).Scan(&shoppingList.OrderID, &stops, &shoppingList.AssignedBotID, &status)
+	err := r.db.QueryRowContext(ctx, r.table(query), id, tenant.FromContext(ctx)).Scan(&shoppingList.OrderID, &stops, &route, &shoppingList.AssignedBotID, &status)
 	if err != nil {
 		return nil, errors.ErrInternalServerError.Err(err)
 	}
@@ -48,31 +50,79 @@ func (r ShoppingListRepository) Find(ctx context.Context, id string) (*domain.Sh
 		return nil, errors.ErrInternalServerError.Err(err)
 	}
 
+	err = json.Unmarshal(route, &shoppingList.Route)
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+	shoppingList.PlanMissingRoute(domain.NewRoutePlanner(domain.MallEntrance))
+
+	return shoppingList, nil
+}
+
+func (r ShoppingListRepository) FindAvailable(ctx context.Context) (*domain.ShoppingList, error) {
+	const query = `SELECT id, order_id, stops, route FROM %s WHERE status = $1 AND tenant_id = $2 ORDER BY updated_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED`
+
+	var id, orderID string
+	var stops, route []byte
+
+	err := r.db.QueryRowContext(ctx, r.table(query), domain.ShoppingListIsAvailable.String(), tenant.FromContext(ctx)).Scan(&id, &orderID, &stops, &route)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	shoppingList := domain.NewShoppingList(id)
+	shoppingList.OrderID = orderID
+	shoppingList.Status = domain.ShoppingListIsAvailable
+
+	err = json.Unmarshal(stops, &shoppingList.Stops)
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+
+	err = json.Unmarshal(route, &shoppingList.Route)
+	if err != nil {
+		return nil, errors.ErrInternalServerError.Err(err)
+	}
+	shoppingList.PlanMissingRoute(domain.NewRoutePlanner(domain.MallEntrance))
+
 	return shoppingList, nil
 }
 
 func (r ShoppingListRepository) Save(ctx context.Context, list *domain.ShoppingList) error {
-	const query = "INSERT INTO %s (id, order_id, stops, assigned_bot_id, status) VALUES ($1, $2, $3, $4, $5)"
+	const query = "INSERT INTO %s (id, order_id, stops, route, assigned_bot_id, status, tenant_id) VALUES ($1, $2, $3, $4, $5, $6, $7)"
 
 	stops, err := json.Marshal(list.Stops)
 	if err != nil {
 		return errors.ErrInternalServerError.Err(err)
 	}
 
-	_, err = r.db.ExecContext(ctx, r.table(query), list.ID(), list.OrderID, stops, list.AssignedBotID, list.Status.String())
+	route, err := json.Marshal(list.Route)
+	if err != nil {
+		return errors.ErrInternalServerError.Err(err)
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), list.ID(), list.OrderID, stops, route, list.AssignedBotID, list.Status.String(), tenant.FromContext(ctx))
 
 	return errors.ErrInternalServerError.Err(err)
 }
 
 func (r ShoppingListRepository) Update(ctx context.Context, list *domain.ShoppingList) error {
-	const query = "UPDATE %s SET stops = $2, assigned_bot_id = $3, status = $4 WHERE id = $1"
+	const query = "UPDATE %s SET stops = $2, route = $3, assigned_bot_id = $4, status = $5 WHERE id = $1 AND tenant_id = $6"
 
 	stops, err := json.Marshal(list.Stops)
 	if err != nil {
 		return errors.ErrInternalServerError.Err(err)
 	}
 
-	_, err = r.db.ExecContext(ctx, r.table(query), list.ID(), stops, list.AssignedBotID, list.Status.String())
+	route, err := json.Marshal(list.Route)
+	if err != nil {
+		return errors.ErrInternalServerError.Err(err)
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), list.ID(), stops, route, list.AssignedBotID, list.Status.String(), tenant.FromContext(ctx))
 
 	return errors.ErrInternalServerError.Err(err)
 }
@@ -9,6 +9,7 @@ import (
 
 	"eda-in-golang/depot/internal/domain"
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 )
 
 type StoreCacheRepository struct {
@@ -28,29 +29,37 @@ func NewStoreCacheRepository(tableName string, db postgres.DB, fallback domain.S
 }
 
 func (r StoreCacheRepository) Add(ctx context.Context, storeID, name, location string) error {
-	const query = "INSERT INTO %s (id, NAME, location) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+	const query = "INSERT INTO %s (id, NAME, location, tenant_id) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, location)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, location, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r StoreCacheRepository) Rename(ctx context.Context, storeID, name string) error {
-	const query = "UPDATE %s SET NAME = $2 WHERE id = $1"
+	const query = "UPDATE %s SET NAME = $2 WHERE id = $1 AND tenant_id = $3"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r StoreCacheRepository) UpdateClosed(ctx context.Context, storeID string, closed bool) error {
+	const query = "UPDATE %s SET closed = $2 WHERE id = $1 AND tenant_id = $3"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, closed, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r StoreCacheRepository) Find(ctx context.Context, storeID string) (*domain.Store, error) {
-	const query = "SELECT name, location FROM %s WHERE id = $1 LIMIT 1"
+	const query = "SELECT name, location, closed FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
 
 	store := &domain.Store{
 		ID: storeID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), storeID).Scan(&store.Name, &store.Location)
+	err := r.db.QueryRowContext(ctx, r.table(query), storeID, tenant.FromContext(ctx)).Scan(&store.Name, &store.Location, &store.Closed)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning store")
@@ -60,7 +69,10 @@ func (r StoreCacheRepository) Find(ctx context.Context, storeID string) (*domain
 			return nil, errors.Wrap(err, "store fallback failed")
 		}
 		// attempt to add it to the cache
-		return store, r.Add(ctx, store.ID, store.Name, store.Location)
+		if err = r.Add(ctx, store.ID, store.Name, store.Location); err != nil {
+			return store, err
+		}
+		return store, r.UpdateClosed(ctx, store.ID, store.Closed)
 	}
 
 	return store, nil
@@ -9,12 +9,13 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"eda-in-golang/depot/depotpb"
+	"eda-in-golang/internal/tenant"
 )
 
 func RegisterGateway(ctx context.Context, mux *chi.Mux, grpcAddr string) error {
 	const apiRoot = "/api/depot"
 
-	gateway := runtime.NewServeMux()
+	gateway := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(tenant.HeaderMatcher))
 	err := depotpb.RegisterDepotServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	})
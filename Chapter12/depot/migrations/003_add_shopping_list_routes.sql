@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE shopping_lists
+  ADD COLUMN route bytea NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE shopping_lists
+  DROP COLUMN IF EXISTS route;
@@ -0,0 +1,97 @@
+-- +goose Up
+ALTER TABLE stores_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE products_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE shopping_lists
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT shopping_lists_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE bots
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT bots_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE return_pickups
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT return_pickups_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE events
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name, stream_version);
+
+ALTER TABLE snapshots
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+ALTER TABLE sagas
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (tenant_id, id, name);
+
+-- +goose Down
+ALTER TABLE stores_cache
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE products_cache
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE shopping_lists
+  DROP CONSTRAINT shopping_lists_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE bots
+  DROP CONSTRAINT bots_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE return_pickups
+  DROP CONSTRAINT return_pickups_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE events
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name, stream_version),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE snapshots
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
+
+ALTER TABLE sagas
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (id, name),
+  DROP COLUMN tenant_id;
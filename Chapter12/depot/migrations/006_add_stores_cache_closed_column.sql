@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE stores_cache
+  ADD COLUMN closed bool NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE stores_cache
+  DROP COLUMN closed;
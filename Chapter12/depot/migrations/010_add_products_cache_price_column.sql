@@ -0,0 +1,9 @@
+-- +goose Up
+-- cached products without a price are priced from the stores service the next
+-- time they are found
+ALTER TABLE products_cache
+  ADD COLUMN price decimal(9, 4);
+
+-- +goose Down
+ALTER TABLE products_cache
+  DROP COLUMN price;
@@ -17,13 +17,16 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/stores/storespb"
 )
@@ -48,7 +51,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.AggregateEvent](), nil
 	})
@@ -61,16 +65,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -101,6 +113,18 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
 		), nil
 	})
+	container.AddScoped(constants.ReturnPickupsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewReturnPickupRepository(
+			constants.ReturnPickupsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddScoped(constants.BotsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewBotRepository(
+			constants.BotsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
 	container.AddScoped(constants.StoresCacheRepoKey, func(c di.Container) (any, error) {
 		return postgres.NewStoreCacheRepository(
 			constants.StoresCacheTableName,
@@ -120,6 +144,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	container.AddScoped(constants.ApplicationKey, func(c di.Container) (any, error) {
 		return application.New(
 			c.Get(constants.ShoppingListsRepoKey).(domain.ShoppingListRepository),
+			c.Get(constants.ReturnPickupsRepoKey).(domain.ReturnPickupRepository),
+			c.Get(constants.BotsRepoKey).(domain.BotRepository),
 			c.Get(constants.StoresCacheRepoKey).(domain.StoreCacheRepository),
 			c.Get(constants.ProductsCacheRepoKey).(domain.ProductCacheRepository),
 			c.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.AggregateEvent]),
@@ -128,6 +154,9 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	container.AddScoped(constants.DomainEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewDomainEventHandlers(c.Get(constants.EventPublisherKey).(am.EventPublisher)), nil
 	})
+	container.AddScoped(constants.DispatchHandlersKey, func(c di.Container) (any, error) {
+		return handlers.NewDispatchHandlers(c.Get(constants.ApplicationKey).(application.App)), nil
+	})
 	container.AddScoped(constants.IntegrationEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewIntegrationEventHandlers(
 			c.Get(constants.RegistryKey).(registry.Registry),
@@ -160,6 +189,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	handlers.RegisterDomainEventHandlersTx(container)
+	handlers.RegisterDispatchHandlersTx(container)
 	if err = handlers.RegisterIntegrationEventHandlersTx(container); err != nil {
 		return err
 	}
@@ -167,6 +197,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	handlers.StartBotMonitor(ctx, container, svc.Tenants(), svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return nil
 }
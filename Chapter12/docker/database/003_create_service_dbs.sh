@@ -136,3 +136,38 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "stores" <<-EOSQL
   CREATE SCHEMA stores;
   GRANT CREATE, USAGE ON SCHEMA stores TO stores_user;
 EOSQL
+
+# data that is exchanged between the services, such as claim check blobs
+# and the data keys of encrypted messages
+psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-EOSQL
+  CREATE DATABASE shared TEMPLATE commondb;
+
+  CREATE USER shared_user WITH ENCRYPTED PASSWORD 'shared_pass';
+  GRANT USAGE ON SCHEMA public TO shared_user;
+  GRANT CREATE, CONNECT ON DATABASE shared TO shared_user;
+EOSQL
+psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "shared" <<-EOSQL
+  CREATE TABLE claim_checks (
+    key        text        NOT NULL,
+    blob_oid   oid         NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (key)
+  );
+
+  CREATE INDEX claim_checks_expires_at_idx ON claim_checks (expires_at);
+
+  GRANT SELECT, INSERT, DELETE ON claim_checks TO shared_user;
+
+  CREATE TABLE encryption_keys (
+    id            text        NOT NULL,
+    master_key_id text        NOT NULL,
+    encrypted_key bytea       NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id)
+  );
+
+  CREATE INDEX encryption_keys_created_at_idx ON encryption_keys (created_at);
+
+  GRANT SELECT, INSERT ON encryption_keys TO shared_user;
+EOSQL
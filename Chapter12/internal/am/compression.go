@@ -0,0 +1,225 @@
+package am
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+)
+
+const CompressionCodecHdr = "COMPRESSION_CODEC"
+
+const (
+	CompressionNone   CompressionCodec = ""
+	CompressionGzip   CompressionCodec = "gzip"
+	CompressionZstd   CompressionCodec = "zstd"
+	CompressionSnappy CompressionCodec = "snappy"
+)
+
+type (
+	CompressionCodec string
+
+	CompressionConfig struct {
+		Codec     CompressionCodec `default:"gzip"`
+		Threshold int              `default:"4096"`
+		MaxSize   int              `envconfig:"MAX_SIZE" default:"1048576"`
+	}
+
+	ErrMessageTooLarge struct {
+		Name  string
+		Size  int
+		Limit int
+	}
+
+	compressedMessage struct {
+		IncomingMessage
+		data     []byte
+		metadata ddd.Metadata
+	}
+)
+
+func (e ErrMessageTooLarge) Error() string {
+	return fmt.Sprintf("message `%s` is %d bytes which exceeds the limit of %d bytes", e.Name, e.Size, e.Limit)
+}
+
+func (c *CompressionCodec) Decode(v string) error {
+	codec := CompressionCodec(v)
+	switch codec {
+	case CompressionNone, CompressionGzip, CompressionZstd, CompressionSnappy:
+		*c = codec
+		return nil
+	default:
+		return fmt.Errorf("unknown compression codec: %q", v)
+	}
+}
+
+// CompressingPublisher compresses message data that is larger than the threshold
+//
+// The codec used is recorded in the metadata so the receiver can reverse it; messages
+// larger than the maximum size before compression are rejected, which is the same
+// limit DecompressingHandler applies once the data has been decompressed
+func CompressingPublisher(cfg CompressionConfig) MessagePublisherMiddleware {
+	return func(next MessagePublisher) MessagePublisher {
+		return MessagePublisherFunc(func(ctx context.Context, topicName string, msg Message) error {
+			data := msg.Data()
+
+			if cfg.MaxSize > 0 && len(data) > cfg.MaxSize {
+				return ErrMessageTooLarge{Name: msg.MessageName(), Size: len(data), Limit: cfg.MaxSize}
+			}
+
+			if cfg.Codec == CompressionNone || len(data) <= cfg.Threshold {
+				return next.Publish(ctx, topicName, msg)
+			}
+
+			compressed, err := compress(cfg.Codec, data)
+			if err != nil {
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata())+1)
+			for key, value := range msg.Metadata() {
+				metadata.Set(key, value)
+			}
+			metadata.Set(CompressionCodecHdr, string(cfg.Codec))
+
+			return next.Publish(ctx, topicName, message{
+				id:       msg.ID(),
+				name:     msg.MessageName(),
+				subject:  msg.Subject(),
+				data:     compressed,
+				metadata: metadata,
+				sentAt:   msg.SentAt(),
+			})
+		})
+	}
+}
+
+// DecompressingHandler reverses the compression applied by CompressingPublisher
+//
+// Messages that decompress to more than the maximum size are rejected
+func DecompressingHandler(cfg CompressionConfig) MessageHandlerMiddleware {
+	return func(next MessageHandler) MessageHandler {
+		return MessageHandlerFunc(func(ctx context.Context, msg IncomingMessage) error {
+			codec, ok := msg.Metadata().Get(CompressionCodecHdr).(string)
+			if !ok || CompressionCodec(codec) == CompressionNone {
+				return next.HandleMessage(ctx, msg)
+			}
+
+			data, err := decompress(CompressionCodec(codec), msg.Data(), cfg.MaxSize)
+			if err != nil {
+				var errTooLarge ErrMessageTooLarge
+				if errors.As(err, &errTooLarge) {
+					errTooLarge.Name = msg.MessageName()
+					errTooLarge.Limit = cfg.MaxSize
+					return errTooLarge
+				}
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata()))
+			for key, value := range msg.Metadata() {
+				metadata.Set(key, value)
+			}
+			metadata.Del(CompressionCodecHdr)
+
+			return next.HandleMessage(ctx, compressedMessage{
+				IncomingMessage: msg,
+				data:            data,
+				metadata:        metadata,
+			})
+		})
+	}
+}
+
+func (m compressedMessage) Data() []byte           { return m.data }
+func (m compressedMessage) Metadata() ddd.Metadata { return m.metadata }
+
+func compress(codec CompressionCodec, data []byte) ([]byte, error) {
+	switch codec {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer enc.Close()
+		return enc.EncodeAll(data, nil), nil
+	case CompressionSnappy:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %q", codec)
+	}
+}
+
+// decompress will stop reading once maxSize bytes have been produced; a zero maxSize disables the limit
+func decompress(codec CompressionCodec, data []byte, maxSize int) ([]byte, error) {
+	var decoded []byte
+	var err error
+
+	switch codec {
+	case CompressionGzip:
+		var r *gzip.Reader
+		r, err = gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		var src io.Reader = r
+		if maxSize > 0 {
+			src = io.LimitReader(r, int64(maxSize)+1)
+		}
+		decoded, err = io.ReadAll(src)
+	case CompressionZstd:
+		opts := []zstd.DOption{zstd.WithDecoderConcurrency(1)}
+		if maxSize > 0 {
+			opts = append(opts, zstd.WithDecoderMaxMemory(uint64(maxSize)+1))
+		}
+		var dec *zstd.Decoder
+		dec, err = zstd.NewReader(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		decoded, err = dec.DecodeAll(data, nil)
+		if err == zstd.ErrDecoderSizeExceeded {
+			return nil, ErrMessageTooLarge{Size: maxSize + 1}
+		}
+	case CompressionSnappy:
+		var size int
+		size, err = snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if maxSize > 0 && size > maxSize {
+			return nil, ErrMessageTooLarge{Size: size}
+		}
+		decoded, err = snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unknown compression codec: %q", codec)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if maxSize > 0 && len(decoded) > maxSize {
+		return nil, ErrMessageTooLarge{Size: len(decoded)}
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,151 @@
+package am
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/ddd"
+)
+
+type testIncomingMessage struct {
+	message
+}
+
+func (m testIncomingMessage) ReceivedAt() time.Time { return time.Now() }
+func (m testIncomingMessage) Ack() error            { return nil }
+func (m testIncomingMessage) NAck() error           { return nil }
+func (m testIncomingMessage) Extend() error         { return nil }
+func (m testIncomingMessage) Kill() error           { return nil }
+
+func TestCompression_RoundTrip(t *testing.T) {
+	compressible := bytes.Repeat([]byte("mallbots"), 512)
+	random := []byte("a payload that is too short to be compressed")
+
+	tests := map[string]struct {
+		cfg            CompressionConfig
+		data           []byte
+		wantCompressed bool
+		wantErr        bool
+	}{
+		"Gzip": {
+			cfg:            CompressionConfig{Codec: CompressionGzip, Threshold: 64, MaxSize: 8192},
+			data:           compressible,
+			wantCompressed: true,
+		},
+		"Zstd": {
+			cfg:            CompressionConfig{Codec: CompressionZstd, Threshold: 64, MaxSize: 8192},
+			data:           compressible,
+			wantCompressed: true,
+		},
+		"Snappy": {
+			cfg:            CompressionConfig{Codec: CompressionSnappy, Threshold: 64, MaxSize: 8192},
+			data:           compressible,
+			wantCompressed: true,
+		},
+		"BelowThreshold": {
+			cfg:  CompressionConfig{Codec: CompressionGzip, Threshold: 64, MaxSize: 8192},
+			data: random,
+		},
+		"NoCodec": {
+			cfg:  CompressionConfig{Codec: CompressionNone, Threshold: 64, MaxSize: 8192},
+			data: compressible,
+		},
+		"NoLimit": {
+			cfg:            CompressionConfig{Codec: CompressionGzip, Threshold: 64},
+			data:           compressible,
+			wantCompressed: true,
+		},
+		"TooLargeBeforeCompression": {
+			cfg:     CompressionConfig{Codec: CompressionGzip, Threshold: 64, MaxSize: 1024},
+			data:    compressible,
+			wantErr: true,
+		},
+		"TooLargeWithoutCompression": {
+			cfg:     CompressionConfig{Codec: CompressionNone, MaxSize: 16},
+			data:    random,
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var published Message
+			publisher := CompressingPublisher(tc.cfg)(MessagePublisherFunc(func(ctx context.Context, topicName string, msg Message) error {
+				published = msg
+				return nil
+			}))
+
+			err := publisher.Publish(context.Background(), "topic", message{
+				id:       "message-id",
+				name:     "message-name",
+				subject:  "topic",
+				data:     tc.data,
+				metadata: ddd.Metadata{},
+			})
+			if tc.wantErr {
+				var errTooLarge ErrMessageTooLarge
+				assert.True(t, errors.As(err, &errTooLarge))
+				assert.Nil(t, published)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			_, compressed := published.Metadata()[CompressionCodecHdr]
+			assert.Equal(t, tc.wantCompressed, compressed)
+
+			var handled IncomingMessage
+			handler := DecompressingHandler(tc.cfg)(MessageHandlerFunc(func(ctx context.Context, msg IncomingMessage) error {
+				handled = msg
+				return nil
+			}))
+
+			err = handler.HandleMessage(context.Background(), testIncomingMessage{message{
+				id:       published.ID(),
+				name:     published.MessageName(),
+				subject:  published.Subject(),
+				data:     published.Data(),
+				metadata: published.Metadata(),
+			}})
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.data, handled.Data())
+				assert.NotContains(t, handled.Metadata(), CompressionCodecHdr)
+			}
+		})
+	}
+}
+
+func TestDecompressingHandler_TooLarge(t *testing.T) {
+	data := bytes.Repeat([]byte("mallbots"), 512)
+
+	for _, codec := range []CompressionCodec{CompressionGzip, CompressionZstd, CompressionSnappy} {
+		t.Run(string(codec), func(t *testing.T) {
+			compressed, err := compress(codec, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			handler := DecompressingHandler(CompressionConfig{Codec: codec, MaxSize: 1024})(MessageHandlerFunc(func(ctx context.Context, msg IncomingMessage) error {
+				t.Error("the message should not have been handled")
+				return nil
+			}))
+
+			err = handler.HandleMessage(context.Background(), testIncomingMessage{message{
+				name:     "message-name",
+				data:     compressed,
+				metadata: ddd.Metadata{CompressionCodecHdr: string(codec)},
+			}})
+
+			var errTooLarge ErrMessageTooLarge
+			if assert.True(t, errors.As(err, &errTooLarge)) {
+				assert.Equal(t, "message-name", errTooLarge.Name)
+				assert.Equal(t, 1024, errTooLarge.Limit)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package claimcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+)
+
+const (
+	ClaimCheckHdrPrefix = "CLAIM_CHECK_"
+	ClaimCheckKeyHdr    = ClaimCheckHdrPrefix + "KEY"
+	ClaimCheckSizeHdr   = ClaimCheckHdrPrefix + "SIZE"
+)
+
+type (
+	Config struct {
+		Store string `default:"postgres"`
+		// Conn is the database the postgres store keeps the blobs in; services
+		// that exchange claim checks must all use the same database, the
+		// service database is used when it is blank
+		Conn       string        `envconfig:"PG_CONN"`
+		Path       string        `default:"./claimchecks"`
+		TableName  string        `envconfig:"TABLE_NAME" default:"claim_checks"`
+		Threshold  int           `default:"524288"`
+		TTL        time.Duration `default:"168h"`
+		GCInterval time.Duration `envconfig:"GC_INTERVAL" default:"1h"`
+	}
+
+	BlobStore interface {
+		Put(ctx context.Context, key string, data []byte, expiresAt time.Time) error
+		Get(ctx context.Context, key string) ([]byte, error)
+		DeleteExpired(ctx context.Context, before time.Time) (int, error)
+	}
+
+	ErrBlobNotFound string
+
+	checkedMessage struct {
+		am.Message
+		data     []byte
+		metadata ddd.Metadata
+	}
+
+	claimedMessage struct {
+		am.IncomingMessage
+		data     []byte
+		metadata ddd.Metadata
+	}
+)
+
+func (e ErrBlobNotFound) Error() string {
+	return fmt.Sprintf("claim check blob not found: %s", string(e))
+}
+
+// Publisher swaps message data larger than the threshold for a reference to a stored blob
+//
+// It belongs after the compressing and encrypting middlewares so that blobs are
+// stored as they would have been sent; Handler is then placed before them
+func Publisher(store BlobStore, cfg Config) am.MessagePublisherMiddleware {
+	return func(next am.MessagePublisher) am.MessagePublisher {
+		return am.MessagePublisherFunc(func(ctx context.Context, topicName string, msg am.Message) error {
+			if len(msg.Data()) <= cfg.Threshold {
+				return next.Publish(ctx, topicName, msg)
+			}
+
+			key := uuid.New().String()
+			if err := store.Put(ctx, key, msg.Data(), time.Now().Add(cfg.TTL)); err != nil {
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata())+2)
+			for k, v := range msg.Metadata() {
+				metadata.Set(k, v)
+			}
+			metadata.Set(ClaimCheckKeyHdr, key)
+			metadata.Set(ClaimCheckSizeHdr, len(msg.Data()))
+
+			return next.Publish(ctx, topicName, checkedMessage{
+				Message:  msg,
+				data:     []byte{},
+				metadata: metadata,
+			})
+		})
+	}
+}
+
+// Handler fetches the blob referenced by a message and inlines it as the message data
+func Handler(store BlobStore) am.MessageHandlerMiddleware {
+	return func(next am.MessageHandler) am.MessageHandler {
+		return am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+			key, ok := msg.Metadata().Get(ClaimCheckKeyHdr).(string)
+			if !ok {
+				return next.HandleMessage(ctx, msg)
+			}
+
+			data, err := store.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata()))
+			for k, v := range msg.Metadata() {
+				metadata.Set(k, v)
+			}
+			metadata.Del(ClaimCheckKeyHdr)
+			metadata.Del(ClaimCheckSizeHdr)
+
+			return next.HandleMessage(ctx, claimedMessage{
+				IncomingMessage: msg,
+				data:            data,
+				metadata:        metadata,
+			})
+		})
+	}
+}
+
+func (m checkedMessage) Data() []byte           { return m.data }
+func (m checkedMessage) Metadata() ddd.Metadata { return m.metadata }
+
+func (m claimedMessage) Data() []byte           { return m.data }
+func (m claimedMessage) Metadata() ddd.Metadata { return m.metadata }
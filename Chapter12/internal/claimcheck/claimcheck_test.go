@@ -0,0 +1,186 @@
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+)
+
+type testMessage struct {
+	data     []byte
+	metadata ddd.Metadata
+}
+
+func (m testMessage) ID() string             { return "message-id" }
+func (m testMessage) Subject() string        { return "topic" }
+func (m testMessage) MessageName() string    { return "message-name" }
+func (m testMessage) Metadata() ddd.Metadata { return m.metadata }
+func (m testMessage) SentAt() time.Time      { return time.Now() }
+func (m testMessage) Data() []byte           { return m.data }
+func (m testMessage) ReceivedAt() time.Time  { return time.Now() }
+func (m testMessage) Ack() error             { return nil }
+func (m testMessage) NAck() error            { return nil }
+func (m testMessage) Extend() error          { return nil }
+func (m testMessage) Kill() error            { return nil }
+
+type testBlobStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func newTestBlobStore() *testBlobStore {
+	return &testBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *testBlobStore) Put(_ context.Context, key string, data []byte, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *testBlobStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, exists := s.blobs[key]
+	if !exists {
+		return nil, ErrBlobNotFound(key)
+	}
+	return data, nil
+}
+
+func (s *testBlobStore) DeleteExpired(context.Context, time.Time) (int, error) { return 0, nil }
+
+func TestClaimCheck_RoundTrip(t *testing.T) {
+	cfg := Config{Threshold: 16, TTL: time.Hour}
+
+	tests := map[string]struct {
+		data        []byte
+		wantChecked bool
+	}{
+		"BelowThreshold": {
+			data: bytes.Repeat([]byte("m"), 15),
+		},
+		"AtThreshold": {
+			data: bytes.Repeat([]byte("m"), 16),
+		},
+		"AboveThreshold": {
+			data:        bytes.Repeat([]byte("m"), 17),
+			wantChecked: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := newTestBlobStore()
+
+			var published am.Message
+			publisher := Publisher(store, cfg)(am.MessagePublisherFunc(func(ctx context.Context, topicName string, msg am.Message) error {
+				published = msg
+				return nil
+			}))
+
+			err := publisher.Publish(context.Background(), "topic", testMessage{
+				data:     tc.data,
+				metadata: ddd.Metadata{"header": "value"},
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			key, checked := published.Metadata().Get(ClaimCheckKeyHdr).(string)
+			assert.Equal(t, tc.wantChecked, checked)
+			assert.Equal(t, "value", published.Metadata().Get("header"))
+			if checked {
+				assert.Empty(t, published.Data())
+				assert.Equal(t, len(tc.data), published.Metadata().Get(ClaimCheckSizeHdr))
+				assert.Equal(t, tc.data, store.blobs[key])
+			} else {
+				assert.Equal(t, tc.data, published.Data())
+				assert.Empty(t, store.blobs)
+			}
+
+			var handled am.IncomingMessage
+			handler := Handler(store)(am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+				handled = msg
+				return nil
+			}))
+
+			err = handler.HandleMessage(context.Background(), testMessage{
+				data:     published.Data(),
+				metadata: published.Metadata(),
+			})
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.data, handled.Data())
+				assert.NotContains(t, handled.Metadata(), ClaimCheckKeyHdr)
+				assert.NotContains(t, handled.Metadata(), ClaimCheckSizeHdr)
+				assert.Equal(t, "value", handled.Metadata().Get("header"))
+			}
+		})
+	}
+}
+
+func TestClaimCheck_StoresCompressedData(t *testing.T) {
+	compression := am.CompressionConfig{Codec: am.CompressionGzip, Threshold: 64}
+	data := bytes.Repeat([]byte("mallbots"), 512)
+	store := newTestBlobStore()
+
+	var published am.Message
+	publisher := am.MessagePublisherWithMiddleware(
+		am.MessagePublisherFunc(func(ctx context.Context, topicName string, msg am.Message) error {
+			published = msg
+			return nil
+		}),
+		am.CompressingPublisher(compression),
+		Publisher(store, Config{Threshold: 16, TTL: time.Hour}),
+	)
+
+	err := publisher.Publish(context.Background(), "topic", testMessage{data: data, metadata: ddd.Metadata{}})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the blob is the compressed data and not the original
+	key, _ := published.Metadata().Get(ClaimCheckKeyHdr).(string)
+	if assert.Contains(t, store.blobs, key) {
+		assert.Less(t, len(store.blobs[key]), len(data))
+	}
+
+	var handled am.IncomingMessage
+	handler := am.MessageHandlerWithMiddleware(
+		am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+			handled = msg
+			return nil
+		}),
+		Handler(store),
+		am.DecompressingHandler(compression),
+	)
+
+	err = handler.HandleMessage(context.Background(), testMessage{data: published.Data(), metadata: published.Metadata()})
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, handled.Data())
+	}
+}
+
+func TestHandler_BlobNotFound(t *testing.T) {
+	handler := Handler(newTestBlobStore())(am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+		t.Error("the message should not have been handled")
+		return nil
+	}))
+
+	err := handler.HandleMessage(context.Background(), testMessage{
+		metadata: ddd.Metadata{ClaimCheckKeyHdr: "blob-key", ClaimCheckSizeHdr: 32},
+	})
+
+	var errNotFound ErrBlobNotFound
+	if assert.True(t, errors.As(err, &errNotFound)) {
+		assert.Equal(t, ErrBlobNotFound("blob-key"), errNotFound)
+	}
+}
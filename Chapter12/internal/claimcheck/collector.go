@@ -0,0 +1,44 @@
+package claimcheck
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Collector periodically removes the blobs that have expired
+type Collector struct {
+	store    BlobStore
+	interval time.Duration
+	logger   zerolog.Logger
+}
+
+func NewCollector(store BlobStore, interval time.Duration, logger zerolog.Logger) Collector {
+	return Collector{
+		store:    store,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (c Collector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			deleted, err := c.store.DeleteExpired(ctx, time.Now())
+			if err != nil {
+				c.logger.Error().Err(err).Msg("failed to delete expired claim check blobs")
+				continue
+			}
+			if deleted > 0 {
+				c.logger.Debug().Msgf("deleted %d expired claim check blobs", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package claimcheck
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+const abandonedWriteTTL = time.Hour
+
+// FileStore keeps blobs as files in a directory
+//
+// The modification time of each file is set to the expiry of the blob
+type FileStore struct {
+	path string
+}
+
+var _ BlobStore = (*FileStore)(nil)
+
+func NewFileStore(path string) (FileStore, error) {
+	if err := os.MkdirAll(path, 0o750); err != nil {
+		return FileStore{}, err
+	}
+
+	return FileStore{path: path}, nil
+}
+
+func (s FileStore) Put(_ context.Context, key string, data []byte, expiresAt time.Time) error {
+	filename := s.filename(key)
+
+	// write then rename so that readers never see a partial blob
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return err
+	}
+	if err := os.Chtimes(tmp, time.Now(), expiresAt); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
+
+func (s FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.filename(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrBlobNotFound(key)
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (s FileStore) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return deleted, ctx.Err()
+		}
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		expiresAt := info.ModTime()
+		if strings.HasSuffix(entry.Name(), ".tmp") {
+			// give interrupted writes plenty of time before removing them
+			expiresAt = expiresAt.Add(abandonedWriteTTL)
+		}
+		if expiresAt.After(before) {
+			continue
+		}
+		if err = os.Remove(filepath.Join(s.path, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (s FileStore) filename(key string) string {
+	return filepath.Join(s.path, filepath.Base(key))
+}
@@ -0,0 +1,91 @@
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore_PutGet(t *testing.T) {
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "claimchecks"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Put(context.Background(), "blob-key", []byte("blob"), time.Now().Add(time.Hour))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	data, err := store.Get(context.Background(), "blob-key")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("blob"), data)
+	}
+
+	// keys cannot reach outside of the store directory
+	data, err = store.Get(context.Background(), "../claimchecks/blob-key")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("blob"), data)
+	}
+
+	_, err = store.Get(context.Background(), "other-key")
+	var errNotFound ErrBlobNotFound
+	assert.True(t, errors.As(err, &errNotFound))
+}
+
+func TestFileStore_DeleteExpired(t *testing.T) {
+	path := t.TempDir()
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	for key, expiresAt := range map[string]time.Time{
+		"expired":  now.Add(-time.Minute),
+		"expiring": now,
+		"current":  now.Add(time.Minute),
+	} {
+		if err = store.Put(context.Background(), key, []byte(key), expiresAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// interrupted writes are removed once they have been left for long enough
+	for name, modifiedAt := range map[string]time.Time{
+		"abandoned.tmp": now.Add(-abandonedWriteTTL - time.Minute),
+		"writing.tmp":   now.Add(-time.Minute),
+	} {
+		filename := filepath.Join(path, name)
+		if err = os.WriteFile(filename, []byte(name), 0o640); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.Chtimes(filename, now, modifiedAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleted, err := store.DeleteExpired(context.Background(), now)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 3, deleted)
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remaining := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		remaining = append(remaining, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"current", "writing.tmp"}, remaining)
+
+	_, err = store.Get(context.Background(), "expired")
+	var errNotFound ErrBlobNotFound
+	assert.True(t, errors.As(err, &errNotFound))
+}
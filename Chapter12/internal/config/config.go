@@ -8,6 +8,9 @@ import (
 
 	"github.com/stackus/dotenv"
 
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/claimcheck"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/rpc"
 	"eda-in-golang/internal/web"
 )
@@ -18,14 +21,38 @@ type (
 	}
 
 	NatsConfig struct {
-		URL    string `required:"true"`
-		Stream string `default:"mallbots"`
+		URL         string `required:"true"`
+		Stream      string `default:"mallbots"`
+		Compression am.CompressionConfig
 	}
 
 	OtelConfig struct {
 		ServiceName      string `envconfig:"SERVICE_NAME" default:"mallbots"`
 		ExporterEndpoint string `envconfig:"EXPORTER_OTLP_ENDPOINT" default:"http://collector:4317"`
 	}
+	// TenantsConfig lists the malls hosted by the deployment; each module
+	// config may override some of its settings per tenant with a list of
+	// tenant:value pairs
+	TenantsConfig struct {
+		IDs []string `envconfig:"IDS" default:"mallbots"`
+	}
+	NotificationsConfig struct {
+		SmsURL            string            `envconfig:"SMS_URL"`
+		SmtpAddr          string            `envconfig:"SMTP_ADDR"`
+		EmailFrom         string            `envconfig:"EMAIL_FROM" default:"notifications@mallbots.local"`
+		TenantEmailFrom   map[string]string `envconfig:"TENANT_EMAIL_FROM"`
+		SinkFile          string            `envconfig:"SINK_FILE"`
+		MaxAttempts       int               `envconfig:"MAX_ATTEMPTS" default:"5"`
+		RetryInterval     time.Duration     `envconfig:"RETRY_INTERVAL" default:"1m"`
+		DeliveryInterval  time.Duration     `envconfig:"DELIVERY_INTERVAL" default:"5s"`
+		DeliveryBatchSize int               `envconfig:"DELIVERY_BATCH_SIZE" default:"50"`
+		ClaimTimeout      time.Duration     `envconfig:"CLAIM_TIMEOUT" default:"2m"`
+	}
+	PaymentsConfig struct {
+		AuthorizationLimit        float64            `envconfig:"AUTHORIZATION_LIMIT" default:"10000"`
+		TenantAuthorizationLimits map[string]float64 `envconfig:"TENANT_AUTHORIZATION_LIMITS"`
+	}
+
 	AppConfig struct {
 		Environment     string
 		LogLevel        string `envconfig:"LOG_LEVEL" default:"DEBUG"`
@@ -34,7 +61,13 @@ type (
 		Rpc             rpc.RpcConfig
 		Web             web.WebConfig
 		Otel            OtelConfig
+		Tenants         TenantsConfig
+		ClaimCheck      claimcheck.Config
+		Encryption      encryption.Config
+		Payments        PaymentsConfig
+		Notifications   NotificationsConfig
 		ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"30s"`
+		DrainTimeout    time.Duration `envconfig:"DRAIN_TIMEOUT" default:"15s"`
 	}
 )
 
@@ -47,3 +80,21 @@ func InitConfig() (cfg AppConfig, err error) {
 
 	return
 }
+
+// ForTenant returns the settings of the tenant
+func (c NotificationsConfig) ForTenant(tenantID string) NotificationsConfig {
+	if emailFrom, exists := c.TenantEmailFrom[tenantID]; exists {
+		c.EmailFrom = emailFrom
+	}
+
+	return c
+}
+
+// ForTenant returns the settings of the tenant
+func (c PaymentsConfig) ForTenant(tenantID string) PaymentsConfig {
+	if authorizationLimit, exists := c.TenantAuthorizationLimits[tenantID]; exists {
+		c.AuthorizationLimit = authorizationLimit
+	}
+
+	return c
+}
@@ -0,0 +1,134 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// envelopeMagic prefixes sealed data so that data written before encryption
+// was enabled can still be told apart and read
+var envelopeMagic = []byte("ENC1")
+
+type Cipher struct {
+	keys KeyProvider
+}
+
+func NewCipher(keys KeyProvider) Cipher {
+	return Cipher{keys: keys}
+}
+
+// Encrypt the plaintext with the current key
+func (c Cipher) Encrypt(ctx context.Context, plaintext []byte) (keyID string, ciphertext []byte, err error) {
+	key, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ciphertext, err = encrypt(key.Material, plaintext)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return key.ID, ciphertext, nil
+}
+
+// Decrypt the ciphertext with the identified key
+func (c Cipher) Decrypt(ctx context.Context, keyID string, ciphertext []byte) ([]byte, error) {
+	key, err := c.keys.Key(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(key.Material, ciphertext)
+}
+
+// Seal encrypts the plaintext and records the key used alongside the ciphertext
+func (c Cipher) Seal(ctx context.Context, plaintext []byte) ([]byte, error) {
+	keyID, ciphertext, err := c.Encrypt(ctx, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyID) > 255 {
+		return nil, fmt.Errorf("encryption key id is too long: %q", keyID)
+	}
+
+	sealed := make([]byte, 0, len(envelopeMagic)+1+len(keyID)+len(ciphertext))
+	sealed = append(sealed, envelopeMagic...)
+	sealed = append(sealed, byte(len(keyID)))
+	sealed = append(sealed, keyID...)
+	sealed = append(sealed, ciphertext...)
+
+	return sealed, nil
+}
+
+// Open reverses Seal; data that was never sealed is returned as-is
+func (c Cipher) Open(ctx context.Context, data []byte) ([]byte, error) {
+	if !IsSealed(data) {
+		return data, nil
+	}
+
+	data = data[len(envelopeMagic):]
+	if len(data) < 1 || len(data) < 1+int(data[0]) {
+		return nil, fmt.Errorf("sealed data is truncated")
+	}
+	keyID := string(data[1 : 1+int(data[0])])
+
+	return c.Decrypt(ctx, keyID, data[1+int(data[0]):])
+}
+
+func IsSealed(data []byte) bool {
+	return bytes.HasPrefix(data, envelopeMagic)
+}
+
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func newKeyMaterial() ([]byte, error) {
+	material := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, material); err != nil {
+		return nil, err
+	}
+
+	return material, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
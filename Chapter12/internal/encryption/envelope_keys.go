@@ -0,0 +1,244 @@
+package encryption
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/tenant"
+)
+
+type (
+	// EncryptedKey is a data key encrypted by a master key
+	EncryptedKey struct {
+		ID           string
+		MasterKeyID  string
+		EncryptedKey []byte
+		CreatedAt    time.Time
+	}
+
+	// KeyStore keeps the data keys of the tenant in the context
+	KeyStore interface {
+		Save(ctx context.Context, key EncryptedKey) error
+		Find(ctx context.Context, keyID string) (EncryptedKey, error)
+		FindLatest(ctx context.Context) (EncryptedKey, error)
+	}
+
+	// EnvelopeKeyProvider serves data keys that are kept encrypted in a KeyStore
+	//
+	// Only the master keys need to be kept outside the database; data keys are
+	// decrypted on first use and cached. Each tenant has data keys of its own.
+	EnvelopeKeyProvider struct {
+		store       KeyStore
+		masters     KeyProvider
+		rotateAfter time.Duration
+		mu          sync.RWMutex
+		// current holds the ID of the current key of each tenant
+		current map[string]string
+		keys    map[tenantKey]Key
+		// refreshMu lets a single caller load or rotate the current key
+		refreshMu sync.Mutex
+	}
+
+	tenantKey struct {
+		tenantID string
+		keyID    string
+	}
+)
+
+var _ KeyProvider = (*EnvelopeKeyProvider)(nil)
+
+// NewEnvelopeKeyProvider creates a provider that will rotate to a new data key
+// when the latest key is older than rotateAfter; zero disables rotation
+func NewEnvelopeKeyProvider(store KeyStore, masters KeyProvider, rotateAfter time.Duration) *EnvelopeKeyProvider {
+	return &EnvelopeKeyProvider{
+		store:       store,
+		masters:     masters,
+		rotateAfter: rotateAfter,
+		current:     make(map[string]string),
+		keys:        make(map[tenantKey]Key),
+	}
+}
+
+// Init loads the latest data key of the tenant, rotating it when it has
+// become too old
+func (p *EnvelopeKeyProvider) Init(ctx context.Context) error {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	return p.refresh(ctx)
+}
+
+// Rotate creates a new data key and uses it for all new data of the tenant
+func (p *EnvelopeKeyProvider) Rotate(ctx context.Context) error {
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	return p.rotate(ctx)
+}
+
+// CurrentKey returns the data key for new data of the tenant
+//
+// The first use loads the latest key and a key that has become too old is
+// replaced; the latest key is reloaded first in case another instance has
+// already rotated it
+func (p *EnvelopeKeyProvider) CurrentKey(ctx context.Context) (Key, error) {
+	tenantID := tenant.FromContext(ctx)
+
+	if key, ok := p.currentKey(tenantID); ok && !p.expired(key) {
+		return key, nil
+	}
+
+	p.refreshMu.Lock()
+	defer p.refreshMu.Unlock()
+
+	// another caller may have loaded or rotated the key while this one waited
+	if key, ok := p.currentKey(tenantID); ok && !p.expired(key) {
+		return key, nil
+	}
+
+	if err := p.refresh(ctx); err != nil {
+		return Key{}, err
+	}
+
+	key, _ := p.currentKey(tenantID)
+
+	return key, nil
+}
+
+func (p *EnvelopeKeyProvider) refresh(ctx context.Context) error {
+	latest, err := p.store.FindLatest(ctx)
+	if err != nil {
+		var errNotFound ErrKeyNotFound
+		if errors.As(err, &errNotFound) {
+			return p.rotate(ctx)
+		}
+		return err
+	}
+
+	if p.rotateAfter > 0 && time.Since(latest.CreatedAt) > p.rotateAfter {
+		return p.rotate(ctx)
+	}
+
+	key, err := p.unwrap(ctx, latest)
+	if err != nil {
+		return err
+	}
+
+	p.use(tenant.FromContext(ctx), key)
+
+	return nil
+}
+
+func (p *EnvelopeKeyProvider) rotate(ctx context.Context) error {
+	master, err := p.masters.CurrentKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	material, err := newKeyMaterial()
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encrypt(master.Material, material)
+	if err != nil {
+		return err
+	}
+
+	key := Key{
+		ID:        uuid.New().String(),
+		Material:  material,
+		CreatedAt: time.Now(),
+	}
+
+	if err = p.store.Save(ctx, EncryptedKey{
+		ID:           key.ID,
+		MasterKeyID:  master.ID,
+		EncryptedKey: encrypted,
+		CreatedAt:    key.CreatedAt,
+	}); err != nil {
+		return err
+	}
+
+	p.use(tenant.FromContext(ctx), key)
+
+	return nil
+}
+
+// use makes the key the current key of the tenant
+func (p *EnvelopeKeyProvider) use(tenantID string, key Key) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.keys[tenantKey{tenantID, key.ID}] = key
+	p.current[tenantID] = key.ID
+}
+
+func (p *EnvelopeKeyProvider) currentKey(tenantID string) (Key, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	keyID, exists := p.current[tenantID]
+	if !exists {
+		return Key{}, false
+	}
+	key, exists := p.keys[tenantKey{tenantID, keyID}]
+
+	return key, exists
+}
+
+func (p *EnvelopeKeyProvider) expired(key Key) bool {
+	return p.rotateAfter > 0 && time.Since(key.CreatedAt) > p.rotateAfter
+}
+
+// Key returns a data key of the tenant; the keys of other tenants are never
+// returned
+func (p *EnvelopeKeyProvider) Key(ctx context.Context, keyID string) (Key, error) {
+	cacheKey := tenantKey{tenant.FromContext(ctx), keyID}
+
+	p.mu.RLock()
+	key, exists := p.keys[cacheKey]
+	p.mu.RUnlock()
+
+	if exists {
+		return key, nil
+	}
+
+	encrypted, err := p.store.Find(ctx, keyID)
+	if err != nil {
+		return Key{}, err
+	}
+
+	key, err = p.unwrap(ctx, encrypted)
+	if err != nil {
+		return Key{}, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys[cacheKey] = key
+
+	return key, nil
+}
+
+func (p *EnvelopeKeyProvider) unwrap(ctx context.Context, encrypted EncryptedKey) (Key, error) {
+	master, err := p.masters.Key(ctx, encrypted.MasterKeyID)
+	if err != nil {
+		return Key{}, err
+	}
+
+	material, err := decrypt(master.Material, encrypted.EncryptedKey)
+	if err != nil {
+		return Key{}, err
+	}
+
+	return Key{
+		ID:        encrypted.ID,
+		Material:  material,
+		CreatedAt: encrypted.CreatedAt,
+	}, nil
+}
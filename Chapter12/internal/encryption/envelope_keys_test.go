@@ -0,0 +1,165 @@
+package encryption
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/tenant"
+)
+
+type testKeyStore struct {
+	mu      sync.Mutex
+	keys    []EncryptedKey
+	tenants []string
+}
+
+func (s *testKeyStore) Save(ctx context.Context, key EncryptedKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = append(s.keys, key)
+	s.tenants = append(s.tenants, tenant.FromContext(ctx))
+
+	return nil
+}
+
+func (s *testKeyStore) Find(ctx context.Context, keyID string) (EncryptedKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, key := range s.keys {
+		if key.ID == keyID && s.tenants[i] == tenant.FromContext(ctx) {
+			return key, nil
+		}
+	}
+
+	return EncryptedKey{}, ErrKeyNotFound(keyID)
+}
+
+func (s *testKeyStore) FindLatest(ctx context.Context) (EncryptedKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.keys) - 1; i >= 0; i-- {
+		if s.tenants[i] == tenant.FromContext(ctx) {
+			return s.keys[i], nil
+		}
+	}
+
+	return EncryptedKey{}, ErrKeyNotFound("latest")
+}
+
+func (s *testKeyStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.keys)
+}
+
+func testMasterKeys(t *testing.T) KeyProvider {
+	material, err := newKeyMaterial()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return StaticKeyProvider{
+		current: "master",
+		keys:    map[string]Key{"master": {ID: "master", Material: material}},
+	}
+}
+
+func TestEnvelopeKeyProvider_CurrentKey(t *testing.T) {
+	store := &testKeyStore{}
+	p := NewEnvelopeKeyProvider(store, testMasterKeys(t), time.Hour)
+
+	var wg sync.WaitGroup
+	keyIDs := make([]string, 10)
+	for i := range keyIDs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key, err := p.CurrentKey(context.Background())
+			if assert.NoError(t, err) {
+				keyIDs[i] = key.ID
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, store.count(), "concurrent first uses should create a single data key")
+	for _, keyID := range keyIDs {
+		assert.Equal(t, keyIDs[0], keyID)
+	}
+}
+
+func TestEnvelopeKeyProvider_Rotation(t *testing.T) {
+	masters := testMasterKeys(t)
+	store := &testKeyStore{}
+	p := NewEnvelopeKeyProvider(store, masters, time.Hour)
+
+	ctx := context.Background()
+
+	first, err := p.CurrentKey(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// age the key in use past the rotation period
+	cacheKey := tenantKey{tenant.DefaultID, first.ID}
+	p.mu.Lock()
+	aged := p.keys[cacheKey]
+	aged.CreatedAt = time.Now().Add(-2 * time.Hour)
+	p.keys[cacheKey] = aged
+	p.mu.Unlock()
+	store.keys[0].CreatedAt = aged.CreatedAt
+
+	second, err := p.CurrentKey(ctx)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, first.ID, second.ID)
+		assert.Equal(t, 2, store.count())
+	}
+
+	// data encrypted with the old key can still be decrypted
+	old, err := p.Key(ctx, first.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, first.Material, old.Material)
+	}
+
+	// another instance picks up the rotated key instead of creating its own
+	other := NewEnvelopeKeyProvider(store, masters, time.Hour)
+	key, err := other.CurrentKey(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, second.ID, key.ID)
+		assert.Equal(t, 2, store.count())
+	}
+}
+
+func TestEnvelopeKeyProvider_Tenants(t *testing.T) {
+	store := &testKeyStore{}
+	p := NewEnvelopeKeyProvider(store, testMasterKeys(t), time.Hour)
+
+	mallbots := tenant.WithID(context.Background(), tenant.DefaultID)
+	riverside := tenant.WithID(context.Background(), "riverside")
+
+	first, err := p.CurrentKey(mallbots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := p.CurrentKey(riverside)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NotEqual(t, first.ID, second.ID, "each tenant should be given a data key of its own")
+	assert.Equal(t, 2, store.count())
+
+	// the keys of one tenant cannot be used by another
+	_, err = p.Key(riverside, first.ID)
+	assert.ErrorAs(t, err, new(ErrKeyNotFound))
+	_, err = NewEnvelopeKeyProvider(store, p.masters, time.Hour).Key(riverside, first.ID)
+	assert.ErrorAs(t, err, new(ErrKeyNotFound))
+}
@@ -0,0 +1,43 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const KeySize = 32
+
+type (
+	Config struct {
+		Enabled  bool   `default:"false"`
+		Provider string `default:"static"`
+		KeyFile  string `envconfig:"KEY_FILE"`
+		// Conn is the database the envelope provider keeps the data keys in;
+		// services that exchange encrypted messages must all use the same
+		// database, the service database is used when it is blank
+		Conn        string        `envconfig:"PG_CONN"`
+		TableName   string        `envconfig:"TABLE_NAME" default:"encryption_keys"`
+		RotateAfter time.Duration `envconfig:"ROTATE_AFTER" default:"720h"`
+	}
+
+	Key struct {
+		ID        string
+		Material  []byte
+		CreatedAt time.Time
+	}
+
+	// KeyProvider supplies the key new data is encrypted with and any key
+	// that data was previously encrypted with; providers may keep different
+	// keys for each tenant
+	KeyProvider interface {
+		CurrentKey(ctx context.Context) (Key, error)
+		Key(ctx context.Context, keyID string) (Key, error)
+	}
+
+	ErrKeyNotFound string
+)
+
+func (e ErrKeyNotFound) Error() string {
+	return fmt.Sprintf("encryption key not found: %s", string(e))
+}
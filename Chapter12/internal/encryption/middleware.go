@@ -0,0 +1,101 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+)
+
+const EncryptionKeyIDHdr = "ENCRYPTION_KEY_ID"
+
+type (
+	encryptedMessage struct {
+		am.Message
+		data     []byte
+		metadata ddd.Metadata
+	}
+
+	decryptedMessage struct {
+		am.IncomingMessage
+		data     []byte
+		metadata ddd.Metadata
+	}
+)
+
+// EncryptingPublisher encrypts the message data and records the key ID in the metadata
+//
+// A nil KeyProvider leaves messages unencrypted
+func EncryptingPublisher(keys KeyProvider) am.MessagePublisherMiddleware {
+	return func(next am.MessagePublisher) am.MessagePublisher {
+		if keys == nil {
+			return next
+		}
+
+		c := NewCipher(keys)
+
+		return am.MessagePublisherFunc(func(ctx context.Context, topicName string, msg am.Message) error {
+			keyID, data, err := c.Encrypt(ctx, msg.Data())
+			if err != nil {
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata())+1)
+			for k, v := range msg.Metadata() {
+				metadata.Set(k, v)
+			}
+			metadata.Set(EncryptionKeyIDHdr, keyID)
+
+			return next.Publish(ctx, topicName, encryptedMessage{
+				Message:  msg,
+				data:     data,
+				metadata: metadata,
+			})
+		})
+	}
+}
+
+// DecryptingHandler decrypts the data of messages that carry a key ID
+func DecryptingHandler(keys KeyProvider) am.MessageHandlerMiddleware {
+	return func(next am.MessageHandler) am.MessageHandler {
+		var c Cipher
+		if keys != nil {
+			c = NewCipher(keys)
+		}
+
+		return am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+			keyID, ok := msg.Metadata().Get(EncryptionKeyIDHdr).(string)
+			if !ok {
+				return next.HandleMessage(ctx, msg)
+			}
+
+			if keys == nil {
+				return fmt.Errorf("message `%s` is encrypted but encryption has not been configured", msg.MessageName())
+			}
+
+			data, err := c.Decrypt(ctx, keyID, msg.Data())
+			if err != nil {
+				return err
+			}
+
+			metadata := make(ddd.Metadata, len(msg.Metadata()))
+			for k, v := range msg.Metadata() {
+				metadata.Set(k, v)
+			}
+			metadata.Del(EncryptionKeyIDHdr)
+
+			return next.HandleMessage(ctx, decryptedMessage{
+				IncomingMessage: msg,
+				data:            data,
+				metadata:        metadata,
+			})
+		})
+	}
+}
+
+func (m encryptedMessage) Data() []byte           { return m.data }
+func (m encryptedMessage) Metadata() ddd.Metadata { return m.metadata }
+
+func (m decryptedMessage) Data() []byte           { return m.data }
+func (m decryptedMessage) Metadata() ddd.Metadata { return m.metadata }
@@ -0,0 +1,189 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+const (
+	// RedactedValue replaces personal data that can no longer be decrypted
+	RedactedValue = "[redacted]"
+
+	piiTag    = "pii"
+	piiPrefix = "pii:"
+)
+
+type (
+	// KeyVault holds one key per data subject
+	//
+	// Destroying the key of a subject makes all personal data encrypted with
+	// it unreadable; this is how a subject is forgotten without rewriting the
+	// events that mention them
+	KeyVault interface {
+		// Create returns the key for the subject, creating it when it does not exist
+		Create(ctx context.Context, subjectID string) (Key, error)
+		Find(ctx context.Context, subjectID string) (Key, error)
+		Destroy(ctx context.Context, subjectID string) error
+	}
+
+	// DataSubject is implemented by values holding personal data of a subject
+	// other than the aggregate they belong to
+	DataSubject interface {
+		DataSubjectID() string
+	}
+
+	// PIIProtector encrypts the string fields tagged `pii:"true"` with the
+	// key of the data subject
+	PIIProtector struct {
+		vault KeyVault
+	}
+
+	ErrSubjectForgotten string
+)
+
+func (e ErrSubjectForgotten) Error() string {
+	return fmt.Sprintf("the data subject has been forgotten: %s", string(e))
+}
+
+func NewPIIProtector(vault KeyVault) PIIProtector {
+	return PIIProtector{vault: vault}
+}
+
+// Protect returns a copy of v with the personal data encrypted
+//
+// Values without personal data are returned as-is
+func (p PIIProtector) Protect(ctx context.Context, subjectID string, v any) (any, error) {
+	fields := piiFields(v)
+	if len(fields) == 0 {
+		return v, nil
+	}
+
+	src := reflect.ValueOf(v).Elem()
+	dst := reflect.New(src.Type())
+	dst.Elem().Set(src)
+
+	var key *Key
+	for _, i := range fields {
+		field := dst.Elem().Field(i)
+		if field.String() == "" || field.String() == RedactedValue {
+			continue
+		}
+
+		if key == nil {
+			k, err := p.vault.Create(ctx, subjectIDOf(v, subjectID))
+			if err != nil {
+				return nil, err
+			}
+			key = &k
+		}
+
+		ciphertext, err := encrypt(key.Material, []byte(field.String()))
+		if err != nil {
+			return nil, err
+		}
+		field.SetString(piiPrefix + base64.StdEncoding.EncodeToString(ciphertext))
+	}
+
+	return dst.Interface(), nil
+}
+
+// Reveal decrypts the personal data in v in place
+//
+// The personal data of forgotten subjects is replaced with RedactedValue
+func (p PIIProtector) Reveal(ctx context.Context, subjectID string, v any) error {
+	fields := piiFields(v)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+
+	var key *Key
+	var forgotten bool
+	for _, i := range fields {
+		field := rv.Field(i)
+		if !strings.HasPrefix(field.String(), piiPrefix) {
+			// data written before the field was marked as personal data
+			continue
+		}
+
+		if key == nil && !forgotten {
+			k, err := p.vault.Find(ctx, subjectIDOf(v, subjectID))
+			if err != nil {
+				var errForgotten ErrSubjectForgotten
+				if !errors.As(err, &errForgotten) {
+					return err
+				}
+				forgotten = true
+			} else {
+				key = &k
+			}
+		}
+
+		if forgotten {
+			field.SetString(RedactedValue)
+			continue
+		}
+
+		ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(field.String(), piiPrefix))
+		if err != nil {
+			return err
+		}
+		plaintext, err := decrypt(key.Material, ciphertext)
+		if err != nil {
+			return err
+		}
+		field.SetString(string(plaintext))
+	}
+
+	return nil
+}
+
+// NewSubjectKey creates the key material for a new data subject
+func NewSubjectKey(subjectID string) (Key, error) {
+	material, err := newKeyMaterial()
+	if err != nil {
+		return Key{}, err
+	}
+
+	return Key{
+		ID:        subjectID,
+		Material:  material,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func subjectIDOf(v any, subjectID string) string {
+	if subject, ok := v.(DataSubject); ok {
+		return subject.DataSubjectID()
+	}
+	return subjectID
+}
+
+// piiFields returns the index of each personal data field when v is a pointer to a struct
+func piiFields(v any) []int {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	if reflect.ValueOf(v).IsNil() {
+		return nil
+	}
+	t = t.Elem()
+
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && field.Type.Kind() == reflect.String && field.Tag.Get(piiTag) == "true" {
+			fields = append(fields, i)
+		}
+	}
+
+	return fields
+}
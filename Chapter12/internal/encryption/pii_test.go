@@ -0,0 +1,235 @@
+package encryption
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPersonalData struct {
+	Name    string `pii:"true"`
+	Email   string `pii:"true"`
+	Comment string
+}
+
+type testOtherSubject struct {
+	SubjectID string
+	Name      string `pii:"true"`
+}
+
+func (d testOtherSubject) DataSubjectID() string { return d.SubjectID }
+
+type testKeyVault struct {
+	mu        sync.Mutex
+	keys      map[string]Key
+	destroyed map[string]bool
+}
+
+func newTestKeyVault() *testKeyVault {
+	return &testKeyVault{
+		keys:      make(map[string]Key),
+		destroyed: make(map[string]bool),
+	}
+}
+
+func (v *testKeyVault) Create(_ context.Context, subjectID string) (Key, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.destroyed[subjectID] {
+		return Key{}, ErrSubjectForgotten(subjectID)
+	}
+	if key, exists := v.keys[subjectID]; exists {
+		return key, nil
+	}
+
+	key, err := NewSubjectKey(subjectID)
+	if err != nil {
+		return Key{}, err
+	}
+	v.keys[subjectID] = key
+
+	return key, nil
+}
+
+func (v *testKeyVault) Find(_ context.Context, subjectID string) (Key, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.destroyed[subjectID] {
+		return Key{}, ErrSubjectForgotten(subjectID)
+	}
+	if key, exists := v.keys[subjectID]; exists {
+		return key, nil
+	}
+
+	return Key{}, ErrKeyNotFound(subjectID)
+}
+
+func (v *testKeyVault) Destroy(_ context.Context, subjectID string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	delete(v.keys, subjectID)
+	v.destroyed[subjectID] = true
+
+	return nil
+}
+
+func TestPIIProtector_ProtectReveal(t *testing.T) {
+	vault := newTestKeyVault()
+	protector := NewPIIProtector(vault)
+	original := &testPersonalData{Name: "name", Email: "name@example.com", Comment: "comment"}
+
+	v, err := protector.Protect(context.Background(), "subject-id", original)
+	if !assert.NoError(t, err) {
+		return
+	}
+	protected := v.(*testPersonalData)
+
+	// the original is left as it was
+	assert.Equal(t, &testPersonalData{Name: "name", Email: "name@example.com", Comment: "comment"}, original)
+
+	assert.True(t, strings.HasPrefix(protected.Name, piiPrefix))
+	assert.True(t, strings.HasPrefix(protected.Email, piiPrefix))
+	assert.NotContains(t, protected.Name, "name")
+	assert.Equal(t, "comment", protected.Comment)
+	assert.Contains(t, vault.keys, "subject-id")
+
+	if assert.NoError(t, protector.Reveal(context.Background(), "subject-id", protected)) {
+		assert.Equal(t, original, protected)
+	}
+}
+
+func TestPIIProtector_Protect(t *testing.T) {
+	tests := map[string]struct {
+		v       any
+		want    any
+		wantKey bool
+	}{
+		"NoPersonalData": {
+			v:    &struct{ Name string }{Name: "name"},
+			want: &struct{ Name string }{Name: "name"},
+		},
+		"NotAPointer": {
+			v:    testPersonalData{Name: "name"},
+			want: testPersonalData{Name: "name"},
+		},
+		"NilPointer": {
+			v:    (*testPersonalData)(nil),
+			want: (*testPersonalData)(nil),
+		},
+		"EmptyAndRedacted": {
+			v:    &testPersonalData{Email: RedactedValue},
+			want: &testPersonalData{Email: RedactedValue},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vault := newTestKeyVault()
+
+			got, err := NewPIIProtector(vault).Protect(context.Background(), "subject-id", tc.v)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.want, got)
+				// keys are only created for subjects with personal data to protect
+				assert.Empty(t, vault.keys)
+			}
+		})
+	}
+}
+
+func TestPIIProtector_DataSubject(t *testing.T) {
+	vault := newTestKeyVault()
+	protector := NewPIIProtector(vault)
+
+	v, err := protector.Protect(context.Background(), "aggregate-id", &testOtherSubject{SubjectID: "subject-id", Name: "name"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Contains(t, vault.keys, "subject-id")
+	assert.NotContains(t, vault.keys, "aggregate-id")
+
+	// forgetting the aggregate leaves the personal data of the other subject readable
+	if err = vault.Destroy(context.Background(), "aggregate-id"); err != nil {
+		t.Fatal(err)
+	}
+	protected := v.(*testOtherSubject)
+	if assert.NoError(t, protector.Reveal(context.Background(), "aggregate-id", protected)) {
+		assert.Equal(t, "name", protected.Name)
+	}
+}
+
+func TestPIIProtector_Reveal(t *testing.T) {
+	tests := map[string]struct {
+		destroy bool
+		v       func(t *testing.T, protector PIIProtector) *testPersonalData
+		want    *testPersonalData
+	}{
+		"Forgotten": {
+			destroy: true,
+			v:       protectedData(&testPersonalData{Name: "name", Email: "name@example.com", Comment: "comment"}),
+			want:    &testPersonalData{Name: RedactedValue, Email: RedactedValue, Comment: "comment"},
+		},
+		"ForgottenEmptyField": {
+			destroy: true,
+			v:       protectedData(&testPersonalData{Name: "name"}),
+			want:    &testPersonalData{Name: RedactedValue},
+		},
+		// data written before the fields were marked as personal data
+		"Plaintext": {
+			v: func(*testing.T, PIIProtector) *testPersonalData {
+				return &testPersonalData{Name: "name", Email: "name@example.com"}
+			},
+			want: &testPersonalData{Name: "name", Email: "name@example.com"},
+		},
+		"ForgottenPlaintext": {
+			destroy: true,
+			v: func(*testing.T, PIIProtector) *testPersonalData {
+				return &testPersonalData{Name: "name"}
+			},
+			want: &testPersonalData{Name: "name"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vault := newTestKeyVault()
+			protector := NewPIIProtector(vault)
+			v := tc.v(t, protector)
+
+			if tc.destroy {
+				if err := vault.Destroy(context.Background(), "subject-id"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if assert.NoError(t, protector.Reveal(context.Background(), "subject-id", v)) {
+				assert.Equal(t, tc.want, v)
+			}
+		})
+	}
+}
+
+func TestPIIProtector_Reveal_KeyNotFound(t *testing.T) {
+	protector := NewPIIProtector(newTestKeyVault())
+	v := protectedData(&testPersonalData{Name: "name"})(t, protector)
+
+	// only a destroyed key redacts the data; a missing key is an error
+	err := NewPIIProtector(newTestKeyVault()).Reveal(context.Background(), "subject-id", v)
+	assert.ErrorIs(t, err, ErrKeyNotFound("subject-id"))
+}
+
+func protectedData(data *testPersonalData) func(t *testing.T, protector PIIProtector) *testPersonalData {
+	return func(t *testing.T, protector PIIProtector) *testPersonalData {
+		t.Helper()
+
+		v, err := protector.Protect(context.Background(), "subject-id", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return v.(*testPersonalData)
+	}
+}
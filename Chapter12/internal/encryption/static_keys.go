@@ -0,0 +1,82 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// StaticKeyProvider serves keys read from a JSON key file
+//
+// The file lists every key by ID and names the one used for new data:
+//
+//	{
+//	  "current": "2022-10",
+//	  "keys": {
+//	    "2022-09": "<base64 encoded 32 byte key>",
+//	    "2022-10": "<base64 encoded 32 byte key>"
+//	  }
+//	}
+//
+// Rotate keys by adding a new key and making it current; older keys must
+// remain in the file for as long as data encrypted with them exists.
+type StaticKeyProvider struct {
+	current string
+	keys    map[string]Key
+}
+
+var _ KeyProvider = (*StaticKeyProvider)(nil)
+
+type keyFile struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+func NewStaticKeyProvider(filename string) (StaticKeyProvider, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return StaticKeyProvider{}, err
+	}
+
+	var kf keyFile
+	if err = json.Unmarshal(data, &kf); err != nil {
+		return StaticKeyProvider{}, err
+	}
+
+	p := StaticKeyProvider{
+		current: kf.Current,
+		keys:    make(map[string]Key, len(kf.Keys)),
+	}
+
+	for id, encoded := range kf.Keys {
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return StaticKeyProvider{}, fmt.Errorf("decoding key %q: %w", id, err)
+		}
+		if len(material) != KeySize {
+			return StaticKeyProvider{}, fmt.Errorf("key %q must be %d bytes", id, KeySize)
+		}
+		p.keys[id] = Key{ID: id, Material: material}
+	}
+
+	if _, exists := p.keys[p.current]; !exists {
+		return StaticKeyProvider{}, ErrKeyNotFound(p.current)
+	}
+
+	return p, nil
+}
+
+func (p StaticKeyProvider) CurrentKey(ctx context.Context) (Key, error) {
+	return p.Key(ctx, p.current)
+}
+
+func (p StaticKeyProvider) Key(_ context.Context, keyID string) (Key, error) {
+	key, exists := p.keys[keyID]
+	if !exists {
+		return Key{}, ErrKeyNotFound(keyID)
+	}
+
+	return key, nil
+}
@@ -2,6 +2,9 @@ package jetstream
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,26 +15,86 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/tenant"
 )
 
 const maxRetries = 5
 
+const drainPollingInterval = 50 * time.Millisecond
+
+// tenantSubjectPrefix starts the subjects of every tenant but the default one
+const tenantSubjectPrefix = "tenants"
+
 type Stream struct {
 	streamName string
 	js         nats.JetStreamContext
+	tenants    tenant.Tenants
 	mu         sync.Mutex
 	subs       []*nats.Subscription
+	inflight   sync.WaitGroup
+	// inflightMu guards closed so that no handler is added to inflight once
+	// Drain has started waiting on it
+	inflightMu sync.Mutex
+	closed     bool
+	ctx        context.Context
+	cancel     context.CancelFunc
 	logger     zerolog.Logger
 }
 
 var _ am.MessageStream = (*Stream)(nil)
 
-func NewStream(streamName string, js nats.JetStreamContext, logger zerolog.Logger) *Stream {
-	return &Stream{
+// StreamSubjects are the subjects a stream holds
+//
+// The default tenant keeps the subjects used before there were tenants so the
+// messages and consumers of existing streams carry on as they were; the
+// subjects of the other tenants are added alongside them
+func StreamSubjects(streamName string) []string {
+	return []string{
+		fmt.Sprintf("%s.>", streamName),
+		fmt.Sprintf("%s.*.%s.>", tenantSubjectPrefix, streamName),
+	}
+}
+
+// TenantSubject is the subject the messages of the tenant are published to
+func TenantSubject(tenantID, topicName string) string {
+	if tenantID == tenant.DefaultID {
+		return topicName
+	}
+
+	return tenantSubjectPrefix + "." + tenantID + "." + topicName
+}
+
+// TopicName returns the topic of a subject returned by TenantSubject
+func TopicName(subject string) string {
+	if parts := strings.SplitN(subject, ".", 3); len(parts) == 3 && parts[0] == tenantSubjectPrefix {
+		return parts[2]
+	}
+
+	return subject
+}
+
+// consumerName is the durable consumer of the group for the tenant; the
+// default tenant keeps the consumers created before there were tenants
+func consumerName(groupName, tenantID string) string {
+	if tenantID == tenant.DefaultID {
+		return groupName
+	}
+
+	return groupName + "_" + tenantID
+}
+
+// NewStream returns a stream that consumes the messages of the tenants given;
+// the messages of tenants hosted elsewhere are left for their own consumers
+func NewStream(streamName string, js nats.JetStreamContext, tenants tenant.Tenants, logger zerolog.Logger) *Stream {
+	s := &Stream{
 		streamName: streamName,
 		js:         js,
+		tenants:    tenants,
 		logger:     logger,
 	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	return s
 }
 
 func (s *Stream) Publish(ctx context.Context, topicName string, rawMsg am.Message) (err error) {
@@ -55,7 +118,7 @@ func (s *Stream) Publish(ctx context.Context, topicName string, rawMsg am.Messag
 
 	var p nats.PubAckFuture
 	p, err = s.js.PublishMsgAsync(&nats.Msg{
-		Subject: rawMsg.Subject(),
+		Subject: TenantSubject(tenant.MetadataID(rawMsg.Metadata()), rawMsg.Subject()),
 		Data:    data,
 	}, nats.MsgId(rawMsg.ID()))
 	if err != nil {
@@ -91,22 +154,37 @@ func (s *Stream) Publish(ctx context.Context, topicName string, rawMsg am.Messag
 }
 
 func (s *Stream) Subscribe(topicName string, handler am.MessageHandler, options ...am.SubscriberOption) (am.Subscription, error) {
-	var err error
-
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	subCfg := am.NewSubscriberConfig(options)
 
+	// each hosted tenant is given a consumer of its own so that deployments
+	// hosting other tenants never see, or acknowledge, each other's messages
+	subs := make(subscription, 0, len(s.tenants.IDs()))
+	for _, tenantID := range s.tenants.IDs() {
+		sub, err := s.subscribe(TenantSubject(tenantID, topicName), consumerName(subCfg.GroupName(), tenantID), subCfg, handler)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
+
+func (s *Stream) subscribe(subject, groupName string, subCfg am.SubscriberConfig, handler am.MessageHandler) (*nats.Subscription, error) {
+	var err error
+
 	opts := []nats.SubOpt{
 		nats.MaxDeliver(subCfg.MaxRedeliver()),
 	}
 	cfg := &nats.ConsumerConfig{
 		MaxDeliver:     subCfg.MaxRedeliver(),
-		DeliverSubject: topicName,
-		FilterSubject:  topicName,
+		DeliverSubject: subject,
+		FilterSubject:  subject,
 	}
-	if groupName := subCfg.GroupName(); groupName != "" {
+	if subCfg.GroupName() != "" {
 		cfg.DeliverSubject = groupName
 		cfg.DeliverGroup = groupName
 		cfg.Durable = groupName
@@ -126,25 +204,49 @@ func (s *Stream) Subscribe(topicName string, handler am.MessageHandler, options
 		opts = append(opts, nats.AckNone())
 	}
 
-	_, err = s.js.AddConsumer(s.streamName, cfg)
-	if err != nil {
+	if err = s.addConsumer(cfg); err != nil {
 		return nil, err
 	}
 
 	var sub *nats.Subscription
 
-	if groupName := subCfg.GroupName(); groupName == "" {
-		sub, err = s.js.Subscribe(topicName, s.handleMsg(subCfg, handler), opts...)
+	if subCfg.GroupName() == "" {
+		sub, err = s.js.Subscribe(subject, s.handleMsg(subCfg, handler), opts...)
 	} else {
-		sub, err = s.js.QueueSubscribe(topicName, groupName, s.handleMsg(subCfg, handler), opts...)
+		sub, err = s.js.QueueSubscribe(subject, groupName, s.handleMsg(subCfg, handler), opts...)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	s.subs = append(s.subs, sub)
 
-	return subscription{sub}, nil
+	return sub, nil
+}
+
+// addConsumer creates the consumer; a durable consumer that already exists is
+// updated instead so changes to its configuration are picked up
+func (s *Stream) addConsumer(cfg *nats.ConsumerConfig) error {
+	if cfg.Durable == "" {
+		_, err := s.js.AddConsumer(s.streamName, cfg)
+		return err
+	}
+
+	_, err := s.js.ConsumerInfo(s.streamName, cfg.Durable)
+	switch {
+	case errors.Is(err, nats.ErrConsumerNotFound):
+		_, err = s.js.AddConsumer(s.streamName, cfg)
+	case err == nil:
+		_, err = s.js.UpdateConsumer(s.streamName, cfg)
+	}
+
+	return err
 }
 
 func (s *Stream) Unsubscribe() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	for _, sub := range s.subs {
 		if !sub.IsValid() {
 			continue
@@ -157,6 +259,82 @@ func (s *Stream) Unsubscribe() error {
 	return nil
 }
 
+// Drain stops consuming new messages and waits for the in-flight handlers to finish
+//
+// Handlers that are still running when the context is done have their contexts
+// canceled and an error is returned
+func (s *Stream) Drain(ctx context.Context) error {
+	if err := s.Unsubscribe(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.waitForSubscriptions(ctx)
+		s.close()
+		s.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		return fmt.Errorf("message handlers failed to finish draining: %w", ctx.Err())
+	}
+}
+
+// waitForSubscriptions waits until every draining subscription has delivered its pending messages
+func (s *Stream) waitForSubscriptions(ctx context.Context) {
+	ticker := time.NewTicker(drainPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		s.mu.Lock()
+		draining := false
+		for _, sub := range s.subs {
+			if sub.IsValid() {
+				draining = true
+				break
+			}
+		}
+		s.mu.Unlock()
+
+		if !draining {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// track adds a handler to the in-flight handlers; false is returned once the
+// stream is closed and no more handlers may be started
+func (s *Stream) track() bool {
+	s.inflightMu.Lock()
+	defer s.inflightMu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	s.inflight.Add(1)
+
+	return true
+}
+
+func (s *Stream) close() {
+	s.inflightMu.Lock()
+	defer s.inflightMu.Unlock()
+
+	s.closed = true
+}
+
 func (s *Stream) handleMsg(cfg am.SubscriberConfig, handler am.MessageHandler) func(*nats.Msg) {
 	var filters map[string]struct{}
 	if len(cfg.MessageFilters()) > 0 {
@@ -169,6 +347,17 @@ func (s *Stream) handleMsg(cfg am.SubscriberConfig, handler am.MessageHandler) f
 	return func(natsMsg *nats.Msg) {
 		var err error
 
+		if !s.track() {
+			// leave the message for the next consumer to handle
+			if cfg.AckType() != am.AckTypeAuto {
+				if err = natsMsg.Nak(); err != nil {
+					s.logger.Warn().Err(err).Msg("failed to Nack a message received while closing")
+				}
+			}
+			return
+		}
+		defer s.inflight.Done()
+
 		m := &StreamMessage{}
 		err = proto.Unmarshal(natsMsg.Data, m)
 		if err != nil {
@@ -189,7 +378,7 @@ func (s *Stream) handleMsg(cfg am.SubscriberConfig, handler am.MessageHandler) f
 		msg := &rawMessage{
 			id:         m.GetId(),
 			name:       m.GetName(),
-			subject:    natsMsg.Subject,
+			subject:    TopicName(natsMsg.Subject),
 			data:       m.GetData(),
 			metadata:   m.GetMetadata().AsMap(),
 			sentAt:     m.SentAt.AsTime(),
@@ -201,11 +390,14 @@ func (s *Stream) handleMsg(cfg am.SubscriberConfig, handler am.MessageHandler) f
 			killFn:     func() error { return natsMsg.Term() },
 		}
 
-		wCtx, cancel := context.WithTimeout(context.Background(), cfg.AckWait())
+		wCtx, cancel := context.WithTimeout(s.ctx, cfg.AckWait())
 		defer cancel()
 
-		errc := make(chan error)
+		// the buffered channel lets an abandoned handler exit once it finishes
+		errc := make(chan error, 1)
+		s.inflight.Add(1) // the callback is still tracked so this cannot race with Drain
 		go func() {
+			defer s.inflight.Done()
 			errc <- handler.HandleMessage(wCtx, msg)
 		}()
 
@@ -229,7 +421,7 @@ func (s *Stream) handleMsg(cfg am.SubscriberConfig, handler am.MessageHandler) f
 				s.logger.Warn().Err(err).Msg("failed to Nack a message")
 			}
 		case <-wCtx.Done():
-			// TODO logging?
+			s.logger.Warn().Err(wCtx.Err()).Str("message", msg.MessageName()).Msg("message handling abandoned")
 			return
 		}
 	}
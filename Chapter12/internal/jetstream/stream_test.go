@@ -0,0 +1,132 @@
+package jetstream
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/tenant"
+)
+
+func TestStream_Drain(t *testing.T) {
+	tests := map[string]struct {
+		timeout  time.Duration
+		finishIn time.Duration
+		wantErr  bool
+	}{
+		"HandlerFinishes": {
+			timeout:  time.Second,
+			finishIn: 20 * time.Millisecond,
+		},
+		"HandlerOutlastsTimeout": {
+			timeout:  20 * time.Millisecond,
+			finishIn: 200 * time.Millisecond,
+			wantErr:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := NewStream("test", nil, tenant.New(tenant.DefaultID), zerolog.Nop())
+
+			if !s.track() {
+				t.Fatal("expected the handler to be tracked")
+			}
+			go func() {
+				time.Sleep(tc.finishIn)
+				s.inflight.Done()
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			err := s.Drain(ctx)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.False(t, s.track(), "no handlers may start once draining has begun")
+		})
+	}
+}
+
+func TestStream_handleMsgAfterDrain(t *testing.T) {
+	s := NewStream("test", nil, tenant.New(tenant.DefaultID), zerolog.Nop())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := s.Drain(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var handled int32
+	handler := am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+		atomic.AddInt32(&handled, 1)
+		return nil
+	})
+
+	data, err := proto.Marshal(&StreamMessage{
+		Id:     "message-id",
+		Name:   "message-name",
+		SentAt: timestamppb.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.handleMsg(am.NewSubscriberConfig(nil), handler)(&nats.Msg{Subject: "tenants.tenant.topic", Data: data})
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&handled))
+
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the refused message was left in flight")
+	}
+}
+
+func TestTenantSubject(t *testing.T) {
+	tests := map[string]struct {
+		tenantID     string
+		wantSubject  string
+		wantConsumer string
+	}{
+		"Default": {
+			tenantID:     tenant.DefaultID,
+			wantSubject:  "mallbots.customers.events.Customer",
+			wantConsumer: "search-customers",
+		},
+		"Other": {
+			tenantID:     "riverside",
+			wantSubject:  "tenants.riverside.mallbots.customers.events.Customer",
+			wantConsumer: "search-customers_riverside",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			subject := TenantSubject(tc.tenantID, "mallbots.customers.events.Customer")
+			assert.Equal(t, tc.wantSubject, subject)
+			assert.Equal(t, "mallbots.customers.events.Customer", TopicName(subject))
+			assert.Equal(t, tc.wantConsumer, consumerName("search-customers", tc.tenantID))
+		})
+	}
+}
+
+func TestStreamSubjects(t *testing.T) {
+	// the subjects of the default tenant are the ones streams held before
+	// there were tenants
+	assert.Equal(t, []string{"mallbots.>", "tenants.*.mallbots.>"}, StreamSubjects("mallbots"))
+}
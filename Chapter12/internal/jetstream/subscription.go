@@ -4,14 +4,18 @@ import (
 	"github.com/nats-io/nats.go"
 )
 
-type subscription struct {
-	s *nats.Subscription
-}
+// subscription holds the subscriptions made for each hosted tenant
+type subscription []*nats.Subscription
 
 func (s subscription) Unsubscribe() error {
-	if !s.s.IsValid() {
-		return nil
+	for _, sub := range s {
+		if !sub.IsValid() {
+			continue
+		}
+		if err := sub.Drain(); err != nil {
+			return err
+		}
 	}
 
-	return s.s.Drain()
+	return nil
 }
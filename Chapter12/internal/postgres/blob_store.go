@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/claimcheck"
+	"eda-in-golang/internal/tenant"
+)
+
+// BlobStore keeps claim check blobs as Postgres large objects
+type BlobStore struct {
+	tableName string
+	db        DB
+}
+
+var _ claimcheck.BlobStore = (*BlobStore)(nil)
+
+func NewBlobStore(tableName string, db DB) BlobStore {
+	return BlobStore{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (s BlobStore) Put(ctx context.Context, key string, data []byte, expiresAt time.Time) error {
+	const query = "INSERT INTO %s (key, blob_oid, expires_at, tenant_id) VALUES ($1, lo_from_bytea(0, $2), $3, $4)"
+
+	_, err := s.db.ExecContext(ctx, s.table(query), key, data, expiresAt, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (s BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	const query = "SELECT lo_get(blob_oid) FROM %s WHERE key = $1 AND tenant_id = $2"
+
+	var data []byte
+
+	err := s.db.QueryRowContext(ctx, s.table(query), key, tenant.FromContext(ctx)).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, claimcheck.ErrBlobNotFound(key)
+		}
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// DeleteExpired removes the expired blobs of every tenant
+func (s BlobStore) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	const query = `WITH expired AS (DELETE FROM %s WHERE expires_at < $1 RETURNING blob_oid)
+SELECT COUNT(lo_unlink(blob_oid)) FROM expired`
+
+	var deleted int
+
+	err := s.db.QueryRowContext(ctx, s.table(query), before).Scan(&deleted)
+
+	return deleted, err
+}
+
+func (s BlobStore) table(query string) string {
+	return fmt.Sprintf(query, s.tableName)
+}
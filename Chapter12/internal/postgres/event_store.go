@@ -12,6 +12,7 @@ import (
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/tenant"
 )
 
 type (
@@ -19,6 +20,7 @@ type (
 		tableName string
 		db        DB
 		registry  registry.Registry
+		cfg       storeCfg
 	}
 
 	aggregateEvent struct {
@@ -35,23 +37,24 @@ var _ es.AggregateStore = (*EventStore)(nil)
 
 var _ ddd.AggregateEvent = (*aggregateEvent)(nil)
 
-func NewEventStore(tableName string, db DB, registry registry.Registry) EventStore {
+func NewEventStore(tableName string, db DB, registry registry.Registry, options ...StoreOption) EventStore {
 	return EventStore{
 		tableName: tableName,
 		db:        db,
 		registry:  registry,
+		cfg:       newStoreCfg(options),
 	}
 }
 
 func (s EventStore) Load(ctx context.Context, aggregate es.EventSourcedAggregate) (err error) {
-	const query = `SELECT stream_version, event_id, event_name, event_data, occurred_at FROM %s WHERE stream_id = $1 AND stream_name = $2 AND stream_version > $3 ORDER BY stream_version ASC`
+	const query = `SELECT stream_version, event_id, event_name, event_data, occurred_at FROM %s WHERE stream_id = $1 AND stream_name = $2 AND stream_version > $3 AND tenant_id = $4 ORDER BY stream_version ASC`
 
 	aggregateID := aggregate.ID()
 	aggregateName := aggregate.AggregateName()
 
 	var rows *sql.Rows
 
-	rows, err = s.db.QueryContext(ctx, s.table(query), aggregateID, aggregateName, aggregate.Version())
+	rows, err = s.db.QueryContext(ctx, s.table(query), aggregateID, aggregateName, aggregate.Version(), tenant.FromContext(ctx))
 	if err != nil {
 		return err
 	}
@@ -78,6 +81,12 @@ func (s EventStore) Load(ctx context.Context, aggregate es.EventSourcedAggregate
 			return err
 		}
 
+		if s.cfg.pii != nil {
+			if err = s.cfg.pii.Reveal(ctx, aggregateID, payload); err != nil {
+				return err
+			}
+		}
+
 		event := aggregateEvent{
 			id:         eventID,
 			name:       eventName,
@@ -96,33 +105,43 @@ func (s EventStore) Load(ctx context.Context, aggregate es.EventSourcedAggregate
 }
 
 func (s EventStore) Save(ctx context.Context, aggregate es.EventSourcedAggregate) (err error) {
-	const query = `INSERT INTO %s (stream_id, stream_name, stream_version, event_id, event_name, event_data, occurred_at) VALUES`
+	const query = `INSERT INTO %s (stream_id, stream_name, stream_version, event_id, event_name, event_data, occurred_at, tenant_id) VALUES`
 
 	aggregateID := aggregate.ID()
 	aggregateName := aggregate.AggregateName()
+	tenantID := tenant.FromContext(ctx)
 
 	placeholders := make([]string, len(aggregate.Events()))
-	values := make([]any, len(aggregate.Events())*7)
+	values := make([]any, len(aggregate.Events())*8)
 
 	for i, event := range aggregate.Events() {
 		var payloadData []byte
 
-		payloadData, err = s.registry.Serialize(event.EventName(), event.Payload())
+		payload := event.Payload()
+		if s.cfg.pii != nil {
+			payload, err = s.cfg.pii.Protect(ctx, aggregateID, payload)
+			if err != nil {
+				return err
+			}
+		}
+
+		payloadData, err = s.registry.Serialize(event.EventName(), payload)
 		if err != nil {
 			return err
 		}
 
-		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7,
+		placeholders[i] = fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8,
 		)
 
-		values[i*7] = aggregateID
-		values[i*1+1] = aggregateName
-		values[i*7+2] = event.AggregateVersion()
-		values[i*7+3] = event.ID()
-		values[i*7+4] = event.EventName()
-		values[i*7+5] = payloadData
-		values[i*7+6] = event.OccurredAt()
+		values[i*8] = aggregateID
+		values[i*8+1] = aggregateName
+		values[i*8+2] = event.AggregateVersion()
+		values[i*8+3] = event.ID()
+		values[i*8+4] = event.EventName()
+		values[i*8+5] = payloadData
+		values[i*8+6] = event.OccurredAt()
+		values[i*8+7] = tenantID
 	}
 	if _, err = s.db.ExecContext(
 		ctx,
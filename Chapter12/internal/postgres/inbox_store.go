@@ -10,6 +10,7 @@ import (
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 )
 
@@ -28,14 +29,14 @@ func NewInboxStore(tableName string, db DB) InboxStore {
 }
 
 func (s InboxStore) Save(ctx context.Context, msg am.IncomingMessage) error {
-	const query = "INSERT INTO %s (id, NAME, subject, DATA, metadata, sent_at, received_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	const query = "INSERT INTO %s (id, NAME, subject, DATA, metadata, sent_at, received_at, tenant_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
 
 	metadata, err := json.Marshal(msg.Metadata())
 	if err != nil {
 		return err
 	}
 
-	_, err = s.db.ExecContext(ctx, s.table(query), msg.ID(), msg.MessageName(), msg.Subject(), msg.Data(), metadata, msg.SentAt(), msg.ReceivedAt())
+	_, err = s.db.ExecContext(ctx, s.table(query), msg.ID(), msg.MessageName(), msg.Subject(), msg.Data(), metadata, msg.SentAt(), msg.ReceivedAt(), tenant.FromContext(ctx))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
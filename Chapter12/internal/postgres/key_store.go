@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/tenant"
+)
+
+// KeyStore keeps the data keys of each tenant
+type KeyStore struct {
+	tableName string
+	db        DB
+}
+
+var _ encryption.KeyStore = (*KeyStore)(nil)
+
+func NewKeyStore(tableName string, db DB) KeyStore {
+	return KeyStore{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (s KeyStore) Save(ctx context.Context, key encryption.EncryptedKey) error {
+	const query = "INSERT INTO %s (id, master_key_id, encrypted_key, created_at, tenant_id) VALUES ($1, $2, $3, $4, $5)"
+
+	_, err := s.db.ExecContext(ctx, s.table(query), key.ID, key.MasterKeyID, key.EncryptedKey, key.CreatedAt, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (s KeyStore) Find(ctx context.Context, keyID string) (encryption.EncryptedKey, error) {
+	const query = "SELECT id, master_key_id, encrypted_key, created_at FROM %s WHERE id = $1 AND tenant_id = $2"
+
+	return s.scan(keyID, s.db.QueryRowContext(ctx, s.table(query), keyID, tenant.FromContext(ctx)))
+}
+
+func (s KeyStore) FindLatest(ctx context.Context) (encryption.EncryptedKey, error) {
+	const query = "SELECT id, master_key_id, encrypted_key, created_at FROM %s WHERE tenant_id = $1 ORDER BY created_at DESC LIMIT 1"
+
+	return s.scan("latest", s.db.QueryRowContext(ctx, s.table(query), tenant.FromContext(ctx)))
+}
+
+func (s KeyStore) scan(keyID string, row *sql.Row) (encryption.EncryptedKey, error) {
+	var key encryption.EncryptedKey
+
+	err := row.Scan(&key.ID, &key.MasterKeyID, &key.EncryptedKey, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return key, encryption.ErrKeyNotFound(keyID)
+		}
+		return key, err
+	}
+
+	return key, nil
+}
+
+func (s KeyStore) table(query string) string {
+	return fmt.Sprintf(query, s.tableName)
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/tenant"
+)
+
+type KeyVault struct {
+	tableName string
+	db        DB
+}
+
+var _ encryption.KeyVault = (*KeyVault)(nil)
+
+func NewKeyVault(tableName string, db DB) KeyVault {
+	return KeyVault{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (v KeyVault) Create(ctx context.Context, subjectID string) (encryption.Key, error) {
+	const query = "INSERT INTO %s (subject_id, key_material, created_at, tenant_id) VALUES ($1, $2, $3, $4) ON CONFLICT (tenant_id, subject_id) DO NOTHING"
+
+	key, err := v.Find(ctx, subjectID)
+	if err == nil {
+		return key, nil
+	}
+	var errNotFound encryption.ErrKeyNotFound
+	if !errors.As(err, &errNotFound) {
+		return encryption.Key{}, err
+	}
+
+	key, err = encryption.NewSubjectKey(subjectID)
+	if err != nil {
+		return encryption.Key{}, err
+	}
+
+	if _, err = v.db.ExecContext(ctx, v.table(query), subjectID, key.Material, key.CreatedAt, tenant.FromContext(ctx)); err != nil {
+		return encryption.Key{}, err
+	}
+
+	// another writer may have created the key first
+	return v.Find(ctx, subjectID)
+}
+
+func (v KeyVault) Find(ctx context.Context, subjectID string) (encryption.Key, error) {
+	const query = "SELECT key_material, created_at FROM %s WHERE subject_id = $1 AND tenant_id = $2"
+
+	key := encryption.Key{ID: subjectID}
+
+	err := v.db.QueryRowContext(ctx, v.table(query), subjectID, tenant.FromContext(ctx)).Scan(&key.Material, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return key, encryption.ErrKeyNotFound(subjectID)
+		}
+		return key, err
+	}
+
+	if key.Material == nil {
+		return key, encryption.ErrSubjectForgotten(subjectID)
+	}
+
+	return key, nil
+}
+
+func (v KeyVault) Destroy(ctx context.Context, subjectID string) error {
+	const query = `INSERT INTO %s (subject_id, key_material, destroyed_at, tenant_id) VALUES ($1, NULL, NOW(), $2)
+ON CONFLICT (tenant_id, subject_id) DO
+UPDATE SET key_material = NULL, destroyed_at = NOW()`
+
+	_, err := v.db.ExecContext(ctx, v.table(query), subjectID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (v KeyVault) table(query string) string {
+	return fmt.Sprintf(query, v.tableName)
+}
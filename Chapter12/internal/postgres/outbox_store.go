@@ -14,6 +14,7 @@ import (
 
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 )
 
@@ -42,14 +43,14 @@ func NewOutboxStore(tableName string, db DB) OutboxStore {
 }
 
 func (s OutboxStore) Save(ctx context.Context, msg am.Message) error {
-	const query = "INSERT INTO %s (id, NAME, subject, DATA, metadata, sent_at) VALUES ($1, $2, $3, $4, $5, $6)"
+	const query = "INSERT INTO %s (id, NAME, subject, DATA, metadata, sent_at, tenant_id) VALUES ($1, $2, $3, $4, $5, $6, $7)"
 
 	metadata, err := json.Marshal(msg.Metadata())
 	if err != nil {
 		return err
 	}
 
-	_, err = s.db.ExecContext(ctx, s.table(query), msg.ID(), msg.MessageName(), msg.Subject(), msg.Data(), metadata, msg.SentAt())
+	_, err = s.db.ExecContext(ctx, s.table(query), msg.ID(), msg.MessageName(), msg.Subject(), msg.Data(), metadata, msg.SentAt(), tenant.FromContext(ctx))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -62,6 +63,8 @@ func (s OutboxStore) Save(ctx context.Context, msg am.Message) error {
 	return err
 }
 
+// FindUnpublished returns the messages of every tenant; each message carries
+// its tenant in its metadata
 func (s OutboxStore) FindUnpublished(ctx context.Context, limit int) ([]am.Message, error) {
 	const query = "SELECT id, name, subject, data, metadata, sent_at FROM %s WHERE published_at IS NULL LIMIT %d"
 
@@ -6,6 +6,7 @@ import (
 
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/sec"
+	"eda-in-golang/internal/tenant"
 )
 
 type SagaStore struct {
@@ -25,23 +26,23 @@ func NewSagaStore(tableName string, db DB, registry registry.Registry) SagaStore
 }
 
 func (s SagaStore) Load(ctx context.Context, sagaName, sagaID string) (*sec.SagaContext[[]byte], error) {
-	const query = "SELECT data, step, done, compensating FROM %s WHERE name = $1 AND id = $2"
+	const query = "SELECT data, step, done, compensating FROM %s WHERE name = $1 AND id = $2 AND tenant_id = $3"
 
 	sagaCtx := &sec.SagaContext[[]byte]{
 		ID: sagaID,
 	}
-	err := s.db.QueryRowContext(ctx, s.table(query), sagaName, sagaID).Scan(&sagaCtx.Data, &sagaCtx.Step, &sagaCtx.Done, &sagaCtx.Compensating)
+	err := s.db.QueryRowContext(ctx, s.table(query), sagaName, sagaID, tenant.FromContext(ctx)).Scan(&sagaCtx.Data, &sagaCtx.Step, &sagaCtx.Done, &sagaCtx.Compensating)
 
 	return sagaCtx, err
 }
 
 func (s SagaStore) Save(ctx context.Context, sagaName string, sagaCtx *sec.SagaContext[[]byte]) error {
-	const query = `INSERT INTO %s (name, id, data, step, done, compensating) 
-VALUES ($1, $2, $3, $4, $5, $6) 
-ON CONFLICT (name, id) DO
+	const query = `INSERT INTO %s (name, id, data, step, done, compensating, tenant_id) 
+VALUES ($1, $2, $3, $4, $5, $6, $7) 
+ON CONFLICT (tenant_id, name, id) DO
 UPDATE SET data = EXCLUDED.data, step = EXCLUDED.step, done = EXCLUDED.done, compensating = EXCLUDED.compensating`
 
-	_, err := s.db.ExecContext(ctx, s.table(query), sagaName, sagaCtx.ID, sagaCtx.Data, sagaCtx.Step, sagaCtx.Done, sagaCtx.Compensating)
+	_, err := s.db.ExecContext(ctx, s.table(query), sagaName, sagaCtx.ID, sagaCtx.Data, sagaCtx.Step, sagaCtx.Done, sagaCtx.Compensating, tenant.FromContext(ctx))
 
 	return err
 }
@@ -9,6 +9,7 @@ import (
 
 	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/tenant"
 )
 
 type SnapshotStore struct {
@@ -16,15 +17,17 @@ type SnapshotStore struct {
 	tableName string
 	db        DB
 	registry  registry.Registry
+	cfg       storeCfg
 }
 
 var _ es.AggregateStore = (*SnapshotStore)(nil)
 
-func NewSnapshotStore(tableName string, db DB, registry registry.Registry) es.AggregateStoreMiddleware {
+func NewSnapshotStore(tableName string, db DB, registry registry.Registry, options ...StoreOption) es.AggregateStoreMiddleware {
 	snapshots := SnapshotStore{
 		tableName: tableName,
 		db:        db,
 		registry:  registry,
+		cfg:       newStoreCfg(options),
 	}
 
 	return func(store es.AggregateStore) es.AggregateStore {
@@ -34,13 +37,13 @@ func NewSnapshotStore(tableName string, db DB, registry registry.Registry) es.Ag
 }
 
 func (s SnapshotStore) Load(ctx context.Context, aggregate es.EventSourcedAggregate) error {
-	const query = `SELECT stream_version, snapshot_name, snapshot_data FROM %s WHERE stream_id = $1 AND stream_name = $2 LIMIT 1`
+	const query = `SELECT stream_version, snapshot_name, snapshot_data FROM %s WHERE stream_id = $1 AND stream_name = $2 AND tenant_id = $3 LIMIT 1`
 
 	var entityVersion int
 	var snapshotName string
 	var snapshotData []byte
 
-	if err := s.db.QueryRowContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName()).Scan(&entityVersion, &snapshotName, &snapshotData); err != nil {
+	if err := s.db.QueryRowContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName(), tenant.FromContext(ctx)).Scan(&entityVersion, &snapshotName, &snapshotData); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return s.AggregateStore.Load(ctx, aggregate)
 		}
@@ -52,6 +55,12 @@ func (s SnapshotStore) Load(ctx context.Context, aggregate es.EventSourcedAggreg
 		return err
 	}
 
+	if s.cfg.pii != nil {
+		if err = s.cfg.pii.Reveal(ctx, aggregate.ID(), v); err != nil {
+			return err
+		}
+	}
+
 	if err := es.LoadSnapshot(aggregate, v.(es.Snapshot), entityVersion); err != nil {
 		return err
 	}
@@ -60,9 +69,9 @@ func (s SnapshotStore) Load(ctx context.Context, aggregate es.EventSourcedAggreg
 }
 
 func (s SnapshotStore) Save(ctx context.Context, aggregate es.EventSourcedAggregate) error {
-	const query = `INSERT INTO %s (stream_id, stream_name, stream_version, snapshot_name, snapshot_data) 
-VALUES ($1, $2, $3, $4, $5) 
-ON CONFLICT (stream_id, stream_name) DO
+	const query = `INSERT INTO %s (stream_id, stream_name, stream_version, snapshot_name, snapshot_data, tenant_id) 
+VALUES ($1, $2, $3, $4, $5, $6) 
+ON CONFLICT (tenant_id, stream_id, stream_name) DO
 UPDATE SET stream_version = EXCLUDED.stream_version, snapshot_name = EXCLUDED.snapshot_name, snapshot_data = EXCLUDED.snapshot_data`
 
 	if err := s.AggregateStore.Save(ctx, aggregate); err != nil {
@@ -80,12 +89,20 @@ UPDATE SET stream_version = EXCLUDED.stream_version, snapshot_name = EXCLUDED.sn
 
 	snapshot := sser.ToSnapshot()
 
-	data, err := s.registry.Serialize(snapshot.SnapshotName(), snapshot)
+	var v any = snapshot
+	var err error
+	if s.cfg.pii != nil {
+		if v, err = s.cfg.pii.Protect(ctx, aggregate.ID(), snapshot); err != nil {
+			return err
+		}
+	}
+
+	data, err := s.registry.Serialize(snapshot.SnapshotName(), v)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.db.ExecContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName(), aggregate.PendingVersion(), snapshot.SnapshotName(), data)
+	_, err = s.db.ExecContext(ctx, s.table(query), aggregate.ID(), aggregate.AggregateName(), aggregate.PendingVersion(), snapshot.SnapshotName(), data, tenant.FromContext(ctx))
 
 	return err
 }
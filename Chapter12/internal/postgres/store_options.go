@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"eda-in-golang/internal/encryption"
+)
+
+type (
+	StoreOption func(c *storeCfg)
+
+	storeCfg struct {
+		pii *encryption.PIIProtector
+	}
+)
+
+// WithPII encrypts the personal data in stored events and snapshots with the
+// key of the data subject; by default the subject is the aggregate
+func WithPII(protector encryption.PIIProtector) StoreOption {
+	return func(c *storeCfg) {
+		c.pii = &protector
+	}
+}
+
+func newStoreCfg(options []StoreOption) storeCfg {
+	var cfg storeCfg
+	for _, option := range options {
+		option(&cfg)
+	}
+	return cfg
+}
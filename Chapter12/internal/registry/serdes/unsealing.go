@@ -0,0 +1,90 @@
+package serdes
+
+import (
+	"context"
+	"fmt"
+
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/tenant"
+)
+
+type codec interface {
+	registry.Serde
+	serialize(v interface{}) ([]byte, error)
+	deserialize(data []byte, v interface{}) error
+}
+
+// UnsealingSerde reads the data that earlier releases sealed in the serde
+//
+// Serdes are not given a context and cannot tell which tenant the data belongs
+// to, so data is now written as the wrapped serde writes it and is encrypted
+// with the keys of its tenant by encryption.EncryptingPublisher instead. The
+// data that was sealed before was always sealed with the keys of the default
+// tenant. A nil KeyProvider fails to read sealed data.
+type UnsealingSerde struct {
+	r      registry.Registry
+	codec  codec
+	cipher *encryption.Cipher
+}
+
+var _ registry.Serde = (*UnsealingSerde)(nil)
+
+func NewUnsealingJsonSerde(r registry.Registry, keys encryption.KeyProvider) *UnsealingSerde {
+	return newUnsealingSerde(r, NewJsonSerde(registry.New()), keys)
+}
+
+func NewUnsealingProtoSerde(r registry.Registry, keys encryption.KeyProvider) *UnsealingSerde {
+	return newUnsealingSerde(r, NewProtoSerde(registry.New()), keys)
+}
+
+// newUnsealingSerde expects the codec to have been given a registry of its own;
+// registering with the codec only validates the value
+func newUnsealingSerde(r registry.Registry, codec codec, keys encryption.KeyProvider) *UnsealingSerde {
+	s := &UnsealingSerde{
+		r:     r,
+		codec: codec,
+	}
+	if keys != nil {
+		c := encryption.NewCipher(keys)
+		s.cipher = &c
+	}
+
+	return s
+}
+
+func (c UnsealingSerde) Register(v registry.Registrable, options ...registry.BuildOption) error {
+	if err := c.codec.Register(v, options...); err != nil {
+		return err
+	}
+	return registry.Register(c.r, v, c.codec.serialize, c.deserialize, options)
+}
+
+func (c UnsealingSerde) RegisterKey(key string, v interface{}, options ...registry.BuildOption) error {
+	if err := c.codec.RegisterKey(key, v, options...); err != nil {
+		return err
+	}
+	return registry.RegisterKey(c.r, key, v, c.codec.serialize, c.deserialize, options)
+}
+
+func (c UnsealingSerde) RegisterFactory(key string, fn func() interface{}, options ...registry.BuildOption) error {
+	if err := c.codec.RegisterFactory(key, fn, options...); err != nil {
+		return err
+	}
+	return registry.RegisterFactory(c.r, key, fn, c.codec.serialize, c.deserialize, options)
+}
+
+func (c UnsealingSerde) deserialize(data []byte, v interface{}) error {
+	if encryption.IsSealed(data) {
+		if c.cipher == nil {
+			return fmt.Errorf("data is encrypted but encryption has not been configured")
+		}
+		var err error
+		data, err = c.cipher.Open(tenant.WithID(context.Background(), tenant.DefaultID), data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.codec.deserialize(data, v)
+}
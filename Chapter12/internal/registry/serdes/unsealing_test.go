@@ -0,0 +1,87 @@
+package serdes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/tenant"
+)
+
+type testPayload struct {
+	Name string
+}
+
+func (testPayload) Key() string { return "test.Payload" }
+
+// testKeys keeps a key for each tenant
+type testKeys map[string]encryption.Key
+
+func (k testKeys) CurrentKey(ctx context.Context) (encryption.Key, error) {
+	return k[tenant.FromContext(ctx)], nil
+}
+
+func (k testKeys) Key(ctx context.Context, keyID string) (encryption.Key, error) {
+	if key := k[tenant.FromContext(ctx)]; key.ID == keyID {
+		return key, nil
+	}
+	return encryption.Key{}, encryption.ErrKeyNotFound(keyID)
+}
+
+func TestUnsealingSerde(t *testing.T) {
+	keys := testKeys{
+		tenant.DefaultID: {ID: "default-key", Material: bytes.Repeat([]byte{1}, 32)},
+		"other":          {ID: "other-key", Material: bytes.Repeat([]byte{2}, 32)},
+	}
+	reg := registry.New()
+	if err := NewUnsealingJsonSerde(reg, keys).Register(testPayload{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// new data is left for the message publisher to encrypt
+	data, err := reg.Serialize(testPayload{}.Key(), &testPayload{Name: "new"})
+	if assert.NoError(t, err) {
+		assert.False(t, encryption.IsSealed(data))
+		assert.JSONEq(t, `{"Name":"new"}`, string(data))
+	}
+
+	// data sealed by earlier releases used the keys of the default tenant
+	plain, err := json.Marshal(testPayload{Name: "sealed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed, err := encryption.NewCipher(keys).Seal(context.Background(), plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range map[string][]byte{"Sealed": sealed, "Plain": plain} {
+		t.Run(name, func(t *testing.T) {
+			v, err := reg.Deserialize(testPayload{}.Key(), data)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "sealed", v.(*testPayload).Name)
+			}
+		})
+	}
+}
+
+func TestUnsealingSerde_NoKeys(t *testing.T) {
+	keys := testKeys{tenant.DefaultID: {ID: "default-key", Material: bytes.Repeat([]byte{1}, 32)}}
+	sealed, err := encryption.NewCipher(keys).Seal(context.Background(), []byte(`{"Name":"sealed"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := registry.New()
+	if err = NewUnsealingJsonSerde(reg, nil).Register(testPayload{}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.Deserialize(testPayload{}.Key(), sealed)
+	assert.Error(t, err)
+}
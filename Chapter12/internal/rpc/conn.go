@@ -7,6 +7,8 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+
+	"eda-in-golang/internal/tenant"
 )
 
 func clientErrorUnaryInterceptor() grpc.UnaryClientInterceptor {
@@ -21,6 +23,7 @@ func Dial(ctx context.Context, endpoint string) (conn *grpc.ClientConn, err erro
 		grpc.WithChainUnaryInterceptor(
 			otelgrpc.UnaryClientInterceptor(),
 			clientErrorUnaryInterceptor(),
+			tenant.UnaryClientInterceptor(),
 		),
 		// If there are streaming endpoints also add
 		// grpc.WithStreamInterceptor(
@@ -25,25 +25,39 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/jetstream"
 	"eda-in-golang/internal/logger"
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/waiter"
 )
 
 type System struct {
-	cfg    config.AppConfig
-	db     *sql.DB
-	nc     *nats.Conn
-	js     nats.JetStreamContext
-	mux    *chi.Mux
-	rpc    *grpc.Server
-	waiter waiter.Waiter
-	logger zerolog.Logger
-	tp     *sdktrace.TracerProvider
+	cfg          config.AppConfig
+	db           *sql.DB
+	sharedDBs    map[string]*sql.DB
+	nc           *nats.Conn
+	js           nats.JetStreamContext
+	mux          *chi.Mux
+	rpc          *grpc.Server
+	waiter       waiter.Waiter
+	logger       zerolog.Logger
+	tp           *sdktrace.TracerProvider
+	blobs        claimcheck.BlobStore
+	keys         encryption.KeyProvider
+	tenants      tenant.Tenants
+	streamDrains []DrainFunc
+	outboxDrains []DrainFunc
 }
 
 func NewSystem(cfg config.AppConfig) (*System, error) {
-	s := &System{cfg: cfg}
+	s := &System{
+		cfg:     cfg,
+		tenants: tenant.New(cfg.Tenants.IDs...),
+	}
 
 	s.initWaiter()
 
@@ -63,6 +77,14 @@ func NewSystem(cfg config.AppConfig) (*System, error) {
 	s.initRpc()
 	s.initLogger()
 
+	if err := s.initBlobStore(); err != nil {
+		return nil, err
+	}
+
+	if err := s.initKeys(); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -89,6 +111,10 @@ func (s *System) Config() config.AppConfig {
 	return s.cfg
 }
 
+func (s *System) Tenants() tenant.Tenants {
+	return s.tenants
+}
+
 func (s *System) initDB() (err error) {
 	s.db, err = sql.Open("pgx", s.cfg.PG.Conn)
 	return err
@@ -119,10 +145,17 @@ func (s *System) initJS() (err error) {
 		return err
 	}
 
-	_, err = s.js.AddStream(&nats.StreamConfig{
+	cfg := &nats.StreamConfig{
 		Name:     s.cfg.Nats.Stream,
-		Subjects: []string{fmt.Sprintf("%s.>", s.cfg.Nats.Stream)},
-	})
+		Subjects: jetstream.StreamSubjects(s.cfg.Nats.Stream),
+	}
+
+	_, err = s.js.AddStream(cfg)
+	// streams created before there were tenants only hold the subjects of the
+	// default tenant; the subjects of the other tenants are added to them
+	if errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		_, err = s.js.UpdateStream(cfg)
+	}
 
 	return err
 }
@@ -131,6 +164,104 @@ func (s *System) JS() nats.JetStreamContext {
 	return s.js
 }
 
+func (s *System) DrainStream(fns ...DrainFunc) {
+	s.streamDrains = append(s.streamDrains, fns...)
+}
+
+func (s *System) DrainOutbox(fns ...DrainFunc) {
+	s.outboxDrains = append(s.outboxDrains, fns...)
+}
+
+func (s *System) initBlobStore() (err error) {
+	switch s.cfg.ClaimCheck.Store {
+	case "postgres":
+		var db *sql.DB
+		if db, err = s.sharedDB(s.cfg.ClaimCheck.Conn); err != nil {
+			return err
+		}
+		s.blobs = postgres.NewBlobStore(s.cfg.ClaimCheck.TableName, db)
+	case "filesystem":
+		s.blobs, err = claimcheck.NewFileStore(s.cfg.ClaimCheck.Path)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown claim check store: %q", s.cfg.ClaimCheck.Store)
+	}
+
+	collector := claimcheck.NewCollector(s.blobs, s.cfg.ClaimCheck.GCInterval, s.logger)
+	s.waiter.Add(collector.Start)
+
+	return nil
+}
+
+// sharedDB opens the database that is shared with the other services; the
+// service database is returned when conn is blank
+func (s *System) sharedDB(conn string) (*sql.DB, error) {
+	if conn == "" {
+		return s.db, nil
+	}
+
+	if db, exists := s.sharedDBs[conn]; exists {
+		return db, nil
+	}
+
+	db, err := sql.Open("pgx", conn)
+	if err != nil {
+		return nil, err
+	}
+	if s.sharedDBs == nil {
+		s.sharedDBs = make(map[string]*sql.DB)
+	}
+	s.sharedDBs[conn] = db
+	s.waiter.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			s.logger.Error().Err(err).Msg("ran into an issue closing the shared database")
+		}
+	})
+
+	return db, nil
+}
+
+func (s *System) BlobStore() claimcheck.BlobStore {
+	return s.blobs
+}
+
+func (s *System) initKeys() error {
+	if !s.cfg.Encryption.Enabled {
+		return nil
+	}
+
+	static, err := encryption.NewStaticKeyProvider(s.cfg.Encryption.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	switch s.cfg.Encryption.Provider {
+	case "static":
+		s.keys = static
+	case "envelope":
+		db, err := s.sharedDB(s.cfg.Encryption.Conn)
+		if err != nil {
+			return err
+		}
+		// the key file holds the master keys that protect the data keys
+		s.keys = encryption.NewEnvelopeKeyProvider(
+			postgres.NewKeyStore(s.cfg.Encryption.TableName, db),
+			static,
+			s.cfg.Encryption.RotateAfter,
+		)
+	default:
+		return fmt.Errorf("unknown encryption key provider: %q", s.cfg.Encryption.Provider)
+	}
+
+	return nil
+}
+
+func (s *System) Keys() encryption.KeyProvider {
+	return s.keys
+}
+
 func (s *System) initLogger() {
 	s.logger = logger.New(logger.LogConfig{
 		Environment: s.cfg.Environment,
@@ -157,6 +288,7 @@ func (s *System) initRpc() {
 		grpc.ChainUnaryInterceptor(
 			otelgrpc.UnaryServerInterceptor(),
 			serverErrorUnaryInterceptor(),
+			tenant.UnaryServerInterceptor(s.tenants),
 		),
 		// If there are streaming endpoints also add
 		// grpc.StreamInterceptor(
@@ -258,11 +390,36 @@ func (s *System) WaitForStream(ctx context.Context) error {
 	})
 	group.Go(func() error {
 		<-gCtx.Done()
+		fmt.Println("message stream to be drained")
+		ctx, cancel := context.WithTimeout(context.Background(), s.cfg.DrainTimeout)
+		defer cancel()
+		// stop consuming and let the in-flight handlers finish
+		if err := runDrains(ctx, s.streamDrains); err != nil {
+			s.logger.Error().Err(err).Msg("ran into an issue draining the message handlers")
+		}
+		// publish anything the handlers left behind in the outboxes
+		if err := runDrains(ctx, s.outboxDrains); err != nil {
+			s.logger.Error().Err(err).Msg("ran into an issue flushing the outboxes")
+		}
+		select {
+		case <-s.js.PublishAsyncComplete():
+		case <-ctx.Done():
+			s.logger.Error().Err(ctx.Err()).Msg("unacknowledged messages remain after draining")
+		}
 		return s.nc.Drain()
 	})
 	return group.Wait()
 }
 
+func runDrains(ctx context.Context, fns []DrainFunc) error {
+	var group errgroup.Group
+	for _, fn := range fns {
+		drainFn := fn
+		group.Go(func() error { return drainFn(ctx) })
+	}
+	return group.Wait()
+}
+
 func serverErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		resp, err = handler(ctx, req)
@@ -9,10 +9,15 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/encryption"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/waiter"
 )
 
+type DrainFunc func(ctx context.Context) error
+
 type Service interface {
 	Config() config.AppConfig
 	DB() *sql.DB
@@ -21,6 +26,15 @@ type Service interface {
 	RPC() *grpc.Server
 	Waiter() waiter.Waiter
 	Logger() zerolog.Logger
+	BlobStore() claimcheck.BlobStore
+	// Keys will be nil when encryption has not been enabled
+	Keys() encryption.KeyProvider
+	// Tenants are the malls hosted by the deployment
+	Tenants() tenant.Tenants
+	// DrainStream with a function that stops consuming and finishes in-flight handlers
+	DrainStream(fns ...DrainFunc)
+	// DrainOutbox with a function that publishes the remaining outbox messages
+	DrainOutbox(fns ...DrainFunc)
 }
 
 type Module interface {
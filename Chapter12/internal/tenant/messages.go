@@ -0,0 +1,51 @@
+package tenant
+
+import (
+	"context"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+)
+
+const TenantIDHdr = "TENANT_ID"
+
+// MessageContextInjector records the tenant of the context in the metadata of
+// the messages being published
+func MessageContextInjector() am.MessagePublisherMiddleware {
+	return func(next am.MessagePublisher) am.MessagePublisher {
+		return am.MessagePublisherFunc(func(ctx context.Context, topicName string, msg am.Message) error {
+			if _, exists := msg.Metadata()[TenantIDHdr]; !exists {
+				msg.Metadata().Set(TenantIDHdr, FromContext(ctx))
+			}
+
+			return next.Publish(ctx, topicName, msg)
+		})
+	}
+}
+
+// MessageContextExtractor handles each message within the context of its
+// tenant
+//
+// Streams only subscribe to the messages of the hosted tenants; a message for
+// a tenant hosted elsewhere is refused so that it is never acknowledged
+func MessageContextExtractor(tenants Tenants) am.MessageHandlerMiddleware {
+	return func(next am.MessageHandler) am.MessageHandler {
+		return am.MessageHandlerFunc(func(ctx context.Context, msg am.IncomingMessage) error {
+			tenantID := MetadataID(msg.Metadata())
+			if err := tenants.Check(tenantID); err != nil {
+				return err
+			}
+
+			return next.HandleMessage(WithID(ctx, tenantID), msg)
+		})
+	}
+}
+
+// MetadataID returns the tenant recorded in the metadata
+func MetadataID(metadata ddd.Metadata) string {
+	if tenantID, ok := metadata.Get(TenantIDHdr).(string); ok && tenantID != "" {
+		return tenantID
+	}
+
+	return DefaultID
+}
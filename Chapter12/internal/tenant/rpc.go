@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// HeaderName is the HTTP header clients name their tenant with
+	HeaderName = "X-Tenant-Id"
+	// rpcMetadataKey carries the tenant in gRPC metadata
+	rpcMetadataKey = "x-tenant-id"
+)
+
+// UnaryServerInterceptor handles each request within the context of the
+// tenant it names; requests for tenants hosted elsewhere are refused
+func UnaryServerInterceptor(tenants Tenants) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		tenantID := DefaultID
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(rpcMetadataKey); len(values) > 0 && values[0] != "" {
+				tenantID = values[0]
+			}
+		}
+
+		if err := tenants.Check(tenantID); err != nil {
+			return nil, err
+		}
+
+		return handler(WithID(ctx, tenantID), req)
+	}
+}
+
+// UnaryClientInterceptor passes the tenant of the context along to the server
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, rpcMetadataKey, FromContext(ctx))
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// HeaderMatcher lets the gRPC gateways pass the tenant header on to the servers
+func HeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == HeaderName {
+		return rpcMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
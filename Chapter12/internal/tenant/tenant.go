@@ -0,0 +1,61 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+)
+
+// DefaultID is the mall every deployment hosted before tenants were introduced;
+// requests and messages that do not name a tenant belong to it
+const DefaultID = "mallbots"
+
+type contextKey int
+
+const tenantKey contextKey = iota
+
+var ErrUnknownTenant = errors.Wrap(errors.ErrPermissionDenied, "the tenant is not hosted by this deployment")
+
+// Tenants are the malls hosted by a deployment
+type Tenants struct {
+	ids   []string
+	known map[string]struct{}
+}
+
+func New(ids ...string) Tenants {
+	known := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		known[id] = struct{}{}
+	}
+
+	return Tenants{
+		ids:   ids,
+		known: known,
+	}
+}
+
+func (t Tenants) IDs() []string {
+	return t.ids
+}
+
+// Check returns ErrUnknownTenant for the tenants hosted elsewhere
+func (t Tenants) Check(tenantID string) error {
+	if _, exists := t.known[tenantID]; !exists {
+		return errors.Wrapf(ErrUnknownTenant, "tenant: %s", tenantID)
+	}
+
+	return nil
+}
+
+func WithID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenantID)
+}
+
+// FromContext returns the tenant the work in the context is done for
+func FromContext(ctx context.Context) string {
+	if tenantID, ok := ctx.Value(tenantKey).(string); ok && tenantID != "" {
+		return tenantID
+	}
+
+	return DefaultID
+}
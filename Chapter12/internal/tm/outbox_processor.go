@@ -12,6 +12,8 @@ const pollingInterval = 333 * time.Millisecond
 
 type OutboxProcessor interface {
 	Start(ctx context.Context) error
+	// Flush publishes every unpublished message before returning
+	Flush(ctx context.Context) error
 }
 
 type outboxProcessor struct {
@@ -41,28 +43,27 @@ func (p outboxProcessor) Start(ctx context.Context) error {
 	}
 }
 
+func (p outboxProcessor) Flush(ctx context.Context) error {
+	for {
+		published, err := p.publishMessages(ctx)
+		if err != nil {
+			return err
+		}
+		if published == 0 {
+			return nil
+		}
+	}
+}
+
 func (p outboxProcessor) processMessages(ctx context.Context) error {
 	timer := time.NewTimer(0)
 	for {
-		msgs, err := p.store.FindUnpublished(ctx, messageLimit)
+		published, err := p.publishMessages(ctx)
 		if err != nil {
 			return err
 		}
 
-		if len(msgs) > 0 {
-			ids := make([]string, len(msgs))
-			for i, msg := range msgs {
-				ids[i] = msg.ID()
-				err = p.publisher.Publish(ctx, msg.Subject(), msg)
-				if err != nil {
-					return err
-				}
-			}
-			err = p.store.MarkPublished(ctx, ids...)
-			if err != nil {
-				return err
-			}
-
+		if published > 0 {
 			// poll again immediately
 			continue
 		}
@@ -84,3 +85,25 @@ func (p outboxProcessor) processMessages(ctx context.Context) error {
 		}
 	}
 }
+
+func (p outboxProcessor) publishMessages(ctx context.Context) (int, error) {
+	msgs, err := p.store.FindUnpublished(ctx, messageLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = msg.ID()
+		err = p.publisher.Publish(ctx, msg.Subject(), msg)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(msgs), p.store.MarkPublished(ctx, ids...)
+}
@@ -0,0 +1,98 @@
+package web
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// carrier-grade NAT addresses are shared inside a provider's network
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// CheckWebhookURL makes sure a URL given to us by a user can be called without
+// reaching into our own network; the URL must be absolute https and must not
+// name a loopback, private or otherwise internal address
+//
+// Host names are only checked when they are dialed; see NewPublicClient
+func CheckWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("the webhook URL cannot be parsed: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("the webhook URL must use https")
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return fmt.Errorf("the webhook URL must have a host")
+	}
+
+	if u.User != nil {
+		return fmt.Errorf("the webhook URL cannot hold credentials")
+	}
+
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("the webhook URL cannot use a local host")
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !IsPublicIP(ip) {
+		return fmt.Errorf("the webhook URL cannot use an internal address")
+	}
+
+	return nil
+}
+
+// IsPublicIP is false for every address that is not routed on the internet
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+// NewPublicClient returns a client that refuses to connect to anything other
+// than public addresses; host names are checked after they are resolved, which
+// includes the hosts of any redirects that are followed
+func NewPublicClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("refusing to connect to the internal address %s", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return fmt.Errorf("refusing to follow a redirect to %s", req.URL.Scheme)
+			}
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after 10 redirects")
+			}
+			return nil
+		},
+	}
+}
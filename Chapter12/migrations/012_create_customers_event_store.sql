@@ -0,0 +1,46 @@
+-- +goose Up
+SET
+SEARCH_PATH TO customers, PUBLIC;
+
+CREATE TABLE events (
+  stream_id      text        NOT NULL,
+  stream_name    text        NOT NULL,
+  stream_version int         NOT NULL,
+  event_id       text        NOT NULL,
+  event_name     text        NOT NULL,
+  event_data     bytea       NOT NULL,
+  occurred_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (stream_id, stream_name, stream_version)
+);
+
+CREATE TABLE snapshots (
+  stream_id      text        NOT NULL,
+  stream_name    text        NOT NULL,
+  stream_version int         NOT NULL,
+  snapshot_name  text        NOT NULL,
+  snapshot_data  bytea       NOT NULL,
+  updated_at     timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (stream_id, stream_name)
+);
+
+CREATE TRIGGER updated_at_snapshots_trgr
+  BEFORE UPDATE
+  ON snapshots
+  FOR EACH ROW
+EXECUTE PROCEDURE updated_at_trigger();
+
+CREATE TABLE key_vault (
+  subject_id   text        NOT NULL,
+  key_material bytea,
+  created_at   timestamptz NOT NULL DEFAULT NOW(),
+  destroyed_at timestamptz,
+  PRIMARY KEY (subject_id)
+);
+
+-- +goose Down
+SET
+SEARCH_PATH TO customers, PUBLIC;
+
+DROP TABLE IF EXISTS key_vault;
+DROP TABLE IF EXISTS snapshots;
+DROP TABLE IF EXISTS events;
@@ -0,0 +1,31 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+CREATE TABLE bots (
+  id               text        NOT NULL,
+  name             text        NOT NULL,
+  status           text        NOT NULL,
+  shopping_list_id text        NOT NULL,
+  last_seen_at     timestamptz NOT NULL,
+  created_at       timestamptz NOT NULL DEFAULT NOW(),
+  updated_at       timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX bots_status_idx ON bots (status, last_seen_at);
+
+CREATE TRIGGER created_at_bots_trgr
+  BEFORE UPDATE
+  ON bots
+  FOR EACH ROW EXECUTE PROCEDURE created_at_trigger();
+CREATE TRIGGER updated_at_bots_trgr
+  BEFORE UPDATE
+  ON bots
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+DROP TABLE IF EXISTS bots;
@@ -0,0 +1,13 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE shopping_lists
+  ADD COLUMN route bytea NOT NULL DEFAULT '[]';
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE shopping_lists
+  DROP COLUMN IF EXISTS route;
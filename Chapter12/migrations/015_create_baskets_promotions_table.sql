@@ -0,0 +1,38 @@
+-- +goose Up
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+CREATE TABLE promotions (
+  id            text          NOT NULL,
+  name          text          NOT NULL,
+  store_id      text          NOT NULL,
+  product_id    text          NOT NULL,
+  code          text          NOT NULL,
+  kind          text          NOT NULL,
+  percent       decimal(5, 2) NOT NULL,
+  amount        decimal(9, 4) NOT NULL,
+  buy_quantity  int           NOT NULL,
+  get_quantity  int           NOT NULL,
+  minimum_spend decimal(9, 4) NOT NULL,
+  created_at    timestamptz   NOT NULL DEFAULT NOW(),
+  updated_at    timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX promotions_store_idx ON promotions (store_id);
+CREATE INDEX promotions_code_idx ON promotions (code);
+
+CREATE TRIGGER created_at_promotions_trgr
+  BEFORE UPDATE
+  ON promotions
+  FOR EACH ROW EXECUTE PROCEDURE created_at_trigger();
+CREATE TRIGGER updated_at_promotions_trgr
+  BEFORE UPDATE
+  ON promotions
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- +goose Down
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+DROP TABLE IF EXISTS promotions;
@@ -0,0 +1,17 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+UPDATE orders SET status = 'pending' WHERE status = 'New';
+UPDATE orders SET status = 'ready' WHERE status = 'Ready For Pickup';
+UPDATE orders SET status = 'cancelled' WHERE status = 'Canceled';
+UPDATE orders SET status = 'completed' WHERE status = 'Completed';
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+UPDATE orders SET status = 'New' WHERE status IN ('pending', 'approved', 'rejected');
+UPDATE orders SET status = 'Ready For Pickup' WHERE status = 'ready';
+UPDATE orders SET status = 'Canceled' WHERE status = 'cancelled';
+UPDATE orders SET status = 'Completed' WHERE status = 'completed';
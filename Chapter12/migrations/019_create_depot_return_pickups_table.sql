@@ -0,0 +1,30 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+CREATE TABLE return_pickups (
+  id         text        NOT NULL,
+  order_id   text        NOT NULL,
+  items      bytea       NOT NULL,
+  status     text        NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT NOW(),
+  updated_at timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX return_pickups_order_id_idx ON return_pickups (order_id);
+
+CREATE TRIGGER created_at_return_pickups_trgr
+  BEFORE UPDATE
+  ON return_pickups
+  FOR EACH ROW EXECUTE PROCEDURE created_at_trigger();
+CREATE TRIGGER updated_at_return_pickups_trgr
+  BEFORE UPDATE
+  ON return_pickups
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+DROP TABLE IF EXISTS return_pickups;
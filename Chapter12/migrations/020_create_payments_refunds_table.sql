@@ -0,0 +1,21 @@
+-- +goose Up
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+CREATE TABLE refunds (
+  id          text          NOT NULL,
+  order_id    text          NOT NULL,
+  payment_id  text          NOT NULL,
+  customer_id text          NOT NULL,
+  amount      decimal(9, 4) NOT NULL,
+  created_at  timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX refunds_payment_id_idx ON refunds (payment_id);
+
+-- +goose Down
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+DROP TABLE IF EXISTS refunds;
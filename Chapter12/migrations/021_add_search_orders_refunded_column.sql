@@ -0,0 +1,11 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE orders ADD COLUMN refunded decimal(9, 4) NOT NULL DEFAULT 0;
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE orders DROP COLUMN refunded;
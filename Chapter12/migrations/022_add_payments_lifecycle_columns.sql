@@ -0,0 +1,27 @@
+-- +goose Up
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+ALTER TABLE payments
+  ADD COLUMN idempotency_key text          NOT NULL DEFAULT '',
+  ADD COLUMN reference       text          NOT NULL DEFAULT '',
+  ADD COLUMN captured        decimal(9, 4) NOT NULL DEFAULT 0,
+  ADD COLUMN refunded        decimal(9, 4) NOT NULL DEFAULT 0,
+  ADD COLUMN status          text          NOT NULL DEFAULT 'authorized';
+
+CREATE UNIQUE INDEX payments_idempotency_key_idx ON payments (customer_id, idempotency_key) WHERE idempotency_key <> '';
+
+UPDATE payments SET reference = 'local-' || id;
+
+-- +goose Down
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+DROP INDEX IF EXISTS payments_idempotency_key_idx;
+
+ALTER TABLE payments
+  DROP COLUMN idempotency_key,
+  DROP COLUMN reference,
+  DROP COLUMN captured,
+  DROP COLUMN refunded,
+  DROP COLUMN status;
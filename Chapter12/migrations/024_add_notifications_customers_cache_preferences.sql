@@ -0,0 +1,19 @@
+-- +goose Up
+SET
+SEARCH_PATH TO notifications, PUBLIC;
+
+ALTER TABLE customers_cache
+  ADD COLUMN quiet_start text  NOT NULL DEFAULT '',
+  ADD COLUMN quiet_end   text  NOT NULL DEFAULT '',
+  ADD COLUMN time_zone   text  NOT NULL DEFAULT '',
+  ADD COLUMN kinds       jsonb NOT NULL DEFAULT '{}';
+
+-- +goose Down
+SET
+SEARCH_PATH TO notifications, PUBLIC;
+
+ALTER TABLE customers_cache
+  DROP COLUMN quiet_start,
+  DROP COLUMN quiet_end,
+  DROP COLUMN time_zone,
+  DROP COLUMN kinds;
@@ -0,0 +1,27 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE orders ADD COLUMN total decimal(9, 4) NOT NULL DEFAULT 0;
+
+UPDATE orders
+SET total = COALESCE((SELECT SUM((item ->> 'Price')::decimal * (item ->> 'Quantity')::int)
+                      FROM jsonb_array_elements(convert_from(items, 'UTF8')::jsonb) AS item), 0);
+
+CREATE INDEX orders_created_at_idx ON orders (created_at, order_id);
+CREATE INDEX orders_total_idx ON orders (total, order_id);
+CREATE INDEX orders_customer_id_idx ON orders (customer_id, created_at);
+CREATE INDEX orders_store_ids_idx ON orders USING GIN (store_ids);
+CREATE INDEX orders_product_ids_idx ON orders USING GIN (product_ids);
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+DROP INDEX IF EXISTS orders_product_ids_idx;
+DROP INDEX IF EXISTS orders_store_ids_idx;
+DROP INDEX IF EXISTS orders_customer_id_idx;
+DROP INDEX IF EXISTS orders_total_idx;
+DROP INDEX IF EXISTS orders_created_at_idx;
+
+ALTER TABLE orders DROP COLUMN total;
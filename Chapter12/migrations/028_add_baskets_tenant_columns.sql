@@ -0,0 +1,93 @@
+-- +goose Up
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+ALTER TABLE stores_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE products_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE promotions
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT promotions_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE basket_activity
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT basket_activity_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE basket_products
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT basket_products_pkey,
+  ADD PRIMARY KEY (tenant_id, basket_id, product_id);
+
+ALTER TABLE events
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name, stream_version);
+
+ALTER TABLE snapshots
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (tenant_id, stream_id, stream_name);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+-- +goose Down
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+ALTER TABLE stores_cache
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE products_cache
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE promotions
+  DROP CONSTRAINT promotions_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE basket_activity
+  DROP CONSTRAINT basket_activity_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE basket_products
+  DROP CONSTRAINT basket_products_pkey,
+  ADD PRIMARY KEY (basket_id, product_id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE events
+  DROP CONSTRAINT events_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name, stream_version),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE snapshots
+  DROP CONSTRAINT snapshots_pkey,
+  ADD PRIMARY KEY (stream_id, stream_name),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
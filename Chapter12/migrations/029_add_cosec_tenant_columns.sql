@@ -0,0 +1,33 @@
+-- +goose Up
+SET
+SEARCH_PATH TO cosec, PUBLIC;
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+ALTER TABLE sagas
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (tenant_id, id, name);
+
+-- +goose Down
+SET
+SEARCH_PATH TO cosec, PUBLIC;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
+
+ALTER TABLE sagas
+  DROP CONSTRAINT sagas_pkey,
+  ADD PRIMARY KEY (id, name),
+  DROP COLUMN tenant_id;
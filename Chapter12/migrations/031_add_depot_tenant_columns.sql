@@ -0,0 +1,73 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE stores_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE products_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE shopping_lists
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT shopping_lists_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE bots
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT bots_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE return_pickups
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT return_pickups_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE stores_cache
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE products_cache
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE shopping_lists
+  DROP CONSTRAINT shopping_lists_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE bots
+  DROP CONSTRAINT bots_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE return_pickups
+  DROP CONSTRAINT return_pickups_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
@@ -0,0 +1,47 @@
+-- +goose Up
+SET
+SEARCH_PATH TO notifications, PUBLIC;
+
+ALTER TABLE customers_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT customers_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE deliveries
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT deliveries_pkey,
+  ADD PRIMARY KEY (tenant_id, id),
+  DROP CONSTRAINT deliveries_event_id_channel_key,
+  ADD UNIQUE (tenant_id, event_id, channel);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+-- +goose Down
+SET
+SEARCH_PATH TO notifications, PUBLIC;
+
+ALTER TABLE customers_cache
+  DROP CONSTRAINT customers_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE deliveries
+  DROP CONSTRAINT deliveries_pkey,
+  ADD PRIMARY KEY (id),
+  DROP CONSTRAINT deliveries_tenant_id_event_id_channel_key,
+  ADD UNIQUE (event_id, channel),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
@@ -0,0 +1,59 @@
+-- +goose Up
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+ALTER TABLE payments
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT payments_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+DROP INDEX IF EXISTS payments_idempotency_key_idx;
+CREATE UNIQUE INDEX payments_idempotency_key_idx ON payments (tenant_id, customer_id, idempotency_key) WHERE idempotency_key <> '';
+
+ALTER TABLE invoices
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT invoices_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE refunds
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT refunds_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+-- +goose Down
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+DROP INDEX IF EXISTS payments_idempotency_key_idx;
+CREATE UNIQUE INDEX payments_idempotency_key_idx ON payments (customer_id, idempotency_key) WHERE idempotency_key <> '';
+
+ALTER TABLE payments
+  DROP CONSTRAINT payments_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE invoices
+  DROP CONSTRAINT invoices_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE refunds
+  DROP CONSTRAINT refunds_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
@@ -0,0 +1,103 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE customers_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT customers_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE stores_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE products_cache
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE orders
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT orders_pkey,
+  ADD PRIMARY KEY (tenant_id, order_id);
+
+ALTER TABLE store_listings
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT store_listings_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE product_listings
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT product_listings_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE customer_order_stats
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT customer_order_stats_pkey,
+  ADD PRIMARY KEY (tenant_id, customer_id);
+
+ALTER TABLE store_sales
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT store_sales_pkey,
+  ADD PRIMARY KEY (tenant_id, store_id, day);
+
+ALTER TABLE inbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+ALTER TABLE outbox
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots';
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE customers_cache
+  DROP CONSTRAINT customers_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE stores_cache
+  DROP CONSTRAINT stores_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE products_cache
+  DROP CONSTRAINT products_cache_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE orders
+  DROP CONSTRAINT orders_pkey,
+  ADD PRIMARY KEY (order_id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE store_listings
+  DROP CONSTRAINT store_listings_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE product_listings
+  DROP CONSTRAINT product_listings_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE customer_order_stats
+  DROP CONSTRAINT customer_order_stats_pkey,
+  ADD PRIMARY KEY (customer_id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE store_sales
+  DROP CONSTRAINT store_sales_pkey,
+  ADD PRIMARY KEY (store_id, day),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE inbox
+  DROP CONSTRAINT inbox_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE outbox
+  DROP COLUMN tenant_id;
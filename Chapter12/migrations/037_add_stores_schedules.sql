@@ -0,0 +1,19 @@
+-- +goose Up
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+ALTER TABLE stores
+  ADD COLUMN schedule  jsonb NOT NULL DEFAULT '{}',
+  ADD COLUMN scheduled bool  NOT NULL DEFAULT FALSE;
+
+CREATE INDEX scheduled_stores_idx ON stores (scheduled) WHERE scheduled;
+
+-- +goose Down
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+DROP INDEX IF EXISTS scheduled_stores_idx;
+
+ALTER TABLE stores
+  DROP COLUMN schedule,
+  DROP COLUMN scheduled;
@@ -0,0 +1,13 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE stores_cache
+  ADD COLUMN closed bool NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE stores_cache
+  DROP COLUMN closed;
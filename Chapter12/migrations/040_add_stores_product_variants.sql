@@ -0,0 +1,17 @@
+-- +goose Up
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+ALTER TABLE products
+  ADD COLUMN category text  NOT NULL DEFAULT '',
+  ADD COLUMN tags     jsonb NOT NULL DEFAULT '[]',
+  ADD COLUMN variants jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+ALTER TABLE products
+  DROP COLUMN category,
+  DROP COLUMN tags,
+  DROP COLUMN variants;
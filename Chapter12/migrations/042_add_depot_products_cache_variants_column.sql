@@ -0,0 +1,13 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE products_cache
+  ADD COLUMN variants jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE products_cache
+  DROP COLUMN variants;
@@ -0,0 +1,19 @@
+-- +goose Up
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+ALTER TABLE products
+  ADD COLUMN scheduled_prices     jsonb NOT NULL DEFAULT '[]',
+  ADD COLUMN next_price_change_at timestamptz;
+
+CREATE INDEX products_price_change_due_idx ON products (next_price_change_at) WHERE next_price_change_at IS NOT NULL;
+
+-- +goose Down
+SET
+SEARCH_PATH TO stores, PUBLIC;
+
+DROP INDEX IF EXISTS products_price_change_due_idx;
+
+ALTER TABLE products
+  DROP COLUMN scheduled_prices,
+  DROP COLUMN next_price_change_at;
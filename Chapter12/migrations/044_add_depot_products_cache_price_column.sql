@@ -0,0 +1,15 @@
+-- +goose Up
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+-- cached products without a price are priced from the stores service the next
+-- time they are found
+ALTER TABLE products_cache
+  ADD COLUMN price decimal(9, 4);
+
+-- +goose Down
+SET
+SEARCH_PATH TO depot, PUBLIC;
+
+ALTER TABLE products_cache
+  DROP COLUMN price;
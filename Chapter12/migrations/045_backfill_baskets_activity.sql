@@ -0,0 +1,31 @@
+-- +goose Up
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+-- baskets that were open before their activity was tracked are tracked from
+-- their last change so they are reminded and expired like any other basket
+INSERT INTO basket_activity (id, customer_id, last_active_at, tenant_id)
+SELECT started.stream_id,
+       convert_from(started.event_data, 'UTF8')::jsonb ->> 'CustomerID',
+       MAX(changed.occurred_at),
+       started.tenant_id
+FROM events started
+  JOIN events changed
+    ON changed.tenant_id = started.tenant_id
+      AND changed.stream_id = started.stream_id
+      AND changed.stream_name = started.stream_name
+      AND changed.event_name IN ('baskets.BasketStarted', 'baskets.BasketItemAdded', 'baskets.BasketItemRemoved',
+                                 'baskets.BasketCouponApplied', 'baskets.BasketCouponRemoved')
+WHERE started.stream_name = 'baskets.Basket'
+  AND started.event_name = 'baskets.BasketStarted'
+  AND NOT EXISTS (SELECT 1
+                  FROM events closed
+                  WHERE closed.tenant_id = started.tenant_id
+                    AND closed.stream_id = started.stream_id
+                    AND closed.stream_name = started.stream_name
+                    AND closed.event_name IN ('baskets.BasketCanceled', 'baskets.BasketCheckedOut', 'baskets.BasketExpired'))
+GROUP BY started.tenant_id, started.stream_id, started.event_data
+ON CONFLICT (tenant_id, id) DO NOTHING;
+
+-- +goose Down
+-- the backfilled activity is left in place; it is removed as the baskets close
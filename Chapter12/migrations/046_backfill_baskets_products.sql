@@ -0,0 +1,39 @@
+-- +goose Up
+SET
+SEARCH_PATH TO baskets, PUBLIC;
+
+-- the products in the baskets that were open before the products were indexed
+-- are indexed from the items added to and removed from each basket; a basket
+-- indexed for a product it no longer holds is left unchanged when the product
+-- changes
+WITH item_changes AS (
+  SELECT events.tenant_id,
+         events.stream_id,
+         CASE events.event_name
+           WHEN 'baskets.BasketItemAdded' THEN data -> 'Item' ->> 'ProductID'
+           ELSE data ->> 'ProductID'
+         END AS product_id,
+         CASE events.event_name
+           WHEN 'baskets.BasketItemAdded' THEN (data -> 'Item' ->> 'Quantity')::int
+           ELSE -(data ->> 'Quantity')::int
+         END AS quantity
+  FROM events,
+       LATERAL (SELECT convert_from(events.event_data, 'UTF8')::jsonb AS data) payload
+  WHERE events.stream_name = 'baskets.Basket'
+    AND events.event_name IN ('baskets.BasketItemAdded', 'baskets.BasketItemRemoved')
+    AND NOT EXISTS (SELECT 1
+                    FROM events closed
+                    WHERE closed.tenant_id = events.tenant_id
+                      AND closed.stream_id = events.stream_id
+                      AND closed.stream_name = events.stream_name
+                      AND closed.event_name IN ('baskets.BasketCanceled', 'baskets.BasketCheckedOut', 'baskets.BasketExpired'))
+)
+INSERT INTO basket_products (basket_id, product_id, tenant_id)
+SELECT stream_id, product_id, tenant_id
+FROM item_changes
+GROUP BY tenant_id, stream_id, product_id
+HAVING SUM(quantity) > 0
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+-- the backfilled products are left in place; they are removed as the baskets close
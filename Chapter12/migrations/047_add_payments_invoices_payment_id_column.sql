@@ -0,0 +1,13 @@
+-- +goose Up
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+ALTER TABLE invoices
+  ADD COLUMN payment_id text NOT NULL DEFAULT '';
+
+-- +goose Down
+SET
+SEARCH_PATH TO payments, PUBLIC;
+
+ALTER TABLE invoices
+  DROP COLUMN payment_id;
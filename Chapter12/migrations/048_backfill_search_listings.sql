@@ -0,0 +1,39 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE store_listings
+  ADD COLUMN synced bool NOT NULL DEFAULT TRUE;
+
+ALTER TABLE product_listings
+  ADD COLUMN synced bool NOT NULL DEFAULT TRUE;
+
+-- listings copied from the caches only hold names; the stores module shares
+-- this database so the rest is copied over from its tables
+UPDATE store_listings l
+SET name          = s.name,
+    location      = s.location,
+    participating = s.participating
+FROM stores.stores s
+WHERE s.tenant_id = l.tenant_id
+  AND s.id = l.id;
+
+UPDATE product_listings l
+SET store_id    = p.store_id,
+    name        = p.name,
+    description = p.description,
+    sku         = p.sku,
+    price       = p.price
+FROM stores.products p
+WHERE p.tenant_id = l.tenant_id
+  AND p.id = l.id;
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE store_listings
+  DROP COLUMN synced;
+
+ALTER TABLE product_listings
+  DROP COLUMN synced;
@@ -0,0 +1,35 @@
+-- +goose Up
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+-- the totals keep growing so they cannot be given a precision limit
+ALTER TABLE customer_order_stats
+  ALTER COLUMN lifetime_spend TYPE numeric,
+  ALTER COLUMN refunded TYPE numeric;
+
+ALTER TABLE store_sales
+  ALTER COLUMN revenue TYPE numeric;
+
+-- orders that were completed or canceled before the stats were kept are
+-- already counted in the customer stats; without a time they would be counted
+-- again when a rejected return completes them once more
+UPDATE orders
+SET completed_at = updated_at
+WHERE completed_at IS NULL
+  AND status IN ('completed', 'return-requested', 'returning', 'returned');
+
+UPDATE orders
+SET canceled_at = updated_at
+WHERE canceled_at IS NULL
+  AND status = 'cancelled';
+
+-- +goose Down
+SET
+SEARCH_PATH TO search, PUBLIC;
+
+ALTER TABLE customer_order_stats
+  ALTER COLUMN lifetime_spend TYPE decimal(9, 4),
+  ALTER COLUMN refunded TYPE decimal(9, 4);
+
+ALTER TABLE store_sales
+  ALTER COLUMN revenue TYPE decimal(9, 4);
@@ -2,57 +2,251 @@ package application
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eda-in-golang/notifications/internal/models"
 )
 
 type (
 	OrderCreated struct {
+		EventID    string
 		OrderID    string
 		CustomerID string
 	}
 
 	OrderCanceled struct {
+		EventID    string
 		OrderID    string
 		CustomerID string
 	}
 
 	OrderReady struct {
+		EventID    string
+		OrderID    string
+		CustomerID string
+	}
+
+	OrderReturnRequested struct {
+		EventID    string
+		OrderID    string
+		CustomerID string
+	}
+
+	RefundIssued struct {
+		EventID    string
 		OrderID    string
 		CustomerID string
+		Amount     float64
+	}
+
+	BasketAbandoned struct {
+		EventID    string
+		BasketID   string
+		CustomerID string
+		ExpiresAt  time.Time
+	}
+
+	DeliverNotifications struct {
+		Due   time.Time
+		Limit int
 	}
 
 	App interface {
 		NotifyOrderCreated(ctx context.Context, notify OrderCreated) error
 		NotifyOrderCanceled(ctx context.Context, notify OrderCanceled) error
 		NotifyOrderReady(ctx context.Context, notify OrderReady) error
+		NotifyOrderReturnRequested(ctx context.Context, notify OrderReturnRequested) error
+		NotifyRefundIssued(ctx context.Context, notify RefundIssued) error
+		NotifyBasketAbandoned(ctx context.Context, notify BasketAbandoned) error
+		DeliverNotifications(ctx context.Context, deliver DeliverNotifications) error
+	}
+
+	// DeliveryPolicy controls how often a failed notification is retried and
+	// how long a notification being delivered is kept from other deliveries
+	DeliveryPolicy struct {
+		MaxAttempts   int
+		RetryInterval time.Duration
+		ClaimTimeout  time.Duration
 	}
 
 	Application struct {
-		customers CustomerRepository
+		customers     CustomerRepository
+		notifications NotificationRepository
+		notifiers     map[models.Channel]Notifier
+		policy        DeliveryPolicy
+	}
+
+	messageData struct {
+		CustomerName string
+		OrderID      string
+		BasketID     string
+		Amount       float64
+		ExpiresAt    time.Time
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(customers CustomerRepository) *Application {
+func New(customers CustomerRepository, notifications NotificationRepository, notifiers map[models.Channel]Notifier, policy DeliveryPolicy) *Application {
 	return &Application{
-		customers: customers,
+		customers:     customers,
+		notifications: notifications,
+		notifiers:     notifiers,
+		policy:        policy,
 	}
 }
 
 func (a Application) NotifyOrderCreated(ctx context.Context, notify OrderCreated) error {
-	// not implemented
-
-	return nil
+	return a.notify(ctx, notify.EventID, notify.CustomerID, OrderCreatedKind, messageData{
+		OrderID: notify.OrderID,
+	})
 }
 
 func (a Application) NotifyOrderCanceled(ctx context.Context, notify OrderCanceled) error {
-	// not implemented
+	return a.notify(ctx, notify.EventID, notify.CustomerID, OrderCanceledKind, messageData{
+		OrderID: notify.OrderID,
+	})
+}
+
+func (a Application) NotifyOrderReady(ctx context.Context, notify OrderReady) error {
+	return a.notify(ctx, notify.EventID, notify.CustomerID, OrderReadyKind, messageData{
+		OrderID: notify.OrderID,
+	})
+}
+
+func (a Application) NotifyOrderReturnRequested(ctx context.Context, notify OrderReturnRequested) error {
+	return a.notify(ctx, notify.EventID, notify.CustomerID, OrderReturnRequestedKind, messageData{
+		OrderID: notify.OrderID,
+	})
+}
+
+func (a Application) NotifyRefundIssued(ctx context.Context, notify RefundIssued) error {
+	return a.notify(ctx, notify.EventID, notify.CustomerID, RefundIssuedKind, messageData{
+		OrderID: notify.OrderID,
+		Amount:  notify.Amount,
+	})
+}
+
+func (a Application) NotifyBasketAbandoned(ctx context.Context, notify BasketAbandoned) error {
+	return a.notify(ctx, notify.EventID, notify.CustomerID, BasketAbandonedKind, messageData{
+		BasketID:  notify.BasketID,
+		ExpiresAt: notify.ExpiresAt,
+	})
+}
+
+// DeliverNotifications sends the notifications that are due; each one is
+// claimed first so no other delivery sends it at the same time
+//
+// Notifications that come due in quiet hours the customer has set since they
+// were recorded are held back until those quiet hours end
+func (a Application) DeliverNotifications(ctx context.Context, deliver DeliverNotifications) error {
+	notifications, err := a.notifications.ClaimDue(ctx, deliver.Due, deliver.Due.Add(a.policy.ClaimTimeout), deliver.Limit)
+	if err != nil {
+		return err
+	}
+
+	customers := make(map[string]*models.Customer)
+	for _, notification := range notifications {
+		customer, exists := customers[notification.CustomerID]
+		if !exists {
+			if customer, err = a.customers.Find(ctx, notification.CustomerID); err != nil {
+				return err
+			}
+			customers[notification.CustomerID] = customer
+		}
+
+		if quietUntil := customer.QuietUntil(deliver.Due); !quietUntil.IsZero() {
+			notification.NextAttemptAt = quietUntil
+			if err = a.notifications.Update(ctx, notification); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = a.deliver(ctx, notification); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (a Application) NotifyOrderReady(ctx context.Context, notify OrderReady) error {
-	// not implemented
+// notify records one message to the customer for each of their channels; the
+// messages are sent later by DeliverNotifications so an event that is handled
+// again is never sent twice
+//
+// Kinds the customer opted out of are dropped and messages falling in their
+// quiet hours are scheduled for when the quiet hours end
+func (a Application) notify(ctx context.Context, eventID, customerID, kind string, data messageData) error {
+	customer, err := a.customers.Find(ctx, customerID)
+	if err != nil {
+		return err
+	}
+
+	if !customer.Wants(kind) {
+		return nil
+	}
+
+	now := time.Now()
+	nextAttemptAt := now
+	quietUntil := customer.QuietUntil(now)
+	if !quietUntil.IsZero() {
+		nextAttemptAt = quietUntil
+	}
+
+	data.CustomerName = customer.Name
+
+	subject, body, err := renderMessage(kind, data)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range customer.Channels {
+		recipient := customer.Recipient(channel)
+		if recipient == "" {
+			continue
+		}
+
+		notification := &models.Notification{
+			ID:         uuid.New().String(),
+			EventID:    eventID,
+			CustomerID: customer.ID,
+			Kind:       kind,
+			Channel:    channel,
+			Message: models.Message{
+				Recipient: recipient,
+				Subject:   subject,
+				Body:      body,
+			},
+			Status:        models.NotificationIsPending,
+			NextAttemptAt: nextAttemptAt,
+		}
+
+		if _, err = a.notifications.Add(ctx, notification); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// deliver makes one attempt at sending the notification; failures are kept
+// on the notification to be retried later and are not returned
+func (a Application) deliver(ctx context.Context, notification *models.Notification) error {
+	notifier, exists := a.notifiers[notification.Channel]
+	if !exists {
+		notification.Status = models.NotificationIsFailed
+		notification.LastError = "no notifier for the " + notification.Channel.String() + " channel"
+		return a.notifications.Update(ctx, notification)
+	}
+
+	if err := notifier.Notify(ctx, notification.Message); err != nil {
+		notification.AttemptFailed(err, time.Now(), a.policy.MaxAttempts, a.policy.RetryInterval)
+	} else {
+		notification.Delivered(time.Now())
+	}
+
+	return a.notifications.Update(ctx, notification)
+}
@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/notifications/internal/models"
+)
+
+type mocks struct {
+	customers     *MockCustomerRepository
+	notifications *MockNotificationRepository
+	sms           *MockNotifier
+	email         *MockNotifier
+}
+
+func newMocks(t *testing.T) mocks {
+	return mocks{
+		customers:     NewMockCustomerRepository(t),
+		notifications: NewMockNotificationRepository(t),
+		sms:           NewMockNotifier(t),
+		email:         NewMockNotifier(t),
+	}
+}
+
+func (m mocks) app() *Application {
+	return New(m.customers, m.notifications, map[models.Channel]Notifier{
+		models.ChannelSms:   m.sms,
+		models.ChannelEmail: m.email,
+	}, DeliveryPolicy{
+		MaxAttempts:   3,
+		RetryInterval: time.Minute,
+		ClaimTimeout:  2 * time.Minute,
+	})
+}
+
+func TestApplication_NotifyOrderReady(t *testing.T) {
+	tests := map[string]struct {
+		customer  *models.Customer
+		wantAdded []models.Channel
+	}{
+		"EachChannel": {
+			customer: &models.Customer{
+				ID:        "customer-id",
+				Name:      "customer",
+				SmsNumber: "555-0100",
+				NotificationPreferences: models.NotificationPreferences{
+					Channels: []models.Channel{models.ChannelSms, models.ChannelEmail},
+					Email:    "customer@example.com",
+				},
+			},
+			wantAdded: []models.Channel{models.ChannelSms, models.ChannelEmail},
+		},
+		"NoRecipient": {
+			customer: &models.Customer{
+				ID:        "customer-id",
+				Name:      "customer",
+				SmsNumber: "555-0100",
+				NotificationPreferences: models.NotificationPreferences{
+					Channels: []models.Channel{models.ChannelSms, models.ChannelEmail},
+				},
+			},
+			wantAdded: []models.Channel{models.ChannelSms},
+		},
+		"OptedOut": {
+			customer: &models.Customer{
+				ID:        "customer-id",
+				Name:      "customer",
+				SmsNumber: "555-0100",
+				NotificationPreferences: models.NotificationPreferences{
+					Channels: []models.Channel{models.ChannelSms},
+					Kinds:    map[string]bool{OrderReadyKind: false},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newMocks(t)
+			m.customers.On("Find", context.Background(), "customer-id").Return(tc.customer, nil)
+			for _, channel := range tc.wantAdded {
+				channel := channel
+				m.notifications.On("Add", context.Background(), mock.MatchedBy(func(notification *models.Notification) bool {
+					return notification.Channel == channel &&
+						notification.EventID == "event-id" &&
+						notification.Status == models.NotificationIsPending
+				})).Return(true, nil).Once()
+			}
+
+			err := m.app().NotifyOrderReady(context.Background(), OrderReady{
+				EventID:    "event-id",
+				OrderID:    "order-id",
+				CustomerID: "customer-id",
+			})
+			assert.NoError(t, err)
+			// nothing is sent while the event is being handled
+			m.sms.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+			m.email.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestApplication_DeliverNotifications(t *testing.T) {
+	due := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	sms := &models.Notification{
+		ID:         "sms-id",
+		CustomerID: "customer-id",
+		Channel:    models.ChannelSms,
+		Message:    models.Message{Recipient: "555-0100", Body: "ready"},
+		Status:     models.NotificationIsPending,
+	}
+	email := &models.Notification{
+		ID:         "email-id",
+		CustomerID: "customer-id",
+		Channel:    models.ChannelEmail,
+		Message:    models.Message{Recipient: "customer@example.com", Body: "ready"},
+		Status:     models.NotificationIsPending,
+		Attempts:   2,
+	}
+	webhook := &models.Notification{
+		ID:         "webhook-id",
+		CustomerID: "customer-id",
+		Channel:    models.ChannelWebhook,
+		Message:    models.Message{Recipient: "https://example.com/hook", Body: "ready"},
+		Status:     models.NotificationIsPending,
+	}
+
+	m := newMocks(t)
+	m.notifications.On("ClaimDue", context.Background(), due, due.Add(2*time.Minute), 10).
+		Return([]*models.Notification{sms, email, webhook}, nil)
+	m.customers.On("Find", context.Background(), "customer-id").Return(&models.Customer{ID: "customer-id"}, nil).Once()
+	m.sms.On("Notify", context.Background(), sms.Message).Return(nil)
+	m.email.On("Notify", context.Background(), email.Message).Return(fmt.Errorf("mailbox unavailable"))
+	m.notifications.On("Update", context.Background(), mock.AnythingOfType("*models.Notification")).Return(nil).Times(3)
+
+	err := m.app().DeliverNotifications(context.Background(), DeliverNotifications{Due: due, Limit: 10})
+	assert.NoError(t, err)
+
+	assert.Equal(t, models.NotificationIsDelivered, sms.Status)
+	assert.Equal(t, 1, sms.Attempts)
+
+	assert.Equal(t, models.NotificationIsFailed, email.Status)
+	assert.Equal(t, 3, email.Attempts)
+	assert.Equal(t, "mailbox unavailable", email.LastError)
+
+	assert.Equal(t, models.NotificationIsFailed, webhook.Status)
+	assert.Equal(t, 0, webhook.Attempts)
+}
+
+func TestApplication_DeliverNotifications_QuietHours(t *testing.T) {
+	due := time.Date(2026, 10, 19, 23, 30, 0, 0, time.UTC)
+
+	notification := &models.Notification{
+		ID:         "sms-id",
+		CustomerID: "customer-id",
+		Channel:    models.ChannelSms,
+		Message:    models.Message{Recipient: "555-0100", Body: "ready"},
+		Status:     models.NotificationIsPending,
+		Attempts:   1,
+	}
+
+	m := newMocks(t)
+	m.notifications.On("ClaimDue", context.Background(), due, due.Add(2*time.Minute), 10).
+		Return([]*models.Notification{notification}, nil)
+	m.customers.On("Find", context.Background(), "customer-id").Return(&models.Customer{
+		ID: "customer-id",
+		NotificationPreferences: models.NotificationPreferences{
+			QuietStart: "22:00",
+			QuietEnd:   "07:00",
+			TimeZone:   "UTC",
+		},
+	}, nil)
+	m.notifications.On("Update", context.Background(), notification).Return(nil)
+
+	err := m.app().DeliverNotifications(context.Background(), DeliverNotifications{Due: due, Limit: 10})
+	assert.NoError(t, err)
+
+	m.sms.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+	assert.Equal(t, models.NotificationIsPending, notification.Status)
+	assert.Equal(t, 1, notification.Attempts)
+	assert.Equal(t, time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC), notification.NextAttemptAt)
+}
@@ -2,10 +2,14 @@ package application
 
 import (
 	"context"
+
+	"eda-in-golang/notifications/internal/models"
 )
 
 type CustomerCacheRepository interface {
 	Add(ctx context.Context, customerID, name, smsNumber string) error
 	UpdateSmsNumber(ctx context.Context, customerID, smsNumber string) error
+	UpdateNotificationPreferences(ctx context.Context, customerID string, preferences models.NotificationPreferences) error
+	Remove(ctx context.Context, customerID string) error
 	CustomerRepository
 }
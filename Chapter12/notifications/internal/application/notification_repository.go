@@ -0,0 +1,19 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/notifications/internal/models"
+)
+
+type NotificationRepository interface {
+	// Add records a new notification; it reports false when the event was
+	// already sent to the customer over the same channel
+	Add(ctx context.Context, notification *models.Notification) (bool, error)
+	Update(ctx context.Context, notification *models.Notification) error
+	// ClaimDue returns the pending notifications that are due and pushes their
+	// next attempt out to claimUntil; notifications already being claimed by
+	// another delivery are skipped
+	ClaimDue(ctx context.Context, due, claimUntil time.Time, limit int) ([]*models.Notification, error)
+}
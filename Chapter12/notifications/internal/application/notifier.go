@@ -0,0 +1,12 @@
+package application
+
+import (
+	"context"
+
+	"eda-in-golang/notifications/internal/models"
+)
+
+// Notifier delivers messages over a single channel
+type Notifier interface {
+	Notify(ctx context.Context, message models.Message) error
+}
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/stackus/errors"
+)
+
+const (
+	OrderCreatedKind         = "order_created"
+	OrderCanceledKind        = "order_canceled"
+	OrderReadyKind           = "order_ready"
+	OrderReturnRequestedKind = "order_return_requested"
+	RefundIssuedKind         = "refund_issued"
+	BasketAbandonedKind      = "basket_abandoned"
+)
+
+type messageTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+var messageTemplates = map[string]messageTemplate{
+	OrderCreatedKind: newMessageTemplate(
+		"Order received",
+		"Hi {{.CustomerName}}, we have received your order {{.OrderID}} and will let you know when it is ready.",
+	),
+	OrderCanceledKind: newMessageTemplate(
+		"Order canceled",
+		"Hi {{.CustomerName}}, your order {{.OrderID}} has been canceled.",
+	),
+	OrderReadyKind: newMessageTemplate(
+		"Order ready for pickup",
+		"Hi {{.CustomerName}}, your order {{.OrderID}} is ready for pickup.",
+	),
+	OrderReturnRequestedKind: newMessageTemplate(
+		"Return requested",
+		"Hi {{.CustomerName}}, we have received the return request for your order {{.OrderID}} and will review it shortly.",
+	),
+	RefundIssuedKind: newMessageTemplate(
+		"Refund issued",
+		"Hi {{.CustomerName}}, a refund of {{printf \"%.2f\" .Amount}} has been issued for your order {{.OrderID}}.",
+	),
+	BasketAbandonedKind: newMessageTemplate(
+		"Your basket is waiting",
+		"Hi {{.CustomerName}}, you still have items in your basket. It will be emptied at {{.ExpiresAt.Format \"Jan 2 15:04 MST\"}}.",
+	),
+}
+
+func newMessageTemplate(subject, body string) messageTemplate {
+	return messageTemplate{
+		subject: template.Must(template.New("subject").Parse(subject)),
+		body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+func renderMessage(kind string, data any) (subject, body string, err error) {
+	tmpl, exists := messageTemplates[kind]
+	if !exists {
+		return "", "", errors.ErrInternal.Msgf("no message template for `%s` notifications", kind)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.subject.Execute(&buf, data); err != nil {
+		return "", "", errors.Wrap(err, "rendering notification subject")
+	}
+	subject = buf.String()
+
+	buf.Reset()
+	if err = tmpl.body.Execute(&buf, data); err != nil {
+		return "", "", errors.Wrap(err, "rendering notification body")
+	}
+
+	return subject, buf.String(), nil
+}
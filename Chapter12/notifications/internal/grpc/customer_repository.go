@@ -40,12 +40,31 @@ func (r CustomerRepository) Find(ctx context.Context, customerID string) (custom
 	}
 
 	return &models.Customer{
-		ID:        resp.GetCustomer().GetId(),
-		Name:      resp.GetCustomer().GetName(),
-		SmsNumber: resp.GetCustomer().GetSmsNumber(),
+		ID:                      resp.GetCustomer().GetId(),
+		Name:                    resp.GetCustomer().GetName(),
+		SmsNumber:               resp.GetCustomer().GetSmsNumber(),
+		NotificationPreferences: r.preferencesToDomain(resp.GetCustomer().GetNotificationPreferences()),
 	}, nil
 }
 
+func (r CustomerRepository) preferencesToDomain(preferences *customerspb.NotificationPreferences) models.NotificationPreferences {
+	channels := models.ToChannels(preferences.GetChannels())
+	// customers that have never set their preferences
+	if len(channels) == 0 {
+		channels = []models.Channel{models.ChannelSms}
+	}
+
+	return models.NotificationPreferences{
+		Channels:   channels,
+		Email:      preferences.GetEmail(),
+		WebhookURL: preferences.GetWebhookUrl(),
+		QuietStart: preferences.GetQuietStart(),
+		QuietEnd:   preferences.GetQuietEnd(),
+		TimeZone:   preferences.GetTimeZone(),
+		Kinds:      preferences.GetKinds(),
+	}
+}
+
 func (r CustomerRepository) dial(ctx context.Context) (*grpc.ClientConn, error) {
 	return rpc.Dial(ctx, r.endpoint)
 }
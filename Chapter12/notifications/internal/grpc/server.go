@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -35,6 +36,7 @@ func (s server) NotifyOrderCreated(ctx context.Context, request *notificationspb
 	)
 
 	err := s.app.NotifyOrderCreated(ctx, application.OrderCreated{
+		EventID:    uuid.New().String(),
 		OrderID:    request.GetOrderId(),
 		CustomerID: request.GetCustomerId(),
 	})
@@ -56,6 +58,7 @@ func (s server) NotifyOrderCanceled(ctx context.Context, request *notificationsp
 	)
 
 	err := s.app.NotifyOrderCanceled(ctx, application.OrderCanceled{
+		EventID:    uuid.New().String(),
 		OrderID:    request.GetOrderId(),
 		CustomerID: request.GetCustomerId(),
 	})
@@ -77,6 +80,7 @@ func (s server) NotifyOrderReady(ctx context.Context, request *notificationspb.N
 	)
 
 	err := s.app.NotifyOrderReady(ctx, application.OrderReady{
+		EventID:    uuid.New().String(),
 		OrderID:    request.GetOrderId(),
 		CustomerID: request.GetCustomerId(),
 	})
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/notifications/internal/application"
+)
+
+// StartDeliveries periodically sends the notifications that are due, which
+// are new notifications and the ones that could not be delivered before; the
+// deliveries of each tenant are made on their own
+//
+// Delivering outside the event handlers keeps a message that is handled again
+// from being sent again
+func StartDeliveries(ctx context.Context, app application.App, cfg config.NotificationsConfig, tenants tenant.Tenants, logger zerolog.Logger) {
+	go func() {
+		ticker := time.NewTicker(cfg.DeliveryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, tenantID := range tenants.IDs() {
+					err := app.DeliverNotifications(tenant.WithID(ctx, tenantID), application.DeliverNotifications{
+						Due:   time.Now(),
+						Limit: cfg.DeliveryBatchSize,
+					})
+					if err != nil {
+						logger.Error().Err(err).Str("Tenant", tenantID).Msg("notifications deliveries encountered an error")
+					}
+				}
+			}
+		}
+	}()
+}
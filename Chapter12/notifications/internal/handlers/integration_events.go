@@ -7,13 +7,16 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"eda-in-golang/baskets/basketspb"
 	"eda-in-golang/customers/customerspb"
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/errorsotel"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
 	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
 )
 
 type integrationHandlers[T ddd.Event] struct {
@@ -34,6 +37,8 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	_, err = subscriber.Subscribe(customerspb.CustomerAggregateChannel, handlers, am.MessageFilter{
 		customerspb.CustomerRegisteredEvent,
 		customerspb.CustomerSmsChangedEvent,
+		customerspb.CustomerForgottenEvent,
+		customerspb.CustomerNotificationPreferencesChangedEvent,
 	}, am.GroupName("notification-customers"))
 	if err != nil {
 		return err
@@ -44,7 +49,22 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 		orderingpb.OrderReadiedEvent,
 		orderingpb.OrderCanceledEvent,
 		orderingpb.OrderCompletedEvent,
+		orderingpb.OrderReturnRequestedEvent,
 	}, am.GroupName("notification-orders"))
+	if err != nil {
+		return err
+	}
+
+	_, err = subscriber.Subscribe(paymentspb.RefundAggregateChannel, handlers, am.MessageFilter{
+		paymentspb.RefundIssuedEvent,
+	}, am.GroupName("notification-refunds"))
+	if err != nil {
+		return err
+	}
+
+	_, err = subscriber.Subscribe(basketspb.BasketAggregateChannel, handlers, am.MessageFilter{
+		basketspb.BasketAbandonedEvent,
+	}, am.GroupName("notification-baskets"))
 	return err
 }
 
@@ -71,12 +91,22 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 		return h.onCustomerRegistered(ctx, event)
 	case customerspb.CustomerSmsChangedEvent:
 		return h.onCustomerSmsChanged(ctx, event)
+	case customerspb.CustomerForgottenEvent:
+		return h.onCustomerForgotten(ctx, event)
+	case customerspb.CustomerNotificationPreferencesChangedEvent:
+		return h.onCustomerNotificationPreferencesChanged(ctx, event)
 	case orderingpb.OrderCreatedEvent:
 		return h.onOrderCreated(ctx, event)
 	case orderingpb.OrderReadiedEvent:
 		return h.onOrderReadied(ctx, event)
 	case orderingpb.OrderCanceledEvent:
 		return h.onOrderCanceled(ctx, event)
+	case orderingpb.OrderReturnRequestedEvent:
+		return h.onOrderReturnRequested(ctx, event)
+	case paymentspb.RefundIssuedEvent:
+		return h.onRefundIssued(ctx, event)
+	case basketspb.BasketAbandonedEvent:
+		return h.onBasketAbandoned(ctx, event)
 	}
 
 	return nil
@@ -92,9 +122,29 @@ func (h integrationHandlers[T]) onCustomerSmsChanged(ctx context.Context, event
 	return h.customers.UpdateSmsNumber(ctx, payload.GetId(), payload.GetSmsNumber())
 }
 
+func (h integrationHandlers[T]) onCustomerForgotten(ctx context.Context, event T) error {
+	payload := event.Payload().(*customerspb.CustomerForgotten)
+	return h.customers.Remove(ctx, payload.GetId())
+}
+
+func (h integrationHandlers[T]) onCustomerNotificationPreferencesChanged(ctx context.Context, event T) error {
+	payload := event.Payload().(*customerspb.CustomerNotificationPreferencesChanged)
+	preferences := payload.GetPreferences()
+	return h.customers.UpdateNotificationPreferences(ctx, payload.GetId(), models.NotificationPreferences{
+		Channels:   models.ToChannels(preferences.GetChannels()),
+		Email:      preferences.GetEmail(),
+		WebhookURL: preferences.GetWebhookUrl(),
+		QuietStart: preferences.GetQuietStart(),
+		QuietEnd:   preferences.GetQuietEnd(),
+		TimeZone:   preferences.GetTimeZone(),
+		Kinds:      preferences.GetKinds(),
+	})
+}
+
 func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCreated)
 	return h.app.NotifyOrderCreated(ctx, application.OrderCreated{
+		EventID:    event.ID(),
 		OrderID:    payload.GetId(),
 		CustomerID: payload.GetCustomerId(),
 	})
@@ -103,6 +153,7 @@ func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event T) err
 func (h integrationHandlers[T]) onOrderReadied(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderReadied)
 	return h.app.NotifyOrderReady(ctx, application.OrderReady{
+		EventID:    event.ID(),
 		OrderID:    payload.GetId(),
 		CustomerID: payload.GetCustomerId(),
 	})
@@ -111,7 +162,37 @@ func (h integrationHandlers[T]) onOrderReadied(ctx context.Context, event T) err
 func (h integrationHandlers[T]) onOrderCanceled(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCanceled)
 	return h.app.NotifyOrderCanceled(ctx, application.OrderCanceled{
+		EventID:    event.ID(),
 		OrderID:    payload.GetId(),
 		CustomerID: payload.GetCustomerId(),
 	})
 }
+
+func (h integrationHandlers[T]) onOrderReturnRequested(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderReturnRequested)
+	return h.app.NotifyOrderReturnRequested(ctx, application.OrderReturnRequested{
+		EventID:    event.ID(),
+		OrderID:    payload.GetId(),
+		CustomerID: payload.GetCustomerId(),
+	})
+}
+
+func (h integrationHandlers[T]) onRefundIssued(ctx context.Context, event T) error {
+	payload := event.Payload().(*paymentspb.RefundIssued)
+	return h.app.NotifyRefundIssued(ctx, application.RefundIssued{
+		EventID:    event.ID(),
+		OrderID:    payload.GetOrderId(),
+		CustomerID: payload.GetCustomerId(),
+		Amount:     payload.GetAmount(),
+	})
+}
+
+func (h integrationHandlers[T]) onBasketAbandoned(ctx context.Context, event T) error {
+	payload := event.Payload().(*basketspb.BasketAbandoned)
+	return h.app.NotifyBasketAbandoned(ctx, application.BasketAbandoned{
+		EventID:    event.ID(),
+		BasketID:   payload.GetId(),
+		CustomerID: payload.GetCustomerId(),
+		ExpiresAt:  payload.GetExpiresAt().AsTime(),
+	})
+}
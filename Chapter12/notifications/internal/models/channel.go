@@ -0,0 +1,43 @@
+package models
+
+type Channel string
+
+const (
+	ChannelUnknown Channel = ""
+	ChannelSms     Channel = "sms"
+	ChannelEmail   Channel = "email"
+	ChannelWebhook Channel = "webhook"
+)
+
+func (c Channel) String() string {
+	switch c {
+	case ChannelSms, ChannelEmail, ChannelWebhook:
+		return string(c)
+	default:
+		return ""
+	}
+}
+
+func ToChannel(channel string) Channel {
+	switch channel {
+	case ChannelSms.String():
+		return ChannelSms
+	case ChannelEmail.String():
+		return ChannelEmail
+	case ChannelWebhook.String():
+		return ChannelWebhook
+	default:
+		return ChannelUnknown
+	}
+}
+
+// ToChannels drops the channels that are not supported
+func ToChannels(channels []string) []Channel {
+	var result []Channel
+	for _, channel := range channels {
+		if c := ToChannel(channel); c != ChannelUnknown {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -1,7 +1,96 @@
 package models
 
+import (
+	"time"
+)
+
 type Customer struct {
 	ID        string
 	Name      string
 	SmsNumber string
+	NotificationPreferences
+}
+
+// NotificationPreferences are kept in step with the preferences the customer
+// sets in the customers module
+type NotificationPreferences struct {
+	Channels   []Channel
+	Email      string
+	WebhookURL string
+	QuietStart string
+	QuietEnd   string
+	TimeZone   string
+	// Kinds holds the explicit opt-ins and opt-outs per kind of notification
+	Kinds map[string]bool
+}
+
+const quietHoursLayout = "15:04"
+
+// Recipient is the address a notification sent over the channel is delivered to
+func (c Customer) Recipient(channel Channel) string {
+	switch channel {
+	case ChannelSms:
+		return c.SmsNumber
+	case ChannelEmail:
+		return c.Email
+	case ChannelWebhook:
+		return c.WebhookURL
+	default:
+		return ""
+	}
+}
+
+// Wants is false only for the kinds of notification the customer opted out of
+func (p NotificationPreferences) Wants(kind string) bool {
+	wanted, exists := p.Kinds[kind]
+	return !exists || wanted
+}
+
+// QuietUntil returns the end of the quiet hours the time falls in, or the
+// zero time when notifications may be sent right away
+func (p NotificationPreferences) QuietUntil(at time.Time) time.Time {
+	if p.QuietStart == "" || p.QuietEnd == "" || p.QuietStart == p.QuietEnd {
+		return time.Time{}
+	}
+
+	location, err := time.LoadLocation(p.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := at.In(location)
+
+	start, err := wallClock(local, p.QuietStart)
+	if err != nil {
+		return time.Time{}
+	}
+	end, err := wallClock(local, p.QuietEnd)
+	if err != nil {
+		return time.Time{}
+	}
+
+	if end.After(start) {
+		if !local.Before(start) && local.Before(end) {
+			return end
+		}
+		return time.Time{}
+	}
+
+	// the quiet hours span midnight
+	switch {
+	case local.Before(end):
+		return end
+	case !local.Before(start):
+		return end.AddDate(0, 0, 1)
+	default:
+		return time.Time{}
+	}
+}
+
+func wallClock(day time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse(quietHoursLayout, clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location()), nil
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationPreferences_QuietUntil(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 19, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := map[string]struct {
+		preferences NotificationPreferences
+		at          time.Time
+		want        time.Time
+	}{
+		"NoQuietHours": {
+			preferences: NotificationPreferences{},
+			at:          day(3, 0),
+		},
+		"SameStartAndEnd": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "22:00"},
+			at:          day(22, 0),
+		},
+		"DuringTheDay": {
+			preferences: NotificationPreferences{QuietStart: "12:00", QuietEnd: "14:00"},
+			at:          day(13, 0),
+			want:        day(14, 0),
+		},
+		"BeforeTheDay": {
+			preferences: NotificationPreferences{QuietStart: "12:00", QuietEnd: "14:00"},
+			at:          day(11, 59),
+		},
+		"AtTheDayEnd": {
+			preferences: NotificationPreferences{QuietStart: "12:00", QuietEnd: "14:00"},
+			at:          day(14, 0),
+		},
+		"BeforeMidnight": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00"},
+			at:          day(23, 30),
+			want:        time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC),
+		},
+		"AtTheNightStart": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00"},
+			at:          day(22, 0),
+			want:        time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC),
+		},
+		"AfterMidnight": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00"},
+			at:          day(2, 0),
+			want:        day(7, 0),
+		},
+		"AtTheNightEnd": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00"},
+			at:          day(7, 0),
+		},
+		"DaytimeOutsideTheNight": {
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00"},
+			at:          day(12, 0),
+		},
+		"InTheCustomerTimeZone": {
+			// 03:30 UTC is 23:30 the day before in New York
+			preferences: NotificationPreferences{QuietStart: "22:00", QuietEnd: "07:00", TimeZone: "America/New_York"},
+			at:          day(3, 30),
+			want:        time.Date(2026, 10, 19, 7, 0, 0, 0, newYork),
+		},
+		"UnparsableHours": {
+			preferences: NotificationPreferences{QuietStart: "late", QuietEnd: "07:00"},
+			at:          day(23, 0),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tc.preferences.QuietUntil(tc.at)
+			assert.True(t, tc.want.Equal(got), "want %v, got %v", tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+type NotificationStatus string
+
+const (
+	NotificationIsUnknown   NotificationStatus = ""
+	NotificationIsPending   NotificationStatus = "pending"
+	NotificationIsDelivered NotificationStatus = "delivered"
+	NotificationIsFailed    NotificationStatus = "failed"
+)
+
+// Notification is a single delivery of a message to a customer over one channel
+type Notification struct {
+	ID            string
+	EventID       string
+	CustomerID    string
+	Kind          string
+	Channel       Channel
+	Message       Message
+	Status        NotificationStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	DeliveredAt   time.Time
+}
+
+type Message struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+func (s NotificationStatus) String() string {
+	switch s {
+	case NotificationIsPending, NotificationIsDelivered, NotificationIsFailed:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func ToNotificationStatus(status string) NotificationStatus {
+	switch status {
+	case NotificationIsPending.String():
+		return NotificationIsPending
+	case NotificationIsDelivered.String():
+		return NotificationIsDelivered
+	case NotificationIsFailed.String():
+		return NotificationIsFailed
+	default:
+		return NotificationIsUnknown
+	}
+}
+
+func (n *Notification) Delivered(at time.Time) {
+	n.Attempts++
+	n.Status = NotificationIsDelivered
+	n.LastError = ""
+	n.DeliveredAt = at
+}
+
+// AttemptFailed schedules the next attempt, backing off linearly, or gives up
+// on the notification once it has used every attempt
+func (n *Notification) AttemptFailed(err error, at time.Time, maxAttempts int, interval time.Duration) {
+	n.Attempts++
+	n.LastError = err.Error()
+
+	if n.Attempts >= maxAttempts {
+		n.Status = NotificationIsFailed
+		return
+	}
+
+	n.NextAttemptAt = at.Add(time.Duration(n.Attempts) * interval)
+}
@@ -0,0 +1,35 @@
+package notifiers
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+
+	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
+)
+
+// EmailNotifier sends messages through an SMTP relay from the address of the
+// tenant the message is sent for
+type EmailNotifier struct {
+	cfg config.NotificationsConfig
+}
+
+var _ application.Notifier = (*EmailNotifier)(nil)
+
+func NewEmailNotifier(cfg config.NotificationsConfig) EmailNotifier {
+	return EmailNotifier{
+		cfg: cfg,
+	}
+}
+
+func (n EmailNotifier) Notify(ctx context.Context, message models.Message) error {
+	from := n.cfg.ForTenant(tenant.FromContext(ctx)).EmailFrom
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		from, message.Recipient, message.Subject, message.Body,
+	)
+
+	return smtp.SendMail(n.cfg.SmtpAddr, nil, from, []string{message.Recipient}, []byte(msg))
+}
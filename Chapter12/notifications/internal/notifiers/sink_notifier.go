@@ -0,0 +1,83 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
+)
+
+// LogNotifier stands in for a channel during local runs by logging each message
+type LogNotifier struct {
+	channel models.Channel
+	logger  zerolog.Logger
+}
+
+var _ application.Notifier = (*LogNotifier)(nil)
+
+func NewLogNotifier(channel models.Channel, logger zerolog.Logger) LogNotifier {
+	return LogNotifier{
+		channel: channel,
+		logger:  logger,
+	}
+}
+
+func (n LogNotifier) Notify(_ context.Context, message models.Message) error {
+	n.logger.Info().
+		Str("Channel", n.channel.String()).
+		Str("Recipient", message.Recipient).
+		Str("Subject", message.Subject).
+		Msg(message.Body)
+
+	return nil
+}
+
+// FileNotifier stands in for a channel during local runs by appending each
+// message to a file as a line of JSON
+type FileNotifier struct {
+	channel models.Channel
+	mu      *sync.Mutex
+	w       io.Writer
+}
+
+var _ application.Notifier = (*FileNotifier)(nil)
+
+func NewFileNotifier(channel models.Channel, mu *sync.Mutex, w io.Writer) FileNotifier {
+	return FileNotifier{
+		channel: channel,
+		mu:      mu,
+		w:       w,
+	}
+}
+
+func (n FileNotifier) Notify(_ context.Context, message models.Message) error {
+	data, err := json.Marshal(struct {
+		Channel   string    `json:"channel"`
+		Recipient string    `json:"recipient"`
+		Subject   string    `json:"subject"`
+		Body      string    `json:"body"`
+		SentAt    time.Time `json:"sent_at"`
+	}{
+		Channel:   n.channel.String(),
+		Recipient: message.Recipient,
+		Subject:   message.Subject,
+		Body:      message.Body,
+		SentAt:    time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err = n.w.Write(append(data, '\n'))
+
+	return err
+}
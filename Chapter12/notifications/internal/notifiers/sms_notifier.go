@@ -0,0 +1,34 @@
+package notifiers
+
+import (
+	"context"
+	"net/http"
+
+	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
+)
+
+// SmsNotifier hands messages to an SMS provider's HTTP API
+type SmsNotifier struct {
+	endpoint string
+	client   *http.Client
+}
+
+var _ application.Notifier = (*SmsNotifier)(nil)
+
+func NewSmsNotifier(endpoint string, client *http.Client) SmsNotifier {
+	return SmsNotifier{
+		endpoint: endpoint,
+		client:   client,
+	}
+}
+
+func (n SmsNotifier) Notify(ctx context.Context, message models.Message) error {
+	return postJSON(ctx, n.client, n.endpoint, struct {
+		To   string `json:"to"`
+		Body string `json:"body"`
+	}{
+		To:   message.Recipient,
+		Body: message.Body,
+	})
+}
@@ -0,0 +1,39 @@
+package notifiers
+
+import (
+	"context"
+	"net/http"
+
+	"eda-in-golang/internal/web"
+	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
+)
+
+// WebhookNotifier posts messages to the URL the customer has registered; the
+// client is expected to refuse internal addresses, see web.NewPublicClient
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+var _ application.Notifier = (*WebhookNotifier)(nil)
+
+func NewWebhookNotifier(client *http.Client) WebhookNotifier {
+	return WebhookNotifier{
+		client: client,
+	}
+}
+
+func (n WebhookNotifier) Notify(ctx context.Context, message models.Message) error {
+	// URLs registered before they were checked are refused here instead
+	if err := web.CheckWebhookURL(message.Recipient); err != nil {
+		return err
+	}
+
+	return postJSON(ctx, n.client, message.Recipient, struct {
+		Subject string `json:"subject"`
+		Body    string `json:"body"`
+	}{
+		Subject: message.Subject,
+		Body:    message.Body,
+	})
+}
@@ -3,11 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/notifications/internal/application"
 	"eda-in-golang/notifications/internal/models"
 )
@@ -29,29 +32,58 @@ func NewCustomerCacheRepository(tableName string, db postgres.DB, fallback appli
 }
 
 func (r CustomerCacheRepository) Add(ctx context.Context, customerID, name, smsNumber string) error {
-	const query = "INSERT INTO %s (id, NAME, sms_number) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING"
+	const query = "INSERT INTO %s (id, NAME, sms_number, tenant_id) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), customerID, name, smsNumber)
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, name, smsNumber, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CustomerCacheRepository) UpdateSmsNumber(ctx context.Context, customerID, smsNumber string) error {
-	const query = `UPDATE %s SET sms_number = $2 WHERE customerID = $1`
+	const query = `UPDATE %s SET sms_number = $2 WHERE id = $1 AND tenant_id = $3`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), customerID, smsNumber)
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, smsNumber, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r CustomerCacheRepository) UpdateNotificationPreferences(ctx context.Context, customerID string, preferences models.NotificationPreferences) error {
+	const query = `UPDATE %s SET channels = $2, email = $3, webhook_url = $4, quiet_start = $5, quiet_end = $6, time_zone = $7, kinds = $8
+WHERE id = $1 AND tenant_id = $9`
+
+	kinds, err := json.Marshal(preferences.Kinds)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), customerID, r.channelsFromDomain(preferences.Channels),
+		preferences.Email, preferences.WebhookURL, preferences.QuietStart, preferences.QuietEnd, preferences.TimeZone, kinds, tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+func (r CustomerCacheRepository) Remove(ctx context.Context, customerID string) error {
+	const query = `DELETE FROM %s WHERE id = $1 AND tenant_id = $2`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CustomerCacheRepository) Find(ctx context.Context, customerID string) (*models.Customer, error) {
-	const query = `SELECT name, sms_number FROM %s WHERE id = $1 LIMIT 1`
+	const query = `SELECT name, sms_number, email, webhook_url, channels, quiet_start, quiet_end, time_zone, kinds
+FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
 
 	customer := &models.Customer{
 		ID: customerID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), customerID).Scan(&customer.Name, &customer.SmsNumber)
+	var channels string
+	var kinds []byte
+	err := r.db.QueryRowContext(ctx, r.table(query), customerID, tenant.FromContext(ctx)).Scan(&customer.Name, &customer.SmsNumber,
+		&customer.Email, &customer.WebhookURL, &channels, &customer.QuietStart, &customer.QuietEnd, &customer.TimeZone, &kinds,
+	)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning customer")
@@ -61,12 +93,32 @@ func (r CustomerCacheRepository) Find(ctx context.Context, customerID string) (*
 			return nil, errors.Wrap(err, "customer fallback failed")
 		}
 		// attempt to add it to the cache
-		return customer, r.Add(ctx, customer.ID, customer.Name, customer.SmsNumber)
+		if err = r.Add(ctx, customer.ID, customer.Name, customer.SmsNumber); err != nil {
+			return customer, err
+		}
+		return customer, r.UpdateNotificationPreferences(ctx, customer.ID, customer.NotificationPreferences)
+	}
+
+	customer.Channels = r.channelsToDomain(channels)
+	if err = json.Unmarshal(kinds, &customer.Kinds); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling notification kinds")
 	}
 
 	return customer, nil
 }
 
+func (r CustomerCacheRepository) channelsToDomain(channels string) []models.Channel {
+	return models.ToChannels(strings.Split(channels, ","))
+}
+
+func (r CustomerCacheRepository) channelsFromDomain(channels []models.Channel) string {
+	result := make([]string, len(channels))
+	for i, channel := range channels {
+		result[i] = channel.String()
+	}
+	return strings.Join(result, ",")
+}
+
 func (r CustomerCacheRepository) table(query string) string {
 	return fmt.Sprintf(query, r.tableName)
 }
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/notifications/internal/application"
+	"eda-in-golang/notifications/internal/models"
+)
+
+type NotificationRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ application.NotificationRepository = (*NotificationRepository)(nil)
+
+func NewNotificationRepository(tableName string, db postgres.DB) NotificationRepository {
+	return NotificationRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r NotificationRepository) Add(ctx context.Context, notification *models.Notification) (bool, error) {
+	const query = `INSERT INTO %s (id, event_id, customer_id, kind, channel, recipient, subject, body, status, attempts, next_attempt_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (tenant_id, event_id, channel) DO NOTHING`
+
+	result, err := r.db.ExecContext(ctx, r.table(query),
+		notification.ID, notification.EventID, notification.CustomerID, notification.Kind, notification.Channel.String(),
+		notification.Message.Recipient, notification.Message.Subject, notification.Message.Body,
+		notification.Status.String(), notification.Attempts, notification.NextAttemptAt, tenant.FromContext(ctx),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows == 1, nil
+}
+
+func (r NotificationRepository) Update(ctx context.Context, notification *models.Notification) error {
+	const query = `UPDATE %s SET status = $2, attempts = $3, last_error = $4, next_attempt_at = $5, delivered_at = $6
+WHERE id = $1 AND tenant_id = $7`
+
+	var deliveredAt sql.NullTime
+	if !notification.DeliveredAt.IsZero() {
+		deliveredAt = sql.NullTime{Time: notification.DeliveredAt, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		notification.ID, notification.Status.String(), notification.Attempts, notification.LastError,
+		notification.NextAttemptAt, deliveredAt, tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+func (r NotificationRepository) ClaimDue(ctx context.Context, due, claimUntil time.Time, limit int) ([]*models.Notification, error) {
+	const query = `UPDATE %[1]s SET next_attempt_at = $3
+WHERE tenant_id = $5 AND id IN (
+  SELECT id FROM %[1]s WHERE status = $1 AND next_attempt_at <= $2 AND tenant_id = $5
+  ORDER BY next_attempt_at
+  LIMIT $4
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, event_id, customer_id, kind, channel, recipient, subject, body, status, attempts, last_error, next_attempt_at`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), models.NotificationIsPending.String(), due, claimUntil, limit, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying notifications")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing notification rows")
+		}
+	}(rows)
+
+	var notifications []*models.Notification
+
+	for rows.Next() {
+		var channel, status string
+		notification := &models.Notification{}
+		err := rows.Scan(&notification.ID, &notification.EventID, &notification.CustomerID, &notification.Kind, &channel,
+			&notification.Message.Recipient, &notification.Message.Subject, &notification.Message.Body,
+			&status, &notification.Attempts, &notification.LastError, &notification.NextAttemptAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "scanning notification")
+		}
+		notification.Channel = models.ToChannel(channel)
+		notification.Status = models.ToNotificationStatus(status)
+		notifications = append(notifications, notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing notification rows")
+	}
+
+	return notifications, nil
+}
+
+func (r NotificationRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
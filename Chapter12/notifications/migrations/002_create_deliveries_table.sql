@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE deliveries (
+  id              text        NOT NULL,
+  event_id        text        NOT NULL,
+  customer_id     text        NOT NULL,
+  kind            text        NOT NULL,
+  channel         text        NOT NULL,
+  recipient       text        NOT NULL,
+  subject         text        NOT NULL,
+  body            text        NOT NULL,
+  status          text        NOT NULL,
+  attempts        int         NOT NULL DEFAULT 0,
+  last_error      text        NOT NULL DEFAULT '',
+  next_attempt_at timestamptz NOT NULL,
+  delivered_at    timestamptz,
+  created_at      timestamptz NOT NULL DEFAULT NOW(),
+  updated_at      timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id),
+  UNIQUE (event_id, channel)
+);
+
+CREATE INDEX deliveries_due_idx ON deliveries (status, next_attempt_at);
+
+CREATE TRIGGER updated_at_deliveries_trgr
+  BEFORE UPDATE
+  ON deliveries
+  FOR EACH ROW
+EXECUTE PROCEDURE updated_at_trigger();
+
+ALTER TABLE customers_cache
+  ADD COLUMN email       text NOT NULL DEFAULT '',
+  ADD COLUMN webhook_url text NOT NULL DEFAULT '',
+  ADD COLUMN channels    text NOT NULL DEFAULT 'sms';
+
+-- +goose Down
+DROP TABLE IF EXISTS deliveries;
+
+ALTER TABLE customers_cache
+  DROP COLUMN email,
+  DROP COLUMN webhook_url,
+  DROP COLUMN channels;
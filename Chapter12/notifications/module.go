@@ -2,23 +2,39 @@ package notifications
 
 import (
 	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/baskets/basketspb"
 	"eda-in-golang/customers/customerspb"
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
+	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
+	"eda-in-golang/internal/web"
 	"eda-in-golang/notifications/internal/application"
 	"eda-in-golang/notifications/internal/constants"
 	"eda-in-golang/notifications/internal/grpc"
 	"eda-in-golang/notifications/internal/handlers"
+	"eda-in-golang/notifications/internal/models"
+	"eda-in-golang/notifications/internal/notifiers"
 	"eda-in-golang/notifications/internal/postgres"
 	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
 )
 
 type Module struct{}
@@ -30,17 +46,29 @@ func (m Module) Startup(ctx context.Context, mono system.Service) (err error) {
 func Root(ctx context.Context, svc system.Service) (err error) {
 	// setup Driven adapters
 	reg := registry.New()
-	if err = customerspb.Registrations(reg); err != nil {
+	if err = customerspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
 		return err
 	}
 	if err = orderingpb.Registrations(reg); err != nil {
 		return err
 	}
+	if err = basketspb.Registrations(reg); err != nil {
+		return err
+	}
+	if err = paymentspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
+		return err
+	}
 	inboxStore := pg.NewInboxStore(constants.InboxTableName, svc.DB())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	messageSubscriber := am.NewMessageSubscriber(
-		jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger()),
+		stream,
+		tenant.MessageContextExtractor(svc.Tenants()),
 		amotel.OtelMessageContextExtractor(),
 		amprom.ReceivedMessagesCounter(constants.ServiceName),
+		claimcheck.Handler(svc.BlobStore()),
+		encryption.DecryptingHandler(svc.Keys()),
+		am.DecompressingHandler(svc.Config().Nats.Compression),
 	)
 	customers := postgres.NewCustomerCacheRepository(
 		constants.CustomersCacheTableName,
@@ -48,8 +76,21 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		grpc.NewCustomerRepository(svc.Config().Rpc.Service(constants.CustomersServiceName)),
 	)
 
+	notifications := postgres.NewNotificationRepository(
+		constants.NotificationsTableName,
+		postgresotel.Trace(svc.DB()),
+	)
+	channels, err := buildNotifiers(svc.Config().Notifications, svc.Logger())
+	if err != nil {
+		return err
+	}
+
 	// setup application
-	app := application.New(customers)
+	app := application.New(customers, notifications, channels, application.DeliveryPolicy{
+		MaxAttempts:   svc.Config().Notifications.MaxAttempts,
+		RetryInterval: svc.Config().Notifications.RetryInterval,
+		ClaimTimeout:  svc.Config().Notifications.ClaimTimeout,
+	})
 	integrationEventHandlers := handlers.NewIntegrationEventHandlers(
 		reg, app, customers,
 		tm.InboxHandler(inboxStore),
@@ -62,6 +103,41 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	if err = handlers.RegisterIntegrationEventHandlers(messageSubscriber, integrationEventHandlers); err != nil {
 		return err
 	}
+	handlers.StartDeliveries(ctx, app, svc.Config().Notifications, svc.Tenants(), svc.Logger())
 
 	return nil
 }
+
+// buildNotifiers uses the real channels that have been configured; the others
+// are replaced by a sink so local runs still show what would have been sent
+func buildNotifiers(cfg config.NotificationsConfig, logger zerolog.Logger) (map[models.Channel]application.Notifier, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	sink := func(channel models.Channel) application.Notifier {
+		return notifiers.NewLogNotifier(channel, logger)
+	}
+	if cfg.SinkFile != "" {
+		file, err := os.OpenFile(cfg.SinkFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		mu := &sync.Mutex{}
+		sink = func(channel models.Channel) application.Notifier {
+			return notifiers.NewFileNotifier(channel, mu, file)
+		}
+	}
+
+	channels := map[models.Channel]application.Notifier{
+		models.ChannelSms:     sink(models.ChannelSms),
+		models.ChannelEmail:   sink(models.ChannelEmail),
+		models.ChannelWebhook: notifiers.NewWebhookNotifier(web.NewPublicClient(10 * time.Second)),
+	}
+	if cfg.SmsURL != "" {
+		channels[models.ChannelSms] = notifiers.NewSmsNotifier(cfg.SmsURL, client)
+	}
+	if cfg.SmtpAddr != "" {
+		channels[models.ChannelEmail] = notifiers.NewEmailNotifier(cfg)
+	}
+
+	return channels, nil
+}
@@ -21,6 +21,12 @@ type (
 		CancelOrder(ctx context.Context, cmd commands.CancelOrder) error
 		ReadyOrder(ctx context.Context, cmd commands.ReadyOrder) error
 		CompleteOrder(ctx context.Context, cmd commands.CompleteOrder) error
+		ShortPickItem(ctx context.Context, cmd commands.ShortPickItem) error
+		SubstituteItem(ctx context.Context, cmd commands.SubstituteItem) error
+		RequestReturn(ctx context.Context, cmd commands.RequestReturn) error
+		ReviewReturnItem(ctx context.Context, cmd commands.ReviewReturnItem) error
+		RejectReturn(ctx context.Context, cmd commands.RejectReturn) error
+		CompleteReturn(ctx context.Context, cmd commands.CompleteReturn) error
 	}
 	Queries interface {
 		GetOrder(ctx context.Context, query queries.GetOrder) (*domain.Order, error)
@@ -37,6 +43,12 @@ type (
 		commands.CancelOrderHandler
 		commands.ReadyOrderHandler
 		commands.CompleteOrderHandler
+		commands.ShortPickItemHandler
+		commands.SubstituteItemHandler
+		commands.RequestReturnHandler
+		commands.ReviewReturnItemHandler
+		commands.RejectReturnHandler
+		commands.CompleteReturnHandler
 	}
 	appQueries struct {
 		queries.GetOrderHandler
@@ -48,12 +60,18 @@ var _ App = (*Application)(nil)
 func New(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) *Application {
 	return &Application{
 		appCommands: appCommands{
-			CreateOrderHandler:   commands.NewCreateOrderHandler(orders, publisher),
-			RejectOrderHandler:   commands.NewRejectOrderHandler(orders, publisher),
-			ApproveOrderHandler:  commands.NewApproveOrderHandler(orders, publisher),
-			CancelOrderHandler:   commands.NewCancelOrderHandler(orders, publisher),
-			ReadyOrderHandler:    commands.NewReadyOrderHandler(orders, publisher),
-			CompleteOrderHandler: commands.NewCompleteOrderHandler(orders, publisher),
+			CreateOrderHandler:      commands.NewCreateOrderHandler(orders, publisher),
+			RejectOrderHandler:      commands.NewRejectOrderHandler(orders, publisher),
+			ApproveOrderHandler:     commands.NewApproveOrderHandler(orders, publisher),
+			CancelOrderHandler:      commands.NewCancelOrderHandler(orders, publisher),
+			ReadyOrderHandler:       commands.NewReadyOrderHandler(orders, publisher),
+			CompleteOrderHandler:    commands.NewCompleteOrderHandler(orders, publisher),
+			ShortPickItemHandler:    commands.NewShortPickItemHandler(orders, publisher),
+			SubstituteItemHandler:   commands.NewSubstituteItemHandler(orders, publisher),
+			RequestReturnHandler:    commands.NewRequestReturnHandler(orders, publisher),
+			ReviewReturnItemHandler: commands.NewReviewReturnItemHandler(orders, publisher),
+			RejectReturnHandler:     commands.NewRejectReturnHandler(orders, publisher),
+			CompleteReturnHandler:   commands.NewCompleteReturnHandler(orders, publisher),
 		},
 		appQueries: appQueries{
 			GetOrderHandler: queries.NewGetOrderHandler(orders),
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type CompleteReturn struct {
+	ID string
+}
+
+type CompleteReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewCompleteReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) CompleteReturnHandler {
+	return CompleteReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h CompleteReturnHandler) CompleteReturn(ctx context.Context, cmd CompleteReturn) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.CompleteReturn()
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
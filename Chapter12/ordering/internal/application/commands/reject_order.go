@@ -9,6 +9,8 @@ import (
 
 type RejectOrder struct {
 	ID string
+	// Reason is the reason code given by the service that refused the order
+	Reason string
 }
 
 type RejectOrderHandler struct {
@@ -29,7 +31,7 @@ func (h RejectOrderHandler) RejectOrder(ctx context.Context, cmd RejectOrder) er
 		return err
 	}
 
-	event, err := order.Reject()
+	event, err := order.Reject(cmd.Reason)
 	if err != nil {
 		return err
 	}
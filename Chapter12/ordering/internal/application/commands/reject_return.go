@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type RejectReturn struct {
+	ID string
+}
+
+type RejectReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRejectReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) RejectReturnHandler {
+	return RejectReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h RejectReturnHandler) RejectReturn(ctx context.Context, cmd RejectReturn) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.RejectReturn()
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type RequestReturn struct {
+	ID     string
+	Items  []domain.ReturnItem
+	Reason string
+}
+
+type RequestReturnHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRequestReturnHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) RequestReturnHandler {
+	return RequestReturnHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h RequestReturnHandler) RequestReturn(ctx context.Context, cmd RequestReturn) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.RequestReturn(cmd.Items, cmd.Reason)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
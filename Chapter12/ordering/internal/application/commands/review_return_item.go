@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type ReviewReturnItem struct {
+	ID        string
+	ProductID string
+	VariantID string
+	Approved  bool
+}
+
+type ReviewReturnItemHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewReviewReturnItemHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ReviewReturnItemHandler {
+	return ReviewReturnItemHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ReviewReturnItemHandler) ReviewReturnItem(ctx context.Context, cmd ReviewReturnItem) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	var event ddd.Event
+	if cmd.Approved {
+		event, err = order.ApproveReturnItem(cmd.ProductID, cmd.VariantID)
+	} else {
+		event, err = order.RejectReturnItem(cmd.ProductID, cmd.VariantID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// events are only applied to the order when it is saved
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	events := []ddd.Event{event}
+
+	// the return is decided once the last item has been reviewed
+	if order.ReturnReviewed() {
+		if len(order.ApprovedReturnItems()) > 0 {
+			event, err = order.ApproveReturn()
+		} else {
+			event, err = order.RejectReturn()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = h.orders.Save(ctx, order); err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	return h.publisher.Publish(ctx, events...)
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type ShortPickItem struct {
+	ID        string
+	ProductID string
+	VariantID string
+	Quantity  int
+}
+
+type ShortPickItemHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewShortPickItemHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) ShortPickItemHandler {
+	return ShortPickItemHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h ShortPickItemHandler) ShortPickItem(ctx context.Context, cmd ShortPickItem) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.ShortPickItem(cmd.ProductID, cmd.VariantID, cmd.Quantity)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
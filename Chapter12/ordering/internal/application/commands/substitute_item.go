@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/internal/domain"
+)
+
+type SubstituteItem struct {
+	ID              string
+	ProductID       string
+	VariantID       string
+	SubstituteID    string
+	SubstituteName  string
+	SubstitutePrice float64
+	Quantity        int
+}
+
+type SubstituteItemHandler struct {
+	orders    domain.OrderRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewSubstituteItemHandler(orders domain.OrderRepository, publisher ddd.EventPublisher[ddd.Event]) SubstituteItemHandler {
+	return SubstituteItemHandler{
+		orders:    orders,
+		publisher: publisher,
+	}
+}
+
+func (h SubstituteItemHandler) SubstituteItem(ctx context.Context, cmd SubstituteItem) error {
+	order, err := h.orders.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := order.SubstituteItem(cmd.ProductID, cmd.VariantID, cmd.SubstituteID, cmd.SubstituteName, cmd.SubstitutePrice, cmd.Quantity)
+	if err != nil {
+		return err
+	}
+
+	if err = h.orders.Save(ctx, order); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
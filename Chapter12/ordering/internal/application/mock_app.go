@@ -60,6 +60,20 @@ func (_m *MockApp) CompleteOrder(ctx context.Context, cmd commands.CompleteOrder
 	return r0
 }
 
+// CompleteReturn provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) CompleteReturn(ctx context.Context, cmd commands.CompleteReturn) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CompleteReturn) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateOrder provides a mock function with given fields: ctx, cmd
 func (_m *MockApp) CreateOrder(ctx context.Context, cmd commands.CreateOrder) error {
 	ret := _m.Called(ctx, cmd)
@@ -125,6 +139,76 @@ func (_m *MockApp) RejectOrder(ctx context.Context, cmd commands.RejectOrder) er
 	return r0
 }
 
+// RejectReturn provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) RejectReturn(ctx context.Context, cmd commands.RejectReturn) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RejectReturn) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestReturn provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) RequestReturn(ctx context.Context, cmd commands.RequestReturn) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RequestReturn) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReviewReturnItem provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReviewReturnItem(ctx context.Context, cmd commands.ReviewReturnItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReviewReturnItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShortPickItem provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ShortPickItem(ctx context.Context, cmd commands.ShortPickItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ShortPickItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubstituteItem provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) SubstituteItem(ctx context.Context, cmd commands.SubstituteItem) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.SubstituteItem) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockApp interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,10 +1,31 @@
 package domain
 
+import (
+	"math"
+)
+
 type Item struct {
 	ProductID   string
+	VariantID   string
+	SKU         string
 	StoreID     string
 	StoreName   string
 	ProductName string
 	Price       float64
 	Quantity    int
+	Discount    float64
+}
+
+// Total is the amount charged for the item after discounts
+func (i Item) Total() float64 {
+	return i.Price*float64(i.Quantity) - i.Discount
+}
+
+// adjust changes the quantity of the item keeping the discount in
+// proportion to the quantity ordered
+func (i *Item) adjust(quantity int) {
+	if i.Quantity > 0 {
+		i.Discount = math.Round(i.Discount*float64(quantity)/float64(i.Quantity)*100) / 100
+	}
+	i.Quantity = quantity
 }
@@ -5,16 +5,21 @@ import (
 
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/es"
+	"eda-in-golang/ordering/orderingpb"
 )
 
 const OrderAggregate = "ordering.Order"
 
 var (
-	ErrOrderAlreadyCreated     = errors.Wrap(errors.ErrBadRequest, "the order cannot be recreated")
-	ErrOrderHasNoItems         = errors.Wrap(errors.ErrBadRequest, "the order has no items")
-	ErrOrderCannotBeCancelled  = errors.Wrap(errors.ErrBadRequest, "the order cannot be cancelled")
-	ErrCustomerIDCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
-	ErrPaymentIDCannotBeBlank  = errors.Wrap(errors.ErrBadRequest, "the payment id cannot be blank")
+	ErrOrderAlreadyCreated          = orderingpb.ErrOrderAlreadyCreated
+	ErrOrderHasNoItems              = errors.Wrap(errors.ErrBadRequest, "the order has no items")
+	ErrOrderCannotBeCancelled       = orderingpb.ErrOrderCannotBeCancelled
+	ErrInvalidOrderStatusTransition = orderingpb.ErrInvalidOrderStatusTransition
+	ErrCustomerIDCannotBeBlank      = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
+	ErrPaymentIDCannotBeBlank       = errors.Wrap(errors.ErrBadRequest, "the payment id cannot be blank")
+	ErrOrderCannotBeAdjusted        = errors.Wrap(errors.ErrBadRequest, "the order cannot be adjusted")
+	ErrOrderItemNotFound            = errors.Wrap(errors.ErrNotFound, "the item is not part of the order")
+	ErrOrderItemQuantity            = errors.Wrap(errors.ErrBadRequest, "the adjusted quantity must be between zero and the quantity ordered")
 )
 
 type Order struct {
@@ -25,6 +30,11 @@ type Order struct {
 	ShoppingID string
 	Items      []Item
 	Status     OrderStatus
+	// RejectReason is the reason code the order was rejected with, if any
+	RejectReason string
+
+	ReturnItems  []ReturnItem
+	ReturnReason string
 }
 
 var _ interface {
@@ -41,8 +51,8 @@ func NewOrder(id string) *Order {
 func (Order) Key() string { return OrderAggregate }
 
 func (o *Order) CreateOrder(id, customerID, paymentID string, items []Item) (ddd.Event, error) {
-	if o.Status != OrderUnknown {
-		return nil, ErrOrderAlreadyCreated
+	if _, err := o.Status.Next(OrderActionCreate); err != nil {
+		return nil, err
 	}
 
 	if len(items) == 0 {
@@ -66,16 +76,22 @@ func (o *Order) CreateOrder(id, customerID, paymentID string, items []Item) (ddd
 	return ddd.NewEvent(OrderCreatedEvent, o), nil
 }
 
-func (o *Order) Reject() (ddd.Event, error) {
-	// validate status
+func (o *Order) Reject(reason string) (ddd.Event, error) {
+	if _, err := o.Status.Next(OrderActionReject); err != nil {
+		return nil, err
+	}
 
-	o.AddEvent(OrderRejectedEvent, &OrderRejected{})
+	o.AddEvent(OrderRejectedEvent, &OrderRejected{
+		Reason: reason,
+	})
 
 	return ddd.NewEvent(OrderRejectedEvent, o), nil
 }
 
 func (o *Order) Approve(shoppingID string) (ddd.Event, error) {
-	// validate status
+	if _, err := o.Status.Next(OrderActionApprove); err != nil {
+		return nil, err
+	}
 
 	o.AddEvent(OrderApprovedEvent, &OrderApproved{
 		ShoppingID: shoppingID,
@@ -85,8 +101,8 @@ func (o *Order) Approve(shoppingID string) (ddd.Event, error) {
 }
 
 func (o *Order) Cancel() (ddd.Event, error) {
-	if o.Status != OrderIsPending {
-		return nil, ErrOrderCannotBeCancelled
+	if _, err := o.Status.Next(OrderActionCancel); err != nil {
+		return nil, err
 	}
 
 	o.AddEvent(OrderCanceledEvent, &OrderCanceled{
@@ -97,7 +113,9 @@ func (o *Order) Cancel() (ddd.Event, error) {
 }
 
 func (o *Order) Ready() (ddd.Event, error) {
-	// validate status
+	if _, err := o.Status.Next(OrderActionReady); err != nil {
+		return nil, err
+	}
 
 	o.AddEvent(OrderReadiedEvent, &OrderReadied{
 		CustomerID: o.CustomerID,
@@ -111,7 +129,9 @@ func (o *Order) Ready() (ddd.Event, error) {
 func (o *Order) Complete(invoiceID string) (ddd.Event, error) {
 	// validate invoice exists
 
-	// validate status
+	if _, err := o.Status.Next(OrderActionComplete); err != nil {
+		return nil, err
+	}
 
 	o.AddEvent(OrderCompletedEvent, &OrderCompleted{
 		CustomerID: o.CustomerID,
@@ -121,11 +141,82 @@ func (o *Order) Complete(invoiceID string) (ddd.Event, error) {
 	return ddd.NewEvent(OrderCompletedEvent, o), nil
 }
 
+// ShortPickItem lowers the quantity of an item to what could be found in the store
+func (o *Order) ShortPickItem(productID, variantID string, quantity int) (ddd.Event, error) {
+	item, err := o.adjustableItem(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity < 0 || quantity > item.Quantity {
+		return nil, ErrOrderItemQuantity
+	}
+
+	o.AddEvent(OrderItemShortPickedEvent, &OrderItemShortPicked{
+		ProductID: productID,
+		VariantID: variantID,
+		Quantity:  quantity,
+	})
+
+	return ddd.NewEvent(OrderItemShortPickedEvent, o), nil
+}
+
+// SubstituteItem replaces an item with another product from the same store
+//
+// The substitute is charged at its own price
+func (o *Order) SubstituteItem(productID, variantID, substituteID, substituteName string, substitutePrice float64, quantity int) (ddd.Event, error) {
+	item, err := o.adjustableItem(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity <= 0 || quantity > item.Quantity {
+		return nil, ErrOrderItemQuantity
+	}
+
+	o.AddEvent(OrderItemSubstitutedEvent, &OrderItemSubstituted{
+		ProductID:       productID,
+		VariantID:       variantID,
+		SubstituteID:    substituteID,
+		SubstituteName:  substituteName,
+		SubstitutePrice: substitutePrice,
+		Quantity:        quantity,
+	})
+
+	return ddd.NewEvent(OrderItemSubstitutedEvent, o), nil
+}
+
+func (o Order) adjustableItem(productID, variantID string) (*Item, error) {
+	switch o.Status {
+	case OrderIsPending, OrderIsApproved, OrderIsReady:
+	default:
+		return nil, ErrOrderCannotBeAdjusted
+	}
+
+	i := o.itemIndex(productID, variantID)
+	if i < 0 {
+		return nil, ErrOrderItemNotFound
+	}
+
+	return &o.Items[i], nil
+}
+
+// itemIndex finds an item by its product and variant; items of the same
+// product that are of different variants are separate items
+func (o Order) itemIndex(productID, variantID string) int {
+	for i, item := range o.Items {
+		if item.ProductID == productID && item.VariantID == variantID {
+			return i
+		}
+	}
+	return -1
+}
+
 func (o Order) GetTotal() float64 {
 	var total float64
 
 	for _, item := range o.Items {
-		total += item.Price * float64(item.Quantity)
+		total += item.Total()
 	}
 
 	return total
@@ -140,6 +231,7 @@ func (o *Order) ApplyEvent(event ddd.Event) error {
 		o.Status = OrderIsPending
 
 	case *OrderRejected:
+		o.RejectReason = payload.Reason
 		o.Status = OrderIsRejected
 
 	case *OrderApproved:
@@ -156,6 +248,45 @@ func (o *Order) ApplyEvent(event ddd.Event) error {
 		o.InvoiceID = payload.InvoiceID
 		o.Status = OrderIsCompleted
 
+	case *OrderItemShortPicked:
+		o.Items[o.itemIndex(payload.ProductID, payload.VariantID)].adjust(payload.Quantity)
+
+	case *OrderItemSubstituted:
+		item := &o.Items[o.itemIndex(payload.ProductID, payload.VariantID)]
+		// the substitute is another product and not a variant of one
+		item.ProductID = payload.SubstituteID
+		item.VariantID = ""
+		item.SKU = ""
+		item.ProductName = payload.SubstituteName
+		// substitutions recorded before substitutes were priced kept the
+		// price of the item they replaced
+		if payload.SubstitutePrice > 0 {
+			item.Price = payload.SubstitutePrice
+		}
+		item.adjust(payload.Quantity)
+
+	case *OrderReturnRequested:
+		o.ReturnItems = payload.Items
+		o.ReturnReason = payload.Reason
+		o.Status = OrderIsReturnRequested
+
+	case *OrderReturnItemApproved:
+		o.ReturnItems[o.returnItemIndex(payload.ProductID, payload.VariantID)].Status = ReturnItemIsApproved
+
+	case *OrderReturnItemRejected:
+		o.ReturnItems[o.returnItemIndex(payload.ProductID, payload.VariantID)].Status = ReturnItemIsRejected
+
+	case *OrderReturnApproved:
+		o.Status = OrderIsReturning
+
+	case *OrderReturnRejected:
+		o.ReturnItems = nil
+		o.ReturnReason = ""
+		o.Status = OrderIsCompleted
+
+	case *OrderReturned:
+		o.Status = OrderIsReturned
+
 	default:
 		return errors.ErrInternal.Msgf("%T received the event %s with unexpected payload %T", o, event.EventName(), payload)
 	}
@@ -171,6 +302,9 @@ func (o *Order) ApplySnapshot(snapshot es.Snapshot) error {
 		o.ShoppingID = ss.ShoppingID
 		o.Items = ss.Items
 		o.Status = ss.Status
+		o.RejectReason = ss.RejectReason
+		o.ReturnItems = ss.ReturnItems
+		o.ReturnReason = ss.ReturnReason
 
 	default:
 		return errors.ErrInternal.Msgf("%T received the unexpected snapshot %T", o, snapshot)
@@ -187,5 +321,10 @@ func (o *Order) ToSnapshot() es.Snapshot {
 		ShoppingID: o.ShoppingID,
 		Items:      o.Items,
 		Status:     o.Status,
+
+		RejectReason: o.RejectReason,
+
+		ReturnItems:  o.ReturnItems,
+		ReturnReason: o.ReturnReason,
 	}
 }
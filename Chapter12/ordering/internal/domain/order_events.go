@@ -7,6 +7,16 @@ const (
 	OrderCanceledEvent  = "ordering.OrderCanceled"
 	OrderReadiedEvent   = "ordering.OrderReadied"
 	OrderCompletedEvent = "ordering.OrderCompleted"
+
+	OrderItemShortPickedEvent = "ordering.OrderItemShortPicked"
+	OrderItemSubstitutedEvent = "ordering.OrderItemSubstituted"
+
+	OrderReturnRequestedEvent    = "ordering.OrderReturnRequested"
+	OrderReturnItemApprovedEvent = "ordering.OrderReturnItemApproved"
+	OrderReturnItemRejectedEvent = "ordering.OrderReturnItemRejected"
+	OrderReturnApprovedEvent     = "ordering.OrderReturnApproved"
+	OrderReturnRejectedEvent     = "ordering.OrderReturnRejected"
+	OrderReturnedEvent           = "ordering.OrderReturned"
 )
 
 type OrderCreated struct {
@@ -18,7 +28,9 @@ type OrderCreated struct {
 
 func (OrderCreated) Key() string { return OrderCreatedEvent }
 
-type OrderRejected struct{}
+type OrderRejected struct {
+	Reason string
+}
 
 func (OrderRejected) Key() string { return OrderRejectedEvent }
 
@@ -49,3 +61,59 @@ type OrderCompleted struct {
 }
 
 func (OrderCompleted) Key() string { return OrderCompletedEvent }
+
+type OrderItemShortPicked struct {
+	ProductID string
+	VariantID string
+	Quantity  int
+}
+
+func (OrderItemShortPicked) Key() string { return OrderItemShortPickedEvent }
+
+type OrderItemSubstituted struct {
+	ProductID       string
+	VariantID       string
+	SubstituteID    string
+	SubstituteName  string
+	SubstitutePrice float64
+	Quantity        int
+}
+
+func (OrderItemSubstituted) Key() string { return OrderItemSubstitutedEvent }
+
+type OrderReturnRequested struct {
+	Items  []ReturnItem
+	Reason string
+}
+
+func (OrderReturnRequested) Key() string { return OrderReturnRequestedEvent }
+
+type OrderReturnItemApproved struct {
+	ProductID string
+	VariantID string
+}
+
+func (OrderReturnItemApproved) Key() string { return OrderReturnItemApprovedEvent }
+
+type OrderReturnItemRejected struct {
+	ProductID string
+	VariantID string
+}
+
+func (OrderReturnItemRejected) Key() string { return OrderReturnItemRejectedEvent }
+
+type OrderReturnApproved struct {
+	RefundAmount float64
+}
+
+func (OrderReturnApproved) Key() string { return OrderReturnApprovedEvent }
+
+type OrderReturnRejected struct{}
+
+func (OrderReturnRejected) Key() string { return OrderReturnRejectedEvent }
+
+type OrderReturned struct {
+	RefundAmount float64
+}
+
+func (OrderReturned) Key() string { return OrderReturnedEvent }
@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"math"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+)
+
+var (
+	ErrReturnHasNoItems          = errors.Wrap(errors.ErrBadRequest, "the return has no items")
+	ErrReturnItemQuantity        = errors.Wrap(errors.ErrBadRequest, "the returned quantity must be between one and the quantity ordered")
+	ErrReturnItemDuplicated      = errors.Wrap(errors.ErrBadRequest, "an item can only be returned once per request")
+	ErrReturnItemNotFound        = errors.Wrap(errors.ErrNotFound, "the item is not part of the return")
+	ErrReturnItemAlreadyReviewed = errors.Wrap(errors.ErrBadRequest, "the returned item has already been reviewed")
+	ErrReturnNotReviewed         = errors.Wrap(errors.ErrBadRequest, "every returned item must be reviewed first")
+	ErrReturnHasNoApprovedItems  = errors.Wrap(errors.ErrBadRequest, "the return has no approved items")
+)
+
+type ReturnItemStatus string
+
+const (
+	ReturnItemIsRequested ReturnItemStatus = "requested"
+	ReturnItemIsApproved  ReturnItemStatus = "approved"
+	ReturnItemIsRejected  ReturnItemStatus = "rejected"
+)
+
+type ReturnItem struct {
+	ProductID string
+	VariantID string
+	StoreID   string
+	Quantity  int
+	Status    ReturnItemStatus
+}
+
+// RequestReturn starts a return for some or all of the items on a completed order
+func (o *Order) RequestReturn(items []ReturnItem, reason string) (ddd.Event, error) {
+	if _, err := o.Status.Next(OrderActionRequestReturn); err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, ErrReturnHasNoItems
+	}
+
+	requested := make([]ReturnItem, 0, len(items))
+	seen := make(map[ReturnItem]struct{}, len(items))
+	for _, item := range items {
+		key := ReturnItem{ProductID: item.ProductID, VariantID: item.VariantID}
+		if _, exists := seen[key]; exists {
+			return nil, ErrReturnItemDuplicated
+		}
+		seen[key] = struct{}{}
+
+		i := o.itemIndex(item.ProductID, item.VariantID)
+		if i < 0 {
+			return nil, ErrOrderItemNotFound
+		}
+		if item.Quantity <= 0 || item.Quantity > o.Items[i].Quantity {
+			return nil, ErrReturnItemQuantity
+		}
+
+		requested = append(requested, ReturnItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			StoreID:   o.Items[i].StoreID,
+			Quantity:  item.Quantity,
+			Status:    ReturnItemIsRequested,
+		})
+	}
+
+	o.AddEvent(OrderReturnRequestedEvent, &OrderReturnRequested{
+		Items:  requested,
+		Reason: reason,
+	})
+
+	return ddd.NewEvent(OrderReturnRequestedEvent, o), nil
+}
+
+// ApproveReturnItem accepts a returned item for a refund
+func (o *Order) ApproveReturnItem(productID, variantID string) (ddd.Event, error) {
+	if err := o.reviewableReturnItem(productID, variantID); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderReturnItemApprovedEvent, &OrderReturnItemApproved{
+		ProductID: productID,
+		VariantID: variantID,
+	})
+
+	return ddd.NewEvent(OrderReturnItemApprovedEvent, o), nil
+}
+
+// RejectReturnItem refuses the return of an item
+func (o *Order) RejectReturnItem(productID, variantID string) (ddd.Event, error) {
+	if err := o.reviewableReturnItem(productID, variantID); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderReturnItemRejectedEvent, &OrderReturnItemRejected{
+		ProductID: productID,
+		VariantID: variantID,
+	})
+
+	return ddd.NewEvent(OrderReturnItemRejectedEvent, o), nil
+}
+
+// ReturnReviewed reports if a decision has been made for every returned item
+func (o Order) ReturnReviewed() bool {
+	for _, item := range o.ReturnItems {
+		if item.Status == ReturnItemIsRequested {
+			return false
+		}
+	}
+
+	return len(o.ReturnItems) > 0
+}
+
+// ApproveReturn accepts a reviewed return so the items can be collected and refunded
+func (o *Order) ApproveReturn() (ddd.Event, error) {
+	if _, err := o.Status.Next(OrderActionApproveReturn); err != nil {
+		return nil, err
+	}
+
+	if !o.ReturnReviewed() {
+		return nil, ErrReturnNotReviewed
+	}
+
+	if len(o.ApprovedReturnItems()) == 0 {
+		return nil, ErrReturnHasNoApprovedItems
+	}
+
+	o.AddEvent(OrderReturnApprovedEvent, &OrderReturnApproved{
+		RefundAmount: o.RefundAmount(),
+	})
+
+	return ddd.NewEvent(OrderReturnApprovedEvent, o), nil
+}
+
+// RejectReturn ends a return without a refund; the order is completed again
+func (o *Order) RejectReturn() (ddd.Event, error) {
+	if _, err := o.Status.Next(OrderActionRejectReturn); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderReturnRejectedEvent, &OrderReturnRejected{})
+
+	return ddd.NewEvent(OrderReturnRejectedEvent, o), nil
+}
+
+// CompleteReturn records that the approved items are being collected; the
+// customer is refunded once the return is complete
+func (o *Order) CompleteReturn() (ddd.Event, error) {
+	if _, err := o.Status.Next(OrderActionCompleteReturn); err != nil {
+		return nil, err
+	}
+
+	o.AddEvent(OrderReturnedEvent, &OrderReturned{
+		RefundAmount: o.RefundAmount(),
+	})
+
+	return ddd.NewEvent(OrderReturnedEvent, o), nil
+}
+
+func (o Order) ApprovedReturnItems() []ReturnItem {
+	items := make([]ReturnItem, 0, len(o.ReturnItems))
+	for _, item := range o.ReturnItems {
+		if item.Status == ReturnItemIsApproved {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// RefundAmount is what the customer paid for the approved items, including
+// their share of any discount
+func (o Order) RefundAmount() float64 {
+	var amount float64
+
+	for _, returned := range o.ApprovedReturnItems() {
+		i := o.itemIndex(returned.ProductID, returned.VariantID)
+		if i < 0 || o.Items[i].Quantity == 0 {
+			continue
+		}
+		amount += o.Items[i].Total() / float64(o.Items[i].Quantity) * float64(returned.Quantity)
+	}
+
+	return math.Round(amount*100) / 100
+}
+
+func (o Order) reviewableReturnItem(productID, variantID string) error {
+	if _, err := o.Status.Next(OrderActionReviewReturn); err != nil {
+		return err
+	}
+
+	i := o.returnItemIndex(productID, variantID)
+	if i < 0 {
+		return ErrReturnItemNotFound
+	}
+
+	if o.ReturnItems[i].Status != ReturnItemIsRequested {
+		return ErrReturnItemAlreadyReviewed
+	}
+
+	return nil
+}
+
+func (o Order) returnItemIndex(productID, variantID string) int {
+	for i, item := range o.ReturnItems {
+		if item.ProductID == productID && item.VariantID == variantID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,453 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/es"
+)
+
+var returnOrderItems = []Item{
+	{ProductID: "product-id", StoreID: "store-id", Price: 10, Quantity: 4, Discount: 2},
+	{ProductID: "product-id2", VariantID: "variant-id", StoreID: "store-id2", Price: 5, Quantity: 1},
+}
+
+func TestOrder_RequestReturn(t *testing.T) {
+	type fields struct {
+		Items  []Item
+		Status OrderStatus
+	}
+	type args struct {
+		items  []ReturnItem
+		reason string
+	}
+	tests := map[string]struct {
+		fields  fields
+		args    args
+		on      func(a *es.MockAggregate)
+		wantErr error
+	}{
+		"CompletedOrder": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{
+					{ProductID: "product-id", Quantity: 2},
+					{ProductID: "product-id2", VariantID: "variant-id", Quantity: 1},
+				},
+				reason: "damaged",
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", OrderReturnRequestedEvent, &OrderReturnRequested{
+					Items: []ReturnItem{
+						{ProductID: "product-id", StoreID: "store-id", Quantity: 2, Status: ReturnItemIsRequested},
+						{ProductID: "product-id2", VariantID: "variant-id", StoreID: "store-id2", Quantity: 1, Status: ReturnItemIsRequested},
+					},
+					Reason: "damaged",
+				})
+			},
+		},
+		"ReadyOrder": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsReady,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "product-id", Quantity: 1}},
+			},
+			wantErr: ErrOrderReturnCannotBeRequested,
+		},
+		"ReturnRequested": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsReturnRequested,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "product-id", Quantity: 1}},
+			},
+			wantErr: ErrOrderReturnCannotBeRequested,
+		},
+		"NoItems": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			wantErr: ErrReturnHasNoItems,
+		},
+		"DuplicatedItem": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{
+					{ProductID: "product-id", Quantity: 1},
+					{ProductID: "product-id", Quantity: 1},
+				},
+			},
+			wantErr: ErrReturnItemDuplicated,
+		},
+		"UnknownProduct": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "unknown-id", Quantity: 1}},
+			},
+			wantErr: ErrOrderItemNotFound,
+		},
+		"MissingVariant": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "product-id2", Quantity: 1}},
+			},
+			wantErr: ErrOrderItemNotFound,
+		},
+		"ZeroQuantity": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "product-id", Quantity: 0}},
+			},
+			wantErr: ErrReturnItemQuantity,
+		},
+		"MoreThanOrdered": {
+			fields: fields{
+				Items:  returnOrderItems,
+				Status: OrderIsCompleted,
+			},
+			args: args{
+				items: []ReturnItem{{ProductID: "product-id", Quantity: 5}},
+			},
+			wantErr: ErrReturnItemQuantity,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			o := &Order{
+				Aggregate: aggregate,
+				Items:     tt.fields.Items,
+				Status:    tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			_, err := o.RequestReturn(tt.args.items, tt.args.reason)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrder_ReviewReturnItem(t *testing.T) {
+	type fields struct {
+		ReturnItems []ReturnItem
+		Status      OrderStatus
+	}
+	type args struct {
+		approve   bool
+		productID string
+		variantID string
+	}
+	requested := []ReturnItem{
+		{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsRequested},
+		{ProductID: "product-id2", VariantID: "variant-id", Quantity: 1, Status: ReturnItemIsRequested},
+	}
+	tests := map[string]struct {
+		fields  fields
+		args    args
+		on      func(a *es.MockAggregate)
+		wantErr error
+	}{
+		"Approve": {
+			fields: fields{
+				ReturnItems: requested,
+				Status:      OrderIsReturnRequested,
+			},
+			args: args{
+				approve:   true,
+				productID: "product-id2",
+				variantID: "variant-id",
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", OrderReturnItemApprovedEvent, &OrderReturnItemApproved{
+					ProductID: "product-id2",
+					VariantID: "variant-id",
+				})
+			},
+		},
+		"Reject": {
+			fields: fields{
+				ReturnItems: requested,
+				Status:      OrderIsReturnRequested,
+			},
+			args: args{
+				productID: "product-id",
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", OrderReturnItemRejectedEvent, &OrderReturnItemRejected{
+					ProductID: "product-id",
+				})
+			},
+		},
+		"NotRequested": {
+			fields: fields{
+				ReturnItems: requested,
+				Status:      OrderIsCompleted,
+			},
+			args: args{
+				approve:   true,
+				productID: "product-id",
+			},
+			wantErr: ErrOrderReturnCannotBeReviewed,
+		},
+		"ReturnApproved": {
+			fields: fields{
+				ReturnItems: requested,
+				Status:      OrderIsReturning,
+			},
+			args: args{
+				productID: "product-id",
+			},
+			wantErr: ErrOrderReturnCannotBeReviewed,
+		},
+		"NotReturned": {
+			fields: fields{
+				ReturnItems: requested,
+				Status:      OrderIsReturnRequested,
+			},
+			args: args{
+				approve:   true,
+				productID: "product-id2",
+			},
+			wantErr: ErrReturnItemNotFound,
+		},
+		"ApproveReviewed": {
+			fields: fields{
+				ReturnItems: []ReturnItem{{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsRejected}},
+				Status:      OrderIsReturnRequested,
+			},
+			args: args{
+				approve:   true,
+				productID: "product-id",
+			},
+			wantErr: ErrReturnItemAlreadyReviewed,
+		},
+		"RejectReviewed": {
+			fields: fields{
+				ReturnItems: []ReturnItem{{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsApproved}},
+				Status:      OrderIsReturnRequested,
+			},
+			args: args{
+				productID: "product-id",
+			},
+			wantErr: ErrReturnItemAlreadyReviewed,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			o := &Order{
+				Aggregate:   aggregate,
+				Items:       returnOrderItems,
+				ReturnItems: tt.fields.ReturnItems,
+				Status:      tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			var err error
+			if tt.args.approve {
+				_, err = o.ApproveReturnItem(tt.args.productID, tt.args.variantID)
+			} else {
+				_, err = o.RejectReturnItem(tt.args.productID, tt.args.variantID)
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrder_ApproveReturn(t *testing.T) {
+	type fields struct {
+		ReturnItems []ReturnItem
+		Status      OrderStatus
+	}
+	tests := map[string]struct {
+		fields  fields
+		on      func(a *es.MockAggregate)
+		wantErr error
+	}{
+		"Reviewed": {
+			fields: fields{
+				ReturnItems: []ReturnItem{
+					{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsApproved},
+					{ProductID: "product-id2", VariantID: "variant-id", Quantity: 1, Status: ReturnItemIsRejected},
+				},
+				Status: OrderIsReturnRequested,
+			},
+			on: func(a *es.MockAggregate) {
+				a.On("AddEvent", OrderReturnApprovedEvent, &OrderReturnApproved{
+					RefundAmount: 19,
+				})
+			},
+		},
+		"NotReviewed": {
+			fields: fields{
+				ReturnItems: []ReturnItem{
+					{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsApproved},
+					{ProductID: "product-id2", VariantID: "variant-id", Quantity: 1, Status: ReturnItemIsRequested},
+				},
+				Status: OrderIsReturnRequested,
+			},
+			wantErr: ErrReturnNotReviewed,
+		},
+		"AllRejected": {
+			fields: fields{
+				ReturnItems: []ReturnItem{
+					{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsRejected},
+				},
+				Status: OrderIsReturnRequested,
+			},
+			wantErr: ErrReturnHasNoApprovedItems,
+		},
+		"AlreadyApproved": {
+			fields: fields{
+				ReturnItems: []ReturnItem{
+					{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsApproved},
+				},
+				Status: OrderIsReturning,
+			},
+			wantErr: ErrOrderReturnCannotBeApproved,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			aggregate := es.NewMockAggregate(t)
+			o := &Order{
+				Aggregate:   aggregate,
+				Items:       returnOrderItems,
+				ReturnItems: tt.fields.ReturnItems,
+				Status:      tt.fields.Status,
+			}
+			if tt.on != nil {
+				tt.on(aggregate)
+			}
+
+			_, err := o.ApproveReturn()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOrder_RefundAmount(t *testing.T) {
+	tests := map[string]struct {
+		items       []Item
+		returnItems []ReturnItem
+		want        float64
+	}{
+		"WholeItem": {
+			items:       []Item{{ProductID: "product-id", Price: 10, Quantity: 4}},
+			returnItems: []ReturnItem{{ProductID: "product-id", Quantity: 4, Status: ReturnItemIsApproved}},
+			want:        40,
+		},
+		"DiscountShare": {
+			items:       []Item{{ProductID: "product-id", Price: 10, Quantity: 4, Discount: 2}},
+			returnItems: []ReturnItem{{ProductID: "product-id", Quantity: 1, Status: ReturnItemIsApproved}},
+			want:        9.5,
+		},
+		"RoundedDiscountShare": {
+			items:       []Item{{ProductID: "product-id", Price: 10, Quantity: 3, Discount: 1}},
+			returnItems: []ReturnItem{{ProductID: "product-id", Quantity: 1, Status: ReturnItemIsApproved}},
+			want:        9.67,
+		},
+		"OnlyApproved": {
+			items: []Item{
+				{ProductID: "product-id", Price: 10, Quantity: 4, Discount: 2},
+				{ProductID: "product-id2", Price: 5, Quantity: 1},
+			},
+			returnItems: []ReturnItem{
+				{ProductID: "product-id", Quantity: 2, Status: ReturnItemIsApproved},
+				{ProductID: "product-id2", Quantity: 1, Status: ReturnItemIsRejected},
+			},
+			want: 19,
+		},
+		"Requested": {
+			items:       []Item{{ProductID: "product-id", Price: 10, Quantity: 4}},
+			returnItems: []ReturnItem{{ProductID: "product-id", Quantity: 4, Status: ReturnItemIsRequested}},
+		},
+		// items short picked to nothing were never charged
+		"ShortPicked": {
+			items:       []Item{{ProductID: "product-id", Price: 10, Quantity: 0}},
+			returnItems: []ReturnItem{{ProductID: "product-id", Quantity: 1, Status: ReturnItemIsApproved}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			o := Order{
+				Items:       tt.items,
+				ReturnItems: tt.returnItems,
+			}
+
+			assert.Equal(t, tt.want, o.RefundAmount())
+		})
+	}
+}
+
+func TestOrder_RequestReturn_Variants(t *testing.T) {
+	order := NewOrder("order-id")
+	_, err := order.CreateOrder("order-id", "customer-id", "payment-id", []Item{
+		{ProductID: "product-id", VariantID: "small-id", StoreID: "store-id", Price: 10, Quantity: 2},
+		{ProductID: "product-id", VariantID: "large-id", StoreID: "store-id", Price: 12, Quantity: 2, Discount: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitOrder(t, order)
+	order.Status = OrderIsCompleted
+
+	// the variants of a product are returned as separate items
+	_, err = order.RequestReturn([]ReturnItem{
+		{ProductID: "product-id", VariantID: "small-id", Quantity: 1},
+		{ProductID: "product-id", VariantID: "large-id", Quantity: 1},
+	}, "too small")
+	if !assert.NoError(t, err) {
+		return
+	}
+	commitOrder(t, order)
+
+	_, err = order.ApproveReturnItem("product-id", "")
+	assert.ErrorIs(t, err, ErrReturnItemNotFound)
+
+	_, err = order.RejectReturnItem("product-id", "small-id")
+	assert.NoError(t, err)
+	commitOrder(t, order)
+	_, err = order.ApproveReturnItem("product-id", "large-id")
+	assert.NoError(t, err)
+	commitOrder(t, order)
+
+	// only the large variant is refunded, with its share of its own discount
+	assert.Equal(t, 11.0, order.RefundAmount())
+}
@@ -7,6 +7,11 @@ type OrderV1 struct {
 	ShoppingID string
 	Items      []Item
 	Status     OrderStatus
+
+	RejectReason string
+
+	ReturnItems  []ReturnItem
+	ReturnReason string
 }
 
 func (OrderV1) SnapshotName() string { return "ordering.OrderV1" }
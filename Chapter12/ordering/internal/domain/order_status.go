@@ -1,44 +1,61 @@
 package domain
 
-type OrderStatus string
+import (
+	"eda-in-golang/ordering/orderingpb"
+)
+
+// The order statuses and the transitions between them are published with the
+// order events so their consumers follow the same rules as the Order aggregate
+
+type (
+	OrderStatus      = orderingpb.OrderStatus
+	OrderAction      = orderingpb.OrderAction
+	OrderStatusError = orderingpb.OrderStatusError
+)
 
 const (
-	OrderUnknown     OrderStatus = ""
-	OrderIsPending   OrderStatus = "pending"
-	OrderIsRejected  OrderStatus = "rejected"
-	OrderIsApproved  OrderStatus = "approved"
-	OrderIsInProcess OrderStatus = "in-progress"
-	OrderIsReady     OrderStatus = "ready"
-	OrderIsCompleted OrderStatus = "completed"
-	OrderIsCancelled OrderStatus = "cancelled"
+	OrderUnknown     = orderingpb.OrderUnknown
+	OrderIsPending   = orderingpb.OrderIsPending
+	OrderIsRejected  = orderingpb.OrderIsRejected
+	OrderIsApproved  = orderingpb.OrderIsApproved
+	OrderIsInProcess = orderingpb.OrderIsInProcess
+	OrderIsReady     = orderingpb.OrderIsReady
+	OrderIsCompleted = orderingpb.OrderIsCompleted
+	OrderIsCancelled = orderingpb.OrderIsCancelled
+
+	OrderIsReturnRequested = orderingpb.OrderIsReturnRequested
+	OrderIsReturning       = orderingpb.OrderIsReturning
+	OrderIsReturned        = orderingpb.OrderIsReturned
 )
 
-func (s OrderStatus) String() string {
-	switch s {
-	case OrderIsPending, OrderIsRejected, OrderIsApproved, OrderIsInProcess, OrderIsReady, OrderIsCompleted, OrderIsCancelled:
-		return string(s)
-	default:
-		return ""
-	}
-}
+const (
+	OrderActionCreate   = orderingpb.OrderActionCreate
+	OrderActionReject   = orderingpb.OrderActionReject
+	OrderActionApprove  = orderingpb.OrderActionApprove
+	OrderActionCancel   = orderingpb.OrderActionCancel
+	OrderActionReady    = orderingpb.OrderActionReady
+	OrderActionComplete = orderingpb.OrderActionComplete
+
+	OrderActionRequestReturn  = orderingpb.OrderActionRequestReturn
+	OrderActionReviewReturn   = orderingpb.OrderActionReviewReturn
+	OrderActionApproveReturn  = orderingpb.OrderActionApproveReturn
+	OrderActionRejectReturn   = orderingpb.OrderActionRejectReturn
+	OrderActionCompleteReturn = orderingpb.OrderActionCompleteReturn
+)
+
+var (
+	ErrOrderCannotBeRejected  = orderingpb.ErrOrderCannotBeRejected
+	ErrOrderCannotBeApproved  = orderingpb.ErrOrderCannotBeApproved
+	ErrOrderCannotBeReadied   = orderingpb.ErrOrderCannotBeReadied
+	ErrOrderCannotBeCompleted = orderingpb.ErrOrderCannotBeCompleted
+
+	ErrOrderReturnCannotBeRequested = orderingpb.ErrOrderReturnCannotBeRequested
+	ErrOrderReturnCannotBeReviewed  = orderingpb.ErrOrderReturnCannotBeReviewed
+	ErrOrderReturnCannotBeApproved  = orderingpb.ErrOrderReturnCannotBeApproved
+	ErrOrderReturnCannotBeRejected  = orderingpb.ErrOrderReturnCannotBeRejected
+	ErrOrderReturnCannotBeCompleted = orderingpb.ErrOrderReturnCannotBeCompleted
+)
 
 func ToOrderStatus(status string) OrderStatus {
-	switch status {
-	case OrderIsPending.String():
-		return OrderIsPending
-	case OrderIsRejected.String():
-		return OrderIsRejected
-	case OrderIsApproved.String():
-		return OrderIsApproved
-	case OrderIsInProcess.String():
-		return OrderIsInProcess
-	case OrderIsReady.String():
-		return OrderIsReady
-	case OrderIsCancelled.String():
-		return OrderIsCancelled
-	case OrderIsCompleted.String():
-		return OrderIsCompleted
-	default:
-		return OrderUnknown
-	}
+	return orderingpb.ToOrderStatus(status)
 }
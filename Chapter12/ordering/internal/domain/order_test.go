@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func commitOrder(t *testing.T, order *Order) {
+	t.Helper()
+
+	for _, event := range order.Events() {
+		if err := order.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	order.CommitEvents()
+}
+
+func TestOrder_SubstituteItem(t *testing.T) {
+	tests := map[string]struct {
+		substitutePrice float64
+		quantity        int
+		wantPrice       float64
+		wantDiscount    float64
+		wantTotal       float64
+		wantErr         error
+	}{
+		"MoreExpensive": {
+			substitutePrice: 12.5,
+			quantity:        4,
+			wantPrice:       12.5,
+			wantDiscount:    2,
+			wantTotal:       48,
+		},
+		"Cheaper": {
+			substitutePrice: 7.5,
+			quantity:        4,
+			wantPrice:       7.5,
+			wantDiscount:    2,
+			wantTotal:       28,
+		},
+		"FewerPicked": {
+			substitutePrice: 12.5,
+			quantity:        2,
+			wantPrice:       12.5,
+			wantDiscount:    1,
+			wantTotal:       24,
+		},
+		"TooManyPicked": {
+			substitutePrice: 12.5,
+			quantity:        5,
+			wantErr:         ErrOrderItemQuantity,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := NewOrder("order-id")
+			_, err := order.CreateOrder("order-id", "customer-id", "payment-id", []Item{
+				{ProductID: "product-id", ProductName: "product", StoreID: "store-id", Price: 10, Quantity: 4, Discount: 2},
+				{ProductID: "other-id", ProductName: "other", StoreID: "store-id", Price: 5, Quantity: 1},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			commitOrder(t, order)
+
+			_, err = order.SubstituteItem("product-id", "", "substitute-id", "substitute", tc.substitutePrice, tc.quantity)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			commitOrder(t, order)
+
+			item := order.Items[order.itemIndex("substitute-id", "")]
+			assert.Equal(t, "substitute", item.ProductName)
+			assert.Equal(t, tc.wantPrice, item.Price)
+			assert.Equal(t, tc.quantity, item.Quantity)
+			assert.Equal(t, tc.wantDiscount, item.Discount)
+			assert.Equal(t, tc.wantTotal, item.Total())
+			assert.Equal(t, tc.wantTotal+5, order.GetTotal())
+		})
+	}
+}
+
+func TestOrder_ApplyEvent_UnpricedSubstitution(t *testing.T) {
+	order := NewOrder("order-id")
+	_, err := order.CreateOrder("order-id", "customer-id", "payment-id", []Item{
+		{ProductID: "product-id", ProductName: "product", StoreID: "store-id", Price: 10, Quantity: 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitOrder(t, order)
+
+	// substitutions were recorded without a price before substitutes were priced
+	order.AddEvent(OrderItemSubstitutedEvent, &OrderItemSubstituted{
+		ProductID:      "product-id",
+		SubstituteID:   "substitute-id",
+		SubstituteName: "substitute",
+		Quantity:       3,
+	})
+	commitOrder(t, order)
+
+	item := order.Items[order.itemIndex("substitute-id", "")]
+	assert.Equal(t, 10.0, item.Price)
+	assert.Equal(t, 30.0, order.GetTotal())
+}
+
+func TestOrder_ShortPickItem_Variants(t *testing.T) {
+	order := NewOrder("order-id")
+	_, err := order.CreateOrder("order-id", "customer-id", "payment-id", []Item{
+		{ProductID: "product-id", VariantID: "small-id", StoreID: "store-id", Price: 10, Quantity: 2},
+		{ProductID: "product-id", VariantID: "large-id", StoreID: "store-id", Price: 12, Quantity: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitOrder(t, order)
+
+	_, err = order.ShortPickItem("product-id", "", 1)
+	assert.ErrorIs(t, err, ErrOrderItemNotFound)
+
+	_, err = order.ShortPickItem("product-id", "large-id", 1)
+	if assert.NoError(t, err) && assert.Len(t, order.Events(), 1) {
+		assert.Equal(t, "large-id", order.Events()[0].Payload().(*OrderItemShortPicked).VariantID)
+	}
+	commitOrder(t, order)
+
+	// only the variant that was short picked is adjusted
+	assert.Equal(t, 2, order.Items[order.itemIndex("product-id", "small-id")].Quantity)
+	assert.Equal(t, 1, order.Items[order.itemIndex("product-id", "large-id")].Quantity)
+	assert.Equal(t, 32.0, order.GetTotal())
+}
+
+func TestOrder_SubstituteItem_Variant(t *testing.T) {
+	order := NewOrder("order-id")
+	_, err := order.CreateOrder("order-id", "customer-id", "payment-id", []Item{
+		{ProductID: "product-id", VariantID: "small-id", SKU: "sku-s", StoreID: "store-id", Price: 10, Quantity: 2},
+		{ProductID: "product-id", VariantID: "large-id", SKU: "sku-l", StoreID: "store-id", Price: 12, Quantity: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitOrder(t, order)
+
+	_, err = order.SubstituteItem("product-id", "small-id", "substitute-id", "substitute", 9, 2)
+	assert.NoError(t, err)
+	commitOrder(t, order)
+
+	// the substitute is another product and keeps nothing of the variant
+	i := order.itemIndex("substitute-id", "")
+	if assert.GreaterOrEqual(t, i, 0) {
+		assert.Empty(t, order.Items[i].SKU)
+		assert.Equal(t, 18.0, order.Items[i].Total())
+	}
+	assert.Equal(t, 2, order.Items[order.itemIndex("product-id", "large-id")].Quantity)
+}
@@ -107,6 +107,59 @@ func (s server) CompleteOrder(ctx context.Context, request *orderingpb.CompleteO
 	return &orderingpb.CompleteOrderResponse{}, err
 }
 
+func (s server) RequestReturn(ctx context.Context, request *orderingpb.RequestReturnRequest) (*orderingpb.RequestReturnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("OrderID", request.GetId()),
+	)
+
+	items := make([]domain.ReturnItem, len(request.GetItems()))
+	for i, item := range request.GetItems() {
+		items[i] = domain.ReturnItem{
+			ProductID: item.GetProductId(),
+			VariantID: item.GetVariantId(),
+			Quantity:  int(item.GetQuantity()),
+		}
+	}
+
+	err := s.app.RequestReturn(ctx, commands.RequestReturn{
+		ID:     request.GetId(),
+		Items:  items,
+		Reason: request.GetReason(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &orderingpb.RequestReturnResponse{}, err
+}
+
+func (s server) ReviewReturnItem(ctx context.Context, request *orderingpb.ReviewReturnItemRequest) (*orderingpb.ReviewReturnItemResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("OrderID", request.GetId()),
+		attribute.String("ProductID", request.GetProductId()),
+		attribute.String("VariantID", request.GetVariantId()),
+		attribute.Bool("Approved", request.GetApproved()),
+	)
+
+	err := s.app.ReviewReturnItem(ctx, commands.ReviewReturnItem{
+		ID:        request.GetId(),
+		ProductID: request.GetProductId(),
+		VariantID: request.GetVariantId(),
+		Approved:  request.GetApproved(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &orderingpb.ReviewReturnItemResponse{}, err
+}
+
 func (s server) GetOrder(ctx context.Context, request *orderingpb.GetOrderRequest) (*orderingpb.GetOrderResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -132,23 +185,46 @@ func (s server) orderFromDomain(order *domain.Order) *orderingpb.Order {
 		items[i] = s.itemFromDomain(item)
 	}
 
+	allowed := order.Status.AllowedActions()
+	actions := make([]string, len(allowed))
+	for i, action := range allowed {
+		actions[i] = string(action)
+	}
+
+	returnItems := make([]*orderingpb.ReturnItem, len(order.ReturnItems))
+	for i, item := range order.ReturnItems {
+		returnItems[i] = &orderingpb.ReturnItem{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(item.Quantity),
+			Status:    string(item.Status),
+			VariantId: item.VariantID,
+		}
+	}
+
 	return &orderingpb.Order{
-		Id:         order.ID(),
-		CustomerId: order.CustomerID,
-		PaymentId:  order.PaymentID,
-		Items:      items,
-		Status:     order.Status.String(),
+		Id:           order.ID(),
+		CustomerId:   order.CustomerID,
+		PaymentId:    order.PaymentID,
+		Items:        items,
+		Status:       order.Status.String(),
+		Actions:      actions,
+		ReturnItems:  returnItems,
+		ReturnReason: order.ReturnReason,
 	}
 }
 
 func (s server) itemToDomain(item *orderingpb.Item) domain.Item {
 	return domain.Item{
 		ProductID:   item.GetProductId(),
+		VariantID:   item.GetVariantId(),
+		SKU:         item.GetSku(),
 		StoreID:     item.GetStoreId(),
 		StoreName:   item.GetStoreName(),
 		ProductName: item.GetProductName(),
 		Price:       item.GetPrice(),
 		Quantity:    int(item.GetQuantity()),
+		Discount:    item.GetDiscount(),
 	}
 }
 
@@ -160,5 +236,8 @@ func (s server) itemFromDomain(item domain.Item) *orderingpb.Item {
 		ProductName: item.ProductName,
 		Price:       item.Price,
 		Quantity:    int32(item.Quantity),
+		Discount:    item.Discount,
+		VariantId:   item.VariantID,
+		Sku:         item.SKU,
 	}
 }
@@ -30,6 +30,8 @@ func RegisterCommandHandlers(subscriber am.MessageSubscriber, handlers am.Messag
 	_, err := subscriber.Subscribe(orderingpb.CommandChannel, handlers, am.MessageFilter{
 		orderingpb.RejectOrderCommand,
 		orderingpb.ApproveOrderCommand,
+		orderingpb.RejectReturnCommand,
+		orderingpb.CompleteReturnCommand,
 	}, am.GroupName("ordering-commands"))
 	return err
 }
@@ -57,6 +59,10 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 		return h.doRejectOrder(ctx, cmd)
 	case orderingpb.ApproveOrderCommand:
 		return h.doApproveOrder(ctx, cmd)
+	case orderingpb.RejectReturnCommand:
+		return h.doRejectReturn(ctx, cmd)
+	case orderingpb.CompleteReturnCommand:
+		return h.doCompleteReturn(ctx, cmd)
 	}
 
 	return nil, nil
@@ -65,7 +71,10 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 func (h commandHandlers) doRejectOrder(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
 	payload := cmd.Payload().(*orderingpb.RejectOrder)
 
-	return nil, h.app.RejectOrder(ctx, commands.RejectOrder{ID: payload.GetId()})
+	return nil, h.app.RejectOrder(ctx, commands.RejectOrder{
+		ID:     payload.GetId(),
+		Reason: payload.GetReason(),
+	})
 }
 
 func (h commandHandlers) doApproveOrder(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
@@ -76,3 +85,15 @@ func (h commandHandlers) doApproveOrder(ctx context.Context, cmd ddd.Command) (d
 		ShoppingID: payload.GetShoppingId(),
 	})
 }
+
+func (h commandHandlers) doRejectReturn(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*orderingpb.RejectReturn)
+
+	return nil, h.app.RejectReturn(ctx, commands.RejectReturn{ID: payload.GetId()})
+}
+
+func (h commandHandlers) doCompleteReturn(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*orderingpb.CompleteReturn)
+
+	return nil, h.app.CompleteReturn(ctx, commands.CompleteReturn{ID: payload.GetId()})
+}
@@ -30,6 +30,12 @@ func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], hand
 		domain.OrderReadiedEvent,
 		domain.OrderCanceledEvent,
 		domain.OrderCompletedEvent,
+		domain.OrderItemShortPickedEvent,
+		domain.OrderItemSubstitutedEvent,
+		domain.OrderReturnRequestedEvent,
+		domain.OrderReturnApprovedEvent,
+		domain.OrderReturnRejectedEvent,
+		domain.OrderReturnedEvent,
 	)
 }
 
@@ -54,12 +60,26 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 	switch event.EventName() {
 	case domain.OrderCreatedEvent:
 		return h.onOrderCreated(ctx, event)
+	case domain.OrderRejectedEvent:
+		return h.onOrderRejected(ctx, event)
+	case domain.OrderApprovedEvent:
+		return h.onOrderApproved(ctx, event)
 	case domain.OrderReadiedEvent:
 		return h.onOrderReadied(ctx, event)
 	case domain.OrderCanceledEvent:
 		return h.onOrderCanceled(ctx, event)
 	case domain.OrderCompletedEvent:
 		return h.onOrderCompleted(ctx, event)
+	case domain.OrderItemShortPickedEvent, domain.OrderItemSubstitutedEvent:
+		return h.onOrderAdjusted(ctx, event)
+	case domain.OrderReturnRequestedEvent:
+		return h.onOrderReturnRequested(ctx, event)
+	case domain.OrderReturnApprovedEvent:
+		return h.onOrderReturnApproved(ctx, event)
+	case domain.OrderReturnRejectedEvent:
+		return h.onOrderReturnRejected(ctx, event)
+	case domain.OrderReturnedEvent:
+		return h.onOrderReturned(ctx, event)
 	}
 	return nil
 }
@@ -73,6 +93,8 @@ func (h domainHandlers[T]) onOrderCreated(ctx context.Context, event ddd.Event)
 			StoreId:   item.StoreID,
 			Price:     item.Price,
 			Quantity:  int32(item.Quantity),
+			VariantId: item.VariantID,
+			Discount:  item.Discount,
 		}
 	}
 	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
@@ -93,6 +115,7 @@ func (h domainHandlers[T]) onOrderRejected(ctx context.Context, event ddd.Event)
 			Id:         payload.ID(),
 			CustomerId: payload.CustomerID,
 			PaymentId:  payload.PaymentID,
+			Reason:     payload.RejectReason,
 		}),
 	)
 }
@@ -141,3 +164,80 @@ func (h domainHandlers[T]) onOrderCompleted(ctx context.Context, event ddd.Event
 		}),
 	)
 }
+
+func (h domainHandlers[T]) onOrderAdjusted(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
+		ddd.NewEvent(orderingpb.OrderAdjustedEvent, &orderingpb.OrderAdjusted{
+			Id:         payload.ID(),
+			CustomerId: payload.CustomerID,
+			PaymentId:  payload.PaymentID,
+			Total:      payload.GetTotal(),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onOrderReturnRequested(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	items := make([]*orderingpb.OrderReturnRequested_Item, len(payload.ReturnItems))
+	for i, item := range payload.ReturnItems {
+		items[i] = &orderingpb.OrderReturnRequested_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(item.Quantity),
+			VariantId: item.VariantID,
+		}
+	}
+	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
+		ddd.NewEvent(orderingpb.OrderReturnRequestedEvent, &orderingpb.OrderReturnRequested{
+			Id:         payload.ID(),
+			CustomerId: payload.CustomerID,
+			Items:      items,
+			Reason:     payload.ReturnReason,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onOrderReturnApproved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	approved := payload.ApprovedReturnItems()
+	items := make([]*orderingpb.OrderReturnApproved_Item, len(approved))
+	for i, item := range approved {
+		items[i] = &orderingpb.OrderReturnApproved_Item{
+			ProductId: item.ProductID,
+			StoreId:   item.StoreID,
+			Quantity:  int32(item.Quantity),
+			VariantId: item.VariantID,
+		}
+	}
+	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
+		ddd.NewEvent(orderingpb.OrderReturnApprovedEvent, &orderingpb.OrderReturnApproved{
+			Id:           payload.ID(),
+			CustomerId:   payload.CustomerID,
+			PaymentId:    payload.PaymentID,
+			Items:        items,
+			RefundAmount: payload.RefundAmount(),
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onOrderReturnRejected(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
+		ddd.NewEvent(orderingpb.OrderReturnRejectedEvent, &orderingpb.OrderReturnRejected{
+			Id:         payload.ID(),
+			CustomerId: payload.CustomerID,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onOrderReturned(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Order)
+	return h.publisher.Publish(ctx, orderingpb.OrderAggregateChannel,
+		ddd.NewEvent(orderingpb.OrderReturnedEvent, &orderingpb.OrderReturned{
+			Id:           payload.ID(),
+			CustomerId:   payload.CustomerID,
+			RefundAmount: payload.RefundAmount(),
+		}),
+	)
+}
@@ -39,6 +39,8 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	}
 
 	_, err = subscriber.Subscribe(depotpb.ShoppingListAggregateChannel, handlers, am.MessageFilter{
+		depotpb.ShoppingListItemShortPickedEvent,
+		depotpb.ShoppingListItemSubstitutedEvent,
 		depotpb.ShoppingListCompletedEvent,
 	}, am.GroupName("ordering-depot"))
 
@@ -66,6 +68,10 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 	switch event.EventName() {
 	case basketspb.BasketCheckedOutEvent:
 		return h.onBasketCheckedOut(ctx, event)
+	case depotpb.ShoppingListItemShortPickedEvent:
+		return h.onShoppingListItemShortPicked(ctx, event)
+	case depotpb.ShoppingListItemSubstitutedEvent:
+		return h.onShoppingListItemSubstituted(ctx, event)
 	case depotpb.ShoppingListCompletedEvent:
 		return h.onShoppingListCompleted(ctx, event)
 	}
@@ -80,11 +86,14 @@ func (h integrationHandlers[T]) onBasketCheckedOut(ctx context.Context, event dd
 	for i, item := range payload.GetItems() {
 		items[i] = domain.Item{
 			ProductID:   item.GetProductId(),
+			VariantID:   item.GetVariantId(),
+			SKU:         item.GetSku(),
 			StoreID:     item.GetStoreId(),
 			StoreName:   item.GetStoreName(),
 			ProductName: item.GetProductName(),
 			Price:       item.GetPrice(),
 			Quantity:    int(item.GetQuantity()),
+			Discount:    item.GetDiscount(),
 		}
 	}
 
@@ -96,6 +105,31 @@ func (h integrationHandlers[T]) onBasketCheckedOut(ctx context.Context, event dd
 	})
 }
 
+func (h integrationHandlers[T]) onShoppingListItemShortPicked(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*depotpb.ShoppingListItemShortPicked)
+
+	return h.app.ShortPickItem(ctx, commands.ShortPickItem{
+		ID:        payload.GetOrderId(),
+		ProductID: payload.GetProductId(),
+		VariantID: payload.GetVariantId(),
+		Quantity:  int(payload.GetQuantity()),
+	})
+}
+
+func (h integrationHandlers[T]) onShoppingListItemSubstituted(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*depotpb.ShoppingListItemSubstituted)
+
+	return h.app.SubstituteItem(ctx, commands.SubstituteItem{
+		ID:              payload.GetOrderId(),
+		ProductID:       payload.GetProductId(),
+		VariantID:       payload.GetVariantId(),
+		SubstituteID:    payload.GetSubstituteId(),
+		SubstituteName:  payload.GetSubstituteName(),
+		SubstitutePrice: payload.GetSubstitutePrice(),
+		Quantity:        int(payload.GetQuantity()),
+	})
+}
+
 func (h integrationHandlers[T]) onShoppingListCompleted(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*depotpb.ShoppingListCompleted)
 
@@ -8,13 +8,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/ordering/orderingpb"
 )
 
 func RegisterGateway(ctx context.Context, mux *chi.Mux, grpcAddr string) error {
 	const apiRoot = "/api/ordering"
 
-	gateway := runtime.NewServeMux()
+	gateway := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(tenant.HeaderMatcher))
 	err := orderingpb.RegisterOrderingServiceHandlerFromEndpoint(ctx, gateway, grpcAddr, []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	})
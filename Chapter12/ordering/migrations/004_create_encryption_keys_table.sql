@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE encryption_keys (
+  id            text        NOT NULL,
+  master_key_id text        NOT NULL,
+  encrypted_key bytea       NOT NULL,
+  created_at    timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX encryption_keys_created_at_idx ON encryption_keys (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS encryption_keys;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- claim checks and data keys are kept apart for each tenant
+ALTER TABLE claim_checks
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT claim_checks_pkey,
+  ADD PRIMARY KEY (tenant_id, key);
+
+ALTER TABLE encryption_keys
+  ADD COLUMN tenant_id text NOT NULL DEFAULT 'mallbots',
+  DROP CONSTRAINT encryption_keys_pkey,
+  ADD PRIMARY KEY (tenant_id, id);
+
+DROP INDEX IF EXISTS encryption_keys_created_at_idx;
+CREATE INDEX encryption_keys_created_at_idx ON encryption_keys (tenant_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS encryption_keys_created_at_idx;
+CREATE INDEX encryption_keys_created_at_idx ON encryption_keys (created_at);
+
+ALTER TABLE claim_checks
+  DROP CONSTRAINT claim_checks_pkey,
+  ADD PRIMARY KEY (key),
+  DROP COLUMN tenant_id;
+
+ALTER TABLE encryption_keys
+  DROP CONSTRAINT encryption_keys_pkey,
+  ADD PRIMARY KEY (id),
+  DROP COLUMN tenant_id;
@@ -11,8 +11,10 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
@@ -20,6 +22,7 @@ import (
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/ordering/internal/application"
 	"eda-in-golang/ordering/internal/constants"
@@ -55,7 +58,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.Event](), nil
 	})
@@ -68,16 +72,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -157,6 +169,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return nil
 }
@@ -191,6 +204,30 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(domain.OrderCompleted{}); err != nil {
 		return err
 	}
+	if err = serde.Register(domain.OrderItemShortPicked{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderItemSubstituted{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturnRequested{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturnItemApproved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturnItemRejected{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturnApproved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturnRejected{}); err != nil {
+		return err
+	}
+	if err = serde.Register(domain.OrderReturned{}); err != nil {
+		return err
+	}
 	// order snapshots
 	if err = serde.RegisterKey(domain.OrderV1{}.SnapshotName(), domain.OrderV1{}); err != nil {
 		return err
@@ -27,6 +27,8 @@ type OrderingServiceClient interface {
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
 	ReadyOrder(ctx context.Context, in *ReadyOrderRequest, opts ...grpc.CallOption) (*ReadyOrderResponse, error)
 	CompleteOrder(ctx context.Context, in *CompleteOrderRequest, opts ...grpc.CallOption) (*CompleteOrderResponse, error)
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error)
+	ReviewReturnItem(ctx context.Context, in *ReviewReturnItemRequest, opts ...grpc.CallOption) (*ReviewReturnItemResponse, error)
 }
 
 type orderingServiceClient struct {
@@ -82,6 +84,24 @@ func (c *orderingServiceClient) CompleteOrder(ctx context.Context, in *CompleteO
 	return out, nil
 }
 
+func (c *orderingServiceClient) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*RequestReturnResponse, error) {
+	out := new(RequestReturnResponse)
+	err := c.cc.Invoke(ctx, "/orderingpb.OrderingService/RequestReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderingServiceClient) ReviewReturnItem(ctx context.Context, in *ReviewReturnItemRequest, opts ...grpc.CallOption) (*ReviewReturnItemResponse, error) {
+	out := new(ReviewReturnItemResponse)
+	err := c.cc.Invoke(ctx, "/orderingpb.OrderingService/ReviewReturnItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderingServiceServer is the server API for OrderingService service.
 // All implementations must embed UnimplementedOrderingServiceServer
 // for forward compatibility
@@ -91,6 +111,8 @@ type OrderingServiceServer interface {
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
 	ReadyOrder(context.Context, *ReadyOrderRequest) (*ReadyOrderResponse, error)
 	CompleteOrder(context.Context, *CompleteOrderRequest) (*CompleteOrderResponse, error)
+	RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error)
+	ReviewReturnItem(context.Context, *ReviewReturnItemRequest) (*ReviewReturnItemResponse, error)
 	mustEmbedUnimplementedOrderingServiceServer()
 }
 
@@ -113,6 +135,12 @@ func (UnimplementedOrderingServiceServer) ReadyOrder(context.Context, *ReadyOrde
 func (UnimplementedOrderingServiceServer) CompleteOrder(context.Context, *CompleteOrderRequest) (*CompleteOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
 }
+func (UnimplementedOrderingServiceServer) RequestReturn(context.Context, *RequestReturnRequest) (*RequestReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedOrderingServiceServer) ReviewReturnItem(context.Context, *ReviewReturnItemRequest) (*ReviewReturnItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewReturnItem not implemented")
+}
 func (UnimplementedOrderingServiceServer) mustEmbedUnimplementedOrderingServiceServer() {}
 
 // UnsafeOrderingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +244,42 @@ func _OrderingService_CompleteOrder_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderingService_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderingServiceServer).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderingpb.OrderingService/RequestReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderingServiceServer).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderingService_ReviewReturnItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewReturnItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderingServiceServer).ReviewReturnItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderingpb.OrderingService/ReviewReturnItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderingServiceServer).ReviewReturnItem(ctx, req.(*ReviewReturnItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderingService_ServiceDesc is the grpc.ServiceDesc for OrderingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +307,14 @@ var OrderingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteOrder",
 			Handler:    _OrderingService_CompleteOrder_Handler,
 		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _OrderingService_RequestReturn_Handler,
+		},
+		{
+			MethodName: "ReviewReturnItem",
+			Handler:    _OrderingService_ReviewReturnItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orderingpb/api.proto",
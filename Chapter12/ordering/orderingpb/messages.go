@@ -14,11 +14,20 @@ const (
 	OrderReadiedEvent   = "ordersapi.OrderReadied"
 	OrderCanceledEvent  = "ordersapi.OrderCanceled"
 	OrderCompletedEvent = "ordersapi.OrderCompleted"
+	OrderAdjustedEvent  = "ordersapi.OrderAdjusted"
+
+	OrderReturnRequestedEvent = "ordersapi.OrderReturnRequested"
+	OrderReturnApprovedEvent  = "ordersapi.OrderReturnApproved"
+	OrderReturnRejectedEvent  = "ordersapi.OrderReturnRejected"
+	OrderReturnedEvent        = "ordersapi.OrderReturned"
 
 	CommandChannel = "mallbots.ordering.commands"
 
 	RejectOrderCommand  = "ordersapi.RejectOrder"
 	ApproveOrderCommand = "ordersapi.ApproveOrder"
+
+	RejectReturnCommand   = "ordersapi.RejectReturn"
+	CompleteReturnCommand = "ordersapi.CompleteReturn"
 )
 
 func Registrations(reg registry.Registry) (err error) {
@@ -43,6 +52,21 @@ func Registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(&OrderCompleted{}); err != nil {
 		return err
 	}
+	if err = serde.Register(&OrderAdjusted{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&OrderReturnRequested{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&OrderReturnApproved{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&OrderReturnRejected{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&OrderReturned{}); err != nil {
+		return err
+	}
 
 	if err = serde.Register(&RejectOrder{}); err != nil {
 		return err
@@ -50,6 +74,12 @@ func Registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(&ApproveOrder{}); err != nil {
 		return err
 	}
+	if err = serde.Register(&RejectReturn{}); err != nil {
+		return err
+	}
+	if err = serde.Register(&CompleteReturn{}); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -60,6 +90,15 @@ func (*OrderApproved) Key() string  { return OrderApprovedEvent }
 func (*OrderReadied) Key() string   { return OrderReadiedEvent }
 func (*OrderCanceled) Key() string  { return OrderCanceledEvent }
 func (*OrderCompleted) Key() string { return OrderCompletedEvent }
+func (*OrderAdjusted) Key() string  { return OrderAdjustedEvent }
+
+func (*OrderReturnRequested) Key() string { return OrderReturnRequestedEvent }
+func (*OrderReturnApproved) Key() string  { return OrderReturnApprovedEvent }
+func (*OrderReturnRejected) Key() string  { return OrderReturnRejectedEvent }
+func (*OrderReturned) Key() string        { return OrderReturnedEvent }
 
 func (*RejectOrder) Key() string  { return RejectOrderCommand }
 func (*ApproveOrder) Key() string { return ApproveOrderCommand }
+
+func (*RejectReturn) Key() string   { return RejectReturnCommand }
+func (*CompleteReturn) Key() string { return CompleteReturnCommand }
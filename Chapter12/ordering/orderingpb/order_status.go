@@ -0,0 +1,57 @@
+package orderingpb
+
+// OrderStatus is shared with the consumers of the order events so the status
+// they project follows the same transitions as the Order aggregate
+type OrderStatus string
+
+const (
+	OrderUnknown     OrderStatus = ""
+	OrderIsPending   OrderStatus = "pending"
+	OrderIsRejected  OrderStatus = "rejected"
+	OrderIsApproved  OrderStatus = "approved"
+	OrderIsInProcess OrderStatus = "in-progress"
+	OrderIsReady     OrderStatus = "ready"
+	OrderIsCompleted OrderStatus = "completed"
+	OrderIsCancelled OrderStatus = "cancelled"
+
+	OrderIsReturnRequested OrderStatus = "return-requested"
+	OrderIsReturning       OrderStatus = "returning"
+	OrderIsReturned        OrderStatus = "returned"
+)
+
+func (s OrderStatus) String() string {
+	switch s {
+	case OrderIsPending, OrderIsRejected, OrderIsApproved, OrderIsInProcess, OrderIsReady, OrderIsCompleted, OrderIsCancelled,
+		OrderIsReturnRequested, OrderIsReturning, OrderIsReturned:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func ToOrderStatus(status string) OrderStatus {
+	switch status {
+	case OrderIsPending.String():
+		return OrderIsPending
+	case OrderIsRejected.String():
+		return OrderIsRejected
+	case OrderIsApproved.String():
+		return OrderIsApproved
+	case OrderIsInProcess.String():
+		return OrderIsInProcess
+	case OrderIsReady.String():
+		return OrderIsReady
+	case OrderIsCancelled.String():
+		return OrderIsCancelled
+	case OrderIsCompleted.String():
+		return OrderIsCompleted
+	case OrderIsReturnRequested.String():
+		return OrderIsReturnRequested
+	case OrderIsReturning.String():
+		return OrderIsReturning
+	case OrderIsReturned.String():
+		return OrderIsReturned
+	default:
+		return OrderUnknown
+	}
+}
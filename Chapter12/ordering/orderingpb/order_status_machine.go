@@ -0,0 +1,188 @@
+package orderingpb
+
+import (
+	"fmt"
+
+	"github.com/stackus/errors"
+)
+
+type OrderAction string
+
+const (
+	OrderActionCreate   OrderAction = "create"
+	OrderActionReject   OrderAction = "reject"
+	OrderActionApprove  OrderAction = "approve"
+	OrderActionCancel   OrderAction = "cancel"
+	OrderActionReady    OrderAction = "ready"
+	OrderActionComplete OrderAction = "complete"
+
+	OrderActionRequestReturn  OrderAction = "request-return"
+	OrderActionReviewReturn   OrderAction = "review-return"
+	OrderActionApproveReturn  OrderAction = "approve-return"
+	OrderActionRejectReturn   OrderAction = "reject-return"
+	OrderActionCompleteReturn OrderAction = "complete-return"
+)
+
+var (
+	ErrOrderAlreadyCreated          = errors.Wrap(errors.ErrBadRequest, "the order cannot be recreated")
+	ErrOrderCannotBeCancelled       = errors.Wrap(errors.ErrBadRequest, "the order cannot be cancelled")
+	ErrInvalidOrderStatusTransition = errors.Wrap(errors.ErrBadRequest, "the order status cannot be changed")
+	ErrOrderCannotBeRejected        = errors.Wrap(errors.ErrBadRequest, "the order cannot be rejected")
+	ErrOrderCannotBeApproved        = errors.Wrap(errors.ErrBadRequest, "the order cannot be approved")
+	ErrOrderCannotBeReadied         = errors.Wrap(errors.ErrBadRequest, "the order cannot be readied")
+	ErrOrderCannotBeCompleted       = errors.Wrap(errors.ErrBadRequest, "the order cannot be completed")
+
+	ErrOrderReturnCannotBeRequested = errors.Wrap(errors.ErrBadRequest, "a return cannot be requested for the order")
+	ErrOrderReturnCannotBeReviewed  = errors.Wrap(errors.ErrBadRequest, "the order return cannot be reviewed")
+	ErrOrderReturnCannotBeApproved  = errors.Wrap(errors.ErrBadRequest, "the order return cannot be approved")
+	ErrOrderReturnCannotBeRejected  = errors.Wrap(errors.ErrBadRequest, "the order return cannot be rejected")
+	ErrOrderReturnCannotBeCompleted = errors.Wrap(errors.ErrBadRequest, "the order return cannot be completed")
+)
+
+type orderTransition struct {
+	action OrderAction
+	from   OrderStatus
+	to     OrderStatus
+}
+
+// orderTransitions are the only legal changes to the status of an order; the
+// order they are listed in is the order actions are reported in
+var orderTransitions = []orderTransition{
+	{action: OrderActionCreate, from: OrderUnknown, to: OrderIsPending},
+	{action: OrderActionReject, from: OrderIsPending, to: OrderIsRejected},
+	{action: OrderActionApprove, from: OrderIsPending, to: OrderIsApproved},
+	{action: OrderActionCancel, from: OrderIsPending, to: OrderIsCancelled},
+	{action: OrderActionReady, from: OrderIsApproved, to: OrderIsReady},
+	{action: OrderActionReady, from: OrderIsInProcess, to: OrderIsReady},
+	{action: OrderActionComplete, from: OrderIsReady, to: OrderIsCompleted},
+	{action: OrderActionRequestReturn, from: OrderIsCompleted, to: OrderIsReturnRequested},
+	{action: OrderActionReviewReturn, from: OrderIsReturnRequested, to: OrderIsReturnRequested},
+	{action: OrderActionApproveReturn, from: OrderIsReturnRequested, to: OrderIsReturning},
+	{action: OrderActionRejectReturn, from: OrderIsReturnRequested, to: OrderIsCompleted},
+	{action: OrderActionRejectReturn, from: OrderIsReturning, to: OrderIsCompleted},
+	{action: OrderActionRejectReturn, from: OrderIsReturned, to: OrderIsCompleted},
+	{action: OrderActionCompleteReturn, from: OrderIsReturning, to: OrderIsReturned},
+}
+
+// OrderStatusError is returned for an action or a status change that the
+// current status of an order does not allow
+type OrderStatusError struct {
+	From   OrderStatus
+	To     OrderStatus
+	Action OrderAction
+}
+
+func (e OrderStatusError) Error() string {
+	if e.Action == "" {
+		return fmt.Sprintf("%s: from %q to %q", ErrInvalidOrderStatusTransition.Error(), e.From, e.To)
+	}
+	return fmt.Sprintf("%s: %q is not allowed when the order is %q", e.Unwrap().Error(), e.Action, e.From)
+}
+
+func (e OrderStatusError) Unwrap() error {
+	switch e.Action {
+	case OrderActionCreate:
+		return ErrOrderAlreadyCreated
+	case OrderActionReject:
+		return ErrOrderCannotBeRejected
+	case OrderActionApprove:
+		return ErrOrderCannotBeApproved
+	case OrderActionCancel:
+		return ErrOrderCannotBeCancelled
+	case OrderActionReady:
+		return ErrOrderCannotBeReadied
+	case OrderActionComplete:
+		return ErrOrderCannotBeCompleted
+	case OrderActionRequestReturn:
+		return ErrOrderReturnCannotBeRequested
+	case OrderActionReviewReturn:
+		return ErrOrderReturnCannotBeReviewed
+	case OrderActionApproveReturn:
+		return ErrOrderReturnCannotBeApproved
+	case OrderActionRejectReturn:
+		return ErrOrderReturnCannotBeRejected
+	case OrderActionCompleteReturn:
+		return ErrOrderReturnCannotBeCompleted
+	default:
+		return ErrInvalidOrderStatusTransition
+	}
+}
+
+// Next returns the status an order moves to when the action is performed
+func (s OrderStatus) Next(action OrderAction) (OrderStatus, error) {
+	for _, t := range orderTransitions {
+		if t.action == action && t.from == s {
+			return t.to, nil
+		}
+	}
+
+	return s, OrderStatusError{From: s, Action: action}
+}
+
+// AllowedActions lists the actions that may be performed on an order in this status
+func (s OrderStatus) AllowedActions() []OrderAction {
+	actions := make([]OrderAction, 0)
+	for _, t := range orderTransitions {
+		if t.from == s {
+			actions = append(actions, t.action)
+		}
+	}
+
+	return actions
+}
+
+// CanTransitionTo reports if any action moves an order from this status to the next
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, t := range orderTransitions {
+		if t.from == s && t.to == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TransitionTo validates a change of status without knowing the action that caused it
+func (s OrderStatus) TransitionTo(next OrderStatus) error {
+	if !s.CanTransitionTo(next) {
+		return OrderStatusError{From: s, To: next}
+	}
+
+	return nil
+}
+
+// PreviousStatuses lists the statuses an order may be in immediately before
+// it is moved into this status
+func (s OrderStatus) PreviousStatuses() []OrderStatus {
+	statuses := make([]OrderStatus, 0)
+	for _, t := range orderTransitions {
+		if t.to == s {
+			statuses = append(statuses, t.from)
+		}
+	}
+
+	return statuses
+}
+
+// CanReach reports if an order in this status may later be moved into the next
+// status by one or more actions
+func (s OrderStatus) CanReach(next OrderStatus) bool {
+	seen := map[OrderStatus]bool{}
+	statuses := []OrderStatus{s}
+	for len(statuses) > 0 {
+		status := statuses[0]
+		statuses = statuses[1:]
+		for _, t := range orderTransitions {
+			if t.from != status || seen[t.to] {
+				continue
+			}
+			if t.to == next {
+				return true
+			}
+			seen[t.to] = true
+			statuses = append(statuses, t.to)
+		}
+	}
+
+	return false
+}
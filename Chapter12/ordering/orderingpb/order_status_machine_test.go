@@ -0,0 +1,149 @@
+package orderingpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderStatus_Next(t *testing.T) {
+	tests := map[string]struct {
+		from    OrderStatus
+		action  OrderAction
+		want    OrderStatus
+		wantErr error
+	}{
+		"Create": {
+			from:   OrderUnknown,
+			action: OrderActionCreate,
+			want:   OrderIsPending,
+		},
+		"Recreate": {
+			from:    OrderIsPending,
+			action:  OrderActionCreate,
+			want:    OrderIsPending,
+			wantErr: ErrOrderAlreadyCreated,
+		},
+		"Approve": {
+			from:   OrderIsPending,
+			action: OrderActionApprove,
+			want:   OrderIsApproved,
+		},
+		"CancelApproved": {
+			from:    OrderIsApproved,
+			action:  OrderActionCancel,
+			want:    OrderIsApproved,
+			wantErr: ErrOrderCannotBeCancelled,
+		},
+		"ReadyInProcess": {
+			from:   OrderIsInProcess,
+			action: OrderActionReady,
+			want:   OrderIsReady,
+		},
+		"CompletePending": {
+			from:    OrderIsPending,
+			action:  OrderActionComplete,
+			want:    OrderIsPending,
+			wantErr: ErrOrderCannotBeCompleted,
+		},
+		"ReviewReturn": {
+			from:   OrderIsReturnRequested,
+			action: OrderActionReviewReturn,
+			want:   OrderIsReturnRequested,
+		},
+		"RejectReturning": {
+			from:   OrderIsReturning,
+			action: OrderActionRejectReturn,
+			want:   OrderIsCompleted,
+		},
+		"RequestReturnTwice": {
+			from:    OrderIsReturnRequested,
+			action:  OrderActionRequestReturn,
+			want:    OrderIsReturnRequested,
+			wantErr: ErrOrderReturnCannotBeRequested,
+		},
+		"CompleteReturnRequested": {
+			from:    OrderIsReturnRequested,
+			action:  OrderActionCompleteReturn,
+			want:    OrderIsReturnRequested,
+			wantErr: ErrOrderReturnCannotBeCompleted,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.from.Next(tc.action)
+			assert.Equal(t, tc.want, got)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.wantErr)
+			var statusErr OrderStatusError
+			if assert.True(t, errors.As(err, &statusErr)) {
+				assert.Equal(t, tc.from, statusErr.From)
+				assert.Equal(t, tc.action, statusErr.Action)
+			}
+		})
+	}
+}
+
+func TestOrderStatus_TransitionTo(t *testing.T) {
+	tests := map[string]struct {
+		from    OrderStatus
+		to      OrderStatus
+		wantErr bool
+	}{
+		"PendingToApproved":          {from: OrderIsPending, to: OrderIsApproved},
+		"PendingToRejected":          {from: OrderIsPending, to: OrderIsRejected},
+		"ApprovedToReady":            {from: OrderIsApproved, to: OrderIsReady},
+		"ReadyToCompleted":           {from: OrderIsReady, to: OrderIsCompleted},
+		"CompletedToReturnRequested": {from: OrderIsCompleted, to: OrderIsReturnRequested},
+		"ReturnRequestedToCompleted": {from: OrderIsReturnRequested, to: OrderIsCompleted},
+		"ReturningToReturned":        {from: OrderIsReturning, to: OrderIsReturned},
+		"ReturnedToCompleted":        {from: OrderIsReturned, to: OrderIsCompleted},
+		"PendingToCompleted":         {from: OrderIsPending, to: OrderIsCompleted, wantErr: true},
+		"CompletedToApproved":        {from: OrderIsCompleted, to: OrderIsApproved, wantErr: true},
+		"CancelledToPending":         {from: OrderIsCancelled, to: OrderIsPending, wantErr: true},
+		"ApprovedToApproved":         {from: OrderIsApproved, to: OrderIsApproved, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.from.TransitionTo(tc.to)
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidOrderStatusTransition)
+		})
+	}
+}
+
+func TestOrderStatus_CanReach(t *testing.T) {
+	tests := map[string]struct {
+		from OrderStatus
+		to   OrderStatus
+		want bool
+	}{
+		"Next":                       {from: OrderIsPending, to: OrderIsApproved, want: true},
+		"Later":                      {from: OrderIsPending, to: OrderIsCompleted, want: true},
+		"ReturnedFromApproved":       {from: OrderIsApproved, to: OrderIsReturned, want: true},
+		"Earlier":                    {from: OrderIsCompleted, to: OrderIsApproved},
+		"FromRejected":               {from: OrderIsRejected, to: OrderIsApproved},
+		"FromReturned":               {from: OrderIsReturned, to: OrderIsApproved},
+		"CompletedAfterReturnReject": {from: OrderIsCompleted, to: OrderIsCompleted, want: true},
+		"ApprovedAgain":              {from: OrderIsApproved, to: OrderIsApproved},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.from.CanReach(tc.to))
+		})
+	}
+}
+
+func TestOrderStatus_AllowedActions(t *testing.T) {
+	assert.Equal(t, []OrderAction{OrderActionReject, OrderActionApprove, OrderActionCancel}, OrderIsPending.AllowedActions())
+	assert.Equal(t, []OrderAction{OrderActionRejectReturn, OrderActionCompleteReturn}, OrderIsReturning.AllowedActions())
+	assert.Equal(t, []OrderAction{OrderActionRejectReturn}, OrderIsReturned.AllowedActions())
+	assert.Empty(t, OrderIsCancelled.AllowedActions())
+}
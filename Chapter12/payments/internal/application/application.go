@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/stackus/errors"
 
@@ -11,13 +12,20 @@ import (
 
 type (
 	AuthorizePayment struct {
-		ID         string
-		CustomerID string
-		Amount     float64
+		ID             string
+		CustomerID     string
+		Amount         float64
+		IdempotencyKey string
 	}
 
 	ConfirmPayment struct {
-		ID string
+		ID     string
+		Amount float64
+	}
+
+	VoidPayment struct {
+		ID      string
+		OrderID string
 	}
 
 	CreateInvoice struct {
@@ -40,55 +48,138 @@ type (
 		ID string
 	}
 
+	IssueRefund struct {
+		ID        string
+		OrderID   string
+		PaymentID string
+		Amount    float64
+	}
+
 	App interface {
 		AuthorizePayment(ctx context.Context, authorize AuthorizePayment) error
 		ConfirmPayment(ctx context.Context, confirm ConfirmPayment) error
+		VoidPayment(ctx context.Context, void VoidPayment) error
 		CreateInvoice(ctx context.Context, create CreateInvoice) error
 		AdjustInvoice(ctx context.Context, adjust AdjustInvoice) error
 		PayInvoice(ctx context.Context, pay PayInvoice) error
 		CancelInvoice(ctx context.Context, cancel CancelInvoice) error
+		IssueRefund(ctx context.Context, issue IssueRefund) error
 	}
 
 	Application struct {
 		invoices  InvoiceRepository
 		payments  PaymentRepository
+		refunds   RefundRepository
+		gateway   PaymentGateway
 		publisher ddd.EventPublisher[ddd.Event]
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(invoices InvoiceRepository, payments PaymentRepository, publisher ddd.EventPublisher[ddd.Event]) *Application {
+var (
+	ErrIdempotencyKeyReused = errors.Wrap(errors.ErrConflict, "the idempotency key was used for a different payment")
+	ErrRefundIDReused       = errors.Wrap(errors.ErrConflict, "the refund id was used for a different refund")
+)
+
+func New(invoices InvoiceRepository, payments PaymentRepository, refunds RefundRepository, gateway PaymentGateway, publisher ddd.EventPublisher[ddd.Event]) *Application {
 	return &Application{
 		invoices:  invoices,
 		payments:  payments,
+		refunds:   refunds,
+		gateway:   gateway,
 		publisher: publisher,
 	}
 }
 
 func (a Application) AuthorizePayment(ctx context.Context, authorize AuthorizePayment) error {
+	if authorize.Amount <= 0 {
+		return models.ErrPaymentAmountNotPositive
+	}
+
+	if authorize.IdempotencyKey != "" {
+		payment, err := a.payments.FindByIdempotencyKey(ctx, authorize.CustomerID, authorize.IdempotencyKey)
+		switch {
+		case err == nil:
+			// a retried request; the original authorization stands
+			if payment.ID != authorize.ID || payment.Amount != authorize.Amount {
+				return ErrIdempotencyKeyReused
+			}
+			return nil
+		case !errors.Is(err, errors.ErrNotFound):
+			return err
+		}
+	}
+
+	reference, err := a.gateway.Authorize(ctx, authorize.CustomerID, authorize.Amount, authorize.IdempotencyKey)
+	if err != nil {
+		return err
+	}
+
 	return a.payments.Save(ctx, &models.Payment{
-		ID:         authorize.ID,
-		CustomerID: authorize.CustomerID,
-		Amount:     authorize.Amount,
+		ID:             authorize.ID,
+		CustomerID:     authorize.CustomerID,
+		IdempotencyKey: authorize.IdempotencyKey,
+		Reference:      reference,
+		Amount:         authorize.Amount,
+		Status:         models.PaymentIsAuthorized,
 	})
 }
 
 func (a Application) ConfirmPayment(ctx context.Context, confirm ConfirmPayment) error {
-	if payment, err := a.payments.Find(ctx, confirm.ID); err != nil || payment == nil {
-		return errors.Wrap(errors.ErrNotFound, "payment cannot be confirmed")
+	payment, err := a.payments.Find(ctx, confirm.ID)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	if err = payment.Capture(confirm.Amount); err != nil {
+		return err
+	}
+
+	if err = a.gateway.Capture(ctx, payment.Reference, confirm.Amount); err != nil {
+		return err
+	}
+
+	return a.payments.Update(ctx, payment)
+}
+
+// VoidPayment releases the authorization of a payment that will not be
+// captured; a payment that was already captured is refunded instead
+func (a Application) VoidPayment(ctx context.Context, void VoidPayment) error {
+	payment, err := a.payments.Find(ctx, void.ID)
+	if err != nil {
+		return err
+	}
+
+	if payment.Status == models.PaymentIsCaptured {
+		return a.refundPayment(ctx, payment, payment.ID, void.OrderID, payment.Refundable())
+	}
+
+	if err = payment.Void(); err != nil {
+		return err
+	}
+
+	if err = a.gateway.Void(ctx, payment.Reference); err != nil {
+		return err
+	}
+
+	return a.payments.Update(ctx, payment)
 }
 
 func (a Application) CreateInvoice(ctx context.Context, create CreateInvoice) error {
-	return a.invoices.Save(ctx, &models.Invoice{
-		ID:      create.ID,
-		OrderID: create.OrderID,
-		Amount:  create.Amount,
-		Status:  models.InvoiceIsPending,
-	})
+	invoice := &models.Invoice{
+		ID:        create.ID,
+		OrderID:   create.OrderID,
+		PaymentID: create.PaymentID,
+		Amount:    create.Amount,
+		Status:    models.InvoiceIsPending,
+	}
+
+	if err := a.invoices.Save(ctx, invoice); err != nil {
+		return err
+	}
+
+	return a.refundOvercharge(ctx, invoice)
 }
 
 func (a Application) AdjustInvoice(ctx context.Context, adjust AdjustInvoice) error {
@@ -97,9 +188,17 @@ func (a Application) AdjustInvoice(ctx context.Context, adjust AdjustInvoice) er
 		return err
 	}
 
+	if invoice.Status != models.InvoiceIsPending {
+		return errors.Wrap(errors.ErrBadRequest, "invoice cannot be adjusted")
+	}
+
 	invoice.Amount = adjust.Amount
 
-	return a.invoices.Update(ctx, invoice)
+	if err = a.invoices.Update(ctx, invoice); err != nil {
+		return err
+	}
+
+	return a.refundOvercharge(ctx, invoice)
 }
 
 func (a Application) PayInvoice(ctx context.Context, pay PayInvoice) error {
@@ -140,3 +239,84 @@ func (a Application) CancelInvoice(ctx context.Context, cancel CancelInvoice) er
 
 	return a.invoices.Update(ctx, invoice)
 }
+
+func (a Application) IssueRefund(ctx context.Context, issue IssueRefund) error {
+	refund, err := a.refunds.Find(ctx, issue.ID)
+	switch {
+	case err == nil:
+		// a retried command; the original refund stands
+		if refund.PaymentID != issue.PaymentID || refund.Amount != issue.Amount {
+			return ErrRefundIDReused
+		}
+		return nil
+	case !errors.Is(err, errors.ErrNotFound):
+		return err
+	}
+
+	payment, err := a.payments.Find(ctx, issue.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	return a.refundPayment(ctx, payment, issue.ID, issue.OrderID, issue.Amount)
+}
+
+// refundOvercharge refunds the part of the captured payment that is more than
+// what the order was invoiced for
+//
+// Each refund leaves the payment with exactly the invoiced amount, so the
+// amount identifies the refund and a retried adjustment is refunded only once
+func (a Application) refundOvercharge(ctx context.Context, invoice *models.Invoice) error {
+	// invoices created before they were linked to their payments
+	if invoice.PaymentID == "" {
+		return nil
+	}
+
+	payment, err := a.payments.Find(ctx, invoice.PaymentID)
+	if err != nil {
+		return err
+	}
+
+	overcharge := payment.Refundable() - invoice.Amount
+	if overcharge <= 0 {
+		return nil
+	}
+
+	refundID := fmt.Sprintf("%s-%.4f", invoice.ID, invoice.Amount)
+
+	return a.refundPayment(ctx, payment, refundID, invoice.OrderID, overcharge)
+}
+
+func (a Application) refundPayment(ctx context.Context, payment *models.Payment, refundID, orderID string, amount float64) error {
+	if err := payment.Refund(amount); err != nil {
+		return err
+	}
+
+	if err := a.gateway.Refund(ctx, payment.Reference, amount, refundID); err != nil {
+		return err
+	}
+
+	if err := a.payments.Update(ctx, payment); err != nil {
+		return err
+	}
+
+	refund := &models.Refund{
+		ID:         refundID,
+		OrderID:    orderID,
+		PaymentID:  payment.ID,
+		CustomerID: payment.CustomerID,
+		Amount:     amount,
+	}
+
+	if err := a.refunds.Save(ctx, refund); err != nil {
+		return err
+	}
+
+	return a.publisher.Publish(ctx, ddd.NewEvent(models.RefundIssuedEvent, &models.RefundIssued{
+		ID:         refund.ID,
+		OrderID:    refund.OrderID,
+		PaymentID:  refund.PaymentID,
+		CustomerID: refund.CustomerID,
+		Amount:     refund.Amount,
+	}))
+}
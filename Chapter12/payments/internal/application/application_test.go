@@ -0,0 +1,225 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackus/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/payments/internal/models"
+)
+
+type mocks struct {
+	invoices  *MockInvoiceRepository
+	payments  *MockPaymentRepository
+	refunds   *MockRefundRepository
+	gateway   *MockPaymentGateway
+	publisher *ddd.MockEventPublisher[ddd.Event]
+}
+
+func newMocks(t *testing.T) mocks {
+	return mocks{
+		invoices:  NewMockInvoiceRepository(t),
+		payments:  NewMockPaymentRepository(t),
+		refunds:   NewMockRefundRepository(t),
+		gateway:   NewMockPaymentGateway(t),
+		publisher: ddd.NewMockEventPublisher[ddd.Event](t),
+	}
+}
+
+func (m mocks) app() *Application {
+	return New(m.invoices, m.payments, m.refunds, m.gateway, m.publisher)
+}
+
+func (m mocks) onRefund(paymentID, refundID string, amount float64) {
+	m.gateway.On("Refund", context.Background(), "reference", amount, refundID).Return(nil)
+	m.payments.On("Update", context.Background(), mock.MatchedBy(func(payment *models.Payment) bool {
+		return payment.ID == paymentID
+	})).Return(nil)
+	m.refunds.On("Save", context.Background(), mock.MatchedBy(func(refund *models.Refund) bool {
+		return refund.ID == refundID && refund.PaymentID == paymentID && refund.Amount == amount
+	})).Return(nil)
+	m.publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil)
+}
+
+func TestApplication_VoidPayment(t *testing.T) {
+	tests := map[string]struct {
+		payment    *models.Payment
+		on         func(m mocks)
+		wantStatus models.PaymentStatus
+		wantErr    error
+	}{
+		"Authorized": {
+			payment: &models.Payment{ID: "payment-id", Reference: "reference", Amount: 100, Status: models.PaymentIsAuthorized},
+			on: func(m mocks) {
+				m.gateway.On("Void", context.Background(), "reference").Return(nil)
+				m.payments.On("Update", context.Background(), mock.AnythingOfType("*models.Payment")).Return(nil)
+			},
+			wantStatus: models.PaymentIsVoided,
+		},
+		"Captured": {
+			payment: &models.Payment{ID: "payment-id", Reference: "reference", Amount: 100, Captured: 100, Refunded: 20, Status: models.PaymentIsCaptured},
+			on: func(m mocks) {
+				m.onRefund("payment-id", "payment-id", 80)
+			},
+			wantStatus: models.PaymentIsRefunded,
+		},
+		"Voided": {
+			payment:    &models.Payment{ID: "payment-id", Reference: "reference", Amount: 100, Status: models.PaymentIsVoided},
+			wantStatus: models.PaymentIsVoided,
+			wantErr:    models.ErrPaymentCannotBeVoided,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newMocks(t)
+			m.payments.On("Find", context.Background(), "payment-id").Return(tc.payment, nil)
+			if tc.on != nil {
+				tc.on(m)
+			}
+
+			err := m.app().VoidPayment(context.Background(), VoidPayment{ID: "payment-id", OrderID: "order-id"})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantStatus, tc.payment.Status)
+		})
+	}
+}
+
+func TestApplication_AdjustInvoice(t *testing.T) {
+	tests := map[string]struct {
+		paymentID string
+		amount    float64
+		on        func(m mocks)
+	}{
+		"Lower": {
+			paymentID: "payment-id",
+			amount:    75.5,
+			on: func(m mocks) {
+				m.payments.On("Find", context.Background(), "payment-id").Return(&models.Payment{
+					ID: "payment-id", Reference: "reference", Amount: 100, Captured: 90, Status: models.PaymentIsCaptured,
+				}, nil)
+				m.onRefund("payment-id", "invoice-id-75.5000", 14.5)
+			},
+		},
+		"Higher": {
+			paymentID: "payment-id",
+			amount:    95,
+			on: func(m mocks) {
+				m.payments.On("Find", context.Background(), "payment-id").Return(&models.Payment{
+					ID: "payment-id", Reference: "reference", Amount: 100, Captured: 90, Status: models.PaymentIsCaptured,
+				}, nil)
+			},
+		},
+		"AlreadyRefunded": {
+			paymentID: "payment-id",
+			amount:    80,
+			on: func(m mocks) {
+				m.payments.On("Find", context.Background(), "payment-id").Return(&models.Payment{
+					ID: "payment-id", Reference: "reference", Amount: 100, Captured: 90, Refunded: 10, Status: models.PaymentIsCaptured,
+				}, nil)
+			},
+		},
+		"Unlinked": {
+			amount: 50,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newMocks(t)
+			m.invoices.On("Find", context.Background(), "invoice-id").Return(&models.Invoice{
+				ID:        "invoice-id",
+				OrderID:   "order-id",
+				PaymentID: tc.paymentID,
+				Amount:    90,
+				Status:    models.InvoiceIsPending,
+			}, nil)
+			m.invoices.On("Update", context.Background(), mock.MatchedBy(func(invoice *models.Invoice) bool {
+				return invoice.Amount == tc.amount
+			})).Return(nil)
+			if tc.on != nil {
+				tc.on(m)
+			}
+
+			err := m.app().AdjustInvoice(context.Background(), AdjustInvoice{ID: "invoice-id", Amount: tc.amount})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestApplication_IssueRefund(t *testing.T) {
+	issue := IssueRefund{ID: "return-id", OrderID: "order-id", PaymentID: "payment-id", Amount: 9.5}
+
+	tests := map[string]struct {
+		on      func(m mocks)
+		wantErr error
+	}{
+		"New": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(nil, errors.ErrNotFound)
+				m.payments.On("Find", context.Background(), "payment-id").Return(&models.Payment{
+					ID: "payment-id", Reference: "reference", Amount: 100, Captured: 90, Status: models.PaymentIsCaptured,
+				}, nil)
+				m.onRefund("payment-id", "return-id", 9.5)
+			},
+		},
+		// a redelivered command does not refund the customer twice
+		"Retried": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(&models.Refund{
+					ID: "return-id", OrderID: "order-id", PaymentID: "payment-id", Amount: 9.5,
+				}, nil)
+			},
+		},
+		"ReusedForAnotherPayment": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(&models.Refund{
+					ID: "return-id", OrderID: "order-id", PaymentID: "other-id", Amount: 9.5,
+				}, nil)
+			},
+			wantErr: ErrRefundIDReused,
+		},
+		"ReusedForAnotherAmount": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(&models.Refund{
+					ID: "return-id", OrderID: "order-id", PaymentID: "payment-id", Amount: 5,
+				}, nil)
+			},
+			wantErr: ErrRefundIDReused,
+		},
+		"ExceedsCapture": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(nil, errors.ErrNotFound)
+				m.payments.On("Find", context.Background(), "payment-id").Return(&models.Payment{
+					ID: "payment-id", Reference: "reference", Amount: 100, Captured: 90, Refunded: 85, Status: models.PaymentIsCaptured,
+				}, nil)
+			},
+			wantErr: models.ErrPaymentRefundExceedsCapture,
+		},
+		"FindFailed": {
+			on: func(m mocks) {
+				m.refunds.On("Find", context.Background(), "return-id").Return(nil, errors.ErrInternal)
+			},
+			wantErr: errors.ErrInternal,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newMocks(t)
+			tc.on(m)
+
+			err := m.app().IssueRefund(context.Background(), issue)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -83,6 +83,20 @@ func (_m *MockApp) CreateInvoice(ctx context.Context, create CreateInvoice) erro
 	return r0
 }
 
+// IssueRefund provides a mock function with given fields: ctx, issue
+func (_m *MockApp) IssueRefund(ctx context.Context, issue IssueRefund) error {
+	ret := _m.Called(ctx, issue)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, IssueRefund) error); ok {
+		r0 = rf(ctx, issue)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PayInvoice provides a mock function with given fields: ctx, pay
 func (_m *MockApp) PayInvoice(ctx context.Context, pay PayInvoice) error {
 	ret := _m.Called(ctx, pay)
@@ -97,6 +111,20 @@ func (_m *MockApp) PayInvoice(ctx context.Context, pay PayInvoice) error {
 	return r0
 }
 
+// VoidPayment provides a mock function with given fields: ctx, void
+func (_m *MockApp) VoidPayment(ctx context.Context, void VoidPayment) error {
+	ret := _m.Called(ctx, void)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, VoidPayment) error); ok {
+		r0 = rf(ctx, void)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockApp interface {
 	mock.TestingT
 	Cleanup(func())
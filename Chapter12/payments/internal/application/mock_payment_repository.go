@@ -37,6 +37,29 @@ func (_m *MockPaymentRepository) Find(ctx context.Context, paymentID string) (*m
 	return r0, r1
 }
 
+// FindByIdempotencyKey provides a mock function with given fields: ctx, customerID, key
+func (_m *MockPaymentRepository) FindByIdempotencyKey(ctx context.Context, customerID string, key string) (*models.Payment, error) {
+	ret := _m.Called(ctx, customerID, key)
+
+	var r0 *models.Payment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Payment); ok {
+		r0 = rf(ctx, customerID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, customerID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: ctx, payment
 func (_m *MockPaymentRepository) Save(ctx context.Context, payment *models.Payment) error {
 	ret := _m.Called(ctx, payment)
@@ -51,6 +74,20 @@ func (_m *MockPaymentRepository) Save(ctx context.Context, payment *models.Payme
 	return r0
 }
 
+// Update provides a mock function with given fields: ctx, payment
+func (_m *MockPaymentRepository) Update(ctx context.Context, payment *models.Payment) error {
+	ret := _m.Called(ctx, payment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Payment) error); ok {
+		r0 = rf(ctx, payment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockPaymentRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,13 @@
+package application
+
+import (
+	"context"
+)
+
+// PaymentGateway is the payment processor that holds and moves the customer's funds
+type PaymentGateway interface {
+	Authorize(ctx context.Context, customerID string, amount float64, idempotencyKey string) (reference string, err error)
+	Capture(ctx context.Context, reference string, amount float64) error
+	Void(ctx context.Context, reference string) error
+	Refund(ctx context.Context, reference string, amount float64, idempotencyKey string) error
+}
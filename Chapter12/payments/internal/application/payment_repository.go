@@ -9,4 +9,6 @@ import (
 type PaymentRepository interface {
 	Save(ctx context.Context, payment *models.Payment) error
 	Find(ctx context.Context, paymentID string) (*models.Payment, error)
+	FindByIdempotencyKey(ctx context.Context, customerID, key string) (*models.Payment, error)
+	Update(ctx context.Context, payment *models.Payment) error
 }
@@ -0,0 +1,12 @@
+package application
+
+import (
+	"context"
+
+	"eda-in-golang/payments/internal/models"
+)
+
+type RefundRepository interface {
+	Save(ctx context.Context, refund *models.Refund) error
+	Find(ctx context.Context, refundID string) (*models.Refund, error)
+}
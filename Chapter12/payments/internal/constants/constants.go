@@ -29,6 +29,9 @@ const (
 
 	InvoicesRepoKey = "invoicesRepo"
 	PaymentsRepoKey = "paymentsRepo"
+	RefundsRepoKey  = "refundsRepo"
+
+	PaymentGatewayKey = "paymentGateway"
 )
 
 // Repository Table Names
@@ -41,4 +44,5 @@ const (
 
 	InvoicesTableName = ServiceName + ".invoices"
 	PaymentsTableName = ServiceName + ".payments"
+	RefundsTableName  = ServiceName + ".refunds"
 )
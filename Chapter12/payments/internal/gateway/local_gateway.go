@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/config"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/payments/internal/application"
+)
+
+const localReferencePrefix = "local-"
+
+var (
+	ErrAuthorizationDeclined = errors.Wrap(errors.ErrFailedPrecondition, "the payment authorization was declined")
+	ErrUnknownReference      = errors.Wrap(errors.ErrNotFound, "the gateway does not know the payment reference")
+)
+
+// LocalGateway stands in for a payment processor during development; it
+// approves every authorization up to the limit of the tenant and accepts any
+// later movement of funds for the references it issued
+type LocalGateway struct {
+	cfg config.PaymentsConfig
+}
+
+var _ application.PaymentGateway = (*LocalGateway)(nil)
+
+func NewLocalGateway(cfg config.PaymentsConfig) LocalGateway {
+	return LocalGateway{
+		cfg: cfg,
+	}
+}
+
+func (g LocalGateway) Authorize(ctx context.Context, _ string, amount float64, _ string) (string, error) {
+	if amount > g.cfg.ForTenant(tenant.FromContext(ctx)).AuthorizationLimit {
+		return "", ErrAuthorizationDeclined
+	}
+
+	return localReferencePrefix + uuid.New().String(), nil
+}
+
+func (g LocalGateway) Capture(_ context.Context, reference string, _ float64) error {
+	return g.checkReference(reference)
+}
+
+func (g LocalGateway) Void(_ context.Context, reference string) error {
+	return g.checkReference(reference)
+}
+
+func (g LocalGateway) Refund(_ context.Context, reference string, _ float64, _ string) error {
+	return g.checkReference(reference)
+}
+
+func (g LocalGateway) checkReference(reference string) error {
+	if !strings.HasPrefix(reference, localReferencePrefix) {
+		return ErrUnknownReference
+	}
+
+	return nil
+}
@@ -30,6 +30,10 @@ func (s server) AuthorizePayment(ctx context.Context, request *paymentspb.Author
 	span := trace.SpanFromContext(ctx)
 
 	id := uuid.New().String()
+	if key := request.GetIdempotencyKey(); key != "" {
+		// retries with the same key must resolve to the same payment
+		id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(request.GetCustomerId()+":"+key)).String()
+	}
 
 	span.SetAttributes(
 		attribute.String("PaymentID", id),
@@ -37,9 +41,10 @@ func (s server) AuthorizePayment(ctx context.Context, request *paymentspb.Author
 	)
 
 	err := s.app.AuthorizePayment(ctx, application.AuthorizePayment{
-		ID:         id,
-		CustomerID: request.GetCustomerId(),
-		Amount:     request.GetAmount(),
+		ID:             id,
+		CustomerID:     request.GetCustomerId(),
+		Amount:         request.GetAmount(),
+		IdempotencyKey: request.GetIdempotencyKey(),
 	})
 	if err != nil {
 		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
@@ -58,7 +63,8 @@ func (s server) ConfirmPayment(ctx context.Context, request *paymentspb.ConfirmP
 	)
 
 	err := s.app.ConfirmPayment(ctx, application.ConfirmPayment{
-		ID: request.GetId(),
+		ID:     request.GetId(),
+		Amount: request.GetAmount(),
 	})
 	if err != nil {
 		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
@@ -68,6 +74,24 @@ func (s server) ConfirmPayment(ctx context.Context, request *paymentspb.ConfirmP
 	return &paymentspb.ConfirmPaymentResponse{}, err
 }
 
+func (s server) VoidPayment(ctx context.Context, request *paymentspb.VoidPaymentRequest) (*paymentspb.VoidPaymentResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("PaymentID", request.GetId()),
+	)
+
+	err := s.app.VoidPayment(ctx, application.VoidPayment{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &paymentspb.VoidPaymentResponse{}, err
+}
+
 func (s server) CreateInvoice(ctx context.Context, request *paymentspb.CreateInvoiceRequest) (*paymentspb.CreateInvoiceResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -79,9 +103,10 @@ func (s server) CreateInvoice(ctx context.Context, request *paymentspb.CreateInv
 	)
 
 	err := s.app.CreateInvoice(ctx, application.CreateInvoice{
-		ID:      id,
-		OrderID: request.GetOrderId(),
-		Amount:  request.GetAmount(),
+		ID:        id,
+		OrderID:   request.GetOrderId(),
+		PaymentID: request.GetPaymentId(),
+		Amount:    request.GetAmount(),
 	})
 	if err != nil {
 		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
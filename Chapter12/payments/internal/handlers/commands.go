@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -28,6 +29,7 @@ func NewCommandHandlers(reg registry.Registry, app application.App, replyPublish
 func RegisterCommandHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) error {
 	_, err := subscriber.Subscribe(paymentspb.CommandChannel, handlers, am.MessageFilter{
 		paymentspb.ConfirmPaymentCommand,
+		paymentspb.IssueRefundCommand,
 	}, am.GroupName("payment-commands"))
 	return err
 }
@@ -53,6 +55,8 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 	switch cmd.CommandName() {
 	case paymentspb.ConfirmPaymentCommand:
 		return h.doConfirmPayment(ctx, cmd)
+	case paymentspb.IssueRefundCommand:
+		return h.doIssueRefund(ctx, cmd)
 	}
 
 	return nil, nil
@@ -61,5 +65,25 @@ func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (re
 func (h commandHandlers) doConfirmPayment(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
 	payload := cmd.Payload().(*paymentspb.ConfirmPayment)
 
-	return nil, h.app.ConfirmPayment(ctx, application.ConfirmPayment{ID: payload.GetId()})
+	return nil, h.app.ConfirmPayment(ctx, application.ConfirmPayment{
+		ID:     payload.GetId(),
+		Amount: payload.GetAmount(),
+	})
+}
+
+func (h commandHandlers) doIssueRefund(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*paymentspb.IssueRefund)
+
+	// refunds requested before they were given an id cannot be matched to a retry
+	refundID := payload.GetId()
+	if refundID == "" {
+		refundID = uuid.New().String()
+	}
+
+	return nil, h.app.IssueRefund(ctx, application.IssueRefund{
+		ID:        refundID,
+		OrderID:   payload.GetOrderId(),
+		PaymentID: payload.GetPaymentId(),
+		Amount:    payload.GetAmount(),
+	})
 }
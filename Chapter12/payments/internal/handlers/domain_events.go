@@ -29,6 +29,7 @@ func NewDomainEventHandlers(publisher am.EventPublisher) ddd.EventHandler[ddd.Ev
 func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers ddd.EventHandler[ddd.Event]) {
 	subscriber.Subscribe(handlers,
 		models.InvoicePaidEvent,
+		models.RefundIssuedEvent,
 	)
 }
 
@@ -53,6 +54,8 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 	switch event.EventName() {
 	case models.InvoicePaidEvent:
 		return h.onInvoicePaid(ctx, event)
+	case models.RefundIssuedEvent:
+		return h.onRefundIssued(ctx, event)
 	}
 	return nil
 }
@@ -66,3 +69,16 @@ func (h domainHandlers[T]) onInvoicePaid(ctx context.Context, event ddd.Event) e
 		}),
 	)
 }
+
+func (h domainHandlers[T]) onRefundIssued(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*models.RefundIssued)
+	return h.publisher.Publish(ctx, paymentspb.RefundAggregateChannel,
+		ddd.NewEvent(paymentspb.RefundIssuedEvent, &paymentspb.RefundIssued{
+			Id:         payload.ID,
+			OrderId:    payload.OrderID,
+			PaymentId:  payload.PaymentID,
+			CustomerId: payload.CustomerID,
+			Amount:     payload.Amount,
+		}),
+	)
+}
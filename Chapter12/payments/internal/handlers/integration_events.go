@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/stackus/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -29,7 +30,10 @@ func NewIntegrationEventHandlers(reg registry.Registry, app application.App, mws
 
 func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) error {
 	_, err := subscriber.Subscribe(orderingpb.OrderAggregateChannel, handlers, am.MessageFilter{
+		orderingpb.OrderAdjustedEvent,
 		orderingpb.OrderReadiedEvent,
+		orderingpb.OrderRejectedEvent,
+		orderingpb.OrderCanceledEvent,
 	}, am.GroupName("payment-orders"))
 	return err
 }
@@ -53,14 +57,32 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 	))
 
 	switch event.EventName() {
+	case orderingpb.OrderAdjustedEvent:
+		return h.onOrderAdjusted(ctx, event)
 	case orderingpb.OrderReadiedEvent:
 		return h.onOrderReadied(ctx, event)
+	case orderingpb.OrderRejectedEvent:
+		return h.onOrderRejected(ctx, event)
 	case orderingpb.OrderCanceledEvent:
 		return h.onOrderCanceled(ctx, event)
 	}
 	return nil
 }
 
+func (h integrationHandlers[T]) onOrderAdjusted(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderAdjusted)
+	err := h.app.AdjustInvoice(ctx, application.AdjustInvoice{
+		ID:     payload.GetId(),
+		Amount: payload.GetTotal(),
+	})
+	// adjustments made before the order is readied are already part of the
+	// invoiced total, and settled invoices are left as they are
+	if errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBadRequest) {
+		return nil
+	}
+	return err
+}
+
 func (h integrationHandlers[T]) onOrderReadied(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderReadied)
 	return h.app.CreateInvoice(ctx, application.CreateInvoice{
@@ -71,9 +93,32 @@ func (h integrationHandlers[T]) onOrderReadied(ctx context.Context, event T) err
 	})
 }
 
+func (h integrationHandlers[T]) onOrderRejected(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderRejected)
+	return h.voidPayment(ctx, payload.GetId(), payload.GetPaymentId())
+}
+
 func (h integrationHandlers[T]) onOrderCanceled(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCanceled)
-	return h.app.CancelInvoice(ctx, application.CancelInvoice{
+	err := h.app.CancelInvoice(ctx, application.CancelInvoice{
 		ID: payload.GetId(),
 	})
+	// orders are only invoiced once they are readied
+	if err != nil && !errors.Is(err, errors.ErrNotFound) && !errors.Is(err, errors.ErrBadRequest) {
+		return err
+	}
+	return h.voidPayment(ctx, payload.GetId(), payload.GetPaymentId())
+}
+
+// voidPayment releases the authorization held for an order that will never
+// be paid for; captured payments are refunded
+func (h integrationHandlers[T]) voidPayment(ctx context.Context, orderID, paymentID string) error {
+	err := h.app.VoidPayment(ctx, application.VoidPayment{
+		ID:      paymentID,
+		OrderID: orderID,
+	})
+	if errors.Is(err, errors.ErrNotFound) || errors.Is(err, errors.ErrBadRequest) {
+		return nil
+	}
+	return err
 }
@@ -10,10 +10,11 @@ const (
 )
 
 type Invoice struct {
-	ID      string
-	OrderID string
-	Amount  float64
-	Status  InvoiceStatus
+	ID        string
+	OrderID   string
+	PaymentID string
+	Amount    float64
+	Status    InvoiceStatus
 }
 
 func (s InvoiceStatus) String() string {
@@ -1,7 +1,133 @@
 package models
 
+import (
+	"math"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrPaymentAmountNotPositive       = errors.Wrap(errors.ErrBadRequest, "the payment amount must be greater than zero")
+	ErrPaymentCannotBeCaptured        = errors.Wrap(errors.ErrBadRequest, "the payment cannot be captured")
+	ErrPaymentCaptureExceedsAuthorize = errors.Wrap(errors.ErrBadRequest, "the capture amount exceeds the authorized amount")
+	ErrPaymentCannotBeVoided          = errors.Wrap(errors.ErrBadRequest, "the payment cannot be voided")
+	ErrPaymentCannotBeRefunded        = errors.Wrap(errors.ErrBadRequest, "the payment cannot be refunded")
+	ErrPaymentRefundExceedsCapture    = errors.Wrap(errors.ErrBadRequest, "the refund cannot be more than what is left of the captured amount")
+)
+
+type PaymentStatus string
+
+const (
+	PaymentIsUnknown    PaymentStatus = ""
+	PaymentIsAuthorized PaymentStatus = "authorized"
+	PaymentIsCaptured   PaymentStatus = "captured"
+	PaymentIsVoided     PaymentStatus = "voided"
+	PaymentIsRefunded   PaymentStatus = "refunded"
+)
+
 type Payment struct {
-	ID         string
-	CustomerID string
-	Amount     float64
+	ID             string
+	CustomerID     string
+	IdempotencyKey string
+	Reference      string
+	Amount         float64
+	Captured       float64
+	Refunded       float64
+	Status         PaymentStatus
+}
+
+func (s PaymentStatus) String() string {
+	switch s {
+	case PaymentIsAuthorized, PaymentIsCaptured, PaymentIsVoided, PaymentIsRefunded:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+func ToPaymentStatus(status string) PaymentStatus {
+	switch status {
+	case PaymentIsAuthorized.String():
+		return PaymentIsAuthorized
+	case PaymentIsCaptured.String():
+		return PaymentIsCaptured
+	case PaymentIsVoided.String():
+		return PaymentIsVoided
+	case PaymentIsRefunded.String():
+		return PaymentIsRefunded
+	default:
+		return PaymentIsUnknown
+	}
+}
+
+// Capture takes some or all of the authorized amount from the customer
+func (p *Payment) Capture(amount float64) error {
+	if p.Status != PaymentIsAuthorized {
+		return ErrPaymentCannotBeCaptured
+	}
+
+	if amount <= 0 {
+		return ErrPaymentAmountNotPositive
+	}
+
+	if amount > p.Amount {
+		return ErrPaymentCaptureExceedsAuthorize
+	}
+
+	p.Captured = amount
+	p.Status = PaymentIsCaptured
+
+	return nil
+}
+
+// Void releases an authorization that will not be captured
+func (p *Payment) Void() error {
+	if p.Status != PaymentIsAuthorized {
+		return ErrPaymentCannotBeVoided
+	}
+
+	p.Status = PaymentIsVoided
+
+	return nil
+}
+
+// Refund returns some of the captured amount; the payment is refunded once
+// nothing is left of the capture
+func (p *Payment) Refund(amount float64) error {
+	if p.Status != PaymentIsCaptured {
+		return ErrPaymentCannotBeRefunded
+	}
+
+	if amount <= 0 {
+		return ErrPaymentAmountNotPositive
+	}
+
+	amount = roundAmount(amount)
+	refundable := p.Refundable()
+	if amount > refundable {
+		return ErrPaymentRefundExceedsCapture
+	}
+
+	if amount == refundable {
+		p.Refunded = p.Captured
+		p.Status = PaymentIsRefunded
+	} else {
+		p.Refunded += amount
+	}
+
+	return nil
+}
+
+// Refundable is what is left of the captured amount that can still be refunded
+func (p Payment) Refundable() float64 {
+	if p.Status != PaymentIsCaptured {
+		return 0
+	}
+
+	return roundAmount(p.Captured - p.Refunded)
+}
+
+// roundAmount rounds to the four decimal places amounts are kept with
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*10000) / 10000
 }
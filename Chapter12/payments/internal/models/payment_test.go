@@ -0,0 +1,162 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_Capture(t *testing.T) {
+	tests := map[string]struct {
+		payment    Payment
+		amount     float64
+		wantStatus PaymentStatus
+		wantErr    error
+	}{
+		"All": {
+			payment:    Payment{Amount: 100, Status: PaymentIsAuthorized},
+			amount:     100,
+			wantStatus: PaymentIsCaptured,
+		},
+		"Some": {
+			payment:    Payment{Amount: 100, Status: PaymentIsAuthorized},
+			amount:     60,
+			wantStatus: PaymentIsCaptured,
+		},
+		"MoreThanAuthorized": {
+			payment:    Payment{Amount: 100, Status: PaymentIsAuthorized},
+			amount:     100.01,
+			wantStatus: PaymentIsAuthorized,
+			wantErr:    ErrPaymentCaptureExceedsAuthorize,
+		},
+		"Nothing": {
+			payment:    Payment{Amount: 100, Status: PaymentIsAuthorized},
+			wantStatus: PaymentIsAuthorized,
+			wantErr:    ErrPaymentAmountNotPositive,
+		},
+		"Twice": {
+			payment:    Payment{Amount: 100, Captured: 100, Status: PaymentIsCaptured},
+			amount:     100,
+			wantStatus: PaymentIsCaptured,
+			wantErr:    ErrPaymentCannotBeCaptured,
+		},
+		"Voided": {
+			payment:    Payment{Amount: 100, Status: PaymentIsVoided},
+			amount:     100,
+			wantStatus: PaymentIsVoided,
+			wantErr:    ErrPaymentCannotBeCaptured,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := tc.payment
+			err := p.Capture(tc.amount)
+			assert.Equal(t, tc.wantStatus, p.Status)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, tc.payment.Captured, p.Captured)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.amount, p.Captured)
+		})
+	}
+}
+
+func TestPayment_Void(t *testing.T) {
+	tests := map[string]struct {
+		status     PaymentStatus
+		wantStatus PaymentStatus
+		wantErr    error
+	}{
+		"Authorized": {status: PaymentIsAuthorized, wantStatus: PaymentIsVoided},
+		"Captured":   {status: PaymentIsCaptured, wantStatus: PaymentIsCaptured, wantErr: ErrPaymentCannotBeVoided},
+		"Voided":     {status: PaymentIsVoided, wantStatus: PaymentIsVoided, wantErr: ErrPaymentCannotBeVoided},
+		"Refunded":   {status: PaymentIsRefunded, wantStatus: PaymentIsRefunded, wantErr: ErrPaymentCannotBeVoided},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := Payment{Amount: 100, Status: tc.status}
+			err := p.Void()
+			assert.Equal(t, tc.wantStatus, p.Status)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPayment_Refund(t *testing.T) {
+	tests := map[string]struct {
+		payment      Payment
+		amount       float64
+		wantRefunded float64
+		wantStatus   PaymentStatus
+		wantErr      error
+	}{
+		"Some": {
+			payment:      Payment{Amount: 100, Captured: 80, Status: PaymentIsCaptured},
+			amount:       30,
+			wantRefunded: 30,
+			wantStatus:   PaymentIsCaptured,
+		},
+		"Rest": {
+			payment:      Payment{Amount: 100, Captured: 80, Refunded: 30, Status: PaymentIsCaptured},
+			amount:       50,
+			wantRefunded: 80,
+			wantStatus:   PaymentIsRefunded,
+		},
+		"RestWithFractions": {
+			payment:      Payment{Amount: 1, Captured: 0.3, Refunded: 0.1, Status: PaymentIsCaptured},
+			amount:       0.3 - 0.1,
+			wantRefunded: 0.3,
+			wantStatus:   PaymentIsRefunded,
+		},
+		"MoreThanCaptured": {
+			payment:      Payment{Amount: 100, Captured: 80, Refunded: 30, Status: PaymentIsCaptured},
+			amount:       50.01,
+			wantRefunded: 30,
+			wantStatus:   PaymentIsCaptured,
+			wantErr:      ErrPaymentRefundExceedsCapture,
+		},
+		"Nothing": {
+			payment:    Payment{Amount: 100, Captured: 80, Status: PaymentIsCaptured},
+			wantStatus: PaymentIsCaptured,
+			wantErr:    ErrPaymentAmountNotPositive,
+		},
+		"Authorized": {
+			payment:    Payment{Amount: 100, Status: PaymentIsAuthorized},
+			amount:     10,
+			wantStatus: PaymentIsAuthorized,
+			wantErr:    ErrPaymentCannotBeRefunded,
+		},
+		"Refunded": {
+			payment:      Payment{Amount: 100, Captured: 80, Refunded: 80, Status: PaymentIsRefunded},
+			amount:       10,
+			wantRefunded: 80,
+			wantStatus:   PaymentIsRefunded,
+			wantErr:      ErrPaymentCannotBeRefunded,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := tc.payment
+			err := p.Refund(tc.amount)
+			assert.Equal(t, tc.wantStatus, p.Status)
+			assert.Equal(t, tc.wantRefunded, p.Refunded)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPayment_Refundable(t *testing.T) {
+	assert.Equal(t, 50.0, Payment{Captured: 80, Refunded: 30, Status: PaymentIsCaptured}.Refundable())
+	assert.Equal(t, 0.0, Payment{Amount: 100, Status: PaymentIsAuthorized}.Refundable())
+	assert.Equal(t, 0.0, Payment{Captured: 80, Refunded: 80, Status: PaymentIsRefunded}.Refundable())
+}
@@ -0,0 +1,9 @@
+package models
+
+type Refund struct {
+	ID         string
+	OrderID    string
+	PaymentID  string
+	CustomerID string
+	Amount     float64
+}
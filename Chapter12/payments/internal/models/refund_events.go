@@ -0,0 +1,11 @@
+package models
+
+const RefundIssuedEvent = "payments.RefundIssued"
+
+type RefundIssued struct {
+	ID         string
+	OrderID    string
+	PaymentID  string
+	CustomerID string
+	Amount     float64
+}
@@ -2,11 +2,13 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/payments/internal/application"
 	"eda-in-golang/payments/internal/models"
 )
@@ -26,14 +28,17 @@ func NewInvoiceRepository(tableName string, db postgres.DB) InvoiceRepository {
 }
 
 func (r InvoiceRepository) Find(ctx context.Context, invoiceID string) (*models.Invoice, error) {
-	const query = "SELECT order_id, amount, status FROM %s WHERE id = $1 LIMIT 1"
+	const query = "SELECT order_id, payment_id, amount, status FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
 
 	invoice := &models.Invoice{
 		ID: invoiceID,
 	}
 	var status string
-	err := r.db.QueryRowContext(ctx, r.table(query), invoiceID).Scan(&invoice.OrderID, &invoice.Amount, &status)
+	err := r.db.QueryRowContext(ctx, r.table(query), invoiceID, tenant.FromContext(ctx)).Scan(&invoice.OrderID, &invoice.PaymentID, &invoice.Amount, &status)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msg("invoice with that ID does not exist")
+		}
 		return nil, errors.Wrap(err, "scanning invoice")
 	}
 
@@ -46,17 +51,17 @@ func (r InvoiceRepository) Find(ctx context.Context, invoiceID string) (*models.
 }
 
 func (r InvoiceRepository) Save(ctx context.Context, invoice *models.Invoice) error {
-	const query = "INSERT INTO %s (id, order_id, amount, status) VALUES ($1, $2, $3, $4)"
+	const query = "INSERT INTO %s (id, order_id, payment_id, amount, status, tenant_id) VALUES ($1, $2, $3, $4, $5, $6)"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), invoice.ID, invoice.OrderID, invoice.Amount, invoice.Status.String())
+	_, err := r.db.ExecContext(ctx, r.table(query), invoice.ID, invoice.OrderID, invoice.PaymentID, invoice.Amount, invoice.Status.String(), tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r InvoiceRepository) Update(ctx context.Context, invoice *models.Invoice) error {
-	const query = "UPDATE %s SET amount = $2, status = $3 WHERE id = $1"
+	const query = "UPDATE %s SET amount = $2, status = $3 WHERE id = $1 AND tenant_id = $4"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), invoice.ID, invoice.Amount, invoice.Status.String())
+	_, err := r.db.ExecContext(ctx, r.table(query), invoice.ID, invoice.Amount, invoice.Status.String(), tenant.FromContext(ctx))
 
 	return err
 }
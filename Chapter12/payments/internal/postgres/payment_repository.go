@@ -2,9 +2,13 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
+	"github.com/stackus/errors"
+
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/payments/internal/application"
 	"eda-in-golang/payments/internal/models"
 )
@@ -24,23 +28,57 @@ func NewPaymentRepository(tableName string, db postgres.DB) PaymentRepository {
 }
 
 func (r PaymentRepository) Save(ctx context.Context, payment *models.Payment) error {
-	const query = "INSERT INTO %s (id, customer_id, amount) VALUES ($1, $2, $3)"
+	const query = `INSERT INTO %s (id, customer_id, idempotency_key, reference, amount, captured, refunded, status, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), payment.ID, payment.CustomerID, payment.Amount)
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		payment.ID, payment.CustomerID, payment.IdempotencyKey, payment.Reference,
+		payment.Amount, payment.Captured, payment.Refunded, payment.Status.String(), tenant.FromContext(ctx),
+	)
 
 	return err
 }
 
 func (r PaymentRepository) Find(ctx context.Context, paymentID string) (*models.Payment, error) {
-	const query = "SELECT customer_id, amount FROM %s WHERE id = $1 LIMIT 1"
+	const query = `SELECT id, customer_id, idempotency_key, reference, amount, captured, refunded, status
+FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
+
+	return r.find(ctx, r.table(query), paymentID, tenant.FromContext(ctx))
+}
+
+func (r PaymentRepository) FindByIdempotencyKey(ctx context.Context, customerID, key string) (*models.Payment, error) {
+	const query = `SELECT id, customer_id, idempotency_key, reference, amount, captured, refunded, status
+FROM %s WHERE customer_id = $1 AND idempotency_key = $2 AND tenant_id = $3 LIMIT 1`
+
+	return r.find(ctx, r.table(query), customerID, key, tenant.FromContext(ctx))
+}
+
+func (r PaymentRepository) Update(ctx context.Context, payment *models.Payment) error {
+	const query = "UPDATE %s SET captured = $2, refunded = $3, status = $4 WHERE id = $1 AND tenant_id = $5"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), payment.ID, payment.Captured, payment.Refunded, payment.Status.String(), tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r PaymentRepository) find(ctx context.Context, query string, args ...any) (*models.Payment, error) {
+	payment := &models.Payment{}
 
-	payment := &models.Payment{
-		ID: paymentID,
+	var status string
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(
+		&payment.ID, &payment.CustomerID, &payment.IdempotencyKey, &payment.Reference,
+		&payment.Amount, &payment.Captured, &payment.Refunded, &status,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msg("payment does not exist")
+		}
+		return nil, errors.Wrap(err, "scanning payment")
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), paymentID).Scan(&payment.CustomerID, &payment.Amount)
+	payment.Status = models.ToPaymentStatus(status)
 
-	return payment, err
+	return payment, nil
 }
 
 func (r PaymentRepository) table(query string) string {
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/payments/internal/application"
+	"eda-in-golang/payments/internal/models"
+)
+
+type RefundRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ application.RefundRepository = (*RefundRepository)(nil)
+
+func NewRefundRepository(tableName string, db postgres.DB) RefundRepository {
+	return RefundRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r RefundRepository) Save(ctx context.Context, refund *models.Refund) error {
+	const query = "INSERT INTO %s (id, order_id, payment_id, customer_id, amount, tenant_id) VALUES ($1, $2, $3, $4, $5, $6)"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), refund.ID, refund.OrderID, refund.PaymentID, refund.CustomerID, refund.Amount, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r RefundRepository) Find(ctx context.Context, refundID string) (*models.Refund, error) {
+	const query = "SELECT id, order_id, payment_id, customer_id, amount FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
+
+	refund := &models.Refund{}
+
+	err := r.db.QueryRowContext(ctx, r.table(query), refundID, tenant.FromContext(ctx)).Scan(
+		&refund.ID, &refund.OrderID, &refund.PaymentID, &refund.CustomerID, &refund.Amount,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msg("refund does not exist")
+		}
+		return nil, errors.Wrap(err, "scanning refund")
+	}
+
+	return refund, nil
+}
+
+func (r RefundRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE refunds (
+  id          text          NOT NULL,
+  order_id    text          NOT NULL,
+  payment_id  text          NOT NULL,
+  customer_id text          NOT NULL,
+  amount      decimal(9, 4) NOT NULL,
+  created_at  timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX refunds_payment_id_idx ON refunds (payment_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS refunds;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE invoices
+  ADD COLUMN payment_id text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE invoices
+  DROP COLUMN payment_id;
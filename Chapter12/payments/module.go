@@ -9,17 +9,22 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/ordering/orderingpb"
 	"eda-in-golang/payments/internal/application"
 	"eda-in-golang/payments/internal/constants"
+	"eda-in-golang/payments/internal/gateway"
 	"eda-in-golang/payments/internal/grpc"
 	"eda-in-golang/payments/internal/handlers"
 	"eda-in-golang/payments/internal/postgres"
@@ -41,12 +46,13 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		if err := orderingpb.Registrations(reg); err != nil {
 			return nil, err
 		}
-		if err := paymentspb.Registrations(reg); err != nil {
+		if err := paymentspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
 			return nil, err
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.Event](), nil
 	})
@@ -59,16 +65,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -99,12 +113,23 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
 		), nil
 	})
+	container.AddScoped(constants.RefundsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewRefundRepository(
+			constants.RefundsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddSingleton(constants.PaymentGatewayKey, func(c di.Container) (any, error) {
+		return gateway.NewLocalGateway(svc.Config().Payments), nil
+	})
 
 	// setup application
 	container.AddScoped(constants.ApplicationKey, func(c di.Container) (any, error) {
 		return application.New(
 			c.Get(constants.InvoicesRepoKey).(application.InvoiceRepository),
 			c.Get(constants.PaymentsRepoKey).(application.PaymentRepository),
+			c.Get(constants.RefundsRepoKey).(application.RefundRepository),
+			c.Get(constants.PaymentGatewayKey).(application.PaymentGateway),
 			c.Get(constants.DomainDispatcherKey).(*ddd.EventDispatcher[ddd.Event]),
 		), nil
 	})
@@ -149,6 +174,7 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		return err
 	}
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return
 }
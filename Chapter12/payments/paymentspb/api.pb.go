@@ -25,8 +25,9 @@ type AuthorizePaymentRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CustomerId string  `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Amount     float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	CustomerId     string  `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount         float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdempotencyKey string  `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
 func (x *AuthorizePaymentRequest) Reset() {
@@ -75,6 +76,13 @@ func (x *AuthorizePaymentRequest) GetAmount() float64 {
 	return 0
 }
 
+func (x *AuthorizePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type AuthorizePaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -127,7 +135,8 @@ type ConfirmPaymentRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (x *ConfirmPaymentRequest) Reset() {
@@ -169,6 +178,13 @@ func (x *ConfirmPaymentRequest) GetId() string {
 	return ""
 }
 
+func (x *ConfirmPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type ConfirmPaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,6 +223,91 @@ func (*ConfirmPaymentResponse) Descriptor() ([]byte, []int) {
 	return file_paymentspb_api_proto_rawDescGZIP(), []int{3}
 }
 
+type VoidPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *VoidPaymentRequest) Reset() {
+	*x = VoidPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_paymentspb_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentRequest) ProtoMessage() {}
+
+func (x *VoidPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentspb_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VoidPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VoidPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type VoidPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VoidPaymentResponse) Reset() {
+	*x = VoidPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_paymentspb_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentResponse) ProtoMessage() {}
+
+func (x *VoidPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentspb_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VoidPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{5}
+}
+
 type CreateInvoiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -220,7 +321,7 @@ type CreateInvoiceRequest struct {
 func (x *CreateInvoiceRequest) Reset() {
 	*x = CreateInvoiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[4]
+		mi := &file_paymentspb_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +334,7 @@ func (x *CreateInvoiceRequest) String() string {
 func (*CreateInvoiceRequest) ProtoMessage() {}
 
 func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[4]
+	mi := &file_paymentspb_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +347,7 @@ func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
 func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{4}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateInvoiceRequest) GetOrderId() string {
@@ -281,7 +382,7 @@ type CreateInvoiceResponse struct {
 func (x *CreateInvoiceResponse) Reset() {
 	*x = CreateInvoiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[5]
+		mi := &file_paymentspb_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -294,7 +395,7 @@ func (x *CreateInvoiceResponse) String() string {
 func (*CreateInvoiceResponse) ProtoMessage() {}
 
 func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[5]
+	mi := &file_paymentspb_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +408,7 @@ func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{5}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateInvoiceResponse) GetId() string {
@@ -329,7 +430,7 @@ type AdjustInvoiceRequest struct {
 func (x *AdjustInvoiceRequest) Reset() {
 	*x = AdjustInvoiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[6]
+		mi := &file_paymentspb_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +443,7 @@ func (x *AdjustInvoiceRequest) String() string {
 func (*AdjustInvoiceRequest) ProtoMessage() {}
 
 func (x *AdjustInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[6]
+	mi := &file_paymentspb_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +456,7 @@ func (x *AdjustInvoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustInvoiceRequest.ProtoReflect.Descriptor instead.
 func (*AdjustInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{6}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AdjustInvoiceRequest) GetId() string {
@@ -381,7 +482,7 @@ type AdjustInvoiceResponse struct {
 func (x *AdjustInvoiceResponse) Reset() {
 	*x = AdjustInvoiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[7]
+		mi := &file_paymentspb_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +495,7 @@ func (x *AdjustInvoiceResponse) String() string {
 func (*AdjustInvoiceResponse) ProtoMessage() {}
 
 func (x *AdjustInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[7]
+	mi := &file_paymentspb_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +508,7 @@ func (x *AdjustInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*AdjustInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{7}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{9}
 }
 
 type PayInvoiceRequest struct {
@@ -421,7 +522,7 @@ type PayInvoiceRequest struct {
 func (x *PayInvoiceRequest) Reset() {
 	*x = PayInvoiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[8]
+		mi := &file_paymentspb_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -434,7 +535,7 @@ func (x *PayInvoiceRequest) String() string {
 func (*PayInvoiceRequest) ProtoMessage() {}
 
 func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[8]
+	mi := &file_paymentspb_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -447,7 +548,7 @@ func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayInvoiceRequest.ProtoReflect.Descriptor instead.
 func (*PayInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{8}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PayInvoiceRequest) GetId() string {
@@ -466,7 +567,7 @@ type PayInvoiceResponse struct {
 func (x *PayInvoiceResponse) Reset() {
 	*x = PayInvoiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[9]
+		mi := &file_paymentspb_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +580,7 @@ func (x *PayInvoiceResponse) String() string {
 func (*PayInvoiceResponse) ProtoMessage() {}
 
 func (x *PayInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[9]
+	mi := &file_paymentspb_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +593,7 @@ func (x *PayInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*PayInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{9}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{11}
 }
 
 type CancelInvoiceRequest struct {
@@ -506,7 +607,7 @@ type CancelInvoiceRequest struct {
 func (x *CancelInvoiceRequest) Reset() {
 	*x = CancelInvoiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[10]
+		mi := &file_paymentspb_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +620,7 @@ func (x *CancelInvoiceRequest) String() string {
 func (*CancelInvoiceRequest) ProtoMessage() {}
 
 func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[10]
+	mi := &file_paymentspb_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +633,7 @@ func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelInvoiceRequest.ProtoReflect.Descriptor instead.
 func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{10}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CancelInvoiceRequest) GetId() string {
@@ -551,7 +652,7 @@ type CancelInvoiceResponse struct {
 func (x *CancelInvoiceResponse) Reset() {
 	*x = CancelInvoiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_paymentspb_api_proto_msgTypes[11]
+		mi := &file_paymentspb_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -564,7 +665,7 @@ func (x *CancelInvoiceResponse) String() string {
 func (*CancelInvoiceResponse) ProtoMessage() {}
 
 func (x *CancelInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_paymentspb_api_proto_msgTypes[11]
+	mi := &file_paymentspb_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +678,7 @@ func (x *CancelInvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelInvoiceResponse.ProtoReflect.Descriptor instead.
 func (*CancelInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_paymentspb_api_proto_rawDescGZIP(), []int{11}
+	return file_paymentspb_api_proto_rawDescGZIP(), []int{13}
 }
 
 var File_paymentspb_api_proto protoreflect.FileDescriptor
@@ -585,53 +686,66 @@ var File_paymentspb_api_proto protoreflect.FileDescriptor
 var file_paymentspb_api_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2f, 0x61, 0x70, 0x69,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x70, 0x62, 0x22, 0x52, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50,
+	0x70, 0x62, 0x22, 0x7b, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50,
 	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
 	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
 	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2a, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x27, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x41, 0x64, 0x6a, 0x75,
-	0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6a, 0x75,
-	0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x23, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22,
+	0x2a, 0x0a, 0x18, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x56, 0x6f, 0x69, 0x64, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x56, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa4, 0x04,
-	0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x5f, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70,
-	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf6, 0x04, 0x0a, 0x0f,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x5f, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0b, 0x56,
+	0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d,
 	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
 	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x20,
 	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
@@ -678,36 +792,40 @@ func file_paymentspb_api_proto_rawDescGZIP() []byte {
 	return file_paymentspb_api_proto_rawDescData
 }
 
-var file_paymentspb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_paymentspb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_paymentspb_api_proto_goTypes = []interface{}{
 	(*AuthorizePaymentRequest)(nil),  // 0: paymentspb.AuthorizePaymentRequest
 	(*AuthorizePaymentResponse)(nil), // 1: paymentspb.AuthorizePaymentResponse
 	(*ConfirmPaymentRequest)(nil),    // 2: paymentspb.ConfirmPaymentRequest
 	(*ConfirmPaymentResponse)(nil),   // 3: paymentspb.ConfirmPaymentResponse
-	(*CreateInvoiceRequest)(nil),     // 4: paymentspb.CreateInvoiceRequest
-	(*CreateInvoiceResponse)(nil),    // 5: paymentspb.CreateInvoiceResponse
-	(*AdjustInvoiceRequest)(nil),     // 6: paymentspb.AdjustInvoiceRequest
-	(*AdjustInvoiceResponse)(nil),    // 7: paymentspb.AdjustInvoiceResponse
-	(*PayInvoiceRequest)(nil),        // 8: paymentspb.PayInvoiceRequest
-	(*PayInvoiceResponse)(nil),       // 9: paymentspb.PayInvoiceResponse
-	(*CancelInvoiceRequest)(nil),     // 10: paymentspb.CancelInvoiceRequest
-	(*CancelInvoiceResponse)(nil),    // 11: paymentspb.CancelInvoiceResponse
+	(*VoidPaymentRequest)(nil),       // 4: paymentspb.VoidPaymentRequest
+	(*VoidPaymentResponse)(nil),      // 5: paymentspb.VoidPaymentResponse
+	(*CreateInvoiceRequest)(nil),     // 6: paymentspb.CreateInvoiceRequest
+	(*CreateInvoiceResponse)(nil),    // 7: paymentspb.CreateInvoiceResponse
+	(*AdjustInvoiceRequest)(nil),     // 8: paymentspb.AdjustInvoiceRequest
+	(*AdjustInvoiceResponse)(nil),    // 9: paymentspb.AdjustInvoiceResponse
+	(*PayInvoiceRequest)(nil),        // 10: paymentspb.PayInvoiceRequest
+	(*PayInvoiceResponse)(nil),       // 11: paymentspb.PayInvoiceResponse
+	(*CancelInvoiceRequest)(nil),     // 12: paymentspb.CancelInvoiceRequest
+	(*CancelInvoiceResponse)(nil),    // 13: paymentspb.CancelInvoiceResponse
 }
 var file_paymentspb_api_proto_depIdxs = []int32{
 	0,  // 0: paymentspb.PaymentsService.AuthorizePayment:input_type -> paymentspb.AuthorizePaymentRequest
 	2,  // 1: paymentspb.PaymentsService.ConfirmPayment:input_type -> paymentspb.ConfirmPaymentRequest
-	4,  // 2: paymentspb.PaymentsService.CreateInvoice:input_type -> paymentspb.CreateInvoiceRequest
-	6,  // 3: paymentspb.PaymentsService.AdjustInvoice:input_type -> paymentspb.AdjustInvoiceRequest
-	8,  // 4: paymentspb.PaymentsService.PayInvoice:input_type -> paymentspb.PayInvoiceRequest
-	10, // 5: paymentspb.PaymentsService.CancelInvoice:input_type -> paymentspb.CancelInvoiceRequest
-	1,  // 6: paymentspb.PaymentsService.AuthorizePayment:output_type -> paymentspb.AuthorizePaymentResponse
-	3,  // 7: paymentspb.PaymentsService.ConfirmPayment:output_type -> paymentspb.ConfirmPaymentResponse
-	5,  // 8: paymentspb.PaymentsService.CreateInvoice:output_type -> paymentspb.CreateInvoiceResponse
-	7,  // 9: paymentspb.PaymentsService.AdjustInvoice:output_type -> paymentspb.AdjustInvoiceResponse
-	9,  // 10: paymentspb.PaymentsService.PayInvoice:output_type -> paymentspb.PayInvoiceResponse
-	11, // 11: paymentspb.PaymentsService.CancelInvoice:output_type -> paymentspb.CancelInvoiceResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	4,  // 2: paymentspb.PaymentsService.VoidPayment:input_type -> paymentspb.VoidPaymentRequest
+	6,  // 3: paymentspb.PaymentsService.CreateInvoice:input_type -> paymentspb.CreateInvoiceRequest
+	8,  // 4: paymentspb.PaymentsService.AdjustInvoice:input_type -> paymentspb.AdjustInvoiceRequest
+	10, // 5: paymentspb.PaymentsService.PayInvoice:input_type -> paymentspb.PayInvoiceRequest
+	12, // 6: paymentspb.PaymentsService.CancelInvoice:input_type -> paymentspb.CancelInvoiceRequest
+	1,  // 7: paymentspb.PaymentsService.AuthorizePayment:output_type -> paymentspb.AuthorizePaymentResponse
+	3,  // 8: paymentspb.PaymentsService.ConfirmPayment:output_type -> paymentspb.ConfirmPaymentResponse
+	5,  // 9: paymentspb.PaymentsService.VoidPayment:output_type -> paymentspb.VoidPaymentResponse
+	7,  // 10: paymentspb.PaymentsService.CreateInvoice:output_type -> paymentspb.CreateInvoiceResponse
+	9,  // 11: paymentspb.PaymentsService.AdjustInvoice:output_type -> paymentspb.AdjustInvoiceResponse
+	11, // 12: paymentspb.PaymentsService.PayInvoice:output_type -> paymentspb.PayInvoiceResponse
+	13, // 13: paymentspb.PaymentsService.CancelInvoice:output_type -> paymentspb.CancelInvoiceResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -768,7 +886,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInvoiceRequest); i {
+			switch v := v.(*VoidPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -780,7 +898,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInvoiceResponse); i {
+			switch v := v.(*VoidPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -792,7 +910,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdjustInvoiceRequest); i {
+			switch v := v.(*CreateInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -804,7 +922,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdjustInvoiceResponse); i {
+			switch v := v.(*CreateInvoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -816,7 +934,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PayInvoiceRequest); i {
+			switch v := v.(*AdjustInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -828,7 +946,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PayInvoiceResponse); i {
+			switch v := v.(*AdjustInvoiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -840,7 +958,7 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelInvoiceRequest); i {
+			switch v := v.(*PayInvoiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -852,6 +970,30 @@ func file_paymentspb_api_proto_init() {
 			}
 		}
 		file_paymentspb_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayInvoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_paymentspb_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_paymentspb_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelInvoiceResponse); i {
 			case 0:
 				return &v.state
@@ -870,7 +1012,7 @@ func file_paymentspb_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_paymentspb_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
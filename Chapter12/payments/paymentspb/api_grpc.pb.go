@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type PaymentsServiceClient interface {
 	AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error)
 	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error)
+	VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error)
 	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error)
 	AdjustInvoice(ctx context.Context, in *AdjustInvoiceRequest, opts ...grpc.CallOption) (*AdjustInvoiceResponse, error)
 	PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceResponse, error)
@@ -56,6 +57,15 @@ func (c *paymentsServiceClient) ConfirmPayment(ctx context.Context, in *ConfirmP
 	return out, nil
 }
 
+func (c *paymentsServiceClient) VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*VoidPaymentResponse, error) {
+	out := new(VoidPaymentResponse)
+	err := c.cc.Invoke(ctx, "/paymentspb.PaymentsService/VoidPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentsServiceClient) CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error) {
 	out := new(CreateInvoiceResponse)
 	err := c.cc.Invoke(ctx, "/paymentspb.PaymentsService/CreateInvoice", in, out, opts...)
@@ -98,6 +108,7 @@ func (c *paymentsServiceClient) CancelInvoice(ctx context.Context, in *CancelInv
 type PaymentsServiceServer interface {
 	AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error)
 	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error)
+	VoidPayment(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error)
 	CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error)
 	AdjustInvoice(context.Context, *AdjustInvoiceRequest) (*AdjustInvoiceResponse, error)
 	PayInvoice(context.Context, *PayInvoiceRequest) (*PayInvoiceResponse, error)
@@ -115,6 +126,9 @@ func (UnimplementedPaymentsServiceServer) AuthorizePayment(context.Context, *Aut
 func (UnimplementedPaymentsServiceServer) ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPayment not implemented")
 }
+func (UnimplementedPaymentsServiceServer) VoidPayment(context.Context, *VoidPaymentRequest) (*VoidPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidPayment not implemented")
+}
 func (UnimplementedPaymentsServiceServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
 }
@@ -176,6 +190,24 @@ func _PaymentsService_ConfirmPayment_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentsService_VoidPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).VoidPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/paymentspb.PaymentsService/VoidPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).VoidPayment(ctx, req.(*VoidPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentsService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInvoiceRequest)
 	if err := dec(in); err != nil {
@@ -263,6 +295,10 @@ var PaymentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmPayment",
 			Handler:    _PaymentsService_ConfirmPayment_Handler,
 		},
+		{
+			MethodName: "VoidPayment",
+			Handler:    _PaymentsService_VoidPayment_Handler,
+		},
 		{
 			MethodName: "CreateInvoice",
 			Handler:    _PaymentsService_CreateInvoice_Handler,
@@ -10,27 +10,44 @@ const (
 
 	InvoicePaidEvent = "paymentsapi.InvoicePaid"
 
+	RefundAggregateChannel = "mallbots.payments.events.Refund"
+
+	RefundIssuedEvent = "paymentsapi.RefundIssued"
+
 	CommandChannel = "mallbots.payments.commands"
 
 	ConfirmPaymentCommand = "paymentsapi.ConfirmPayment"
+	IssueRefundCommand    = "paymentsapi.IssueRefund"
 )
 
-func Registrations(reg registry.Registry) (err error) {
-	serde := serdes.NewProtoSerde(reg)
+func Registrations(reg registry.Registry) error {
+	return RegistrationsWithSerde(serdes.NewProtoSerde(reg))
+}
 
+func RegistrationsWithSerde(serde registry.Serde) (err error) {
 	// Invoice events
 	if err = serde.Register(&InvoicePaid{}); err != nil {
 		return err
 	}
 
+	// Refund events
+	if err = serde.Register(&RefundIssued{}); err != nil {
+		return err
+	}
+
 	// commands
 	if err = serde.Register(&ConfirmPayment{}); err != nil {
 		return
 	}
+	if err = serde.Register(&IssueRefund{}); err != nil {
+		return
+	}
 
 	return
 }
 
-func (*InvoicePaid) Key() string { return InvoicePaidEvent }
+func (*InvoicePaid) Key() string  { return InvoicePaidEvent }
+func (*RefundIssued) Key() string { return RefundIssuedEvent }
 
 func (*ConfirmPayment) Key() string { return ConfirmPaymentCommand }
+func (*IssueRefund) Key() string    { return IssueRefundCommand }
@@ -20,38 +20,164 @@ type (
 	}
 	SearchOrders struct {
 		Filters Filters
+		Sort    OrderSort
 		Next    string
 		Limit   int
 	}
 
+	// OrderPage is one page of search results; Next is blank on the last page
+	OrderPage struct {
+		Orders []*models.Order
+		Next   string
+	}
+
 	GetOrder struct {
 		OrderID string
 	}
 
+	GetCustomerOrderHistory struct {
+		CustomerID string
+		Next       string
+		Limit      int
+	}
+
+	// CustomerOrderHistory is a page of the customer orders, newest first,
+	// along with the summary of all of them
+	CustomerOrderHistory struct {
+		Stats *models.CustomerStats
+		OrderPage
+	}
+
+	// GetStoreSales covers the days from From up to and including To
+	GetStoreSales struct {
+		StoreID string
+		From    time.Time
+		To      time.Time
+	}
+
 	Application interface {
-		SearchOrders(ctx context.Context, search SearchOrders) ([]*models.Order, error)
+		SearchOrders(ctx context.Context, search SearchOrders) (*OrderPage, error)
 		GetOrder(ctx context.Context, get GetOrder) (*models.Order, error)
+		SearchProducts(ctx context.Context, search SearchProducts) (*ProductPage, error)
+		SearchStores(ctx context.Context, search SearchStores) (*StorePage, error)
+		GetCustomerOrderHistory(ctx context.Context, get GetCustomerOrderHistory) (*CustomerOrderHistory, error)
+		GetStoreSales(ctx context.Context, get GetStoreSales) (*models.StoreSales, error)
 	}
 
 	app struct {
-		orders OrderRepository
+		orders   OrderRepository
+		stats    OrderStatsRepository
+		products ProductListingRepository
+		stores   StoreListingRepository
 	}
 )
 
 var _ Application = (*app)(nil)
 
-func New(orders OrderRepository) *app {
+func New(orders OrderRepository, stats OrderStatsRepository, products ProductListingRepository, stores StoreListingRepository) *app {
 	return &app{
-		orders: orders,
+		orders:   orders,
+		stats:    stats,
+		products: products,
+		stores:   stores,
 	}
 }
 
-func (a app) SearchOrders(ctx context.Context, search SearchOrders) ([]*models.Order, error) {
-	// TODO implement me
-	panic("implement me")
+func (a app) SearchOrders(ctx context.Context, search SearchOrders) (*OrderPage, error) {
+	if search.Sort == "" {
+		search.Sort = NewestFirst
+	}
+	if err := search.Sort.validate(); err != nil {
+		return nil, err
+	}
+
+	search.Limit = searchLimit(search.Limit)
+
+	if !search.Filters.After.IsZero() && !search.Filters.Before.IsZero() && !search.Filters.After.Before(search.Filters.Before) {
+		return nil, ErrInvalidDateRange
+	}
+	if search.Filters.MaxTotal > 0 && search.Filters.MinTotal > search.Filters.MaxTotal {
+		return nil, ErrInvalidTotalRange
+	}
+
+	return a.orders.Search(ctx, search)
 }
 
 func (a app) GetOrder(ctx context.Context, get GetOrder) (*models.Order, error) {
-	// TODO implement me
-	panic("implement me")
+	return a.orders.Get(ctx, get.OrderID)
+}
+
+func (a app) SearchProducts(ctx context.Context, search SearchProducts) (*ProductPage, error) {
+	if search.MaxPrice > 0 && search.MinPrice > search.MaxPrice {
+		return nil, ErrInvalidPriceRange
+	}
+	search.Limit = searchLimit(search.Limit)
+
+	return a.products.Search(ctx, search)
+}
+
+func (a app) SearchStores(ctx context.Context, search SearchStores) (*StorePage, error) {
+	search.Limit = searchLimit(search.Limit)
+
+	return a.stores.Search(ctx, search)
+}
+
+func (a app) GetCustomerOrderHistory(ctx context.Context, get GetCustomerOrderHistory) (*CustomerOrderHistory, error) {
+	if get.CustomerID == "" {
+		return nil, ErrCustomerIDCannotBeBlank
+	}
+
+	stats, err := a.stats.FindCustomerStats(ctx, get.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := a.SearchOrders(ctx, SearchOrders{
+		Filters: Filters{CustomerID: get.CustomerID},
+		Sort:    NewestFirst,
+		Next:    get.Next,
+		Limit:   get.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomerOrderHistory{
+		Stats:     stats,
+		OrderPage: *page,
+	}, nil
+}
+
+func (a app) GetStoreSales(ctx context.Context, get GetStoreSales) (*models.StoreSales, error) {
+	if get.StoreID == "" {
+		return nil, ErrStoreIDCannotBeBlank
+	}
+	if get.To.IsZero() {
+		get.To = time.Now()
+	}
+	if get.From.IsZero() {
+		get.From = get.To.AddDate(0, 0, -DefaultSalesDays+1)
+	}
+	if get.To.Before(get.From) {
+		return nil, ErrInvalidDateRange
+	}
+
+	days, err := a.stats.FindStoreSales(ctx, get.StoreID, get.From, get.To)
+	if err != nil {
+		return nil, err
+	}
+
+	sales := &models.StoreSales{
+		StoreID: get.StoreID,
+		Days:    days,
+	}
+	for _, day := range days {
+		sales.Total.OrderCount += day.OrderCount
+		sales.Total.CompletedCount += day.CompletedCount
+		sales.Total.CanceledCount += day.CanceledCount
+		sales.Total.ItemsSold += day.ItemsSold
+		sales.Total.Revenue += day.Revenue
+	}
+
+	return sales, nil
 }
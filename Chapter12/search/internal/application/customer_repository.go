@@ -12,5 +12,6 @@ type CustomerRepository interface {
 
 type CustomerCacheRepository interface {
 	Add(ctx context.Context, customerID, name string) error
+	Remove(ctx context.Context, customerID string) error
 	CustomerRepository
 }
@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+
+	"eda-in-golang/search/internal/models"
+)
+
+type ProductListingRepository interface {
+	Add(ctx context.Context, product *models.ProductListing) error
+	Rebrand(ctx context.Context, productID, name, description string) error
+	ChangePrice(ctx context.Context, productID string, delta float64) error
+	Remove(ctx context.Context, productID string) error
+	Search(ctx context.Context, search SearchProducts) (*ProductPage, error)
+	// FindUnsynced returns the products listed before their details were kept
+	FindUnsynced(ctx context.Context) ([]string, error)
+	Sync(ctx context.Context, product *models.ProductListing) error
+}
+
+type StoreListingRepository interface {
+	Add(ctx context.Context, store *models.StoreListing) error
+	Rebrand(ctx context.Context, storeID, name string) error
+	ToggleParticipation(ctx context.Context, storeID string, participating bool) error
+	Search(ctx context.Context, search SearchStores) (*StorePage, error)
+	// FindUnsynced returns the stores listed before their details were kept
+	FindUnsynced(ctx context.Context) ([]string, error)
+	Sync(ctx context.Context, store *models.StoreListing) error
+}
+
+// ListingSource has the current details of the stores and products
+type ListingSource interface {
+	FindStoreListing(ctx context.Context, storeID string) (*models.StoreListing, error)
+	FindProductListing(ctx context.Context, productID string) (*models.ProductListing, error)
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/search/internal/models"
+)
+
+var ErrInvalidPriceRange = errors.Wrap(errors.ErrBadRequest, "the search minimum price cannot exceed the maximum price")
+
+// PriceFacetBounds are the lower bounds of the price ranges products are counted in
+var PriceFacetBounds = []float64{0, 10, 25, 50, 100}
+
+type (
+	SearchProducts struct {
+		Query    string
+		StoreIDs []string
+		MinPrice float64
+		MaxPrice float64
+		Limit    int
+	}
+
+	ProductPage struct {
+		Products    []*models.ProductListing
+		StoreFacets []models.StoreFacet
+		PriceFacets []models.PriceFacet
+	}
+
+	SearchStores struct {
+		Query             string
+		ParticipatingOnly bool
+		Limit             int
+	}
+
+	StorePage struct {
+		Stores []*models.StoreListing
+	}
+)
+
+// PrefixQuery turns free text into a tsquery expression where every word may
+// be the start of a longer word; a blank result matches everything
+func PrefixQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
+
+func searchLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultSearchLimit
+	case limit > MaxSearchLimit:
+		return MaxSearchLimit
+	default:
+		return limit
+	}
+}
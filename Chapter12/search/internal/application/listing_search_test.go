@@ -0,0 +1,68 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixQuery(t *testing.T) {
+	tests := map[string]struct {
+		text string
+		want string
+	}{
+		"Blank": {
+			text: "",
+			want: "",
+		},
+		"Whitespace": {
+			text: " \t\n ",
+			want: "",
+		},
+		"Words": {
+			text: "Red  Running Shoes",
+			want: "red:* & running:* & shoes:*",
+		},
+		"Digits": {
+			text: "size 10",
+			want: "size:* & 10:*",
+		},
+		"Punctuation": {
+			text: "kid's t-shirt, (large).",
+			want: "kid:* & s:* & t:* & shirt:* & large:*",
+		},
+		"PunctuationOnly": {
+			text: "... -- ,;'\"",
+			want: "",
+		},
+		"Operators": {
+			text: "a&b|c!d:*(e) <-> f",
+			want: "a:* & b:* & c:* & d:* & e:* & f:*",
+		},
+		"OperatorsOnly": {
+			text: "& | ! : * ( ) <->",
+			want: "",
+		},
+		"Escapes": {
+			text: `\'coffee\' $1`,
+			want: "coffee:* & 1:*",
+		},
+		"Unicode": {
+			text: "Café MÜLLER",
+			want: "café:* & müller:*",
+		},
+		"NonLatin": {
+			text: "東京 Москва",
+			want: "東京:* & москва:*",
+		},
+		"Symbols": {
+			text: "🍕pizza™",
+			want: "pizza:*",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, PrefixQuery(tc.text))
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 type OrderRepository interface {
 	Add(ctx context.Context, order *models.Order) error
-	UpdateStatus(ctx context.Context, orderID, status string) error
-	Search(ctx context.Context, search SearchOrders) ([]*models.Order, error)
+	// UpdateStatus saves the status and status timestamps of the order
+	UpdateStatus(ctx context.Context, order *models.Order) error
+	AddRefund(ctx context.Context, orderID string, amount float64) error
+	Search(ctx context.Context, search SearchOrders) (*OrderPage, error)
 	Get(ctx context.Context, orderID string) (*models.Order, error)
 }
@@ -0,0 +1,50 @@
+package application
+
+import (
+	"github.com/stackus/errors"
+)
+
+// OrderSort is the order search results are returned in; ties are broken on
+// the order ID so every order has a stable position between pages
+type OrderSort string
+
+const (
+	NewestFirst        OrderSort = "-created_at"
+	OldestFirst        OrderSort = "created_at"
+	LargestTotalFirst  OrderSort = "-total"
+	SmallestTotalFirst OrderSort = "total"
+
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+
+	// DefaultSalesDays is how far back store sales go when no range is given
+	DefaultSalesDays = 30
+)
+
+var (
+	ErrUnknownOrderSort        = errors.Wrap(errors.ErrBadRequest, "the order search sort is not supported")
+	ErrInvalidDateRange        = errors.Wrap(errors.ErrBadRequest, "the search date range must end after it starts")
+	ErrInvalidTotalRange       = errors.Wrap(errors.ErrBadRequest, "the search minimum total cannot exceed the maximum total")
+	ErrInvalidCursor           = errors.Wrap(errors.ErrBadRequest, "the search cursor is not valid for this search")
+	ErrCustomerIDCannotBeBlank = errors.Wrap(errors.ErrBadRequest, "the customer id cannot be blank")
+	ErrStoreIDCannotBeBlank    = errors.Wrap(errors.ErrBadRequest, "the store id cannot be blank")
+)
+
+func (s OrderSort) validate() error {
+	switch s {
+	case NewestFirst, OldestFirst, LargestTotalFirst, SmallestTotalFirst:
+		return nil
+	default:
+		return errors.Wrapf(ErrUnknownOrderSort, "sort: %s", s)
+	}
+}
+
+// Descending is true when results run from the largest to the smallest value
+func (s OrderSort) Descending() bool {
+	return s == NewestFirst || s == LargestTotalFirst
+}
+
+// ByTotal is true when results are ordered by the order total rather than when it was created
+func (s OrderSort) ByTotal() bool {
+	return s == LargestTotalFirst || s == SmallestTotalFirst
+}
@@ -0,0 +1,17 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/search/internal/models"
+)
+
+type OrderStatsRepository interface {
+	OrderPlaced(ctx context.Context, order *models.Order) error
+	OrderCompleted(ctx context.Context, order *models.Order) error
+	OrderCanceled(ctx context.Context, order *models.Order) error
+	RefundIssued(ctx context.Context, order *models.Order, amount float64) error
+	FindCustomerStats(ctx context.Context, customerID string) (*models.CustomerStats, error)
+	FindStoreSales(ctx context.Context, storeID string, from, to time.Time) ([]models.StoreSalesDay, error)
+}
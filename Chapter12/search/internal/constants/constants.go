@@ -27,10 +27,15 @@ const (
 	CommandHandlersKey          = "commandHandlers"
 	ReplyHandlersKey            = "replyHandlers"
 
-	OrdersRepoKey    = "ordersRepo"
-	CustomersRepoKey = "customersRepo"
-	StoresRepoKey    = "storesRepo"
-	ProductsRepoKey  = "productsRepo"
+	OrdersRepoKey     = "ordersRepo"
+	OrderStatsRepoKey = "orderStatsRepo"
+	CustomersRepoKey  = "customersRepo"
+	StoresRepoKey     = "storesRepo"
+	ProductsRepoKey   = "productsRepo"
+
+	ProductListingsRepoKey = "productListingsRepo"
+	StoreListingsRepoKey   = "storeListingsRepo"
+	ListingSourceKey       = "listingSource"
 )
 
 // Repository Table Names
@@ -41,8 +46,12 @@ const (
 	SnapshotsTableName = ServiceName + ".snapshots"
 	SagasTableName     = ServiceName + ".sagas"
 
-	OrdersTableName         = ServiceName + ".orders"
-	CustomersCacheTableName = ServiceName + ".customers_cache"
-	StoresCacheTableName    = ServiceName + ".stores_cache"
-	ProductsCacheTableName  = ServiceName + ".products_cache"
+	OrdersTableName             = ServiceName + ".orders"
+	CustomerOrderStatsTableName = ServiceName + ".customer_order_stats"
+	StoreSalesTableName         = ServiceName + ".store_sales"
+	CustomersCacheTableName     = ServiceName + ".customers_cache"
+	StoresCacheTableName        = ServiceName + ".stores_cache"
+	ProductsCacheTableName      = ServiceName + ".products_cache"
+	ProductListingsTableName    = ServiceName + ".product_listings"
+	StoreListingsTableName      = ServiceName + ".store_listings"
 )
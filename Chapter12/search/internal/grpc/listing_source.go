@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"eda-in-golang/internal/rpc"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
+	"eda-in-golang/stores/storespb"
+)
+
+type ListingSource struct {
+	endpoint string
+}
+
+var _ application.ListingSource = (*ListingSource)(nil)
+
+func NewListingSource(endpoint string) ListingSource {
+	return ListingSource{
+		endpoint: endpoint,
+	}
+}
+
+func (r ListingSource) FindStoreListing(ctx context.Context, storeID string) (store *models.StoreListing, err error) {
+	var conn *grpc.ClientConn
+	conn, err = r.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(conn *grpc.ClientConn) {
+		_ = conn.Close()
+	}(conn)
+
+	resp, err := storespb.NewStoresServiceClient(conn).GetStore(ctx, &storespb.GetStoreRequest{Id: storeID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.StoreListing{
+		ID:            resp.GetStore().GetId(),
+		Name:          resp.GetStore().GetName(),
+		Location:      resp.GetStore().GetLocation(),
+		Participating: resp.GetStore().GetParticipating(),
+	}, nil
+}
+
+func (r ListingSource) FindProductListing(ctx context.Context, productID string) (product *models.ProductListing, err error) {
+	var conn *grpc.ClientConn
+	conn, err = r.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(conn *grpc.ClientConn) {
+		_ = conn.Close()
+	}(conn)
+
+	resp, err := storespb.NewStoresServiceClient(conn).GetProduct(ctx, &storespb.GetProductRequest{Id: productID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ProductListing{
+		ID:          resp.GetProduct().GetId(),
+		StoreID:     resp.GetProduct().GetStoreId(),
+		Name:        resp.GetProduct().GetName(),
+		Description: resp.GetProduct().GetDescription(),
+		SKU:         resp.GetProduct().GetSku(),
+		Price:       resp.GetProduct().GetPrice(),
+	}, nil
+}
+
+func (r ListingSource) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	return rpc.Dial(ctx, r.endpoint)
+}
@@ -2,10 +2,13 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
 	"eda-in-golang/search/searchpb"
 )
 
@@ -20,11 +23,234 @@ func RegisterServer(_ context.Context, app application.Application, registrar gr
 }
 
 func (s server) SearchOrders(ctx context.Context, request *searchpb.SearchOrdersRequest) (*searchpb.SearchOrdersResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	filters := request.GetFilters()
+
+	search := application.SearchOrders{
+		Filters: application.Filters{
+			CustomerID: filters.GetCustomerId(),
+			StoreIDs:   filters.GetStoreIds(),
+			ProductIDs: filters.GetProductIds(),
+			MinTotal:   filters.GetMinTotal(),
+			MaxTotal:   filters.GetMaxTotal(),
+			Status:     filters.GetStatus(),
+		},
+		Sort:  application.OrderSort(request.GetSort()),
+		Next:  request.GetNext(),
+		Limit: int(request.GetLimit()),
+	}
+	if filters.GetAfter() != nil {
+		search.Filters.After = filters.GetAfter().AsTime()
+	}
+	if filters.GetBefore() != nil {
+		search.Filters.Before = filters.GetBefore().AsTime()
+	}
+
+	page, err := s.app.SearchOrders(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*searchpb.Order, len(page.Orders))
+	for i, order := range page.Orders {
+		orders[i] = s.orderFromDomain(order)
+	}
+
+	return &searchpb.SearchOrdersResponse{
+		Orders: orders,
+		Next:   page.Next,
+	}, nil
 }
 
 func (s server) GetOrder(ctx context.Context, request *searchpb.GetOrderRequest) (*searchpb.GetOrderResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	order, err := s.app.GetOrder(ctx, application.GetOrder{OrderID: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchpb.GetOrderResponse{
+		Order: s.orderFromDomain(order),
+	}, nil
+}
+
+func (s server) SearchProducts(ctx context.Context, request *searchpb.SearchProductsRequest) (*searchpb.SearchProductsResponse, error) {
+	page, err := s.app.SearchProducts(ctx, application.SearchProducts{
+		Query:    request.GetQuery(),
+		StoreIDs: request.GetStoreIds(),
+		MinPrice: request.GetMinPrice(),
+		MaxPrice: request.GetMaxPrice(),
+		Limit:    int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*searchpb.Product, len(page.Products))
+	for i, product := range page.Products {
+		products[i] = &searchpb.Product{
+			Id:          product.ID,
+			StoreId:     product.StoreID,
+			StoreName:   product.StoreName,
+			Name:        product.Name,
+			Description: product.Description,
+			Sku:         product.SKU,
+			Price:       product.Price,
+			Rank:        product.Rank,
+		}
+	}
+	storeFacets := make([]*searchpb.SearchProductsResponse_StoreFacet, len(page.StoreFacets))
+	for i, facet := range page.StoreFacets {
+		storeFacets[i] = &searchpb.SearchProductsResponse_StoreFacet{
+			StoreId:   facet.StoreID,
+			StoreName: facet.StoreName,
+			Count:     int64(facet.Count),
+		}
+	}
+	priceFacets := make([]*searchpb.SearchProductsResponse_PriceFacet, len(page.PriceFacets))
+	for i, facet := range page.PriceFacets {
+		priceFacets[i] = &searchpb.SearchProductsResponse_PriceFacet{
+			Min:   facet.Min,
+			Max:   facet.Max,
+			Count: int64(facet.Count),
+		}
+	}
+
+	return &searchpb.SearchProductsResponse{
+		Products:    products,
+		StoreFacets: storeFacets,
+		PriceFacets: priceFacets,
+	}, nil
+}
+
+func (s server) SearchStores(ctx context.Context, request *searchpb.SearchStoresRequest) (*searchpb.SearchStoresResponse, error) {
+	page, err := s.app.SearchStores(ctx, application.SearchStores{
+		Query:             request.GetQuery(),
+		ParticipatingOnly: request.GetParticipatingOnly(),
+		Limit:             int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make([]*searchpb.Store, len(page.Stores))
+	for i, store := range page.Stores {
+		stores[i] = &searchpb.Store{
+			Id:            store.ID,
+			Name:          store.Name,
+			Location:      store.Location,
+			Participating: store.Participating,
+			Rank:          store.Rank,
+		}
+	}
+
+	return &searchpb.SearchStoresResponse{
+		Stores: stores,
+	}, nil
+}
+
+func (s server) GetCustomerOrderHistory(ctx context.Context, request *searchpb.GetCustomerOrderHistoryRequest) (*searchpb.GetCustomerOrderHistoryResponse, error) {
+	history, err := s.app.GetCustomerOrderHistory(ctx, application.GetCustomerOrderHistory{
+		CustomerID: request.GetCustomerId(),
+		Next:       request.GetNext(),
+		Limit:      int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*searchpb.Order, len(history.Orders))
+	for i, order := range history.Orders {
+		orders[i] = s.orderFromDomain(order)
+	}
+
+	return &searchpb.GetCustomerOrderHistoryResponse{
+		Stats: &searchpb.CustomerStats{
+			CustomerId:     history.Stats.CustomerID,
+			OrderCount:     int64(history.Stats.OrderCount),
+			CompletedCount: int64(history.Stats.CompletedCount),
+			CanceledCount:  int64(history.Stats.CanceledCount),
+			LifetimeSpend:  history.Stats.LifetimeSpend,
+			Refunded:       history.Stats.Refunded,
+			NetSpend:       history.Stats.NetSpend(),
+			FirstOrderAt:   timestampFromDomain(history.Stats.FirstOrderAt),
+			LastOrderAt:    timestampFromDomain(history.Stats.LastOrderAt),
+		},
+		Orders: orders,
+		Next:   history.Next,
+	}, nil
+}
+
+func (s server) GetStoreSales(ctx context.Context, request *searchpb.GetStoreSalesRequest) (*searchpb.GetStoreSalesResponse, error) {
+	get := application.GetStoreSales{
+		StoreID: request.GetStoreId(),
+	}
+	if request.GetFrom() != nil {
+		get.From = request.GetFrom().AsTime()
+	}
+	if request.GetTo() != nil {
+		get.To = request.GetTo().AsTime()
+	}
+
+	sales, err := s.app.GetStoreSales(ctx, get)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*searchpb.StoreSalesDay, len(sales.Days))
+	for i, day := range sales.Days {
+		days[i] = s.salesDayFromDomain(day)
+	}
+
+	return &searchpb.GetStoreSalesResponse{
+		StoreId: sales.StoreID,
+		Days:    days,
+		Total:   s.salesDayFromDomain(sales.Total),
+	}, nil
+}
+
+func (s server) salesDayFromDomain(day models.StoreSalesDay) *searchpb.StoreSalesDay {
+	return &searchpb.StoreSalesDay{
+		Day:            timestampFromDomain(day.Day),
+		OrderCount:     int64(day.OrderCount),
+		CompletedCount: int64(day.CompletedCount),
+		CanceledCount:  int64(day.CanceledCount),
+		ItemsSold:      int64(day.ItemsSold),
+		Revenue:        day.Revenue,
+	}
+}
+
+func (s server) orderFromDomain(order *models.Order) *searchpb.Order {
+	items := make([]*searchpb.Order_Item, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = &searchpb.Order_Item{
+			ProductId:   item.ProductID,
+			StoreId:     item.StoreID,
+			ProductName: item.ProductName,
+			StoreName:   item.StoreName,
+			Price:       item.Price,
+			Quantity:    int64(item.Quantity),
+		}
+	}
+
+	return &searchpb.Order{
+		OrderId:      order.OrderID,
+		CustomerId:   order.CustomerID,
+		CustomerName: order.CustomerName,
+		Items:        items,
+		Total:        order.Total,
+		Status:       order.Status,
+		Refunded:     order.Refunded,
+		CreatedAt:    timestamppb.New(order.CreatedAt),
+		ApprovedAt:   timestampFromDomain(order.ApprovedAt),
+		ReadiedAt:    timestampFromDomain(order.ReadiedAt),
+		CompletedAt:  timestampFromDomain(order.CompletedAt),
+		CanceledAt:   timestampFromDomain(order.CanceledAt),
+	}
+}
+
+// timestampFromDomain leaves unset times out of the response
+func timestampFromDomain(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
 }
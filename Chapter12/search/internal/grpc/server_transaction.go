@@ -48,6 +48,50 @@ func (s serverTx) GetOrder(ctx context.Context, request *searchpb.GetOrderReques
 	return next.GetOrder(ctx, request)
 }
 
+func (s serverTx) SearchProducts(ctx context.Context, request *searchpb.SearchProductsRequest) (resp *searchpb.SearchProductsResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.Application)}
+
+	return next.SearchProducts(ctx, request)
+}
+
+func (s serverTx) SearchStores(ctx context.Context, request *searchpb.SearchStoresRequest) (resp *searchpb.SearchStoresResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.Application)}
+
+	return next.SearchStores(ctx, request)
+}
+
+func (s serverTx) GetCustomerOrderHistory(ctx context.Context, request *searchpb.GetCustomerOrderHistoryRequest) (resp *searchpb.GetCustomerOrderHistoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.Application)}
+
+	return next.GetCustomerOrderHistory(ctx, request)
+}
+
+func (s serverTx) GetStoreSales(ctx context.Context, request *searchpb.GetStoreSalesRequest) (resp *searchpb.GetStoreSalesResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.Application)}
+
+	return next.GetStoreSales(ctx, request)
+}
+
 func (s serverTx) closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/stackus/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -13,6 +14,7 @@ import (
 	"eda-in-golang/internal/errorsotel"
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/models"
 	"eda-in-golang/stores/storespb"
@@ -20,43 +22,74 @@ import (
 
 type integrationHandlers[T ddd.Event] struct {
 	orders    application.OrderRepository
+	stats     application.OrderStatsRepository
 	customers application.CustomerCacheRepository
 	products  application.ProductCacheRepository
 	stores    application.StoreCacheRepository
+	listings  listings
+}
+
+type listings struct {
+	products application.ProductListingRepository
+	stores   application.StoreListingRepository
 }
 
 var _ ddd.EventHandler[ddd.Event] = (*integrationHandlers[ddd.Event])(nil)
 
-func NewIntegrationEventHandlers(reg registry.Registry, orders application.OrderRepository, customers application.CustomerCacheRepository,
-	stores application.StoreCacheRepository, products application.ProductCacheRepository, mws ...am.MessageHandlerMiddleware) am.MessageHandler {
+func NewIntegrationEventHandlers(reg registry.Registry, orders application.OrderRepository, stats application.OrderStatsRepository, customers application.CustomerCacheRepository,
+	stores application.StoreCacheRepository, products application.ProductCacheRepository,
+	productListings application.ProductListingRepository, storeListings application.StoreListingRepository,
+	mws ...am.MessageHandlerMiddleware) am.MessageHandler {
 	return am.NewEventHandler(reg, integrationHandlers[ddd.Event]{
 		orders:    orders,
+		stats:     stats,
 		customers: customers,
 		products:  products,
 		stores:    stores,
+		listings: listings{
+			products: productListings,
+			stores:   storeListings,
+		},
 	}, mws...)
 }
 
 func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) (err error) {
 	if _, err = subscriber.Subscribe(customerspb.CustomerAggregateChannel, handlers, am.MessageFilter{
 		customerspb.CustomerRegisteredEvent,
+		customerspb.CustomerForgottenEvent,
 	}, am.GroupName("search-customers")); err != nil {
 		return
 	}
 
 	if _, err = subscriber.Subscribe(orderingpb.OrderAggregateChannel, handlers, am.MessageFilter{
 		orderingpb.OrderCreatedEvent,
+		orderingpb.OrderRejectedEvent,
+		orderingpb.OrderApprovedEvent,
 		orderingpb.OrderReadiedEvent,
 		orderingpb.OrderCanceledEvent,
 		orderingpb.OrderCompletedEvent,
+		orderingpb.OrderReturnRequestedEvent,
+		orderingpb.OrderReturnApprovedEvent,
+		orderingpb.OrderReturnRejectedEvent,
+		orderingpb.OrderReturnedEvent,
 	}, am.GroupName("notification-orders")); err != nil {
 		return
 	}
 
+	if _, err = subscriber.Subscribe(paymentspb.RefundAggregateChannel, handlers, am.MessageFilter{
+		paymentspb.RefundIssuedEvent,
+	}, am.GroupName("search-refunds")); err != nil {
+		return
+	}
+
 	if _, err = subscriber.Subscribe(storespb.ProductAggregateChannel, handlers, am.MessageFilter{
 		storespb.ProductAddedEvent,
 		storespb.ProductRebrandedEvent,
+		storespb.ProductPriceIncreasedEvent,
+		storespb.ProductPriceDecreasedEvent,
 		storespb.ProductRemovedEvent,
+		storespb.ProductScheduledPriceActivatedEvent,
+		storespb.ProductScheduledPriceRevertedEvent,
 	}, am.GroupName("search-products")); err != nil {
 		return
 	}
@@ -64,6 +97,7 @@ func RegisterIntegrationEventHandlers(subscriber am.MessageSubscriber, handlers
 	if _, err = subscriber.Subscribe(storespb.StoreAggregateChannel, handlers, am.MessageFilter{
 		storespb.StoreCreatedEvent,
 		storespb.StoreRebrandedEvent,
+		storespb.StoreParticipatingToggledEvent,
 	}, am.GroupName("search-stores")); err != nil {
 		return
 	}
@@ -92,24 +126,46 @@ func (h integrationHandlers[T]) HandleEvent(ctx context.Context, event T) (err e
 	switch event.EventName() {
 	case customerspb.CustomerRegisteredEvent:
 		return h.onCustomerRegistered(ctx, event)
+	case customerspb.CustomerForgottenEvent:
+		return h.onCustomerForgotten(ctx, event)
 	case storespb.ProductAddedEvent:
 		return h.onProductAdded(ctx, event)
 	case storespb.ProductRebrandedEvent:
 		return h.onProductRebranded(ctx, event)
+	case storespb.ProductPriceIncreasedEvent, storespb.ProductPriceDecreasedEvent:
+		return h.onProductPriceChanged(ctx, event)
+	case storespb.ProductScheduledPriceActivatedEvent, storespb.ProductScheduledPriceRevertedEvent:
+		return h.onProductScheduledPriceChanged(ctx, event)
 	case storespb.ProductRemovedEvent:
 		return h.onProductRemoved(ctx, event)
 	case storespb.StoreCreatedEvent:
 		return h.onStoreCreated(ctx, event)
 	case storespb.StoreRebrandedEvent:
 		return h.onStoreRebranded(ctx, event)
+	case storespb.StoreParticipatingToggledEvent:
+		return h.onStoreParticipationToggled(ctx, event)
 	case orderingpb.OrderCreatedEvent:
 		return h.onOrderCreated(ctx, event)
+	case orderingpb.OrderRejectedEvent:
+		return h.onOrderRejected(ctx, event)
+	case orderingpb.OrderApprovedEvent:
+		return h.onOrderApproved(ctx, event)
 	case orderingpb.OrderReadiedEvent:
 		return h.onOrderReadied(ctx, event)
 	case orderingpb.OrderCanceledEvent:
 		return h.onOrderCanceled(ctx, event)
 	case orderingpb.OrderCompletedEvent:
 		return h.onOrderCompleted(ctx, event)
+	case orderingpb.OrderReturnRequestedEvent:
+		return h.onOrderReturnRequested(ctx, event)
+	case orderingpb.OrderReturnApprovedEvent:
+		return h.onOrderReturnApproved(ctx, event)
+	case orderingpb.OrderReturnRejectedEvent:
+		return h.onOrderReturnRejected(ctx, event)
+	case orderingpb.OrderReturnedEvent:
+		return h.onOrderReturned(ctx, event)
+	case paymentspb.RefundIssuedEvent:
+		return h.onRefundIssued(ctx, event)
 	}
 
 	return nil
@@ -120,29 +176,75 @@ func (h integrationHandlers[T]) onCustomerRegistered(ctx context.Context, event
 	return h.customers.Add(ctx, payload.GetId(), payload.GetName())
 }
 
+func (h integrationHandlers[T]) onCustomerForgotten(ctx context.Context, event T) error {
+	payload := event.Payload().(*customerspb.CustomerForgotten)
+	return h.customers.Remove(ctx, payload.GetId())
+}
+
 func (h integrationHandlers[T]) onProductAdded(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductAdded)
-	return h.products.Add(ctx, payload.GetId(), payload.GetStoreId(), payload.GetName())
+	if err := h.products.Add(ctx, payload.GetId(), payload.GetStoreId(), payload.GetName()); err != nil {
+		return err
+	}
+	return h.listings.products.Add(ctx, &models.ProductListing{
+		ID:          payload.GetId(),
+		StoreID:     payload.GetStoreId(),
+		Name:        payload.GetName(),
+		Description: payload.GetDescription(),
+		SKU:         payload.GetSku(),
+		Price:       payload.GetPrice(),
+	})
 }
 
 func (h integrationHandlers[T]) onProductRebranded(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductRebranded)
-	return h.products.Rebrand(ctx, payload.GetId(), payload.GetName())
+	if err := h.products.Rebrand(ctx, payload.GetId(), payload.GetName()); err != nil {
+		return err
+	}
+	return h.listings.products.Rebrand(ctx, payload.GetId(), payload.GetName(), payload.GetDescription())
+}
+
+func (h integrationHandlers[T]) onProductPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductPriceChanged)
+	return h.listings.products.ChangePrice(ctx, payload.GetId(), payload.GetDelta())
+}
+
+func (h integrationHandlers[T]) onProductScheduledPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.ProductScheduledPriceChanged)
+	return h.listings.products.ChangePrice(ctx, payload.GetId(), payload.GetDelta())
 }
 
 func (h integrationHandlers[T]) onProductRemoved(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.ProductRemoved)
-	return h.products.Remove(ctx, payload.GetId())
+	if err := h.products.Remove(ctx, payload.GetId()); err != nil {
+		return err
+	}
+	return h.listings.products.Remove(ctx, payload.GetId())
 }
 
 func (h integrationHandlers[T]) onStoreCreated(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.StoreCreated)
-	return h.stores.Add(ctx, payload.GetId(), payload.GetName())
+	if err := h.stores.Add(ctx, payload.GetId(), payload.GetName()); err != nil {
+		return err
+	}
+	return h.listings.stores.Add(ctx, &models.StoreListing{
+		ID:       payload.GetId(),
+		Name:     payload.GetName(),
+		Location: payload.GetLocation(),
+	})
 }
 
 func (h integrationHandlers[T]) onStoreRebranded(ctx context.Context, event ddd.Event) error {
 	payload := event.Payload().(*storespb.StoreRebranded)
-	return h.stores.Rename(ctx, payload.GetId(), payload.GetName())
+	if err := h.stores.Rename(ctx, payload.GetId(), payload.GetName()); err != nil {
+		return err
+	}
+	return h.listings.stores.Rebrand(ctx, payload.GetId(), payload.GetName())
+}
+
+func (h integrationHandlers[T]) onStoreParticipationToggled(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*storespb.StoreParticipationToggled)
+	return h.listings.stores.ToggleParticipation(ctx, payload.GetId(), payload.GetParticipating())
 }
 func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCreated)
@@ -177,8 +279,9 @@ func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event T) err
 			StoreName:   store.Name,
 			Price:       item.Price,
 			Quantity:    int(item.Quantity),
+			Discount:    item.GetDiscount(),
 		}
-		total += float64(item.Quantity) * item.Price
+		total += items[i].Total()
 	}
 	order := &models.Order{
 		OrderID:      payload.GetId(),
@@ -186,22 +289,137 @@ func (h integrationHandlers[T]) onOrderCreated(ctx context.Context, event T) err
 		CustomerName: customer.Name,
 		Items:        items,
 		Total:        total,
-		Status:       "New",
+		Status:       orderingpb.OrderIsPending.String(),
+		CreatedAt:    event.OccurredAt(),
 	}
-	return h.orders.Add(ctx, order)
+	if err = h.orders.Add(ctx, order); err != nil {
+		return err
+	}
+	return h.stats.OrderPlaced(ctx, order)
+}
+
+func (h integrationHandlers[T]) onOrderRejected(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderRejected)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsRejected, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onOrderApproved(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderApproved)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsApproved, event.OccurredAt())
 }
 
 func (h integrationHandlers[T]) onOrderReadied(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderReadied)
-	return h.orders.UpdateStatus(ctx, payload.GetId(), "Ready For Pickup")
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsReady, event.OccurredAt())
 }
 
 func (h integrationHandlers[T]) onOrderCanceled(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCanceled)
-	return h.orders.UpdateStatus(ctx, payload.GetId(), "Canceled")
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsCancelled, event.OccurredAt())
 }
 
 func (h integrationHandlers[T]) onOrderCompleted(ctx context.Context, event T) error {
 	payload := event.Payload().(*orderingpb.OrderCompleted)
-	return h.orders.UpdateStatus(ctx, payload.GetId(), "Completed")
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsCompleted, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onOrderReturnRequested(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderReturnRequested)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsReturnRequested, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onOrderReturnApproved(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderReturnApproved)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsReturning, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onOrderReturnRejected(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderReturnRejected)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsCompleted, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onOrderReturned(ctx context.Context, event T) error {
+	payload := event.Payload().(*orderingpb.OrderReturned)
+	return h.updateStatus(ctx, payload.GetId(), orderingpb.OrderIsReturned, event.OccurredAt())
+}
+
+func (h integrationHandlers[T]) onRefundIssued(ctx context.Context, event T) error {
+	payload := event.Payload().(*paymentspb.RefundIssued)
+	if err := h.orders.AddRefund(ctx, payload.GetOrderId(), payload.GetAmount()); err != nil {
+		return err
+	}
+
+	order, err := h.orders.Get(ctx, payload.GetOrderId())
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return h.stats.RefundIssued(ctx, order, payload.GetAmount())
+}
+
+// updateStatus projects a new order status only when the ordering state
+// machine allows the order to move into it from the projected status
+//
+// An event that arrives ahead of the events before it is returned as an error
+// so it is delivered again once the projection has caught up; an event that
+// was redelivered or has been overtaken leaves the projection as it is
+//
+// The first time an order reaches a status with a timestamp it is also
+// counted in the customer and store stats
+func (h integrationHandlers[T]) updateStatus(ctx context.Context, orderID string, status orderingpb.OrderStatus, at time.Time) error {
+	order, err := h.orders.Get(ctx, orderID)
+	if err != nil {
+		// the order may not have been projected yet
+		return err
+	}
+
+	current := orderingpb.ToOrderStatus(order.Status)
+	if err = current.TransitionTo(status); err != nil {
+		if current.CanReach(status) && !status.CanReach(current) {
+			return errors.Wrap(err, "the order status arrived ahead of the statuses before it")
+		}
+		trace.SpanFromContext(ctx).AddEvent("Ignored order status change", trace.WithAttributes(
+			errorsotel.ErrAttrs(err)...,
+		))
+		return nil
+	}
+
+	order.Status = status.String()
+
+	var count func(context.Context, *models.Order) error
+	switch status {
+	case orderingpb.OrderIsApproved:
+		order.ApprovedAt = firstTime(order.ApprovedAt, at)
+	case orderingpb.OrderIsReady:
+		order.ReadiedAt = firstTime(order.ReadiedAt, at)
+	case orderingpb.OrderIsCompleted:
+		if order.CompletedAt.IsZero() {
+			count = h.stats.OrderCompleted
+		}
+		order.CompletedAt = firstTime(order.CompletedAt, at)
+	case orderingpb.OrderIsCancelled:
+		if order.CanceledAt.IsZero() {
+			count = h.stats.OrderCanceled
+		}
+		order.CanceledAt = firstTime(order.CanceledAt, at)
+	}
+
+	if err = h.orders.UpdateStatus(ctx, order); err != nil {
+		return err
+	}
+
+	if count != nil {
+		return count(ctx, order)
+	}
+
+	return nil
+}
+
+func firstTime(current, at time.Time) time.Time {
+	if current.IsZero() {
+		return at
+	}
+	return current
 }
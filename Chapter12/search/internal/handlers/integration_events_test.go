@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
+)
+
+func TestIntegrationHandlers_OrderStatus(t *testing.T) {
+	completedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	completed := ddd.NewEvent(orderingpb.OrderCompletedEvent, &orderingpb.OrderCompleted{Id: "order-id"})
+	canceled := ddd.NewEvent(orderingpb.OrderCanceledEvent, &orderingpb.OrderCanceled{Id: "order-id"})
+	approved := ddd.NewEvent(orderingpb.OrderApprovedEvent, &orderingpb.OrderApproved{Id: "order-id"})
+	returnRejected := ddd.NewEvent(orderingpb.OrderReturnRejectedEvent, &orderingpb.OrderReturnRejected{Id: "order-id"})
+
+	tests := map[string]struct {
+		order      *models.Order
+		event      ddd.Event
+		wantStatus orderingpb.OrderStatus
+		wantUpdate bool
+		wantCount  string
+		wantErr    bool
+	}{
+		"Completed": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsReady.String()},
+			event:      completed,
+			wantStatus: orderingpb.OrderIsCompleted,
+			wantUpdate: true,
+			wantCount:  "OrderCompleted",
+		},
+		"Canceled": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsPending.String()},
+			event:      canceled,
+			wantStatus: orderingpb.OrderIsCancelled,
+			wantUpdate: true,
+			wantCount:  "OrderCanceled",
+		},
+		// a redelivered event is dropped by the status check and is not counted again
+		"RedeliveredCompleted": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsCompleted.String(), CompletedAt: completedAt},
+			event:      completed,
+			wantStatus: orderingpb.OrderIsCompleted,
+		},
+		"RedeliveredCanceled": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsCancelled.String(), CanceledAt: completedAt},
+			event:      canceled,
+			wantStatus: orderingpb.OrderIsCancelled,
+		},
+		// an event that has been overtaken leaves the projection as it is
+		"Overtaken": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsReady.String()},
+			event:      approved,
+			wantStatus: orderingpb.OrderIsReady,
+		},
+		// an event that arrives ahead of the events before it is delivered again later
+		"Ahead": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsPending.String()},
+			event:      completed,
+			wantStatus: orderingpb.OrderIsPending,
+			wantErr:    true,
+		},
+		// an order completed again after a rejected return was counted the first time
+		"CompletedAgain": {
+			order:      &models.Order{OrderID: "order-id", Status: orderingpb.OrderIsReturnRequested.String(), CompletedAt: completedAt},
+			event:      returnRejected,
+			wantStatus: orderingpb.OrderIsCompleted,
+			wantUpdate: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			orders := application.NewMockOrderRepository(t)
+			stats := application.NewMockOrderStatsRepository(t)
+			h := integrationHandlers[ddd.Event]{orders: orders, stats: stats}
+
+			// the mocks fail on any update or count that is not expected
+			orders.On("Get", context.Background(), "order-id").Return(tc.order, nil)
+			if tc.wantUpdate {
+				orders.On("UpdateStatus", context.Background(), tc.order).Return(nil).Once()
+			}
+			if tc.wantCount != "" {
+				stats.On(tc.wantCount, context.Background(), tc.order).Return(nil).Once()
+			}
+
+			err := h.HandleEvent(context.Background(), tc.event)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantStatus.String(), tc.order.Status)
+			if tc.wantUpdate && tc.wantStatus == orderingpb.OrderIsCompleted {
+				assert.False(t, tc.order.CompletedAt.IsZero())
+			}
+		})
+	}
+}
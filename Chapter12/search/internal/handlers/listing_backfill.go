@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/constants"
+)
+
+// BackfillListings fills in the listings of every tenant that were copied from
+// the caches with only their names; the current details are fetched from the
+// stores service
+//
+// Each listing is filled in its own transaction; a listing that cannot be
+// filled in is logged and tried again the next time the module starts
+func BackfillListings(ctx context.Context, container di.Container, tenants tenant.Tenants, logger zerolog.Logger) error {
+	for _, tenantID := range tenants.IDs() {
+		tenantCtx := tenant.WithID(ctx, tenantID)
+		tenantLogger := logger.With().Str("Tenant", tenantID).Logger()
+
+		var storeIDs, productIDs []string
+		err := withTransaction(tenantCtx, container, func(ctx context.Context) (err error) {
+			if storeIDs, err = di.Get(ctx, constants.StoreListingsRepoKey).(application.StoreListingRepository).FindUnsynced(ctx); err != nil {
+				return err
+			}
+			productIDs, err = di.Get(ctx, constants.ProductListingsRepoKey).(application.ProductListingRepository).FindUnsynced(ctx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, storeID := range storeIDs {
+			err = withTransaction(tenantCtx, container, func(ctx context.Context) error {
+				store, err := di.Get(ctx, constants.ListingSourceKey).(application.ListingSource).FindStoreListing(ctx, storeID)
+				if err != nil {
+					return err
+				}
+				return di.Get(ctx, constants.StoreListingsRepoKey).(application.StoreListingRepository).Sync(ctx, store)
+			})
+			if err != nil {
+				tenantLogger.Error().Err(err).Str("StoreID", storeID).Msg("search listings backfill failed to fill in a store")
+			}
+		}
+
+		for _, productID := range productIDs {
+			err = withTransaction(tenantCtx, container, func(ctx context.Context) error {
+				product, err := di.Get(ctx, constants.ListingSourceKey).(application.ListingSource).FindProductListing(ctx, productID)
+				if err != nil {
+					return err
+				}
+				return di.Get(ctx, constants.ProductListingsRepoKey).(application.ProductListingRepository).Sync(ctx, product)
+			})
+			if err != nil {
+				tenantLogger.Error().Err(err).Str("ProductID", productID).Msg("search listings backfill failed to fill in a product")
+			}
+		}
+
+		if len(storeIDs) > 0 || len(productIDs) > 0 {
+			tenantLogger.Info().Int("Stores", len(storeIDs)).Int("Products", len(productIDs)).Msg("search listings backfill finished")
+		}
+	}
+
+	return nil
+}
+
+func withTransaction(ctx context.Context, container di.Container, fn func(ctx context.Context) error) (err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	return fn(ctx)
+}
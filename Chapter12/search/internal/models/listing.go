@@ -0,0 +1,38 @@
+package models
+
+// ProductListing is a product as found by a product search
+type ProductListing struct {
+	ID          string
+	StoreID     string
+	StoreName   string
+	Name        string
+	Description string
+	SKU         string
+	Price       float64
+	// Rank is how well the product matched the search terms
+	Rank float64
+}
+
+// StoreListing is a store as found by a store search
+type StoreListing struct {
+	ID            string
+	Name          string
+	Location      string
+	Participating bool
+	Rank          float64
+}
+
+// StoreFacet counts the matching products in one store
+type StoreFacet struct {
+	StoreID   string
+	StoreName string
+	Count     int
+}
+
+// PriceFacet counts the matching products priced from Min up to, but not
+// including, Max; the last facet has no Max
+type PriceFacet struct {
+	Min   float64
+	Max   float64
+	Count int
+}
@@ -10,8 +10,14 @@ type Order struct {
 	CustomerName string
 	Items        []Item
 	Total        float64
+	Refunded     float64
 	Status       string
 	CreatedAt    time.Time
+	// the zero time until the order reaches the status
+	ApprovedAt  time.Time
+	ReadiedAt   time.Time
+	CompletedAt time.Time
+	CanceledAt  time.Time
 }
 
 type Item struct {
@@ -21,4 +27,11 @@ type Item struct {
 	StoreName   string
 	Price       float64
 	Quantity    int
+	Discount    float64
+}
+
+// Total is the amount charged for the item after discounts; the order total,
+// customer spend and store revenue are all sums of it
+func (i Item) Total() float64 {
+	return i.Price*float64(i.Quantity) - i.Discount
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// CustomerStats summarizes every order a customer has placed
+type CustomerStats struct {
+	CustomerID     string
+	OrderCount     int
+	CompletedCount int
+	CanceledCount  int
+	// LifetimeSpend is the total of the completed orders after discounts but
+	// before refunds
+	LifetimeSpend float64
+	Refunded      float64
+	FirstOrderAt  time.Time
+	LastOrderAt   time.Time
+}
+
+func (s CustomerStats) NetSpend() float64 {
+	return s.LifetimeSpend - s.Refunded
+}
+
+// StoreSalesDay holds the sales of a store on one day; orders are counted on
+// the day they were placed, completed or canceled
+type StoreSalesDay struct {
+	Day            time.Time
+	OrderCount     int
+	CompletedCount int
+	CanceledCount  int
+	ItemsSold      int
+	// Revenue is what was charged for the items after discounts
+	Revenue float64
+}
+
+type StoreSales struct {
+	StoreID string
+	Days    []StoreSalesDay
+	Total   StoreSalesDay
+}
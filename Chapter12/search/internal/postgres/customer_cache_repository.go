@@ -10,6 +10,7 @@ import (
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/models"
 )
@@ -31,9 +32,9 @@ func NewCustomerCacheRepository(tableName string, db postgres.DB, fallback appli
 }
 
 func (r CustomerCacheRepository) Add(ctx context.Context, customerID, name string) error {
-	const query = "INSERT INTO %s (id, NAME) VALUES ($1, $2)"
+	const query = "INSERT INTO %s (id, NAME, tenant_id) VALUES ($1, $2, $3)"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), customerID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, name, tenant.FromContext(ctx))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -46,14 +47,22 @@ func (r CustomerCacheRepository) Add(ctx context.Context, customerID, name strin
 	return err
 }
 
+func (r CustomerCacheRepository) Remove(ctx context.Context, customerID string) error {
+	const query = "DELETE FROM %s WHERE id = $1 AND tenant_id = $2"
+
+	_, err := r.db.ExecContext(ctx, r.table(query), customerID, tenant.FromContext(ctx))
+
+	return err
+}
+
 func (r CustomerCacheRepository) Find(ctx context.Context, customerID string) (*models.Customer, error) {
-	const query = `SELECT name FROM %s WHERE id = $1 LIMIT 1`
+	const query = `SELECT name FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
 
 	customer := &models.Customer{
 		ID: customerID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), customerID).Scan(&customer.Name)
+	err := r.db.QueryRowContext(ctx, r.table(query), customerID, tenant.FromContext(ctx)).Scan(&customer.Name)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning customer")
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"eda-in-golang/search/internal/application"
+)
+
+// orderCursor is the position of the last order on a page; clients receive
+// it as an opaque token
+type orderCursor struct {
+	Sort      application.OrderSort `json:"s"`
+	OrderID   string                `json:"id"`
+	CreatedAt time.Time             `json:"c"`
+	Total     float64               `json:"t"`
+}
+
+func encodeCursor(c orderCursor) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor rejects cursors from searches with a different sort since the
+// position they hold would be meaningless
+func decodeCursor(token string, sort application.OrderSort) (orderCursor, error) {
+	var c orderCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, application.ErrInvalidCursor
+	}
+	if err = json.Unmarshal(data, &c); err != nil {
+		return c, application.ErrInvalidCursor
+	}
+	if c.Sort != sort || c.OrderID == "" {
+		return c, application.ErrInvalidCursor
+	}
+
+	return c, nil
+}
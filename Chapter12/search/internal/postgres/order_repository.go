@@ -3,14 +3,17 @@ package postgres
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/models"
 )
@@ -32,11 +35,11 @@ func NewOrderRepository(tableName string, db postgres.DB) OrderRepository {
 func (r OrderRepository) Add(ctx context.Context, order *models.Order) error {
 	const query = `INSERT INTO %s (
 order_id, customer_id, customer_name,
-items, status, product_ids, store_ids,
-created_at) VALUES (
+items, total, status, product_ids, store_ids,
+created_at, tenant_id) VALUES (
 $1, $2, $3,
-$4, $5, $6, $7,
-$8)`
+$4, $5, $6, $7, $8,
+$9, $10)`
 
 	items, err := json.Marshal(order.Items)
 	if err != nil {
@@ -56,36 +59,164 @@ $8)`
 
 	_, err = r.db.ExecContext(ctx, r.table(query),
 		order.OrderID, order.CustomerID, order.CustomerName,
-		items, order.Status, productIDs, storeIDs,
-		order.CreatedAt,
+		items, order.Total, order.Status, productIDs, storeIDs,
+		order.CreatedAt, tenant.FromContext(ctx),
 	)
 	return err
 }
 
-func (r OrderRepository) UpdateStatus(ctx context.Context, orderID, status string) error {
-	const query = `UPDATE %s SET status = $2 WHERE order_id = $1`
+func (r OrderRepository) UpdateStatus(ctx context.Context, order *models.Order) error {
+	const query = `UPDATE %s SET status = $2, approved_at = $3, readied_at = $4, completed_at = $5, canceled_at = $6
+WHERE order_id = $1 AND tenant_id = $7`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), orderID, status)
+	_, err := r.db.ExecContext(ctx, r.table(query), order.OrderID, order.Status,
+		nullTime(order.ApprovedAt), nullTime(order.ReadiedAt), nullTime(order.CompletedAt), nullTime(order.CanceledAt), tenant.FromContext(ctx),
+	)
 	return err
 }
 
-func (r OrderRepository) Search(ctx context.Context, search application.SearchOrders) ([]*models.Order, error) {
-	// TODO implement me
-	panic("implement me")
+func (r OrderRepository) AddRefund(ctx context.Context, orderID string, amount float64) error {
+	const query = `UPDATE %s SET refunded = refunded + $2 WHERE order_id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), orderID, amount, tenant.FromContext(ctx))
+	return err
+}
+
+func (r OrderRepository) Search(ctx context.Context, search application.SearchOrders) (*application.OrderPage, error) {
+	const query = `SELECT order_id, customer_id, customer_name, items, total, refunded, status, created_at,
+approved_at, readied_at, completed_at, canceled_at FROM %s
+WHERE %s
+ORDER BY %s
+LIMIT %d`
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"tenant_id = " + arg(tenant.FromContext(ctx))}
+	filters := search.Filters
+	if filters.CustomerID != "" {
+		conditions = append(conditions, "customer_id = "+arg(filters.CustomerID))
+	}
+	if !filters.After.IsZero() {
+		conditions = append(conditions, "created_at >= "+arg(filters.After))
+	}
+	if !filters.Before.IsZero() {
+		conditions = append(conditions, "created_at < "+arg(filters.Before))
+	}
+	// orders match when they share at least one store or product with the filter
+	if len(filters.StoreIDs) > 0 {
+		conditions = append(conditions, "store_ids && "+arg(IDArray(filters.StoreIDs))+"::text[]")
+	}
+	if len(filters.ProductIDs) > 0 {
+		conditions = append(conditions, "product_ids && "+arg(IDArray(filters.ProductIDs))+"::text[]")
+	}
+	if filters.MinTotal > 0 {
+		conditions = append(conditions, "total >= "+arg(filters.MinTotal))
+	}
+	if filters.MaxTotal > 0 {
+		conditions = append(conditions, "total <= "+arg(filters.MaxTotal))
+	}
+	if filters.Status != "" {
+		conditions = append(conditions, "status = "+arg(filters.Status))
+	}
+
+	column, direction, comparison := "created_at", "ASC", ">"
+	if search.Sort.ByTotal() {
+		column = "total"
+	}
+	if search.Sort.Descending() {
+		direction, comparison = "DESC", "<"
+	}
+
+	if search.Next != "" {
+		c, err := decodeCursor(search.Next, search.Sort)
+		if err != nil {
+			return nil, err
+		}
+		var value any = c.CreatedAt
+		if search.Sort.ByTotal() {
+			value = c.Total
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, order_id) %s (%s, %s)", column, comparison, arg(value), arg(c.OrderID)))
+	}
+
+	// one extra row tells whether there is another page
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, r.tableName,
+		strings.Join(conditions, " AND "),
+		fmt.Sprintf("%s %s, order_id %s", column, direction, direction),
+		search.Limit+1,
+	), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing order rows")
+		}
+	}(rows)
+
+	page := &application.OrderPage{}
+	for rows.Next() {
+		order := &models.Order{}
+		var itemData []byte
+		var approvedAt, readiedAt, completedAt, canceledAt sql.NullTime
+		err = rows.Scan(&order.OrderID, &order.CustomerID, &order.CustomerName, &itemData, &order.Total, &order.Refunded, &order.Status, &order.CreatedAt,
+			&approvedAt, &readiedAt, &completedAt, &canceledAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		order.ApprovedAt, order.ReadiedAt, order.CompletedAt, order.CanceledAt = approvedAt.Time, readiedAt.Time, completedAt.Time, canceledAt.Time
+		if err = json.Unmarshal(itemData, &order.Items); err != nil {
+			return nil, err
+		}
+		page.Orders = append(page.Orders, order)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(page.Orders) > search.Limit {
+		page.Orders = page.Orders[:search.Limit]
+		last := page.Orders[len(page.Orders)-1]
+		page.Next, err = encodeCursor(orderCursor{
+			Sort:      search.Sort,
+			OrderID:   last.OrderID,
+			CreatedAt: last.CreatedAt,
+			Total:     last.Total,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
 }
 
 func (r OrderRepository) Get(ctx context.Context, orderID string) (*models.Order, error) {
-	const query = `SELECT customer_id, customer_name, items, status, created_at FROM %s WHERE order_id = $1`
+	const query = `SELECT customer_id, customer_name, items, total, refunded, status, created_at,
+approved_at, readied_at, completed_at, canceled_at FROM %s WHERE order_id = $1 AND tenant_id = $2`
 
 	order := &models.Order{
 		OrderID: orderID,
 	}
 
 	var itemData []byte
-	err := r.db.QueryRowContext(ctx, r.table(query)).Scan(&order.CustomerID, &order.CustomerName, &itemData, &order.Status, &order.CreatedAt)
+	var approvedAt, readiedAt, completedAt, canceledAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, r.table(query), orderID, tenant.FromContext(ctx)).Scan(&order.CustomerID, &order.CustomerName, &itemData, &order.Total, &order.Refunded, &order.Status, &order.CreatedAt,
+		&approvedAt, &readiedAt, &completedAt, &canceledAt,
+	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.Msgf("order with id: `%s` does not exist", orderID)
+		}
 		return nil, err
 	}
+	order.ApprovedAt, order.ReadiedAt, order.CompletedAt, order.CanceledAt = approvedAt.Time, readiedAt.Time, completedAt.Time, canceledAt.Time
 
 	var items []models.Item
 	err = json.Unmarshal(itemData, &items)
@@ -101,6 +232,10 @@ func (r OrderRepository) table(query string) string {
 	return fmt.Sprintf(query, r.tableName)
 }
 
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
 type IDArray []string
 
 func (a *IDArray) Scan(src any) error {
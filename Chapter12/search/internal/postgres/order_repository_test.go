@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+)
+
+var errQueryCaptured = fmt.Errorf("query captured")
+
+// queryRecorder keeps the last query made and fails it so nothing has to be
+// scanned
+type queryRecorder struct {
+	query string
+	args  []any
+}
+
+func (d *queryRecorder) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errQueryCaptured
+}
+
+func (d *queryRecorder) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	d.query, d.args = query, args
+	return nil, errQueryCaptured
+}
+
+func (d *queryRecorder) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	d.query, d.args = query, args
+	return nil, errQueryCaptured
+}
+
+func (d *queryRecorder) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	d.query, d.args = query, args
+	return nil
+}
+
+func TestOrderCursor(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)
+
+	for _, sort := range []application.OrderSort{application.NewestFirst, application.OldestFirst, application.LargestTotalFirst, application.SmallestTotalFirst} {
+		t.Run(string(sort), func(t *testing.T) {
+			token, err := encodeCursor(orderCursor{Sort: sort, OrderID: "order-id", CreatedAt: createdAt, Total: 12.5})
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			c, err := decodeCursor(token, sort)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "order-id", c.OrderID)
+				assert.True(t, createdAt.Equal(c.CreatedAt))
+				assert.Equal(t, 12.5, c.Total)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	otherSort, err := encodeCursor(orderCursor{Sort: application.OldestFirst, OrderID: "order-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noOrder, err := encodeCursor(orderCursor{Sort: application.NewestFirst})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"OtherSort":  otherSort,
+		"NoOrder":    noOrder,
+		"NotBase64":  "not a cursor!",
+		"NotJSON":    "bm90IGpzb24",
+		"Tampered":   otherSort[:len(otherSort)-4],
+		"EmptyToken": "",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeCursor(token, application.NewestFirst)
+			assert.ErrorIs(t, err, application.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestOrderRepository_Search_Keyset(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		sort      application.OrderSort
+		wantOrder string
+		wantAfter string
+		wantValue any
+	}{
+		"NewestFirst": {
+			sort:      application.NewestFirst,
+			wantOrder: "ORDER BY created_at DESC, order_id DESC",
+			wantAfter: "(created_at, order_id) < ($2, $3)",
+			wantValue: createdAt,
+		},
+		"OldestFirst": {
+			sort:      application.OldestFirst,
+			wantOrder: "ORDER BY created_at ASC, order_id ASC",
+			wantAfter: "(created_at, order_id) > ($2, $3)",
+			wantValue: createdAt,
+		},
+		"LargestTotalFirst": {
+			sort:      application.LargestTotalFirst,
+			wantOrder: "ORDER BY total DESC, order_id DESC",
+			wantAfter: "(total, order_id) < ($2, $3)",
+			wantValue: 12.5,
+		},
+		"SmallestTotalFirst": {
+			sort:      application.SmallestTotalFirst,
+			wantOrder: "ORDER BY total ASC, order_id ASC",
+			wantAfter: "(total, order_id) > ($2, $3)",
+			wantValue: 12.5,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tenant.WithID(context.Background(), "tenant-id")
+			db := &queryRecorder{}
+			repo := NewOrderRepository("search.orders", db)
+
+			// the first page starts at the beginning
+			_, err := repo.Search(ctx, application.SearchOrders{Sort: tc.sort, Limit: 10})
+			assert.ErrorIs(t, err, errQueryCaptured)
+			assert.Contains(t, db.query, tc.wantOrder)
+			assert.Contains(t, db.query, "LIMIT 11")
+			assert.NotContains(t, db.query, ", order_id) ")
+			assert.Equal(t, []any{"tenant-id"}, db.args)
+
+			// later pages start after the last order of the page before
+			next, err := encodeCursor(orderCursor{Sort: tc.sort, OrderID: "order-id", CreatedAt: createdAt, Total: 12.5})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = repo.Search(ctx, application.SearchOrders{Sort: tc.sort, Next: next, Limit: 10})
+			assert.ErrorIs(t, err, errQueryCaptured)
+			assert.Contains(t, db.query, tc.wantOrder)
+			assert.Contains(t, db.query, tc.wantAfter)
+			if assert.Len(t, db.args, 3) {
+				assert.Equal(t, "tenant-id", db.args[0])
+				assert.Equal(t, "order-id", db.args[2])
+				assert.Equal(t, tc.wantValue, db.args[1])
+			}
+		})
+	}
+}
+
+func TestOrderRepository_Search_CursorOfOtherSort(t *testing.T) {
+	db := &queryRecorder{}
+	repo := NewOrderRepository("search.orders", db)
+
+	next, err := encodeCursor(orderCursor{Sort: application.NewestFirst, OrderID: "order-id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = repo.Search(context.Background(), application.SearchOrders{Sort: application.LargestTotalFirst, Next: next, Limit: 10})
+	assert.ErrorIs(t, err, application.ErrInvalidCursor)
+	assert.Empty(t, db.query)
+}
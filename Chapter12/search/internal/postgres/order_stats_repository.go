@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
+)
+
+type OrderStatsRepository struct {
+	customersTableName string
+	storesTableName    string
+	db                 postgres.DB
+}
+
+// the changes an order makes to the stats; every column is added to what is
+// already there so the events can be projected one at a time
+type customerStatsDelta struct {
+	orders, completed, canceled int
+	spend, refunded             float64
+	orderedAt                   time.Time
+}
+
+type storeSalesDelta struct {
+	orders, completed, canceled int
+	items                       bool
+}
+
+var _ application.OrderStatsRepository = (*OrderStatsRepository)(nil)
+
+func NewOrderStatsRepository(customersTableName, storesTableName string, db postgres.DB) OrderStatsRepository {
+	return OrderStatsRepository{
+		customersTableName: customersTableName,
+		storesTableName:    storesTableName,
+		db:                 db,
+	}
+}
+
+func (r OrderStatsRepository) OrderPlaced(ctx context.Context, order *models.Order) error {
+	if err := r.addCustomerStats(ctx, order.CustomerID, customerStatsDelta{orders: 1, orderedAt: order.CreatedAt}); err != nil {
+		return err
+	}
+
+	return r.addStoreSales(ctx, order, order.CreatedAt, storeSalesDelta{orders: 1})
+}
+
+func (r OrderStatsRepository) OrderCompleted(ctx context.Context, order *models.Order) error {
+	if err := r.addCustomerStats(ctx, order.CustomerID, customerStatsDelta{completed: 1, spend: order.Total}); err != nil {
+		return err
+	}
+
+	return r.addStoreSales(ctx, order, order.CompletedAt, storeSalesDelta{completed: 1, items: true})
+}
+
+func (r OrderStatsRepository) OrderCanceled(ctx context.Context, order *models.Order) error {
+	if err := r.addCustomerStats(ctx, order.CustomerID, customerStatsDelta{canceled: 1}); err != nil {
+		return err
+	}
+
+	return r.addStoreSales(ctx, order, order.CanceledAt, storeSalesDelta{canceled: 1})
+}
+
+func (r OrderStatsRepository) RefundIssued(ctx context.Context, order *models.Order, amount float64) error {
+	return r.addCustomerStats(ctx, order.CustomerID, customerStatsDelta{refunded: amount})
+}
+
+func (r OrderStatsRepository) FindCustomerStats(ctx context.Context, customerID string) (*models.CustomerStats, error) {
+	const query = `SELECT order_count, completed_count, canceled_count, lifetime_spend, refunded, first_order_at, last_order_at
+FROM %s WHERE customer_id = $1 AND tenant_id = $2`
+
+	stats := &models.CustomerStats{
+		CustomerID: customerID,
+	}
+
+	var firstOrderAt, lastOrderAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, fmt.Sprintf(query, r.customersTableName), customerID, tenant.FromContext(ctx)).Scan(
+		&stats.OrderCount, &stats.CompletedCount, &stats.CanceledCount, &stats.LifetimeSpend, &stats.Refunded, &firstOrderAt, &lastOrderAt,
+	)
+	if err != nil {
+		// customers without orders have nothing to summarize
+		if errors.Is(err, sql.ErrNoRows) {
+			return stats, nil
+		}
+		return nil, err
+	}
+	stats.FirstOrderAt, stats.LastOrderAt = firstOrderAt.Time, lastOrderAt.Time
+
+	return stats, nil
+}
+
+func (r OrderStatsRepository) FindStoreSales(ctx context.Context, storeID string, from, to time.Time) ([]models.StoreSalesDay, error) {
+	const query = `SELECT day, order_count, completed_count, canceled_count, items_sold, revenue
+FROM %s WHERE store_id = $1 AND day BETWEEN $2 AND $3 AND tenant_id = $4
+ORDER BY day`
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, r.storesTableName), storeID, salesDay(from), salesDay(to), tenant.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing store sales rows")
+		}
+	}(rows)
+
+	var days []models.StoreSalesDay
+	for rows.Next() {
+		var day models.StoreSalesDay
+		if err = rows.Scan(&day.Day, &day.OrderCount, &day.CompletedCount, &day.CanceledCount, &day.ItemsSold, &day.Revenue); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
+
+func (r OrderStatsRepository) addCustomerStats(ctx context.Context, customerID string, delta customerStatsDelta) error {
+	const query = `INSERT INTO %s AS s (customer_id, order_count, completed_count, canceled_count, lifetime_spend, refunded, first_order_at, last_order_at, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8)
+ON CONFLICT (tenant_id, customer_id) DO UPDATE SET
+order_count = s.order_count + EXCLUDED.order_count,
+completed_count = s.completed_count + EXCLUDED.completed_count,
+canceled_count = s.canceled_count + EXCLUDED.canceled_count,
+lifetime_spend = s.lifetime_spend + EXCLUDED.lifetime_spend,
+refunded = s.refunded + EXCLUDED.refunded,
+first_order_at = LEAST(s.first_order_at, EXCLUDED.first_order_at),
+last_order_at = GREATEST(s.last_order_at, EXCLUDED.last_order_at)`
+
+	_, err := r.db.ExecContext(ctx, fmt.Sprintf(query, r.customersTableName), customerID,
+		delta.orders, delta.completed, delta.canceled, delta.spend, delta.refunded, nullTime(delta.orderedAt), tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+// addStoreSales adds the delta to every store the order bought from
+func (r OrderStatsRepository) addStoreSales(ctx context.Context, order *models.Order, at time.Time, delta storeSalesDelta) error {
+	const query = `INSERT INTO %s AS s (store_id, day, order_count, completed_count, canceled_count, items_sold, revenue, tenant_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (tenant_id, store_id, day) DO UPDATE SET
+order_count = s.order_count + EXCLUDED.order_count,
+completed_count = s.completed_count + EXCLUDED.completed_count,
+canceled_count = s.canceled_count + EXCLUDED.canceled_count,
+items_sold = s.items_sold + EXCLUDED.items_sold,
+revenue = s.revenue + EXCLUDED.revenue`
+
+	type storeTotals struct {
+		items   int
+		revenue float64
+	}
+
+	var storeIDs []string
+	totals := make(map[string]*storeTotals)
+	for _, item := range order.Items {
+		t, exists := totals[item.StoreID]
+		if !exists {
+			t = &storeTotals{}
+			totals[item.StoreID] = t
+			storeIDs = append(storeIDs, item.StoreID)
+		}
+		t.items += item.Quantity
+		t.revenue += item.Total()
+	}
+
+	for _, storeID := range storeIDs {
+		var items int
+		var revenue float64
+		if delta.items {
+			items, revenue = totals[storeID].items, totals[storeID].revenue
+		}
+
+		_, err := r.db.ExecContext(ctx, fmt.Sprintf(query, r.storesTableName), storeID, salesDay(at),
+			delta.orders, delta.completed, delta.canceled, items, revenue, tenant.FromContext(ctx),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// salesDay is the UTC day sales are kept under
+func salesDay(at time.Time) time.Time {
+	at = at.UTC()
+	return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+}
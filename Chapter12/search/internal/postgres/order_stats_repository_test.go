@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/models"
+)
+
+// execRecorder keeps the arguments of every statement executed
+type execRecorder struct {
+	queryRecorder
+	execs [][]any
+}
+
+func (d *execRecorder) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	d.execs = append(d.execs, args)
+	return nil, nil
+}
+
+func TestOrderStatsRepository(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 23, 30, 0, 0, time.FixedZone("", -2*60*60))
+	completedAt := createdAt.Add(time.Hour)
+	day := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	order := &models.Order{
+		OrderID:    "order-id",
+		CustomerID: "customer-id",
+		Items: []models.Item{
+			{ProductID: "product-id", StoreID: "store-id", Price: 10, Quantity: 2, Discount: 3},
+			{ProductID: "product-id2", StoreID: "store-id2", Price: 20, Quantity: 1, Discount: 2},
+			{ProductID: "product-id3", StoreID: "store-id", Price: 5, Quantity: 1},
+		},
+		// the order total is the sum of the item totals after their discounts
+		Total:       40,
+		CreatedAt:   createdAt,
+		CompletedAt: completedAt,
+		CanceledAt:  completedAt,
+	}
+
+	tests := map[string]struct {
+		record        func(ctx context.Context, r OrderStatsRepository) error
+		wantCustomer  []any
+		wantStoreDays [][]any
+	}{
+		"OrderPlaced": {
+			record: func(ctx context.Context, r OrderStatsRepository) error {
+				return r.OrderPlaced(ctx, order)
+			},
+			wantCustomer: []any{"customer-id", 1, 0, 0, 0.0, 0.0, sql.NullTime{Time: createdAt, Valid: true}, "tenant-id"},
+			wantStoreDays: [][]any{
+				{"store-id", day, 1, 0, 0, 0, 0.0, "tenant-id"},
+				{"store-id2", day, 1, 0, 0, 0, 0.0, "tenant-id"},
+			},
+		},
+		// the spend and revenue are what was charged after the discounts
+		"OrderCompleted": {
+			record: func(ctx context.Context, r OrderStatsRepository) error {
+				return r.OrderCompleted(ctx, order)
+			},
+			wantCustomer: []any{"customer-id", 0, 1, 0, 40.0, 0.0, sql.NullTime{}, "tenant-id"},
+			wantStoreDays: [][]any{
+				{"store-id", day, 0, 1, 0, 3, 22.0, "tenant-id"},
+				{"store-id2", day, 0, 1, 0, 1, 18.0, "tenant-id"},
+			},
+		},
+		"OrderCanceled": {
+			record: func(ctx context.Context, r OrderStatsRepository) error {
+				return r.OrderCanceled(ctx, order)
+			},
+			wantCustomer: []any{"customer-id", 0, 0, 1, 0.0, 0.0, sql.NullTime{}, "tenant-id"},
+			wantStoreDays: [][]any{
+				{"store-id", day, 0, 0, 1, 0, 0.0, "tenant-id"},
+				{"store-id2", day, 0, 0, 1, 0, 0.0, "tenant-id"},
+			},
+		},
+		// refunds are only kept for the customer
+		"RefundIssued": {
+			record: func(ctx context.Context, r OrderStatsRepository) error {
+				return r.RefundIssued(ctx, order, 9.5)
+			},
+			wantCustomer:  []any{"customer-id", 0, 0, 0, 0.0, 9.5, sql.NullTime{}, "tenant-id"},
+			wantStoreDays: [][]any{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tenant.WithID(context.Background(), "tenant-id")
+			db := &execRecorder{}
+
+			err := tc.record(ctx, NewOrderStatsRepository("search.customer_order_stats", "search.store_sales", db))
+			if !assert.NoError(t, err) || !assert.Len(t, db.execs, 1+len(tc.wantStoreDays)) {
+				return
+			}
+			assert.Equal(t, tc.wantCustomer, db.execs[0])
+			assert.Equal(t, tc.wantStoreDays, db.execs[1:])
+		})
+	}
+}
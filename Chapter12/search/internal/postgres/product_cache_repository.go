@@ -10,6 +10,7 @@ import (
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/models"
 )
@@ -31,9 +32,9 @@ func NewProductCacheRepository(tableName string, db postgres.DB, fallback applic
 }
 
 func (r ProductCacheRepository) Add(ctx context.Context, productID, storeID, name string) error {
-	const query = `INSERT INTO %s (id, store_id, NAME) VALUES ($1, $2, $3)`
+	const query = `INSERT INTO %s (id, store_id, NAME, tenant_id) VALUES ($1, $2, $3, $4)`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name, tenant.FromContext(ctx))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -47,29 +48,29 @@ func (r ProductCacheRepository) Add(ctx context.Context, productID, storeID, nam
 }
 
 func (r ProductCacheRepository) Rebrand(ctx context.Context, productID, name string) error {
-	const query = `UPDATE %s SET NAME = $2 WHERE id = $1`
+	const query = `UPDATE %s SET NAME = $2 WHERE id = $1 AND tenant_id = $3`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, name, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) Remove(ctx context.Context, productID string) error {
-	const query = `DELETE FROM %s WHERE id = $1`
+	const query = `DELETE FROM %s WHERE id = $1 AND tenant_id = $2`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r ProductCacheRepository) Find(ctx context.Context, productID string) (*models.Product, error) {
-	const query = `SELECT store_id, name FROM %s WHERE id = $1 LIMIT 1`
+	const query = `SELECT store_id, name FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
 
 	product := &models.Product{
 		ID: productID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), productID).Scan(&product.StoreID, &product.Name)
+	err := r.db.QueryRowContext(ctx, r.table(query), productID, tenant.FromContext(ctx)).Scan(&product.StoreID, &product.Name)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning product")
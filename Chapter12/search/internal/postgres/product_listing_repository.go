@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
+)
+
+type ProductListingRepository struct {
+	tableName       string
+	storesTableName string
+	db              postgres.DB
+}
+
+var _ application.ProductListingRepository = (*ProductListingRepository)(nil)
+
+func NewProductListingRepository(tableName, storesTableName string, db postgres.DB) ProductListingRepository {
+	return ProductListingRepository{
+		tableName:       tableName,
+		storesTableName: storesTableName,
+		db:              db,
+	}
+}
+
+func (r ProductListingRepository) Add(ctx context.Context, product *models.ProductListing) error {
+	const query = `INSERT INTO %s (id, store_id, name, description, sku, price, tenant_id) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tenant_id, id) DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		product.ID, product.StoreID, product.Name, product.Description, product.SKU, product.Price, tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+func (r ProductListingRepository) Rebrand(ctx context.Context, productID, name, description string) error {
+	const query = `UPDATE %s SET name = $2, description = $3 WHERE id = $1 AND tenant_id = $4`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, name, description, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r ProductListingRepository) ChangePrice(ctx context.Context, productID string, delta float64) error {
+	const query = `UPDATE %s SET price = price + $2 WHERE id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, delta, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r ProductListingRepository) Remove(ctx context.Context, productID string) error {
+	const query = `DELETE FROM %s WHERE id = $1 AND tenant_id = $2`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r ProductListingRepository) Sync(ctx context.Context, product *models.ProductListing) error {
+	const query = `UPDATE %s SET store_id = $2, name = $3, description = $4, sku = $5, price = $6, synced = TRUE
+WHERE id = $1 AND tenant_id = $7`
+
+	_, err := r.db.ExecContext(ctx, r.table(query),
+		product.ID, product.StoreID, product.Name, product.Description, product.SKU, product.Price, tenant.FromContext(ctx),
+	)
+
+	return err
+}
+
+func (r ProductListingRepository) FindUnsynced(ctx context.Context) ([]string, error) {
+	const query = `SELECT id FROM %s WHERE NOT synced AND tenant_id = $1`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), tenant.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing product listing rows")
+		}
+	}(rows)
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Search ranks the products on how well their name and description match
+// the query; the store and price facets are each counted without their own
+// filter so the other choices remain visible
+func (r ProductListingRepository) Search(ctx context.Context, search application.SearchProducts) (*application.ProductPage, error) {
+	page := &application.ProductPage{}
+
+	var err error
+	if page.Products, err = r.products(ctx, search); err != nil {
+		return nil, err
+	}
+	if page.StoreFacets, err = r.storeFacets(ctx, search); err != nil {
+		return nil, err
+	}
+	if page.PriceFacets, err = r.priceFacets(ctx, search); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (r ProductListingRepository) products(ctx context.Context, search application.SearchProducts) ([]*models.ProductListing, error) {
+	const query = `SELECT p.id, p.store_id, COALESCE(s.name, ''), p.name, p.description, p.sku, p.price, %s AS rank
+FROM %s p LEFT JOIN %s s ON s.tenant_id = p.tenant_id AND s.id = p.store_id
+WHERE %s
+ORDER BY rank DESC, p.name, p.id
+LIMIT %d`
+
+	f := productFilters(ctx, search, true, true)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, f.rank, r.tableName, r.storesTableName, f.where(), search.Limit), f.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing product listing rows")
+		}
+	}(rows)
+
+	var products []*models.ProductListing
+	for rows.Next() {
+		product := &models.ProductListing{}
+		err = rows.Scan(&product.ID, &product.StoreID, &product.StoreName, &product.Name, &product.Description, &product.SKU, &product.Price, &product.Rank)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	return products, rows.Err()
+}
+
+func (r ProductListingRepository) storeFacets(ctx context.Context, search application.SearchProducts) ([]models.StoreFacet, error) {
+	const query = `SELECT p.store_id, COALESCE(s.name, ''), COUNT(*)
+FROM %s p LEFT JOIN %s s ON s.tenant_id = p.tenant_id AND s.id = p.store_id
+WHERE %s
+GROUP BY p.store_id, s.name
+ORDER BY COUNT(*) DESC, s.name`
+
+	f := productFilters(ctx, search, false, true)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, r.tableName, r.storesTableName, f.where()), f.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing store facet rows")
+		}
+	}(rows)
+
+	var facets []models.StoreFacet
+	for rows.Next() {
+		var facet models.StoreFacet
+		if err = rows.Scan(&facet.StoreID, &facet.StoreName, &facet.Count); err != nil {
+			return nil, err
+		}
+		facets = append(facets, facet)
+	}
+
+	return facets, rows.Err()
+}
+
+func (r ProductListingRepository) priceFacets(ctx context.Context, search application.SearchProducts) ([]models.PriceFacet, error) {
+	const query = `SELECT width_bucket(p.price, ARRAY[%s]::decimal[]) AS bucket, COUNT(*)
+FROM %s p
+WHERE %s
+GROUP BY bucket`
+
+	bounds := make([]string, len(application.PriceFacetBounds))
+	facets := make([]models.PriceFacet, len(application.PriceFacetBounds))
+	for i, bound := range application.PriceFacetBounds {
+		bounds[i] = fmt.Sprintf("%g", bound)
+		facets[i].Min = bound
+		if i+1 < len(application.PriceFacetBounds) {
+			facets[i].Max = application.PriceFacetBounds[i+1]
+		}
+	}
+
+	f := productFilters(ctx, search, true, false)
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, strings.Join(bounds, ", "), r.tableName, f.where()), f.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing price facet rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var bucket, count int
+		if err = rows.Scan(&bucket, &count); err != nil {
+			return nil, err
+		}
+		// bucket 0 holds prices below the first bound
+		if bucket > 0 {
+			facets[bucket-1].Count = count
+		}
+	}
+
+	return facets, rows.Err()
+}
+
+func (r ProductListingRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
+
+type listingFilters struct {
+	rank       string
+	conditions []string
+	args       []any
+}
+
+func (f *listingFilters) arg(v any) string {
+	f.args = append(f.args, v)
+	return fmt.Sprintf("$%d", len(f.args))
+}
+
+// scope limits the listings to those of the tenant in the context
+func (f *listingFilters) scope(ctx context.Context, column string) {
+	f.conditions = append(f.conditions, column+" = "+f.arg(tenant.FromContext(ctx)))
+}
+
+func (f *listingFilters) match(vector, terms string) {
+	f.rank = "0"
+	if terms == "" {
+		return
+	}
+	query := "to_tsquery('english', " + f.arg(terms) + ")"
+	f.rank = "ts_rank(" + vector + ", " + query + ")"
+	f.conditions = append(f.conditions, vector+" @@ "+query)
+}
+
+func (f listingFilters) where() string {
+	return strings.Join(append([]string{"TRUE"}, f.conditions...), " AND ")
+}
+
+// productFilters leaves out the store or price filters when counting the facets for them
+func productFilters(ctx context.Context, search application.SearchProducts, byStores, byPrices bool) listingFilters {
+	var f listingFilters
+
+	f.scope(ctx, "p.tenant_id")
+	f.match("p.search_vector", application.PrefixQuery(search.Query))
+	if byStores && len(search.StoreIDs) > 0 {
+		f.conditions = append(f.conditions, "p.store_id = ANY("+f.arg(IDArray(search.StoreIDs))+"::text[])")
+	}
+	if byPrices && search.MinPrice > 0 {
+		f.conditions = append(f.conditions, "p.price >= "+f.arg(search.MinPrice))
+	}
+	if byPrices && search.MaxPrice > 0 {
+		f.conditions = append(f.conditions, "p.price <= "+f.arg(search.MaxPrice))
+	}
+
+	return f
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+)
+
+func TestProductListingRepository_Search_Facets(t *testing.T) {
+	const (
+		storeFilter    = "p.store_id = ANY("
+		minPriceFilter = "p.price >= "
+		maxPriceFilter = "p.price <= "
+		matchFilter    = "p.search_vector @@ to_tsquery('english', "
+	)
+	search := application.SearchProducts{
+		Query:    "red shoes",
+		StoreIDs: []string{"store-id"},
+		MinPrice: 10,
+		MaxPrice: 50,
+		Limit:    10,
+	}
+
+	tests := map[string]struct {
+		query       func(ctx context.Context, r ProductListingRepository) error
+		wantFilters []string
+		wantOmitted []string
+		wantArgs    []any
+	}{
+		"Products": {
+			query: func(ctx context.Context, r ProductListingRepository) error {
+				_, err := r.products(ctx, search)
+				return err
+			},
+			wantFilters: []string{matchFilter, storeFilter, minPriceFilter, maxPriceFilter},
+			wantArgs:    []any{"tenant-id", "red:* & shoes:*", IDArray{"store-id"}, 10.0, 50.0},
+		},
+		// the store facet counts the other stores within the price range
+		"StoreFacets": {
+			query: func(ctx context.Context, r ProductListingRepository) error {
+				_, err := r.storeFacets(ctx, search)
+				return err
+			},
+			wantFilters: []string{matchFilter, minPriceFilter, maxPriceFilter},
+			wantOmitted: []string{storeFilter},
+			wantArgs:    []any{"tenant-id", "red:* & shoes:*", 10.0, 50.0},
+		},
+		// the price facet counts the other price ranges within the stores
+		"PriceFacets": {
+			query: func(ctx context.Context, r ProductListingRepository) error {
+				_, err := r.priceFacets(ctx, search)
+				return err
+			},
+			wantFilters: []string{matchFilter, storeFilter},
+			wantOmitted: []string{minPriceFilter, maxPriceFilter},
+			wantArgs:    []any{"tenant-id", "red:* & shoes:*", IDArray{"store-id"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := tenant.WithID(context.Background(), "tenant-id")
+			db := &queryRecorder{}
+
+			err := tc.query(ctx, NewProductListingRepository("search.products", "search.stores", db))
+			assert.ErrorIs(t, err, errQueryCaptured)
+			assert.Contains(t, db.query, "p.tenant_id = $1")
+			for _, filter := range tc.wantFilters {
+				assert.Contains(t, db.query, filter)
+			}
+			for _, filter := range tc.wantOmitted {
+				assert.NotContains(t, db.query, filter)
+			}
+			assert.Equal(t, tc.wantArgs, db.args)
+		})
+	}
+}
+
+func TestProductFilters_BlankSearch(t *testing.T) {
+	f := productFilters(tenant.WithID(context.Background(), "tenant-id"), application.SearchProducts{Query: " !& "}, true, true)
+
+	// nothing but the tenant limits a blank search
+	assert.Equal(t, "TRUE AND p.tenant_id = $1", f.where())
+	assert.Equal(t, "0", f.rank)
+	assert.Equal(t, []any{"tenant-id"}, f.args)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/models"
 )
@@ -31,9 +32,9 @@ func NewStoreCacheRepository(tableName string, db postgres.DB, fallback applicat
 }
 
 func (r StoreCacheRepository) Add(ctx context.Context, storeID, name string) error {
-	const query = "INSERT INTO %s (id, NAME) VALUES ($1, $2)"
+	const query = "INSERT INTO %s (id, NAME, tenant_id) VALUES ($1, $2, $3)"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, tenant.FromContext(ctx))
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -47,21 +48,21 @@ func (r StoreCacheRepository) Add(ctx context.Context, storeID, name string) err
 }
 
 func (r StoreCacheRepository) Rename(ctx context.Context, storeID, name string) error {
-	const query = "UPDATE %s SET NAME = $2 WHERE id = $1"
+	const query = "UPDATE %s SET NAME = $2 WHERE id = $1 AND tenant_id = $3"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r StoreCacheRepository) Find(ctx context.Context, storeID string) (*models.Store, error) {
-	const query = "SELECT name FROM %s WHERE id = $1 LIMIT 1"
+	const query = "SELECT name FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
 
 	store := &models.Store{
 		ID: storeID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), storeID).Scan(&store.Name)
+	err := r.db.QueryRowContext(ctx, r.table(query), storeID, tenant.FromContext(ctx)).Scan(&store.Name)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.Wrap(err, "scanning store")
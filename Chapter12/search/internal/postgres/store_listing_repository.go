@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/search/internal/application"
+	"eda-in-golang/search/internal/models"
+)
+
+type StoreListingRepository struct {
+	tableName string
+	db        postgres.DB
+}
+
+var _ application.StoreListingRepository = (*StoreListingRepository)(nil)
+
+func NewStoreListingRepository(tableName string, db postgres.DB) StoreListingRepository {
+	return StoreListingRepository{
+		tableName: tableName,
+		db:        db,
+	}
+}
+
+func (r StoreListingRepository) Add(ctx context.Context, store *models.StoreListing) error {
+	const query = `INSERT INTO %s (id, name, location, participating, tenant_id) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (tenant_id, id) DO NOTHING`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), store.ID, store.Name, store.Location, store.Participating, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r StoreListingRepository) Rebrand(ctx context.Context, storeID, name string) error {
+	const query = `UPDATE %s SET name = $2 WHERE id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r StoreListingRepository) ToggleParticipation(ctx context.Context, storeID string, participating bool) error {
+	const query = `UPDATE %s SET participating = $2 WHERE id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, participating, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r StoreListingRepository) Sync(ctx context.Context, store *models.StoreListing) error {
+	const query = `UPDATE %s SET name = $2, location = $3, participating = $4, synced = TRUE WHERE id = $1 AND tenant_id = $5`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), store.ID, store.Name, store.Location, store.Participating, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r StoreListingRepository) FindUnsynced(ctx context.Context) ([]string, error) {
+	const query = `SELECT id FROM %s WHERE NOT synced AND tenant_id = $1`
+
+	rows, err := r.db.QueryContext(ctx, r.table(query), tenant.FromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing store listing rows")
+		}
+	}(rows)
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Search ranks the stores on how well their name and location match the query
+func (r StoreListingRepository) Search(ctx context.Context, search application.SearchStores) (*application.StorePage, error) {
+	const query = `SELECT id, name, location, participating, %s AS rank
+FROM %s
+WHERE %s
+ORDER BY rank DESC, name, id
+LIMIT %d`
+
+	var f listingFilters
+	f.scope(ctx, "tenant_id")
+	f.match("search_vector", application.PrefixQuery(search.Query))
+	if search.ParticipatingOnly {
+		f.conditions = append(f.conditions, "participating")
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(query, f.rank, r.tableName, f.where(), search.Limit), f.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing store listing rows")
+		}
+	}(rows)
+
+	page := &application.StorePage{}
+	for rows.Next() {
+		store := &models.StoreListing{}
+		if err = rows.Scan(&store.ID, &store.Name, &store.Location, &store.Participating, &store.Rank); err != nil {
+			return nil, err
+		}
+		page.Stores = append(page.Stores, store)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (r StoreListingRepository) table(query string) string {
+	return fmt.Sprintf(query, r.tableName)
+}
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN refunded decimal(9, 4) NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN refunded;
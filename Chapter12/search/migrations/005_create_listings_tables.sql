@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE store_listings (
+  id            text        NOT NULL,
+  name          text        NOT NULL,
+  location      text        NOT NULL DEFAULT '',
+  participating bool        NOT NULL DEFAULT FALSE,
+  search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', name), 'A') || setweight(to_tsvector('english', location), 'B')
+    ) STORED,
+  created_at    timestamptz NOT NULL DEFAULT NOW(),
+  updated_at    timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX store_listings_search_idx ON store_listings USING GIN (search_vector);
+
+CREATE TRIGGER updated_at_store_listings_trgr
+  BEFORE UPDATE
+  ON store_listings
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+CREATE TABLE product_listings (
+  id            text          NOT NULL,
+  store_id      text          NOT NULL,
+  name          text          NOT NULL,
+  description   text          NOT NULL DEFAULT '',
+  sku           text          NOT NULL DEFAULT '',
+  price         decimal(9, 4) NOT NULL DEFAULT 0,
+  search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', name), 'A') || setweight(to_tsvector('english', description), 'B')
+    ) STORED,
+  created_at    timestamptz   NOT NULL DEFAULT NOW(),
+  updated_at    timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX product_listings_search_idx ON product_listings USING GIN (search_vector);
+CREATE INDEX product_listings_store_id_idx ON product_listings (store_id);
+CREATE INDEX product_listings_price_idx ON product_listings (price);
+
+CREATE TRIGGER updated_at_product_listings_trgr
+  BEFORE UPDATE
+  ON product_listings
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- the caches only hold names; the rest fills in as products and stores change
+INSERT INTO store_listings (id, name) SELECT id, name FROM stores_cache;
+INSERT INTO product_listings (id, store_id, name) SELECT id, store_id, name FROM products_cache;
+
+-- +goose Down
+DROP TABLE IF EXISTS product_listings;
+DROP TABLE IF EXISTS store_listings;
@@ -0,0 +1,68 @@
+-- +goose Up
+ALTER TABLE orders
+  ADD COLUMN approved_at  timestamptz,
+  ADD COLUMN readied_at   timestamptz,
+  ADD COLUMN completed_at timestamptz,
+  ADD COLUMN canceled_at  timestamptz;
+
+CREATE TABLE customer_order_stats (
+  customer_id     text          NOT NULL,
+  order_count     int           NOT NULL DEFAULT 0,
+  completed_count int           NOT NULL DEFAULT 0,
+  canceled_count  int           NOT NULL DEFAULT 0,
+  lifetime_spend  decimal(9, 4) NOT NULL DEFAULT 0,
+  refunded        decimal(9, 4) NOT NULL DEFAULT 0,
+  first_order_at  timestamptz,
+  last_order_at   timestamptz,
+  created_at      timestamptz   NOT NULL DEFAULT NOW(),
+  updated_at      timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (customer_id)
+);
+
+CREATE TRIGGER updated_at_customer_order_stats_trgr
+  BEFORE UPDATE
+  ON customer_order_stats
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+CREATE TABLE store_sales (
+  store_id        text          NOT NULL,
+  day             date          NOT NULL,
+  order_count     int           NOT NULL DEFAULT 0,
+  completed_count int           NOT NULL DEFAULT 0,
+  canceled_count  int           NOT NULL DEFAULT 0,
+  items_sold      int           NOT NULL DEFAULT 0,
+  revenue         decimal(9, 4) NOT NULL DEFAULT 0,
+  created_at      timestamptz   NOT NULL DEFAULT NOW(),
+  updated_at      timestamptz   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (store_id, day)
+);
+
+CREATE TRIGGER updated_at_store_sales_trgr
+  BEFORE UPDATE
+  ON store_sales
+  FOR EACH ROW EXECUTE PROCEDURE updated_at_trigger();
+
+-- the status history of existing orders is unknown; only the customer
+-- totals can be rebuilt from them
+INSERT INTO customer_order_stats (customer_id, order_count, completed_count, canceled_count, lifetime_spend, refunded,
+                                  first_order_at, last_order_at)
+SELECT customer_id,
+       COUNT(*),
+       COUNT(*) FILTER (WHERE status IN ('completed', 'return-requested', 'returning', 'returned')),
+       COUNT(*) FILTER (WHERE status = 'cancelled'),
+       COALESCE(SUM(total) FILTER (WHERE status IN ('completed', 'return-requested', 'returning', 'returned')), 0),
+       SUM(refunded),
+       MIN(created_at),
+       MAX(created_at)
+FROM orders
+GROUP BY customer_id;
+
+-- +goose Down
+DROP TABLE IF EXISTS store_sales;
+DROP TABLE IF EXISTS customer_order_stats;
+
+ALTER TABLE orders
+  DROP COLUMN approved_at,
+  DROP COLUMN readied_at,
+  DROP COLUMN completed_at,
+  DROP COLUMN canceled_at;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE claim_checks (
+  key        text        NOT NULL,
+  blob_oid   oid         NOT NULL,
+  expires_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (key)
+);
+
+CREATE INDEX claim_checks_expires_at_idx ON claim_checks (expires_at);
+
+-- +goose Down
+SELECT lo_unlink(blob_oid) FROM claim_checks;
+DROP TABLE IF EXISTS claim_checks;
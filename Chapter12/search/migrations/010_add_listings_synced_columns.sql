@@ -0,0 +1,18 @@
+-- +goose Up
+-- listings copied from the caches only hold names; they are filled in from the
+-- stores service when the module starts
+ALTER TABLE store_listings
+  ADD COLUMN synced bool NOT NULL DEFAULT TRUE;
+
+ALTER TABLE product_listings
+  ADD COLUMN synced bool NOT NULL DEFAULT TRUE;
+
+UPDATE store_listings SET synced = FALSE;
+UPDATE product_listings SET synced = FALSE;
+
+-- +goose Down
+ALTER TABLE store_listings
+  DROP COLUMN synced;
+
+ALTER TABLE product_listings
+  DROP COLUMN synced;
@@ -8,14 +8,19 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
 	"eda-in-golang/internal/postgresotel"
 	"eda-in-golang/internal/registry"
+	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/ordering/orderingpb"
+	"eda-in-golang/payments/paymentspb"
 	"eda-in-golang/search/internal/application"
 	"eda-in-golang/search/internal/constants"
 	"eda-in-golang/search/internal/grpc"
@@ -39,23 +44,31 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		if err := orderingpb.Registrations(reg); err != nil {
 			return nil, err
 		}
-		if err := customerspb.Registrations(reg); err != nil {
+		if err := customerspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
 			return nil, err
 		}
 		if err := storespb.Registrations(reg); err != nil {
 			return nil, err
 		}
+		if err := paymentspb.RegistrationsWithSerde(serdes.NewUnsealingProtoSerde(reg, svc.Keys())); err != nil {
+			return nil, err
+		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddScoped(constants.DatabaseTransactionKey, func(c di.Container) (any, error) {
 		return svc.DB().Begin()
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.InboxStoreKey, func(c di.Container) (any, error) {
@@ -89,20 +102,49 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
 		), nil
 	})
+	container.AddScoped(constants.OrderStatsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewOrderStatsRepository(
+			constants.CustomerOrderStatsTableName,
+			constants.StoreSalesTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddScoped(constants.ProductListingsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewProductListingRepository(
+			constants.ProductListingsTableName,
+			constants.StoreListingsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddScoped(constants.StoreListingsRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewStoreListingRepository(
+			constants.StoreListingsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+		), nil
+	})
+	container.AddSingleton(constants.ListingSourceKey, func(c di.Container) (any, error) {
+		return grpc.NewListingSource(svc.Config().Rpc.Service(constants.StoresServiceName)), nil
+	})
 
 	// setup application
 	container.AddScoped(constants.ApplicationKey, func(c di.Container) (any, error) {
 		return application.New(
 			c.Get(constants.OrdersRepoKey).(application.OrderRepository),
+			c.Get(constants.OrderStatsRepoKey).(application.OrderStatsRepository),
+			c.Get(constants.ProductListingsRepoKey).(application.ProductListingRepository),
+			c.Get(constants.StoreListingsRepoKey).(application.StoreListingRepository),
 		), nil
 	})
 	container.AddScoped(constants.IntegrationEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewIntegrationEventHandlers(
 			c.Get(constants.RegistryKey).(registry.Registry),
 			c.Get(constants.OrdersRepoKey).(application.OrderRepository),
+			c.Get(constants.OrderStatsRepoKey).(application.OrderStatsRepository),
 			c.Get(constants.CustomersRepoKey).(application.CustomerCacheRepository),
 			c.Get(constants.StoresRepoKey).(application.StoreCacheRepository),
 			c.Get(constants.ProductsRepoKey).(application.ProductCacheRepository),
+			c.Get(constants.ProductListingsRepoKey).(application.ProductListingRepository),
+			c.Get(constants.StoreListingsRepoKey).(application.StoreListingRepository),
 			tm.InboxHandler(c.Get(constants.InboxStoreKey).(tm.InboxStore)),
 		), nil
 	})
@@ -117,6 +159,10 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	if err = rest.RegisterSwagger(svc.Mux()); err != nil {
 		return err
 	}
+	// listings are filled in before the events that change them are handled
+	if err = handlers.BackfillListings(ctx, container, svc.Tenants(), svc.Logger()); err != nil {
+		return err
+	}
 	if err = handlers.RegisterIntegrationEventHandlersTx(container); err != nil {
 		return err
 	}
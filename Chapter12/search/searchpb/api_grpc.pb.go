@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             (unknown)
+// - protoc             v3.18.1
 // source: searchpb/api.proto
 
 package searchpb
@@ -24,6 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type SearchServiceClient interface {
 	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	SearchStores(ctx context.Context, in *SearchStoresRequest, opts ...grpc.CallOption) (*SearchStoresResponse, error)
+	GetCustomerOrderHistory(ctx context.Context, in *GetCustomerOrderHistoryRequest, opts ...grpc.CallOption) (*GetCustomerOrderHistoryResponse, error)
+	GetStoreSales(ctx context.Context, in *GetStoreSalesRequest, opts ...grpc.CallOption) (*GetStoreSalesResponse, error)
 }
 
 type searchServiceClient struct {
@@ -52,12 +56,52 @@ func (c *searchServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest,
 	return out, nil
 }
 
+func (c *searchServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, "/searchpb.SearchService/SearchProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) SearchStores(ctx context.Context, in *SearchStoresRequest, opts ...grpc.CallOption) (*SearchStoresResponse, error) {
+	out := new(SearchStoresResponse)
+	err := c.cc.Invoke(ctx, "/searchpb.SearchService/SearchStores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) GetCustomerOrderHistory(ctx context.Context, in *GetCustomerOrderHistoryRequest, opts ...grpc.CallOption) (*GetCustomerOrderHistoryResponse, error) {
+	out := new(GetCustomerOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/searchpb.SearchService/GetCustomerOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) GetStoreSales(ctx context.Context, in *GetStoreSalesRequest, opts ...grpc.CallOption) (*GetStoreSalesResponse, error) {
+	out := new(GetStoreSalesResponse)
+	err := c.cc.Invoke(ctx, "/searchpb.SearchService/GetStoreSales", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServiceServer is the server API for SearchService service.
 // All implementations must embed UnimplementedSearchServiceServer
 // for forward compatibility
 type SearchServiceServer interface {
 	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	SearchStores(context.Context, *SearchStoresRequest) (*SearchStoresResponse, error)
+	GetCustomerOrderHistory(context.Context, *GetCustomerOrderHistoryRequest) (*GetCustomerOrderHistoryResponse, error)
+	GetStoreSales(context.Context, *GetStoreSalesRequest) (*GetStoreSalesResponse, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
 
@@ -71,6 +115,18 @@ func (UnimplementedSearchServiceServer) SearchOrders(context.Context, *SearchOrd
 func (UnimplementedSearchServiceServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
+func (UnimplementedSearchServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedSearchServiceServer) SearchStores(context.Context, *SearchStoresRequest) (*SearchStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStores not implemented")
+}
+func (UnimplementedSearchServiceServer) GetCustomerOrderHistory(context.Context, *GetCustomerOrderHistoryRequest) (*GetCustomerOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerOrderHistory not implemented")
+}
+func (UnimplementedSearchServiceServer) GetStoreSales(context.Context, *GetStoreSalesRequest) (*GetStoreSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreSales not implemented")
+}
 func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
 
 // UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +176,78 @@ func _SearchService_GetOrder_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/searchpb.SearchService/SearchProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_SearchStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/searchpb.SearchService/SearchStores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchStores(ctx, req.(*SearchStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_GetCustomerOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).GetCustomerOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/searchpb.SearchService/GetCustomerOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).GetCustomerOrderHistory(ctx, req.(*GetCustomerOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_GetStoreSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).GetStoreSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/searchpb.SearchService/GetStoreSales",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).GetStoreSales(ctx, req.(*GetStoreSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +263,22 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrder",
 			Handler:    _SearchService_GetOrder_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _SearchService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "SearchStores",
+			Handler:    _SearchService_SearchStores_Handler,
+		},
+		{
+			MethodName: "GetCustomerOrderHistory",
+			Handler:    _SearchService_GetCustomerOrderHistory_Handler,
+		},
+		{
+			MethodName: "GetStoreSales",
+			Handler:    _SearchService_GetStoreSales_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "searchpb/api.proto",
@@ -18,19 +18,36 @@ type (
 		CreateStore(ctx context.Context, cmd commands.CreateStore) error
 		EnableParticipation(ctx context.Context, cmd commands.EnableParticipation) error
 		DisableParticipation(ctx context.Context, cmd commands.DisableParticipation) error
+		SetStoreSchedule(ctx context.Context, cmd commands.SetStoreSchedule) error
+		ApplyStoreSchedule(ctx context.Context, cmd commands.ApplyStoreSchedule) error
 		RebrandStore(ctx context.Context, cmd commands.RebrandStore) error
 		AddProduct(ctx context.Context, cmd commands.AddProduct) error
 		RebrandProduct(ctx context.Context, cmd commands.RebrandProduct) error
 		IncreaseProductPrice(ctx context.Context, cmd commands.IncreaseProductPrice) error
 		DecreaseProductPrice(ctx context.Context, cmd commands.DecreaseProductPrice) error
+		ScheduleProductPrice(ctx context.Context, cmd commands.ScheduleProductPrice) error
+		CancelScheduledProductPrice(ctx context.Context, cmd commands.CancelScheduledProductPrice) error
+		ApplyScheduledPrices(ctx context.Context, cmd commands.ApplyScheduledPrices) error
 		RemoveProduct(ctx context.Context, cmd commands.RemoveProduct) error
+		CategorizeProduct(ctx context.Context, cmd commands.CategorizeProduct) error
+		AddProductVariant(ctx context.Context, cmd commands.AddProductVariant) error
+		RemoveProductVariant(ctx context.Context, cmd commands.RemoveProductVariant) error
+		ReceiveInventory(ctx context.Context, cmd commands.ReceiveInventory) error
+		AdjustInventory(ctx context.Context, cmd commands.AdjustInventory) error
+		ReserveStock(ctx context.Context, cmd commands.ReserveStock) error
+		ReleaseStock(ctx context.Context, cmd commands.ReleaseStock) error
+		CommitStock(ctx context.Context, cmd commands.CommitStock) error
 	}
 	Queries interface {
 		GetStore(ctx context.Context, query queries.GetStore) (*domain.MallStore, error)
 		GetStores(ctx context.Context, query queries.GetStores) ([]*domain.MallStore, error)
 		GetParticipatingStores(ctx context.Context, query queries.GetParticipatingStores) ([]*domain.MallStore, error)
+		GetStoresDueForSchedule(ctx context.Context, query queries.GetStoresDueForSchedule) ([]*domain.MallStore, error)
 		GetCatalog(ctx context.Context, query queries.GetCatalog) ([]*domain.CatalogProduct, error)
 		GetProduct(ctx context.Context, query queries.GetProduct) (*domain.CatalogProduct, error)
+		GetProductsWithDuePrices(ctx context.Context, query queries.GetProductsWithDuePrices) ([]string, error)
+		GetProductPriceHistory(ctx context.Context, query queries.GetProductPriceHistory) (*domain.ProductPriceHistory, error)
+		GetInventory(ctx context.Context, query queries.GetInventory) (*domain.Inventory, error)
 	}
 
 	Application struct {
@@ -41,46 +58,76 @@ type (
 		commands.CreateStoreHandler
 		commands.EnableParticipationHandler
 		commands.DisableParticipationHandler
+		commands.SetStoreScheduleHandler
+		commands.ApplyStoreScheduleHandler
 		commands.RebrandStoreHandler
 		commands.AddProductHandler
 		commands.RebrandProductHandler
 		commands.IncreaseProductPriceHandler
 		commands.DecreaseProductPriceHandler
+		commands.ScheduleProductPriceHandler
+		commands.CancelScheduledProductPriceHandler
+		commands.ApplyScheduledPricesHandler
 		commands.RemoveProductHandler
+		commands.CategorizeProductHandler
+		commands.AddProductVariantHandler
+		commands.RemoveProductVariantHandler
+		commands.ReceiveInventoryHandler
+		commands.AdjustInventoryHandler
+		commands.StockReservationsHandler
 	}
 	appQueries struct {
 		queries.GetStoreHandler
 		queries.GetStoresHandler
 		queries.GetParticipatingStoresHandler
+		queries.GetStoresDueForScheduleHandler
 		queries.GetCatalogHandler
 		queries.GetProductHandler
+		queries.GetProductsWithDuePricesHandler
+		queries.GetProductPriceHistoryHandler
+		queries.GetInventoryHandler
 	}
 )
 
 var _ App = (*Application)(nil)
 
-func New(stores domain.StoreRepository, products domain.ProductRepository,
-	catalog domain.CatalogRepository, mall domain.MallRepository,
+func New(stores domain.StoreRepository, products domain.ProductRepository, inventory domain.InventoryRepository,
+	catalog domain.CatalogRepository, mall domain.MallRepository, priceHistory domain.ProductPriceHistoryRepository,
 	publisher ddd.EventPublisher[ddd.Event],
 ) *Application {
 	return &Application{
 		appCommands: appCommands{
-			CreateStoreHandler:          commands.NewCreateStoreHandler(stores, publisher),
-			EnableParticipationHandler:  commands.NewEnableParticipationHandler(stores, publisher),
-			DisableParticipationHandler: commands.NewDisableParticipationHandler(stores, publisher),
-			RebrandStoreHandler:         commands.NewRebrandStoreHandler(stores, publisher),
-			AddProductHandler:           commands.NewAddProductHandler(products, publisher),
-			RebrandProductHandler:       commands.NewRebrandProductHandler(products, publisher),
-			IncreaseProductPriceHandler: commands.NewIncreaseProductPriceHandler(products, publisher),
-			DecreaseProductPriceHandler: commands.NewDecreaseProductPriceHandler(products, publisher),
-			RemoveProductHandler:        commands.NewRemoveProductHandler(products, publisher),
+			CreateStoreHandler:                 commands.NewCreateStoreHandler(stores, publisher),
+			EnableParticipationHandler:         commands.NewEnableParticipationHandler(stores, publisher),
+			DisableParticipationHandler:        commands.NewDisableParticipationHandler(stores, publisher),
+			SetStoreScheduleHandler:            commands.NewSetStoreScheduleHandler(stores, publisher),
+			ApplyStoreScheduleHandler:          commands.NewApplyStoreScheduleHandler(stores, publisher),
+			RebrandStoreHandler:                commands.NewRebrandStoreHandler(stores, publisher),
+			AddProductHandler:                  commands.NewAddProductHandler(products, publisher),
+			RebrandProductHandler:              commands.NewRebrandProductHandler(products, publisher),
+			IncreaseProductPriceHandler:        commands.NewIncreaseProductPriceHandler(products, publisher),
+			DecreaseProductPriceHandler:        commands.NewDecreaseProductPriceHandler(products, publisher),
+			ScheduleProductPriceHandler:        commands.NewScheduleProductPriceHandler(products, publisher),
+			CancelScheduledProductPriceHandler: commands.NewCancelScheduledProductPriceHandler(products, publisher),
+			ApplyScheduledPricesHandler:        commands.NewApplyScheduledPricesHandler(products, publisher),
+			RemoveProductHandler:               commands.NewRemoveProductHandler(products, publisher),
+			CategorizeProductHandler:           commands.NewCategorizeProductHandler(products, publisher),
+			AddProductVariantHandler:           commands.NewAddProductVariantHandler(products, publisher),
+			RemoveProductVariantHandler:        commands.NewRemoveProductVariantHandler(products, publisher),
+			ReceiveInventoryHandler:            commands.NewReceiveInventoryHandler(inventory, publisher),
+			AdjustInventoryHandler:             commands.NewAdjustInventoryHandler(inventory, publisher),
+			StockReservationsHandler:           commands.NewStockReservationsHandler(inventory, publisher),
 		},
 		appQueries: appQueries{
-			GetStoreHandler:               queries.NewGetStoreHandler(mall),
-			GetStoresHandler:              queries.NewGetStoresHandler(mall),
-			GetParticipatingStoresHandler: queries.NewGetParticipatingStoresHandler(mall),
-			GetCatalogHandler:             queries.NewGetCatalogHandler(catalog),
-			GetProductHandler:             queries.NewGetProductHandler(catalog),
+			GetStoreHandler:                 queries.NewGetStoreHandler(mall),
+			GetStoresHandler:                queries.NewGetStoresHandler(mall),
+			GetParticipatingStoresHandler:   queries.NewGetParticipatingStoresHandler(mall),
+			GetStoresDueForScheduleHandler:  queries.NewGetStoresDueForScheduleHandler(mall),
+			GetCatalogHandler:               queries.NewGetCatalogHandler(catalog),
+			GetProductHandler:               queries.NewGetProductHandler(catalog),
+			GetProductsWithDuePricesHandler: queries.NewGetProductsWithDuePricesHandler(catalog),
+			GetProductPriceHistoryHandler:   queries.NewGetProductPriceHistoryHandler(priceHistory),
+			GetInventoryHandler:             queries.NewGetInventoryHandler(inventory),
 		},
 	}
 }
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type AddProductVariant struct {
+	ID         string
+	VariantID  string
+	SKU        string
+	Price      float64
+	Attributes map[string]string
+}
+
+type AddProductVariantHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewAddProductVariantHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) AddProductVariantHandler {
+	return AddProductVariantHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h AddProductVariantHandler) AddProductVariant(ctx context.Context, cmd AddProductVariant) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := product.AddVariant(domain.ProductVariant{
+		ID:         cmd.VariantID,
+		SKU:        cmd.SKU,
+		Price:      cmd.Price,
+		Attributes: cmd.Attributes,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.products.Save(ctx, product)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type AdjustInventory struct {
+	ProductID string
+	Count     int
+	Reason    string
+}
+
+type AdjustInventoryHandler struct {
+	inventory domain.InventoryRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewAdjustInventoryHandler(inventory domain.InventoryRepository, publisher ddd.EventPublisher[ddd.Event]) AdjustInventoryHandler {
+	return AdjustInventoryHandler{
+		inventory: inventory,
+		publisher: publisher,
+	}
+}
+
+func (h AdjustInventoryHandler) AdjustInventory(ctx context.Context, cmd AdjustInventory) error {
+	inventory, err := h.inventory.Load(ctx, cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	event, err := inventory.Adjust(cmd.Count, cmd.Reason)
+	if err != nil {
+		return err
+	}
+
+	err = h.inventory.Save(ctx, inventory)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
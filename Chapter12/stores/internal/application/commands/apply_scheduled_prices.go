@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+// ApplyScheduledPrices puts the scheduled prices of the product that are due
+// by At into effect and reverts the sales that have ended
+type ApplyScheduledPrices struct {
+	ID string
+	At time.Time
+}
+
+type ApplyScheduledPricesHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewApplyScheduledPricesHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) ApplyScheduledPricesHandler {
+	return ApplyScheduledPricesHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h ApplyScheduledPricesHandler) ApplyScheduledPrices(ctx context.Context, cmd ApplyScheduledPrices) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	events := product.ApplyScheduledPrices(cmd.At)
+	if len(events) == 0 {
+		return nil
+	}
+
+	if err = h.products.Save(ctx, product); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, events...)
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+// ApplyStoreSchedule opens or closes a scheduled store when its schedule has
+// crossed a boundary by At
+type ApplyStoreSchedule struct {
+	ID string
+	At time.Time
+}
+
+type ApplyStoreScheduleHandler struct {
+	stores    domain.StoreRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewApplyStoreScheduleHandler(stores domain.StoreRepository, publisher ddd.EventPublisher[ddd.Event]) ApplyStoreScheduleHandler {
+	return ApplyStoreScheduleHandler{
+		stores:    stores,
+		publisher: publisher,
+	}
+}
+
+func (h ApplyStoreScheduleHandler) ApplyStoreSchedule(ctx context.Context, cmd ApplyStoreSchedule) error {
+	store, err := h.stores.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := store.ApplySchedule(cmd.At)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return nil
+	}
+
+	if err = h.stores.Save(ctx, store); err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
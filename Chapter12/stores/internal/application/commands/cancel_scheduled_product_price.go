@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type CancelScheduledProductPrice struct {
+	ID               string
+	ScheduledPriceID string
+}
+
+type CancelScheduledProductPriceHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewCancelScheduledProductPriceHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) CancelScheduledProductPriceHandler {
+	return CancelScheduledProductPriceHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h CancelScheduledProductPriceHandler) CancelScheduledProductPrice(ctx context.Context, cmd CancelScheduledProductPrice) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := product.CancelScheduledPrice(cmd.ScheduledPriceID)
+	if err != nil {
+		return err
+	}
+
+	err = h.products.Save(ctx, product)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
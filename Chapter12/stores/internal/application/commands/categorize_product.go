@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type CategorizeProduct struct {
+	ID       string
+	Category string
+	Tags     []string
+}
+
+type CategorizeProductHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewCategorizeProductHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) CategorizeProductHandler {
+	return CategorizeProductHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h CategorizeProductHandler) CategorizeProduct(ctx context.Context, cmd CategorizeProduct) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := product.Categorize(cmd.Category, cmd.Tags)
+	if err != nil {
+		return err
+	}
+
+	err = h.products.Save(ctx, product)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type ReceiveInventory struct {
+	ProductID string
+	Quantity  int
+}
+
+type ReceiveInventoryHandler struct {
+	inventory domain.InventoryRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewReceiveInventoryHandler(inventory domain.InventoryRepository, publisher ddd.EventPublisher[ddd.Event]) ReceiveInventoryHandler {
+	return ReceiveInventoryHandler{
+		inventory: inventory,
+		publisher: publisher,
+	}
+}
+
+func (h ReceiveInventoryHandler) ReceiveInventory(ctx context.Context, cmd ReceiveInventory) error {
+	inventory, err := h.inventory.Load(ctx, cmd.ProductID)
+	if err != nil {
+		return err
+	}
+
+	event, err := inventory.Receive(cmd.Quantity)
+	if err != nil {
+		return err
+	}
+
+	err = h.inventory.Save(ctx, inventory)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type RemoveProductVariant struct {
+	ID        string
+	VariantID string
+}
+
+type RemoveProductVariantHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewRemoveProductVariantHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) RemoveProductVariantHandler {
+	return RemoveProductVariantHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h RemoveProductVariantHandler) RemoveProductVariant(ctx context.Context, cmd RemoveProductVariant) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := product.RemoveVariant(cmd.VariantID)
+	if err != nil {
+		return err
+	}
+
+	err = h.products.Save(ctx, product)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
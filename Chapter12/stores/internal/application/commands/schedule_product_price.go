@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+// ScheduleProductPrice sets a price the product switches to at EffectiveFrom;
+// a sale also has an EffectiveUntil at which the price reverts
+type ScheduleProductPrice struct {
+	ID               string
+	ScheduledPriceID string
+	Price            float64
+	EffectiveFrom    time.Time
+	EffectiveUntil   time.Time
+}
+
+type ScheduleProductPriceHandler struct {
+	products  domain.ProductRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewScheduleProductPriceHandler(products domain.ProductRepository, publisher ddd.EventPublisher[ddd.Event]) ScheduleProductPriceHandler {
+	return ScheduleProductPriceHandler{
+		products:  products,
+		publisher: publisher,
+	}
+}
+
+func (h ScheduleProductPriceHandler) ScheduleProductPrice(ctx context.Context, cmd ScheduleProductPrice) error {
+	product, err := h.products.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := product.SchedulePrice(domain.ScheduledPrice{
+		ID:             cmd.ScheduledPriceID,
+		Price:          cmd.Price,
+		EffectiveFrom:  cmd.EffectiveFrom,
+		EffectiveUntil: cmd.EffectiveUntil,
+	}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	err = h.products.Save(ctx, product)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
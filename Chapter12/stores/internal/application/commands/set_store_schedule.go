@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type SetStoreSchedule struct {
+	ID       string
+	Schedule domain.StoreSchedule
+}
+
+type SetStoreScheduleHandler struct {
+	stores    domain.StoreRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewSetStoreScheduleHandler(stores domain.StoreRepository, publisher ddd.EventPublisher[ddd.Event]) SetStoreScheduleHandler {
+	return SetStoreScheduleHandler{
+		stores:    stores,
+		publisher: publisher,
+	}
+}
+
+func (h SetStoreScheduleHandler) SetStoreSchedule(ctx context.Context, cmd SetStoreSchedule) error {
+	store, err := h.stores.Load(ctx, cmd.ID)
+	if err != nil {
+		return err
+	}
+
+	event, err := store.SetSchedule(cmd.Schedule)
+	if err != nil {
+		return err
+	}
+
+	err = h.stores.Save(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	return h.publisher.Publish(ctx, event)
+}
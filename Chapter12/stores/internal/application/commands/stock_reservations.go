@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+type StockItem struct {
+	ProductID string
+	Quantity  int
+}
+
+// ReserveStock reserves the stock for every item or for none of them; items
+// without tracked stock are not reserved
+type ReserveStock struct {
+	ReservationID string
+	Items         []StockItem
+}
+
+type ReleaseStock struct {
+	ReservationID string
+	ProductIDs    []string
+}
+
+type CommitStock struct {
+	ReservationID string
+	ProductIDs    []string
+}
+
+type StockReservationsHandler struct {
+	inventory domain.InventoryRepository
+	publisher ddd.EventPublisher[ddd.Event]
+}
+
+func NewStockReservationsHandler(inventory domain.InventoryRepository, publisher ddd.EventPublisher[ddd.Event]) StockReservationsHandler {
+	return StockReservationsHandler{
+		inventory: inventory,
+		publisher: publisher,
+	}
+}
+
+func (h StockReservationsHandler) ReserveStock(ctx context.Context, cmd ReserveStock) error {
+	// the same product may appear more than once in an order
+	quantities := make(map[string]int)
+	productIDs := make([]string, 0, len(cmd.Items))
+	for _, item := range cmd.Items {
+		if _, exists := quantities[item.ProductID]; !exists {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		quantities[item.ProductID] += item.Quantity
+	}
+
+	inventories := make([]*domain.Inventory, 0, len(productIDs))
+	events := make([]ddd.Event, 0, len(productIDs))
+	for _, productID := range productIDs {
+		inventory, err := h.inventory.Load(ctx, productID)
+		if err != nil {
+			return err
+		}
+
+		event, err := inventory.Reserve(cmd.ReservationID, quantities[productID])
+		if err != nil {
+			return errors.Wrapf(err, "reserving stock for product %s", productID)
+		}
+		if event == nil {
+			continue
+		}
+
+		inventories = append(inventories, inventory)
+		events = append(events, event)
+	}
+
+	for _, inventory := range inventories {
+		if err := h.inventory.Save(ctx, inventory); err != nil {
+			return err
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return h.publisher.Publish(ctx, events...)
+}
+
+func (h StockReservationsHandler) ReleaseStock(ctx context.Context, cmd ReleaseStock) error {
+	return h.apply(ctx, cmd.ProductIDs, func(inventory *domain.Inventory) (ddd.Event, error) {
+		return inventory.Release(cmd.ReservationID)
+	})
+}
+
+func (h StockReservationsHandler) CommitStock(ctx context.Context, cmd CommitStock) error {
+	return h.apply(ctx, cmd.ProductIDs, func(inventory *domain.Inventory) (ddd.Event, error) {
+		return inventory.Commit(cmd.ReservationID)
+	})
+}
+
+func (h StockReservationsHandler) apply(ctx context.Context, productIDs []string, fn func(*domain.Inventory) (ddd.Event, error)) error {
+	seen := make(map[string]bool, len(productIDs))
+	for _, productID := range productIDs {
+		if seen[productID] {
+			continue
+		}
+		seen[productID] = true
+
+		inventory, err := h.inventory.Load(ctx, productID)
+		if err != nil {
+			return err
+		}
+
+		event, err := fn(inventory)
+		if err != nil {
+			return errors.Wrapf(err, "updating stock for product %s", productID)
+		}
+		if event == nil {
+			continue
+		}
+
+		if err = h.inventory.Save(ctx, inventory); err != nil {
+			return err
+		}
+
+		if err = h.publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
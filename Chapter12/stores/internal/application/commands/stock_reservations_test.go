@@ -0,0 +1,180 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/stores/internal/domain"
+)
+
+func TestStockReservationsHandler_ReserveStock(t *testing.T) {
+	tests := map[string]struct {
+		inventories  map[string]*domain.Inventory
+		items        []StockItem
+		wantReserved map[string]int
+		wantErr      error
+	}{
+		"Untracked": {
+			inventories: map[string]*domain.Inventory{
+				"product-a": domain.NewInventory("product-a"),
+			},
+			items: []StockItem{{ProductID: "product-a", Quantity: 100}},
+		},
+		"Tracked": {
+			inventories: map[string]*domain.Inventory{
+				"product-a": stockedInventory(t, "product-a", 10),
+				"product-b": domain.NewInventory("product-b"),
+			},
+			// the same product in two lines is reserved once for both
+			items: []StockItem{
+				{ProductID: "product-a", Quantity: 4},
+				{ProductID: "product-b", Quantity: 1},
+				{ProductID: "product-a", Quantity: 6},
+			},
+			wantReserved: map[string]int{"product-a": 10},
+		},
+		"Insufficient": {
+			inventories: map[string]*domain.Inventory{
+				"product-a": stockedInventory(t, "product-a", 10),
+				"product-b": stockedInventory(t, "product-b", 2),
+			},
+			items: []StockItem{
+				{ProductID: "product-a", Quantity: 4},
+				{ProductID: "product-b", Quantity: 3},
+			},
+			wantErr: domain.ErrInsufficientStock,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inventory := domain.NewMockInventoryRepository(t)
+			publisher := ddd.NewMockEventPublisher[ddd.Event](t)
+			for productID, i := range tc.inventories {
+				inventory.On("Load", context.Background(), productID).Return(i, nil)
+			}
+			for productID := range tc.wantReserved {
+				inventory.On("Save", context.Background(), tc.inventories[productID]).Return(nil)
+			}
+			if len(tc.wantReserved) != 0 {
+				publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil)
+			}
+
+			h := NewStockReservationsHandler(inventory, publisher)
+			err := h.ReserveStock(context.Background(), ReserveStock{ReservationID: "order-id", Items: tc.items})
+			if tc.wantErr != nil {
+				// nothing is reserved when any item is short
+				assert.ErrorIs(t, err, tc.wantErr)
+				inventory.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+				return
+			}
+			if assert.NoError(t, err) {
+				for productID, quantity := range tc.wantReserved {
+					events := tc.inventories[productID].Events()
+					if assert.Len(t, events, 1) {
+						assert.Equal(t, quantity, events[0].Payload().(*domain.InventoryReserved).Quantity)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestStockReservationsHandler_ReleaseStock(t *testing.T) {
+	reserved := reservedInventory(t, "product-a", 10, 4)
+	untracked := domain.NewInventory("product-b")
+
+	inventory := domain.NewMockInventoryRepository(t)
+	publisher := ddd.NewMockEventPublisher[ddd.Event](t)
+	inventory.On("Load", context.Background(), "product-a").Return(reserved, nil).Once()
+	inventory.On("Load", context.Background(), "product-b").Return(untracked, nil).Once()
+	inventory.On("Save", context.Background(), reserved).Return(nil).Once()
+	publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil).Once()
+
+	h := NewStockReservationsHandler(inventory, publisher)
+	err := h.ReleaseStock(context.Background(), ReleaseStock{
+		ReservationID: "order-id",
+		ProductIDs:    []string{"product-a", "product-b", "product-a"},
+	})
+	assert.NoError(t, err)
+}
+
+func TestStockReservationsHandler_CommitStock(t *testing.T) {
+	tests := map[string]struct {
+		inventory *domain.Inventory
+		wantSaved bool
+		wantErr   error
+	}{
+		"Reserved": {
+			inventory: reservedInventory(t, "product-a", 10, 4),
+			wantSaved: true,
+		},
+		"NotReserved": {
+			inventory: stockedInventory(t, "product-a", 10),
+			wantErr:   domain.ErrReservationNotFound,
+		},
+		"Untracked": {
+			inventory: domain.NewInventory("product-a"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inventory := domain.NewMockInventoryRepository(t)
+			publisher := ddd.NewMockEventPublisher[ddd.Event](t)
+			inventory.On("Load", context.Background(), "product-a").Return(tc.inventory, nil).Once()
+			if tc.wantSaved {
+				inventory.On("Save", context.Background(), tc.inventory).Return(nil).Once()
+				publisher.On("Publish", context.Background(), mock.AnythingOfType("ddd.event")).Return(nil).Once()
+			}
+
+			h := NewStockReservationsHandler(inventory, publisher)
+			err := h.CommitStock(context.Background(), CommitStock{
+				ReservationID: "order-id",
+				ProductIDs:    []string{"product-a", "product-a"},
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func stockedInventory(t *testing.T, productID string, onHand int) *domain.Inventory {
+	t.Helper()
+
+	inventory := domain.NewInventory(productID)
+	if _, err := inventory.Receive(onHand); err != nil {
+		t.Fatal(err)
+	}
+	commitInventory(t, inventory)
+
+	return inventory
+}
+
+func reservedInventory(t *testing.T, productID string, onHand, reserved int) *domain.Inventory {
+	t.Helper()
+
+	inventory := stockedInventory(t, productID, onHand)
+	if _, err := inventory.Reserve("order-id", reserved); err != nil {
+		t.Fatal(err)
+	}
+	commitInventory(t, inventory)
+
+	return inventory
+}
+
+func commitInventory(t *testing.T, inventory *domain.Inventory) {
+	t.Helper()
+
+	for _, event := range inventory.Events() {
+		if err := inventory.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	inventory.CommitEvents()
+}
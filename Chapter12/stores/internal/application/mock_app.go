@@ -32,6 +32,104 @@ func (_m *MockApp) AddProduct(ctx context.Context, cmd commands.AddProduct) erro
 	return r0
 }
 
+// AddProductVariant provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) AddProductVariant(ctx context.Context, cmd commands.AddProductVariant) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.AddProductVariant) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdjustInventory provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) AdjustInventory(ctx context.Context, cmd commands.AdjustInventory) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.AdjustInventory) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyScheduledPrices provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ApplyScheduledPrices(ctx context.Context, cmd commands.ApplyScheduledPrices) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ApplyScheduledPrices) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyStoreSchedule provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ApplyStoreSchedule(ctx context.Context, cmd commands.ApplyStoreSchedule) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ApplyStoreSchedule) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelScheduledProductPrice provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) CancelScheduledProductPrice(ctx context.Context, cmd commands.CancelScheduledProductPrice) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CancelScheduledProductPrice) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategorizeProduct provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) CategorizeProduct(ctx context.Context, cmd commands.CategorizeProduct) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CategorizeProduct) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommitStock provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) CommitStock(ctx context.Context, cmd commands.CommitStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CommitStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateStore provides a mock function with given fields: ctx, cmd
 func (_m *MockApp) CreateStore(ctx context.Context, cmd commands.CreateStore) error {
 	ret := _m.Called(ctx, cmd)
@@ -111,6 +209,29 @@ func (_m *MockApp) GetCatalog(ctx context.Context, query queries.GetCatalog) ([]
 	return r0, r1
 }
 
+// GetInventory provides a mock function with given fields: ctx, query
+func (_m *MockApp) GetInventory(ctx context.Context, query queries.GetInventory) (*domain.Inventory, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *domain.Inventory
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetInventory) *domain.Inventory); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Inventory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetInventory) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetParticipatingStores provides a mock function with given fields: ctx, query
 func (_m *MockApp) GetParticipatingStores(ctx context.Context, query queries.GetParticipatingStores) ([]*domain.MallStore, error) {
 	ret := _m.Called(ctx, query)
@@ -157,6 +278,52 @@ func (_m *MockApp) GetProduct(ctx context.Context, query queries.GetProduct) (*d
 	return r0, r1
 }
 
+// GetProductPriceHistory provides a mock function with given fields: ctx, query
+func (_m *MockApp) GetProductPriceHistory(ctx context.Context, query queries.GetProductPriceHistory) (*domain.ProductPriceHistory, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *domain.ProductPriceHistory
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetProductPriceHistory) *domain.ProductPriceHistory); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductPriceHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetProductPriceHistory) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsWithDuePrices provides a mock function with given fields: ctx, query
+func (_m *MockApp) GetProductsWithDuePrices(ctx context.Context, query queries.GetProductsWithDuePrices) ([]string, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetProductsWithDuePrices) []string); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetProductsWithDuePrices) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStore provides a mock function with given fields: ctx, query
 func (_m *MockApp) GetStore(ctx context.Context, query queries.GetStore) (*domain.MallStore, error) {
 	ret := _m.Called(ctx, query)
@@ -203,6 +370,29 @@ func (_m *MockApp) GetStores(ctx context.Context, query queries.GetStores) ([]*d
 	return r0, r1
 }
 
+// GetStoresDueForSchedule provides a mock function with given fields: ctx, query
+func (_m *MockApp) GetStoresDueForSchedule(ctx context.Context, query queries.GetStoresDueForSchedule) ([]*domain.MallStore, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*domain.MallStore
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetStoresDueForSchedule) []*domain.MallStore); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.MallStore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetStoresDueForSchedule) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IncreaseProductPrice provides a mock function with given fields: ctx, cmd
 func (_m *MockApp) IncreaseProductPrice(ctx context.Context, cmd commands.IncreaseProductPrice) error {
 	ret := _m.Called(ctx, cmd)
@@ -245,6 +435,34 @@ func (_m *MockApp) RebrandStore(ctx context.Context, cmd commands.RebrandStore)
 	return r0
 }
 
+// ReceiveInventory provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReceiveInventory(ctx context.Context, cmd commands.ReceiveInventory) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReceiveInventory) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseStock provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReleaseStock(ctx context.Context, cmd commands.ReleaseStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveProduct provides a mock function with given fields: ctx, cmd
 func (_m *MockApp) RemoveProduct(ctx context.Context, cmd commands.RemoveProduct) error {
 	ret := _m.Called(ctx, cmd)
@@ -259,6 +477,62 @@ func (_m *MockApp) RemoveProduct(ctx context.Context, cmd commands.RemoveProduct
 	return r0
 }
 
+// RemoveProductVariant provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) RemoveProductVariant(ctx context.Context, cmd commands.RemoveProductVariant) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RemoveProductVariant) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveStock provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ReserveStock(ctx context.Context, cmd commands.ReserveStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReserveStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleProductPrice provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) ScheduleProductPrice(ctx context.Context, cmd commands.ScheduleProductPrice) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ScheduleProductPrice) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetStoreSchedule provides a mock function with given fields: ctx, cmd
+func (_m *MockApp) SetStoreSchedule(ctx context.Context, cmd commands.SetStoreSchedule) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.SetStoreSchedule) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockApp interface {
 	mock.TestingT
 	Cleanup(func())
@@ -28,6 +28,104 @@ func (_m *MockCommands) AddProduct(ctx context.Context, cmd commands.AddProduct)
 	return r0
 }
 
+// AddProductVariant provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) AddProductVariant(ctx context.Context, cmd commands.AddProductVariant) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.AddProductVariant) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdjustInventory provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) AdjustInventory(ctx context.Context, cmd commands.AdjustInventory) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.AdjustInventory) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyScheduledPrices provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ApplyScheduledPrices(ctx context.Context, cmd commands.ApplyScheduledPrices) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ApplyScheduledPrices) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyStoreSchedule provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ApplyStoreSchedule(ctx context.Context, cmd commands.ApplyStoreSchedule) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ApplyStoreSchedule) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelScheduledProductPrice provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) CancelScheduledProductPrice(ctx context.Context, cmd commands.CancelScheduledProductPrice) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CancelScheduledProductPrice) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategorizeProduct provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) CategorizeProduct(ctx context.Context, cmd commands.CategorizeProduct) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CategorizeProduct) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommitStock provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) CommitStock(ctx context.Context, cmd commands.CommitStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.CommitStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateStore provides a mock function with given fields: ctx, cmd
 func (_m *MockCommands) CreateStore(ctx context.Context, cmd commands.CreateStore) error {
 	ret := _m.Called(ctx, cmd)
@@ -126,6 +224,34 @@ func (_m *MockCommands) RebrandStore(ctx context.Context, cmd commands.RebrandSt
 	return r0
 }
 
+// ReceiveInventory provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ReceiveInventory(ctx context.Context, cmd commands.ReceiveInventory) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReceiveInventory) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseStock provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ReleaseStock(ctx context.Context, cmd commands.ReleaseStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReleaseStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveProduct provides a mock function with given fields: ctx, cmd
 func (_m *MockCommands) RemoveProduct(ctx context.Context, cmd commands.RemoveProduct) error {
 	ret := _m.Called(ctx, cmd)
@@ -140,6 +266,62 @@ func (_m *MockCommands) RemoveProduct(ctx context.Context, cmd commands.RemovePr
 	return r0
 }
 
+// RemoveProductVariant provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) RemoveProductVariant(ctx context.Context, cmd commands.RemoveProductVariant) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.RemoveProductVariant) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveStock provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ReserveStock(ctx context.Context, cmd commands.ReserveStock) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ReserveStock) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleProductPrice provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) ScheduleProductPrice(ctx context.Context, cmd commands.ScheduleProductPrice) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.ScheduleProductPrice) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetStoreSchedule provides a mock function with given fields: ctx, cmd
+func (_m *MockCommands) SetStoreSchedule(ctx context.Context, cmd commands.SetStoreSchedule) error {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, commands.SetStoreSchedule) error); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockCommands interface {
 	mock.TestingT
 	Cleanup(func())
@@ -39,6 +39,29 @@ func (_m *MockQueries) GetCatalog(ctx context.Context, query queries.GetCatalog)
 	return r0, r1
 }
 
+// GetInventory provides a mock function with given fields: ctx, query
+func (_m *MockQueries) GetInventory(ctx context.Context, query queries.GetInventory) (*domain.Inventory, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *domain.Inventory
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetInventory) *domain.Inventory); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Inventory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetInventory) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetParticipatingStores provides a mock function with given fields: ctx, query
 func (_m *MockQueries) GetParticipatingStores(ctx context.Context, query queries.GetParticipatingStores) ([]*domain.MallStore, error) {
 	ret := _m.Called(ctx, query)
@@ -85,6 +108,52 @@ func (_m *MockQueries) GetProduct(ctx context.Context, query queries.GetProduct)
 	return r0, r1
 }
 
+// GetProductPriceHistory provides a mock function with given fields: ctx, query
+func (_m *MockQueries) GetProductPriceHistory(ctx context.Context, query queries.GetProductPriceHistory) (*domain.ProductPriceHistory, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *domain.ProductPriceHistory
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetProductPriceHistory) *domain.ProductPriceHistory); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductPriceHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetProductPriceHistory) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsWithDuePrices provides a mock function with given fields: ctx, query
+func (_m *MockQueries) GetProductsWithDuePrices(ctx context.Context, query queries.GetProductsWithDuePrices) ([]string, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetProductsWithDuePrices) []string); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetProductsWithDuePrices) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStore provides a mock function with given fields: ctx, query
 func (_m *MockQueries) GetStore(ctx context.Context, query queries.GetStore) (*domain.MallStore, error) {
 	ret := _m.Called(ctx, query)
@@ -131,6 +200,29 @@ func (_m *MockQueries) GetStores(ctx context.Context, query queries.GetStores) (
 	return r0, r1
 }
 
+// GetStoresDueForSchedule provides a mock function with given fields: ctx, query
+func (_m *MockQueries) GetStoresDueForSchedule(ctx context.Context, query queries.GetStoresDueForSchedule) ([]*domain.MallStore, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*domain.MallStore
+	if rf, ok := ret.Get(0).(func(context.Context, queries.GetStoresDueForSchedule) []*domain.MallStore); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.MallStore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queries.GetStoresDueForSchedule) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockQueries interface {
 	mock.TestingT
 	Cleanup(func())
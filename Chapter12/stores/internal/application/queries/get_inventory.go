@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"context"
+
+	"eda-in-golang/stores/internal/domain"
+)
+
+type GetInventory struct {
+	ProductID string
+}
+
+type GetInventoryHandler struct {
+	inventory domain.InventoryRepository
+}
+
+func NewGetInventoryHandler(inventory domain.InventoryRepository) GetInventoryHandler {
+	return GetInventoryHandler{inventory: inventory}
+}
+
+func (h GetInventoryHandler) GetInventory(ctx context.Context, query GetInventory) (*domain.Inventory, error) {
+	return h.inventory.Load(ctx, query.ProductID)
+}
@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"context"
+
+	"eda-in-golang/stores/internal/domain"
+)
+
+type GetProductPriceHistory struct {
+	ID string
+}
+
+type GetProductPriceHistoryHandler struct {
+	priceHistory domain.ProductPriceHistoryRepository
+}
+
+func NewGetProductPriceHistoryHandler(priceHistory domain.ProductPriceHistoryRepository) GetProductPriceHistoryHandler {
+	return GetProductPriceHistoryHandler{priceHistory: priceHistory}
+}
+
+func (h GetProductPriceHistoryHandler) GetProductPriceHistory(ctx context.Context, query GetProductPriceHistory) (*domain.ProductPriceHistory, error) {
+	return h.priceHistory.Load(ctx, query.ID)
+}
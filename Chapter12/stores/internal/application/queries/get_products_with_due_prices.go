@@ -0,0 +1,26 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/stores/internal/domain"
+)
+
+// GetProductsWithDuePrices finds the products with a scheduled price to put
+// into effect, or a sale to end, by At
+type GetProductsWithDuePrices struct {
+	At time.Time
+}
+
+type GetProductsWithDuePricesHandler struct {
+	catalog domain.CatalogRepository
+}
+
+func NewGetProductsWithDuePricesHandler(catalog domain.CatalogRepository) GetProductsWithDuePricesHandler {
+	return GetProductsWithDuePricesHandler{catalog: catalog}
+}
+
+func (h GetProductsWithDuePricesHandler) GetProductsWithDuePrices(ctx context.Context, query GetProductsWithDuePrices) ([]string, error) {
+	return h.catalog.AllWithDuePrices(ctx, query.At)
+}
@@ -0,0 +1,38 @@
+package queries
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/stores/internal/domain"
+)
+
+// GetStoresDueForSchedule finds the scheduled stores whose participation no
+// longer matches their schedule at At
+type GetStoresDueForSchedule struct {
+	At time.Time
+}
+
+type GetStoresDueForScheduleHandler struct {
+	mall domain.MallRepository
+}
+
+func NewGetStoresDueForScheduleHandler(mall domain.MallRepository) GetStoresDueForScheduleHandler {
+	return GetStoresDueForScheduleHandler{mall: mall}
+}
+
+func (h GetStoresDueForScheduleHandler) GetStoresDueForSchedule(ctx context.Context, query GetStoresDueForSchedule) ([]*domain.MallStore, error) {
+	scheduled, err := h.mall.AllScheduled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]*domain.MallStore, 0, len(scheduled))
+	for _, store := range scheduled {
+		if store.Schedule.IsOpen(query.At) != store.Participating {
+			due = append(due, store)
+		}
+	}
+
+	return due, nil
+}
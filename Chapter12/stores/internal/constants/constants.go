@@ -1,5 +1,9 @@
 package constants
 
+import (
+	"time"
+)
+
 // ServiceName The name of this module/service
 const ServiceName = "stores"
 
@@ -31,10 +35,12 @@ const (
 	CatalogHandlersKey = "catalogHandlers"
 	MallHandlersKey    = "mallHandlers"
 
-	StoresRepoKey   = "storesRepo"
-	ProductsRepoKey = "productsRepo"
-	CatalogRepoKey  = "catalogRepo"
-	MallRepoKey     = "mallRepo"
+	StoresRepoKey       = "storesRepo"
+	ProductsRepoKey     = "productsRepo"
+	InventoryRepoKey    = "inventoryRepo"
+	CatalogRepoKey      = "catalogRepo"
+	MallRepoKey         = "mallRepo"
+	PriceHistoryRepoKey = "priceHistoryRepo"
 )
 
 // Repository Table Names
@@ -48,3 +54,16 @@ const (
 	CatalogTableName = ServiceName + ".products"
 	MallTableName    = ServiceName + ".stores"
 )
+
+// Store schedules
+const (
+	// StoreSchedulerInterval is how often the scheduled stores are opened and closed
+	StoreSchedulerInterval = time.Minute
+)
+
+// Scheduled prices
+const (
+	// PriceSchedulerInterval is how often the scheduled prices are put into
+	// effect and the ended sales reverted
+	PriceSchedulerInterval = time.Minute
+)
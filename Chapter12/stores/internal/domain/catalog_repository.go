@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 type CatalogProduct struct {
@@ -11,13 +12,26 @@ type CatalogProduct struct {
 	Description string
 	SKU         string
 	Price       float64
+	Category    string
+	Tags        []string
+	Variants    []ProductVariant
+	// ScheduledPrices are the prices yet to take effect and the sales that
+	// are in effect
+	ScheduledPrices ScheduledPrices
 }
 
 type CatalogRepository interface {
 	AddProduct(ctx context.Context, productID, storeID, name, description, sku string, price float64) error
 	Rebrand(ctx context.Context, productID, name, description string) error
 	UpdatePrice(ctx context.Context, productID string, delta float64) error
+	Categorize(ctx context.Context, productID, category string, tags []string) error
+	AddVariant(ctx context.Context, productID string, variant ProductVariant) error
+	RemoveVariant(ctx context.Context, productID, variantID string) error
+	UpdateScheduledPrices(ctx context.Context, productID string, price float64, scheduledPrices ScheduledPrices) error
 	RemoveProduct(ctx context.Context, productID string) error
 	Find(ctx context.Context, productID string) (*CatalogProduct, error)
 	GetCatalog(ctx context.Context, storeID string) ([]*CatalogProduct, error)
+	// AllWithDuePrices returns the ids of the products with a scheduled price
+	// or sale end due by the time
+	AllWithDuePrices(ctx context.Context, at time.Time) ([]string, error)
 }
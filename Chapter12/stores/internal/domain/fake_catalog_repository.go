@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 type FakeCatalogRepository struct {
@@ -31,6 +32,26 @@ func (r *FakeCatalogRepository) UpdatePrice(ctx context.Context, productID strin
 	panic("implement me")
 }
 
+func (r *FakeCatalogRepository) Categorize(ctx context.Context, productID, category string, tags []string) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (r *FakeCatalogRepository) AddVariant(ctx context.Context, productID string, variant ProductVariant) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (r *FakeCatalogRepository) RemoveVariant(ctx context.Context, productID, variantID string) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (r *FakeCatalogRepository) UpdateScheduledPrices(ctx context.Context, productID string, price float64, scheduledPrices ScheduledPrices) error {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (r *FakeCatalogRepository) RemoveProduct(ctx context.Context, productID string) error {
 	// TODO implement me
 	panic("implement me")
@@ -45,3 +66,8 @@ func (r *FakeCatalogRepository) GetCatalog(ctx context.Context, storeID string)
 	// TODO implement me
 	panic("implement me")
 }
+
+func (r *FakeCatalogRepository) AllWithDuePrices(ctx context.Context, at time.Time) ([]string, error) {
+	// TODO implement me
+	panic("implement me")
+}
@@ -31,6 +31,11 @@ func (r *FakeMallRepository) RenameStore(ctx context.Context, storeID, name stri
 	panic("implement me")
 }
 
+func (r *FakeMallRepository) SetStoreSchedule(ctx context.Context, storeID string, schedule StoreSchedule) error {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (r *FakeMallRepository) Find(ctx context.Context, storeID string) (*MallStore, error) {
 	// TODO implement me
 	panic("implement me")
@@ -45,3 +50,8 @@ func (r *FakeMallRepository) AllParticipating(ctx context.Context) ([]*MallStore
 	// TODO implement me
 	panic("implement me")
 }
+
+func (r *FakeMallRepository) AllScheduled(ctx context.Context) ([]*MallStore, error) {
+	// TODO implement me
+	panic("implement me")
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/es"
+)
+
+const InventoryAggregate = "stores.Inventory"
+
+var (
+	ErrInventoryQuantityIsNotPositive = errors.Wrap(errors.ErrBadRequest, "the quantity must be greater than zero")
+	ErrInventoryCountIsNegative       = errors.Wrap(errors.ErrBadRequest, "the counted stock cannot be negative")
+	ErrInventoryCountIsBelowReserved  = errors.Wrap(errors.ErrBadRequest, "the counted stock cannot be below the reserved stock")
+	ErrInsufficientStock              = errors.Wrap(errors.ErrFailedPrecondition, "there is not enough stock available")
+	ErrReservationAlreadyExists       = errors.Wrap(errors.ErrConflict, "the stock has already been reserved")
+	ErrReservationNotFound            = errors.Wrap(errors.ErrNotFound, "the stock reservation does not exist")
+)
+
+// Inventory is the stock of a single product; it shares its ID with the product
+//
+// Reserved stock remains on hand until the reservation is either released
+// back or committed, which takes it off the shelf for good
+//
+// Counting stock is opt-in; the stock of a product is only tracked once it has
+// been received or adjusted, until then it is unlimited
+type Inventory struct {
+	es.Aggregate
+	OnHand       int
+	Reservations map[string]int
+}
+
+var _ interface {
+	es.EventApplier
+	es.Snapshotter
+} = (*Inventory)(nil)
+
+func NewInventory(id string) *Inventory {
+	return &Inventory{
+		Aggregate:    es.NewAggregate(id, InventoryAggregate),
+		Reservations: make(map[string]int),
+	}
+}
+
+// Key implements registry.Registerable
+func (Inventory) Key() string { return InventoryAggregate }
+
+// IsTracked tells whether the stock of the product is counted
+func (i Inventory) IsTracked() bool {
+	return i.PendingVersion() > 0
+}
+
+// Reserved is the stock held for reservations
+func (i Inventory) Reserved() int {
+	reserved := 0
+	for _, quantity := range i.Reservations {
+		reserved += quantity
+	}
+	return reserved
+}
+
+// Available is the stock that can still be reserved
+func (i Inventory) Available() int {
+	return i.OnHand - i.Reserved()
+}
+
+func (i *Inventory) Receive(quantity int) (ddd.Event, error) {
+	if quantity <= 0 {
+		return nil, ErrInventoryQuantityIsNotPositive
+	}
+
+	i.AddEvent(InventoryReceivedEvent, &InventoryReceived{
+		Quantity: quantity,
+	})
+
+	return ddd.NewEvent(InventoryReceivedEvent, i), nil
+}
+
+// Adjust corrects the stock on hand to the counted amount
+func (i *Inventory) Adjust(count int, reason string) (ddd.Event, error) {
+	if count < 0 {
+		return nil, ErrInventoryCountIsNegative
+	}
+
+	if count < i.Reserved() {
+		return nil, ErrInventoryCountIsBelowReserved
+	}
+
+	i.AddEvent(InventoryAdjustedEvent, &InventoryAdjusted{
+		Delta:  count - i.OnHand,
+		Reason: reason,
+	})
+
+	return ddd.NewEvent(InventoryAdjustedEvent, i), nil
+}
+
+// Reserve holds the stock for the reservation; a nil event is returned when the
+// stock is not tracked
+func (i *Inventory) Reserve(reservationID string, quantity int) (ddd.Event, error) {
+	if quantity <= 0 {
+		return nil, ErrInventoryQuantityIsNotPositive
+	}
+
+	if !i.IsTracked() {
+		return nil, nil
+	}
+
+	if _, exists := i.Reservations[reservationID]; exists {
+		return nil, ErrReservationAlreadyExists
+	}
+
+	if quantity > i.Available() {
+		return nil, ErrInsufficientStock
+	}
+
+	i.AddEvent(InventoryReservedEvent, &InventoryReserved{
+		ReservationID: reservationID,
+		Quantity:      quantity,
+	})
+
+	return ddd.NewEvent(InventoryReservedEvent, i), nil
+}
+
+// Release returns the reserved stock; releasing a reservation that does not
+// exist does nothing so that compensations may always be applied
+func (i *Inventory) Release(reservationID string) (ddd.Event, error) {
+	quantity, exists := i.Reservations[reservationID]
+	if !exists {
+		return nil, nil
+	}
+
+	i.AddEvent(InventoryReleasedEvent, &InventoryReleased{
+		ReservationID: reservationID,
+		Quantity:      quantity,
+	})
+
+	return ddd.NewEvent(InventoryReleasedEvent, i), nil
+}
+
+// Commit takes the reserved stock off the shelf; a nil event is returned when
+// the stock is not tracked
+func (i *Inventory) Commit(reservationID string) (ddd.Event, error) {
+	if !i.IsTracked() {
+		return nil, nil
+	}
+
+	quantity, exists := i.Reservations[reservationID]
+	if !exists {
+		return nil, ErrReservationNotFound
+	}
+
+	i.AddEvent(InventoryCommittedEvent, &InventoryCommitted{
+		ReservationID: reservationID,
+		Quantity:      quantity,
+	})
+
+	return ddd.NewEvent(InventoryCommittedEvent, i), nil
+}
+
+func (i *Inventory) ApplyEvent(event ddd.Event) error {
+	switch payload := event.Payload().(type) {
+	case *InventoryReceived:
+		i.OnHand += payload.Quantity
+
+	case *InventoryAdjusted:
+		i.OnHand += payload.Delta
+
+	case *InventoryReserved:
+		i.Reservations[payload.ReservationID] = payload.Quantity
+
+	case *InventoryReleased:
+		delete(i.Reservations, payload.ReservationID)
+
+	case *InventoryCommitted:
+		delete(i.Reservations, payload.ReservationID)
+		i.OnHand -= payload.Quantity
+
+	default:
+		return errors.ErrInternal.Msgf("%T received the event %s with unexpected payload %T", i, event.EventName(), payload)
+	}
+
+	return nil
+}
+
+func (i *Inventory) ApplySnapshot(snapshot es.Snapshot) error {
+	switch ss := snapshot.(type) {
+	case *InventoryV1:
+		i.OnHand = ss.OnHand
+		i.Reservations = make(map[string]int, len(ss.Reservations))
+		for reservationID, quantity := range ss.Reservations {
+			i.Reservations[reservationID] = quantity
+		}
+
+	default:
+		return errors.ErrInternal.Msgf("%T received the unexpected snapshot %T", i, snapshot)
+	}
+
+	return nil
+}
+
+func (i Inventory) ToSnapshot() es.Snapshot {
+	reservations := make(map[string]int, len(i.Reservations))
+	for reservationID, quantity := range i.Reservations {
+		reservations[reservationID] = quantity
+	}
+
+	return InventoryV1{
+		OnHand:       i.OnHand,
+		Reservations: reservations,
+	}
+}
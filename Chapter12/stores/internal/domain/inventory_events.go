@@ -0,0 +1,48 @@
+package domain
+
+const (
+	InventoryReceivedEvent  = "stores.InventoryReceived"
+	InventoryAdjustedEvent  = "stores.InventoryAdjusted"
+	InventoryReservedEvent  = "stores.InventoryReserved"
+	InventoryReleasedEvent  = "stores.InventoryReleased"
+	InventoryCommittedEvent = "stores.InventoryCommitted"
+)
+
+type InventoryReceived struct {
+	Quantity int
+}
+
+// Key implements registry.Registerable
+func (InventoryReceived) Key() string { return InventoryReceivedEvent }
+
+type InventoryAdjusted struct {
+	Delta  int
+	Reason string
+}
+
+// Key implements registry.Registerable
+func (InventoryAdjusted) Key() string { return InventoryAdjustedEvent }
+
+type InventoryReserved struct {
+	ReservationID string
+	Quantity      int
+}
+
+// Key implements registry.Registerable
+func (InventoryReserved) Key() string { return InventoryReservedEvent }
+
+type InventoryReleased struct {
+	ReservationID string
+	Quantity      int
+}
+
+// Key implements registry.Registerable
+func (InventoryReleased) Key() string { return InventoryReleasedEvent }
+
+type InventoryCommitted struct {
+	ReservationID string
+	Quantity      int
+}
+
+// Key implements registry.Registerable
+func (InventoryCommitted) Key() string { return InventoryCommittedEvent }
@@ -0,0 +1,10 @@
+package domain
+
+import (
+	"context"
+)
+
+type InventoryRepository interface {
+	Load(ctx context.Context, productID string) (*Inventory, error)
+	Save(ctx context.Context, inventory *Inventory) error
+}
@@ -0,0 +1,8 @@
+package domain
+
+type InventoryV1 struct {
+	OnHand       int
+	Reservations map[string]int
+}
+
+func (InventoryV1) SnapshotName() string { return "stores.InventoryV1" }
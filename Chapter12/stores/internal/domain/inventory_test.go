@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventory_Reserve(t *testing.T) {
+	tests := map[string]struct {
+		inventory     func(t *testing.T) *Inventory
+		reservationID string
+		quantity      int
+		wantEvent     bool
+		wantErr       error
+	}{
+		"Available": {
+			inventory:     stockedInventory(10),
+			reservationID: "order-id",
+			quantity:      4,
+			wantEvent:     true,
+		},
+		"AllAvailable": {
+			inventory:     stockedInventory(10, "other-id", 6),
+			reservationID: "order-id",
+			quantity:      4,
+			wantEvent:     true,
+		},
+		"Insufficient": {
+			inventory:     stockedInventory(10, "other-id", 6),
+			reservationID: "order-id",
+			quantity:      5,
+			wantErr:       ErrInsufficientStock,
+		},
+		"NoneOnHand": {
+			inventory:     stockedInventory(0),
+			reservationID: "order-id",
+			quantity:      1,
+			wantErr:       ErrInsufficientStock,
+		},
+		"AlreadyReserved": {
+			inventory:     stockedInventory(10, "order-id", 1),
+			reservationID: "order-id",
+			quantity:      1,
+			wantErr:       ErrReservationAlreadyExists,
+		},
+		"NoQuantity": {
+			inventory:     stockedInventory(10),
+			reservationID: "order-id",
+			wantErr:       ErrInventoryQuantityIsNotPositive,
+		},
+		"Untracked": {
+			inventory:     untrackedInventory,
+			reservationID: "order-id",
+			quantity:      100,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inventory := tc.inventory(t)
+			reserved := inventory.Reserved()
+
+			event, err := inventory.Reserve(tc.reservationID, tc.quantity)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, inventory.Events())
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			if !tc.wantEvent {
+				assert.Nil(t, event)
+				assert.Empty(t, inventory.Events())
+				return
+			}
+			assert.Equal(t, InventoryReservedEvent, event.EventName())
+			commitInventory(t, inventory)
+
+			assert.Equal(t, reserved+tc.quantity, inventory.Reserved())
+			assert.Equal(t, tc.quantity, inventory.Reservations[tc.reservationID])
+		})
+	}
+}
+
+func TestInventory_Release(t *testing.T) {
+	inventory := stockedInventory(10, "order-id", 4)(t)
+
+	// releasing what was never reserved is allowed so compensations may run
+	event, err := inventory.Release("other-id")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+
+	event, err = inventory.Release("order-id")
+	if assert.NoError(t, err) {
+		assert.Equal(t, InventoryReleasedEvent, event.EventName())
+	}
+	commitInventory(t, inventory)
+
+	assert.Equal(t, 10, inventory.OnHand)
+	assert.Equal(t, 10, inventory.Available())
+	assert.Empty(t, inventory.Reservations)
+
+	// untracked stock has nothing to release
+	untracked := untrackedInventory(t)
+	event, err = untracked.Release("order-id")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+	assert.False(t, untracked.IsTracked())
+}
+
+func TestInventory_Commit(t *testing.T) {
+	inventory := stockedInventory(10, "order-id", 4)(t)
+
+	_, err := inventory.Commit("other-id")
+	assert.ErrorIs(t, err, ErrReservationNotFound)
+
+	event, err := inventory.Commit("order-id")
+	if assert.NoError(t, err) {
+		assert.Equal(t, InventoryCommittedEvent, event.EventName())
+	}
+	commitInventory(t, inventory)
+
+	assert.Equal(t, 6, inventory.OnHand)
+	assert.Equal(t, 6, inventory.Available())
+	assert.Empty(t, inventory.Reservations)
+
+	// untracked stock is never short, nor is any of it taken off the shelf
+	untracked := untrackedInventory(t)
+	event, err = untracked.Commit("order-id")
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+	assert.False(t, untracked.IsTracked())
+}
+
+func TestInventory_Adjust(t *testing.T) {
+	tests := map[string]struct {
+		inventory  func(t *testing.T) *Inventory
+		count      int
+		wantOnHand int
+		wantErr    error
+	}{
+		"Lower": {
+			inventory:  stockedInventory(10, "order-id", 4),
+			count:      7,
+			wantOnHand: 7,
+		},
+		"Higher": {
+			inventory:  stockedInventory(10),
+			count:      12,
+			wantOnHand: 12,
+		},
+		"ToReserved": {
+			inventory:  stockedInventory(10, "order-id", 4),
+			count:      4,
+			wantOnHand: 4,
+		},
+		"BelowReserved": {
+			inventory: stockedInventory(10, "order-id", 4),
+			count:     3,
+			wantErr:   ErrInventoryCountIsBelowReserved,
+		},
+		"Negative": {
+			inventory: stockedInventory(10),
+			count:     -1,
+			wantErr:   ErrInventoryCountIsNegative,
+		},
+		// counting the stock of a product starts tracking it
+		"Untracked": {
+			inventory:  untrackedInventory,
+			count:      0,
+			wantOnHand: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inventory := tc.inventory(t)
+
+			event, err := inventory.Adjust(tc.count, "counted")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, inventory.Events())
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, InventoryAdjustedEvent, event.EventName())
+			}
+			commitInventory(t, inventory)
+
+			assert.Equal(t, tc.wantOnHand, inventory.OnHand)
+			assert.True(t, inventory.IsTracked())
+		})
+	}
+}
+
+func untrackedInventory(t *testing.T) *Inventory {
+	return NewInventory("product-id")
+}
+
+// stockedInventory receives the stock on hand and then reserves each
+// reservation id and quantity pair given
+func stockedInventory(onHand int, reservations ...any) func(t *testing.T) *Inventory {
+	return func(t *testing.T) *Inventory {
+		t.Helper()
+
+		inventory := NewInventory("product-id")
+		var err error
+		if onHand > 0 {
+			_, err = inventory.Receive(onHand)
+		} else {
+			_, err = inventory.Adjust(onHand, "counted")
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitInventory(t, inventory)
+
+		for i := 0; i < len(reservations); i += 2 {
+			if _, err = inventory.Reserve(reservations[i].(string), reservations[i+1].(int)); err != nil {
+				t.Fatal(err)
+			}
+			commitInventory(t, inventory)
+		}
+
+		return inventory
+	}
+}
+
+func commitInventory(t *testing.T, inventory *Inventory) {
+	t.Helper()
+
+	for _, event := range inventory.Events() {
+		if err := inventory.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	inventory.CommitEvents()
+}
@@ -9,13 +9,16 @@ type MallStore struct {
 	Name          string
 	Location      string
 	Participating bool
+	Schedule      StoreSchedule
 }
 
 type MallRepository interface {
 	AddStore(ctx context.Context, storeID, name, location string) error
 	SetStoreParticipation(ctx context.Context, storeID string, participating bool) error
 	RenameStore(ctx context.Context, storeID, name string) error
+	SetStoreSchedule(ctx context.Context, storeID string, schedule StoreSchedule) error
 	Find(ctx context.Context, storeID string) (*MallStore, error)
 	All(ctx context.Context) ([]*MallStore, error)
 	AllParticipating(ctx context.Context) ([]*MallStore, error)
+	AllScheduled(ctx context.Context) ([]*MallStore, error)
 }
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockCatalogRepository is an autogenerated mock type for the CatalogRepository type
@@ -27,6 +29,57 @@ func (_m *MockCatalogRepository) AddProduct(ctx context.Context, productID strin
 	return r0
 }
 
+// AddVariant provides a mock function with given fields: ctx, productID, variant
+func (_m *MockCatalogRepository) AddVariant(ctx context.Context, productID string, variant ProductVariant) error {
+	ret := _m.Called(ctx, productID, variant)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ProductVariant) error); ok {
+		r0 = rf(ctx, productID, variant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AllWithDuePrices provides a mock function with given fields: ctx, at
+func (_m *MockCatalogRepository) AllWithDuePrices(ctx context.Context, at time.Time) ([]string, error) {
+	ret := _m.Called(ctx, at)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Categorize provides a mock function with given fields: ctx, productID, category, tags
+func (_m *MockCatalogRepository) Categorize(ctx context.Context, productID string, category string, tags []string) error {
+	ret := _m.Called(ctx, productID, category, tags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, productID, category, tags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Find provides a mock function with given fields: ctx, productID
 func (_m *MockCatalogRepository) Find(ctx context.Context, productID string) (*CatalogProduct, error) {
 	ret := _m.Called(ctx, productID)
@@ -101,6 +154,20 @@ func (_m *MockCatalogRepository) RemoveProduct(ctx context.Context, productID st
 	return r0
 }
 
+// RemoveVariant provides a mock function with given fields: ctx, productID, variantID
+func (_m *MockCatalogRepository) RemoveVariant(ctx context.Context, productID string, variantID string) error {
+	ret := _m.Called(ctx, productID, variantID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, productID, variantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePrice provides a mock function with given fields: ctx, productID, delta
 func (_m *MockCatalogRepository) UpdatePrice(ctx context.Context, productID string, delta float64) error {
 	ret := _m.Called(ctx, productID, delta)
@@ -115,6 +182,20 @@ func (_m *MockCatalogRepository) UpdatePrice(ctx context.Context, productID stri
 	return r0
 }
 
+// UpdateScheduledPrices provides a mock function with given fields: ctx, productID, price, scheduledPrices
+func (_m *MockCatalogRepository) UpdateScheduledPrices(ctx context.Context, productID string, price float64, scheduledPrices ScheduledPrices) error {
+	ret := _m.Called(ctx, productID, price, scheduledPrices)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, ScheduledPrices) error); ok {
+		r0 = rf(ctx, productID, price, scheduledPrices)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockCatalogRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -73,6 +73,29 @@ func (_m *MockMallRepository) AllParticipating(ctx context.Context) ([]*MallStor
 	return r0, r1
 }
 
+// AllScheduled provides a mock function with given fields: ctx
+func (_m *MockMallRepository) AllScheduled(ctx context.Context) ([]*MallStore, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*MallStore
+	if rf, ok := ret.Get(0).(func(context.Context) []*MallStore); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MallStore)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Find provides a mock function with given fields: ctx, storeID
 func (_m *MockMallRepository) Find(ctx context.Context, storeID string) (*MallStore, error) {
 	ret := _m.Called(ctx, storeID)
@@ -124,6 +147,20 @@ func (_m *MockMallRepository) SetStoreParticipation(ctx context.Context, storeID
 	return r0
 }
 
+// SetStoreSchedule provides a mock function with given fields: ctx, storeID, schedule
+func (_m *MockMallRepository) SetStoreSchedule(ctx context.Context, storeID string, schedule StoreSchedule) error {
+	ret := _m.Called(ctx, storeID, schedule)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, StoreSchedule) error); ok {
+		r0 = rf(ctx, storeID, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMockMallRepository interface {
 	mock.TestingT
 	Cleanup(func())
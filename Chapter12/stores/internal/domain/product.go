@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/ddd"
@@ -23,6 +27,12 @@ type Product struct {
 	Description string
 	SKU         string
 	Price       float64
+	Category    string
+	Tags        []string
+	Variants    []ProductVariant
+	// ScheduledPrices are the prices yet to take effect and the sales that
+	// are in effect
+	ScheduledPrices ScheduledPrices
 }
 
 var _ interface {
@@ -78,7 +88,7 @@ func (p *Product) IncreasePrice(price float64) (ddd.Event, error) {
 		Delta: delta,
 	})
 
-	return ddd.NewEvent(ProductPriceIncreasedEvent, ProductPriceDelta{
+	return ddd.NewEvent(ProductPriceIncreasedEvent, &ProductPriceDelta{
 		Product: p,
 		Delta:   delta,
 	}), nil
@@ -94,12 +104,193 @@ func (p *Product) DecreasePrice(price float64) (ddd.Event, error) {
 		Delta: delta,
 	})
 
-	return ddd.NewEvent(ProductPriceDecreasedEvent, ProductPriceDelta{
+	return ddd.NewEvent(ProductPriceDecreasedEvent, &ProductPriceDelta{
 		Product: p,
 		Delta:   delta,
 	}), nil
 }
 
+// SchedulePrice sets a price the product will switch to later; now is the
+// current time, which the price must take effect after
+func (p *Product) SchedulePrice(scheduledPrice ScheduledPrice, now time.Time) (ddd.Event, error) {
+	if err := scheduledPrice.validate(now); err != nil {
+		return nil, err
+	}
+
+	for _, existing := range p.ScheduledPrices {
+		if existing.overlaps(scheduledPrice) {
+			return nil, errors.Wrapf(ErrScheduledPriceConflicts, "scheduled price: %s", existing.ID)
+		}
+	}
+
+	scheduledPrice.Active = false
+	scheduledPrice.RevertPrice = 0
+
+	p.AddEvent(ProductPriceScheduledEvent, &ProductPriceScheduled{
+		ScheduledPrice: scheduledPrice,
+	})
+
+	return ddd.NewEvent(ProductPriceScheduledEvent, &ProductScheduledPriceChange{
+		Product:        p,
+		ScheduledPrice: scheduledPrice,
+		Price:          p.Price,
+	}), nil
+}
+
+// CancelScheduledPrice drops a scheduled price before it takes effect
+func (p *Product) CancelScheduledPrice(scheduledPriceID string) (ddd.Event, error) {
+	i, exists := p.ScheduledPrices.find(scheduledPriceID)
+	if !exists {
+		return nil, errors.Wrapf(ErrScheduledPriceNotFound, "scheduled price: %s", scheduledPriceID)
+	}
+
+	scheduledPrice := p.ScheduledPrices[i]
+	if scheduledPrice.Active {
+		return nil, errors.Wrapf(ErrScheduledPriceIsActive, "scheduled price: %s", scheduledPriceID)
+	}
+
+	p.AddEvent(ProductScheduledPriceCanceledEvent, &ProductScheduledPriceCanceled{
+		ScheduledPriceID: scheduledPriceID,
+	})
+
+	return ddd.NewEvent(ProductScheduledPriceCanceledEvent, &ProductScheduledPriceChange{
+		Product:        p,
+		ScheduledPrice: scheduledPrice,
+		Price:          p.Price,
+	}), nil
+}
+
+// ApplyScheduledPrices puts the scheduled prices that are due by the time into
+// effect and reverts the sales that have ended, in the order they happened; a
+// sale reverts to the price the product had when the sale started
+func (p *Product) ApplyScheduledPrices(at time.Time) []ddd.Event {
+	type boundary struct {
+		at    time.Time
+		index int
+		ends  bool
+	}
+
+	var boundaries []boundary
+	for i, sp := range p.ScheduledPrices {
+		if !sp.Active && !sp.EffectiveFrom.After(at) {
+			boundaries = append(boundaries, boundary{at: sp.EffectiveFrom, index: i})
+		}
+		if sp.IsSale() && !sp.EffectiveUntil.After(at) {
+			boundaries = append(boundaries, boundary{at: sp.EffectiveUntil, index: i, ends: true})
+		}
+	}
+
+	// a sale that ends at the moment another price starts is reverted first
+	sort.SliceStable(boundaries, func(i, j int) bool {
+		if boundaries[i].at.Equal(boundaries[j].at) {
+			return boundaries[i].ends && !boundaries[j].ends
+		}
+		return boundaries[i].at.Before(boundaries[j].at)
+	})
+
+	price := p.Price
+	revertPrices := make(map[int]float64)
+	events := make([]ddd.Event, 0, len(boundaries))
+	for _, b := range boundaries {
+		scheduledPrice := p.ScheduledPrices[b.index]
+
+		if b.ends {
+			revertPrice, started := revertPrices[b.index]
+			if !started {
+				revertPrice = scheduledPrice.RevertPrice
+			}
+			delta := revertPrice - price
+			price = revertPrice
+
+			p.AddEvent(ProductScheduledPriceRevertedEvent, &ProductScheduledPriceReverted{
+				ScheduledPriceID: scheduledPrice.ID,
+				Delta:            delta,
+			})
+			events = append(events, ddd.NewEvent(ProductScheduledPriceRevertedEvent, &ProductScheduledPriceChange{
+				Product:        p,
+				ScheduledPrice: scheduledPrice,
+				Price:          price,
+				Delta:          delta,
+			}))
+			continue
+		}
+
+		revertPrices[b.index] = price
+		delta := scheduledPrice.Price - price
+		price = scheduledPrice.Price
+
+		p.AddEvent(ProductScheduledPriceActivatedEvent, &ProductScheduledPriceActivated{
+			ScheduledPriceID: scheduledPrice.ID,
+			Delta:            delta,
+		})
+		events = append(events, ddd.NewEvent(ProductScheduledPriceActivatedEvent, &ProductScheduledPriceChange{
+			Product:        p,
+			ScheduledPrice: scheduledPrice,
+			Price:          price,
+			Delta:          delta,
+		}))
+	}
+
+	return events
+}
+
+// Categorize files the product under a category and replaces its tags
+func (p *Product) Categorize(category string, tags []string) (ddd.Event, error) {
+	p.AddEvent(ProductCategorizedEvent, &ProductCategorized{
+		Category: strings.TrimSpace(category),
+		Tags:     normalizeTags(tags),
+	})
+
+	return ddd.NewEvent(ProductCategorizedEvent, p), nil
+}
+
+func (p *Product) AddVariant(variant ProductVariant) (ddd.Event, error) {
+	if err := variant.validate(); err != nil {
+		return nil, err
+	}
+
+	for _, existing := range p.Variants {
+		if existing.conflicts(variant) {
+			return nil, errors.Wrapf(ErrProductVariantAlreadyExists, "sku: %s", variant.SKU)
+		}
+	}
+
+	p.AddEvent(ProductVariantAddedEvent, &ProductVariantAdded{
+		Variant: variant,
+	})
+
+	return ddd.NewEvent(ProductVariantAddedEvent, &ProductVariantChange{
+		Product: p,
+		Variant: variant,
+	}), nil
+}
+
+func (p *Product) RemoveVariant(variantID string) (ddd.Event, error) {
+	variant, exists := p.Variant(variantID)
+	if !exists {
+		return nil, errors.Wrapf(ErrProductVariantNotFound, "variant: %s", variantID)
+	}
+
+	p.AddEvent(ProductVariantRemovedEvent, &ProductVariantRemoved{
+		VariantID: variantID,
+	})
+
+	return ddd.NewEvent(ProductVariantRemovedEvent, &ProductVariantChange{
+		Product: p,
+		Variant: variant,
+	}), nil
+}
+
+func (p Product) Variant(variantID string) (ProductVariant, bool) {
+	for _, variant := range p.Variants {
+		if variant.ID == variantID {
+			return variant, true
+		}
+	}
+
+	return ProductVariant{}, false
+}
+
 func (p *Product) Remove() (ddd.Event, error) {
 	p.AddEvent(ProductRemovedEvent, &ProductRemoved{})
 
@@ -122,6 +313,43 @@ func (p *Product) ApplyEvent(event ddd.Event) error {
 	case *ProductPriceChanged:
 		p.Price = p.Price + payload.Delta
 
+	case *ProductCategorized:
+		p.Category = payload.Category
+		p.Tags = payload.Tags
+
+	case *ProductVariantAdded:
+		p.Variants = append(p.Variants, payload.Variant)
+
+	case *ProductVariantRemoved:
+		variants := make([]ProductVariant, 0, len(p.Variants))
+		for _, variant := range p.Variants {
+			if variant.ID != payload.VariantID {
+				variants = append(variants, variant)
+			}
+		}
+		p.Variants = variants
+
+	case *ProductPriceScheduled:
+		p.ScheduledPrices = append(p.ScheduledPrices, payload.ScheduledPrice)
+
+	case *ProductScheduledPriceCanceled:
+		p.ScheduledPrices = p.ScheduledPrices.without(payload.ScheduledPriceID)
+
+	case *ProductScheduledPriceActivated:
+		if i, exists := p.ScheduledPrices.find(payload.ScheduledPriceID); exists {
+			if p.ScheduledPrices[i].IsSale() {
+				p.ScheduledPrices[i].Active = true
+				p.ScheduledPrices[i].RevertPrice = p.Price
+			} else {
+				p.ScheduledPrices = p.ScheduledPrices.without(payload.ScheduledPriceID)
+			}
+		}
+		p.Price = p.Price + payload.Delta
+
+	case *ProductScheduledPriceReverted:
+		p.ScheduledPrices = p.ScheduledPrices.without(payload.ScheduledPriceID)
+		p.Price = p.Price + payload.Delta
+
 	case *ProductRemoved:
 		// noop
 
@@ -140,6 +368,10 @@ func (p *Product) ApplySnapshot(snapshot es.Snapshot) error {
 		p.Description = ss.Description
 		p.SKU = ss.SKU
 		p.Price = ss.Price
+		p.Category = ss.Category
+		p.Tags = ss.Tags
+		p.Variants = ss.Variants
+		p.ScheduledPrices = ss.ScheduledPrices
 
 	default:
 		return errors.ErrInternal.Msgf("%T received the unexpected snapshot %T", p, snapshot)
@@ -150,10 +382,14 @@ func (p *Product) ApplySnapshot(snapshot es.Snapshot) error {
 
 func (p Product) ToSnapshot() es.Snapshot {
 	return ProductV1{
-		StoreID:     p.StoreID,
-		Name:        p.Name,
-		Description: p.Description,
-		SKU:         p.SKU,
-		Price:       p.Price,
+		StoreID:         p.StoreID,
+		Name:            p.Name,
+		Description:     p.Description,
+		SKU:             p.SKU,
+		Price:           p.Price,
+		Category:        p.Category,
+		Tags:            p.Tags,
+		Variants:        p.Variants,
+		ScheduledPrices: p.ScheduledPrices,
 	}
 }
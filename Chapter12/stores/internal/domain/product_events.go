@@ -6,6 +6,14 @@ const (
 	ProductPriceIncreasedEvent = "stores.ProductPriceIncreased"
 	ProductPriceDecreasedEvent = "stores.ProductPriceDecreased"
 	ProductRemovedEvent        = "stores.ProductRemoved"
+	ProductCategorizedEvent    = "stores.ProductCategorized"
+	ProductVariantAddedEvent   = "stores.ProductVariantAdded"
+	ProductVariantRemovedEvent = "stores.ProductVariantRemoved"
+
+	ProductPriceScheduledEvent          = "stores.ProductPriceScheduled"
+	ProductScheduledPriceCanceledEvent  = "stores.ProductScheduledPriceCanceled"
+	ProductScheduledPriceActivatedEvent = "stores.ProductScheduledPriceActivated"
+	ProductScheduledPriceRevertedEvent  = "stores.ProductScheduledPriceReverted"
 )
 
 type ProductAdded struct {
@@ -36,9 +44,76 @@ type ProductRemoved struct{}
 // Key implements registry.Registerable
 func (ProductRemoved) Key() string { return ProductRemovedEvent }
 
+type ProductCategorized struct {
+	Category string
+	Tags     []string
+}
+
+// Key implements registry.Registerable
+func (ProductCategorized) Key() string { return ProductCategorizedEvent }
+
+type ProductVariantAdded struct {
+	Variant ProductVariant
+}
+
+// Key implements registry.Registerable
+func (ProductVariantAdded) Key() string { return ProductVariantAddedEvent }
+
+type ProductVariantRemoved struct {
+	VariantID string
+}
+
+// Key implements registry.Registerable
+func (ProductVariantRemoved) Key() string { return ProductVariantRemovedEvent }
+
+type ProductPriceScheduled struct {
+	ScheduledPrice ScheduledPrice
+}
+
+// Key implements registry.Registerable
+func (ProductPriceScheduled) Key() string { return ProductPriceScheduledEvent }
+
+type ProductScheduledPriceCanceled struct {
+	ScheduledPriceID string
+}
+
+// Key implements registry.Registerable
+func (ProductScheduledPriceCanceled) Key() string { return ProductScheduledPriceCanceledEvent }
+
+type ProductScheduledPriceActivated struct {
+	ScheduledPriceID string
+	Delta            float64
+}
+
+// Key implements registry.Registerable
+func (ProductScheduledPriceActivated) Key() string { return ProductScheduledPriceActivatedEvent }
+
+type ProductScheduledPriceReverted struct {
+	ScheduledPriceID string
+	Delta            float64
+}
+
+// Key implements registry.Registerable
+func (ProductScheduledPriceReverted) Key() string { return ProductScheduledPriceRevertedEvent }
+
 // Domain Events
 
 type ProductPriceDelta struct {
 	Product *Product
 	Delta   float64
 }
+
+type ProductVariantChange struct {
+	Product *Product
+	Variant ProductVariant
+}
+
+// ProductScheduledPriceChange is the price of the product once the scheduled
+// price was scheduled, canceled, put into effect or reverted; Delta is zero
+// unless the price moved
+type ProductScheduledPriceChange struct {
+	Product        *Product
+	ScheduledPrice ScheduledPrice
+	Price          float64
+	Delta          float64
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/es"
+)
+
+type PriceChangeReason string
+
+const (
+	PriceSet       PriceChangeReason = "set"
+	PriceIncreased PriceChangeReason = "increased"
+	PriceDecreased PriceChangeReason = "decreased"
+	PriceScheduled PriceChangeReason = "scheduled"
+	PriceSaleEnded PriceChangeReason = "sale_ended"
+)
+
+// ProductPriceHistory is every price a product has had, replayed from the
+// events of the product; it is never saved
+type ProductPriceHistory struct {
+	es.Aggregate
+	Changes []PriceChange
+}
+
+type PriceChange struct {
+	Price         float64
+	PreviousPrice float64
+	Reason        PriceChangeReason
+	// ScheduledPriceID is set when a scheduled price or sale made the change
+	ScheduledPriceID string
+	ChangedAt        time.Time
+}
+
+type ProductPriceHistoryRepository interface {
+	Load(ctx context.Context, productID string) (*ProductPriceHistory, error)
+}
+
+var _ es.EventApplier = (*ProductPriceHistory)(nil)
+
+func NewProductPriceHistory(productID string) *ProductPriceHistory {
+	return &ProductPriceHistory{
+		Aggregate: es.NewAggregate(productID, ProductAggregate),
+	}
+}
+
+// ApplyEvent records the events that changed the price and skips the others
+func (h *ProductPriceHistory) ApplyEvent(event ddd.Event) error {
+	switch payload := event.Payload().(type) {
+	case *ProductAdded:
+		h.record(payload.Price, PriceSet, "", event.OccurredAt())
+
+	case *ProductPriceChanged:
+		reason := PriceIncreased
+		if event.EventName() == ProductPriceDecreasedEvent {
+			reason = PriceDecreased
+		}
+		h.record(h.price()+payload.Delta, reason, "", event.OccurredAt())
+
+	case *ProductScheduledPriceActivated:
+		h.record(h.price()+payload.Delta, PriceScheduled, payload.ScheduledPriceID, event.OccurredAt())
+
+	case *ProductScheduledPriceReverted:
+		h.record(h.price()+payload.Delta, PriceSaleEnded, payload.ScheduledPriceID, event.OccurredAt())
+	}
+
+	return nil
+}
+
+func (h ProductPriceHistory) price() float64 {
+	if len(h.Changes) == 0 {
+		return 0
+	}
+
+	return h.Changes[len(h.Changes)-1].Price
+}
+
+func (h *ProductPriceHistory) record(price float64, reason PriceChangeReason, scheduledPriceID string, changedAt time.Time) {
+	h.Changes = append(h.Changes, PriceChange{
+		Price:            price,
+		PreviousPrice:    h.price(),
+		Reason:           reason,
+		ScheduledPriceID: scheduledPriceID,
+		ChangedAt:        changedAt,
+	})
+}
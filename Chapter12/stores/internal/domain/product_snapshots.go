@@ -1,11 +1,15 @@
 package domain
 
 type ProductV1 struct {
-	StoreID     string
-	Name        string
-	Description string
-	SKU         string
-	Price       float64
+	StoreID         string
+	Name            string
+	Description     string
+	SKU             string
+	Price           float64
+	Category        string
+	Tags            []string
+	Variants        []ProductVariant
+	ScheduledPrices ScheduledPrices
 }
 
 func (ProductV1) SnapshotName() string { return "stores.ProductV1" }
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProduct_Categorize(t *testing.T) {
+	product := NewProduct("product-id")
+
+	event, err := product.Categorize("  Outdoor ", []string{"Camping", " tents", "", "camping", "Hiking"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ProductCategorizedEvent, event.EventName())
+	}
+	commitProduct(t, product)
+
+	assert.Equal(t, "Outdoor", product.Category)
+	assert.Equal(t, []string{"camping", "hiking", "tents"}, product.Tags)
+
+	// the tags are replaced and not added to
+	_, err = product.Categorize("", nil)
+	assert.NoError(t, err)
+	commitProduct(t, product)
+
+	assert.Empty(t, product.Category)
+	assert.Empty(t, product.Tags)
+}
+
+func TestProduct_AddVariant(t *testing.T) {
+	medium := ProductVariant{ID: "medium-id", SKU: "sku-m", Price: 10, Attributes: map[string]string{"size": "M"}}
+
+	tests := map[string]struct {
+		variant ProductVariant
+		wantErr error
+	}{
+		"Variant": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Price: 12, Attributes: map[string]string{"size": "L"}},
+		},
+		"FreeVariant": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Attributes: map[string]string{"size": "L"}},
+		},
+		"MoreAttributes": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Attributes: map[string]string{"size": "M", "color": "red"}},
+		},
+		"BlankID": {
+			variant: ProductVariant{SKU: "sku-l", Attributes: map[string]string{"size": "L"}},
+			wantErr: ErrProductVariantIDIsBlank,
+		},
+		"BlankSKU": {
+			variant: ProductVariant{ID: "large-id", Attributes: map[string]string{"size": "L"}},
+			wantErr: ErrProductVariantSKUIsBlank,
+		},
+		"NegativePrice": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Price: -1, Attributes: map[string]string{"size": "L"}},
+			wantErr: ErrProductVariantPriceIsNegative,
+		},
+		"NoAttributes": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l"},
+			wantErr: ErrProductVariantAttributesAreBlank,
+		},
+		"BlankAttribute": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Attributes: map[string]string{"size": " "}},
+			wantErr: ErrProductVariantAttributesAreBlank,
+		},
+		"SameID": {
+			variant: ProductVariant{ID: "medium-id", SKU: "sku-l", Attributes: map[string]string{"size": "L"}},
+			wantErr: ErrProductVariantAlreadyExists,
+		},
+		"SameSKU": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-m", Attributes: map[string]string{"size": "L"}},
+			wantErr: ErrProductVariantAlreadyExists,
+		},
+		"SameAttributes": {
+			variant: ProductVariant{ID: "large-id", SKU: "sku-l", Attributes: map[string]string{"size": "M"}},
+			wantErr: ErrProductVariantAlreadyExists,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			product := NewProduct("product-id")
+			if _, err := product.AddVariant(medium); err != nil {
+				t.Fatal(err)
+			}
+			commitProduct(t, product)
+
+			event, err := product.AddVariant(tc.variant)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, product.Events())
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, ProductVariantAddedEvent, event.EventName())
+				assert.Equal(t, tc.variant, event.Payload().(*ProductVariantChange).Variant)
+			}
+			commitProduct(t, product)
+
+			assert.Equal(t, []ProductVariant{medium, tc.variant}, product.Variants)
+		})
+	}
+}
+
+func TestProduct_RemoveVariant(t *testing.T) {
+	medium := ProductVariant{ID: "medium-id", SKU: "sku-m", Price: 10, Attributes: map[string]string{"size": "M"}}
+	large := ProductVariant{ID: "large-id", SKU: "sku-l", Price: 12, Attributes: map[string]string{"size": "L"}}
+
+	product := NewProduct("product-id")
+	for _, variant := range []ProductVariant{medium, large} {
+		if _, err := product.AddVariant(variant); err != nil {
+			t.Fatal(err)
+		}
+	}
+	commitProduct(t, product)
+
+	_, err := product.RemoveVariant("small-id")
+	assert.ErrorIs(t, err, ErrProductVariantNotFound)
+	assert.Empty(t, product.Events())
+
+	event, err := product.RemoveVariant("medium-id")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ProductVariantRemovedEvent, event.EventName())
+		// the removed variant is handed on so its price and SKU are known
+		assert.Equal(t, medium, event.Payload().(*ProductVariantChange).Variant)
+	}
+	commitProduct(t, product)
+
+	assert.Equal(t, []ProductVariant{large}, product.Variants)
+	_, exists := product.Variant("medium-id")
+	assert.False(t, exists)
+
+	// a removed variant may be added again, e.g. at a new price
+	medium.Price = 11
+	_, err = product.AddVariant(medium)
+	assert.NoError(t, err)
+	commitProduct(t, product)
+
+	variant, exists := product.Variant("medium-id")
+	if assert.True(t, exists) {
+		assert.Equal(t, 11.0, variant.Price)
+	}
+}
+
+func commitProduct(t *testing.T, product *Product) {
+	t.Helper()
+
+	for _, event := range product.Events() {
+		if err := product.ApplyEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	product.CommitEvents()
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrProductVariantIDIsBlank          = errors.Wrap(errors.ErrBadRequest, "the product variant id cannot be blank")
+	ErrProductVariantSKUIsBlank         = errors.Wrap(errors.ErrBadRequest, "the product variant sku cannot be blank")
+	ErrProductVariantPriceIsNegative    = errors.Wrap(errors.ErrBadRequest, "the product variant price cannot be negative")
+	ErrProductVariantAttributesAreBlank = errors.Wrap(errors.ErrBadRequest, "the product variant needs at least one attribute")
+	ErrProductVariantAlreadyExists      = errors.Wrap(errors.ErrAlreadyExists, "the product already has a variant with that id, sku or attributes")
+	ErrProductVariantNotFound           = errors.Wrap(errors.ErrNotFound, "the product variant does not exist")
+)
+
+// ProductVariant is a version of a product, such as a size or a color, that is
+// sold under its own SKU and price
+//
+// The price of a variant is fixed when it is added; price changes, scheduled
+// prices and sales only apply to the price of the product. A variant is
+// repriced by removing it and adding it again, which flags it as unavailable
+// in the baskets holding it
+type ProductVariant struct {
+	ID    string
+	SKU   string
+	Price float64
+	// Attributes tell the variants of a product apart, e.g. size: M, color: red
+	Attributes map[string]string
+}
+
+func (v ProductVariant) validate() error {
+	if v.ID == "" {
+		return ErrProductVariantIDIsBlank
+	}
+
+	if v.SKU == "" {
+		return ErrProductVariantSKUIsBlank
+	}
+
+	if v.Price < 0 {
+		return ErrProductVariantPriceIsNegative
+	}
+
+	if len(v.Attributes) == 0 {
+		return ErrProductVariantAttributesAreBlank
+	}
+
+	for name, value := range v.Attributes {
+		if strings.TrimSpace(name) == "" || strings.TrimSpace(value) == "" {
+			return ErrProductVariantAttributesAreBlank
+		}
+	}
+
+	return nil
+}
+
+// conflicts tells whether the two variants could not be told apart
+func (v ProductVariant) conflicts(other ProductVariant) bool {
+	if v.ID == other.ID || v.SKU == other.SKU {
+		return true
+	}
+
+	if len(v.Attributes) != len(other.Attributes) {
+		return false
+	}
+
+	for name, value := range v.Attributes {
+		if other.Attributes[name] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeTags lowercases the tags and drops the blank and repeated ones
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, exists := seen[tag]; exists {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+var (
+	ErrScheduledPriceIDIsBlank     = errors.Wrap(errors.ErrBadRequest, "the scheduled price id cannot be blank")
+	ErrScheduledPriceIsNegative    = errors.Wrap(errors.ErrBadRequest, "the scheduled price cannot be negative")
+	ErrScheduledPriceIsNotInFuture = errors.Wrap(errors.ErrBadRequest, "the scheduled price must take effect in the future")
+	ErrSaleEndsBeforeItStarts      = errors.Wrap(errors.ErrBadRequest, "the sale must end after it starts")
+	ErrScheduledPriceConflicts     = errors.Wrap(errors.ErrAlreadyExists, "the scheduled price overlaps another scheduled price")
+	ErrScheduledPriceNotFound      = errors.Wrap(errors.ErrNotFound, "the scheduled price does not exist")
+	ErrScheduledPriceIsActive      = errors.Wrap(errors.ErrFailedPrecondition, "the scheduled price is already in effect")
+)
+
+// ScheduledPrice is a price the product switches to at EffectiveFrom; a sale
+// also has an EffectiveUntil at which the price reverts to what it was before
+// the sale started
+type ScheduledPrice struct {
+	ID             string
+	Price          float64
+	EffectiveFrom  time.Time
+	EffectiveUntil time.Time
+	// Active is set while a sale is in effect and RevertPrice is the price the
+	// product goes back to when it ends
+	Active      bool
+	RevertPrice float64
+}
+
+type ScheduledPrices []ScheduledPrice
+
+// IsSale tells whether the price reverts once the sale window is over
+func (sp ScheduledPrice) IsSale() bool {
+	return !sp.EffectiveUntil.IsZero()
+}
+
+func (sp ScheduledPrice) validate(now time.Time) error {
+	if sp.ID == "" {
+		return ErrScheduledPriceIDIsBlank
+	}
+
+	if sp.Price < 0 {
+		return ErrScheduledPriceIsNegative
+	}
+
+	if !sp.EffectiveFrom.After(now) {
+		return ErrScheduledPriceIsNotInFuture
+	}
+
+	if sp.IsSale() && !sp.EffectiveUntil.After(sp.EffectiveFrom) {
+		return ErrSaleEndsBeforeItStarts
+	}
+
+	return nil
+}
+
+// overlaps tells whether the two scheduled prices would be in effect at the
+// same time; a sale may start at the moment another one ends
+func (sp ScheduledPrice) overlaps(other ScheduledPrice) bool {
+	if sp.ID == other.ID || sp.EffectiveFrom.Equal(other.EffectiveFrom) {
+		return true
+	}
+
+	return sp.EffectiveFrom.Before(other.end()) && other.EffectiveFrom.Before(sp.end())
+}
+
+func (sp ScheduledPrice) end() time.Time {
+	if sp.IsSale() {
+		return sp.EffectiveUntil
+	}
+
+	return sp.EffectiveFrom
+}
+
+// NextChangeAt is when the earliest pending price takes effect or the active
+// sale ends; false is returned when nothing is scheduled
+func (sps ScheduledPrices) NextChangeAt() (time.Time, bool) {
+	var next time.Time
+	for _, sp := range sps {
+		at := sp.EffectiveFrom
+		if sp.Active {
+			at = sp.EffectiveUntil
+		}
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+func (sps ScheduledPrices) find(id string) (int, bool) {
+	for i, sp := range sps {
+		if sp.ID == id {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func (sps ScheduledPrices) without(id string) ScheduledPrices {
+	remaining := make(ScheduledPrices, 0, len(sps))
+	for _, sp := range sps {
+		if sp.ID != id {
+			remaining = append(remaining, sp)
+		}
+	}
+
+	return remaining
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledPrice_overlaps(t *testing.T) {
+	noon := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	sale := ScheduledPrice{ID: "sale-id", Price: 8, EffectiveFrom: noon, EffectiveUntil: noon.Add(2 * time.Hour)}
+
+	tests := map[string]struct {
+		other ScheduledPrice
+		want  bool
+	}{
+		"SameID": {
+			other: ScheduledPrice{ID: "sale-id", EffectiveFrom: noon.Add(24 * time.Hour)},
+			want:  true,
+		},
+		"SameStart": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon},
+			want:  true,
+		},
+		"PriceDuringSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(time.Hour)},
+			want:  true,
+		},
+		"SaleDuringSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(-time.Hour), EffectiveUntil: noon.Add(time.Hour)},
+			want:  true,
+		},
+		"SaleAroundSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(-time.Hour), EffectiveUntil: noon.Add(3 * time.Hour)},
+			want:  true,
+		},
+		"PriceBeforeSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(-time.Hour)},
+		},
+		"PriceAtSaleEnd": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(2 * time.Hour)},
+		},
+		"SaleAfterSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(2 * time.Hour), EffectiveUntil: noon.Add(3 * time.Hour)},
+		},
+		"SaleBeforeSale": {
+			other: ScheduledPrice{ID: "other-id", EffectiveFrom: noon.Add(-time.Hour), EffectiveUntil: noon},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, sale.overlaps(tc.other))
+			assert.Equal(t, tc.want, tc.other.overlaps(sale))
+		})
+	}
+}
+
+func TestProduct_ApplyScheduledPrices(t *testing.T) {
+	noon := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	// a sale, a new price the moment the sale ends and then a second sale
+	firstSale := ScheduledPrice{ID: "first-sale-id", Price: 8, EffectiveFrom: noon, EffectiveUntil: noon.Add(time.Hour)}
+	newPrice := ScheduledPrice{ID: "new-price-id", Price: 12, EffectiveFrom: noon.Add(time.Hour)}
+	secondSale := ScheduledPrice{ID: "second-sale-id", Price: 9, EffectiveFrom: noon.Add(2 * time.Hour), EffectiveUntil: noon.Add(3 * time.Hour)}
+
+	type change struct {
+		name             string
+		scheduledPriceID string
+		price            float64
+		delta            float64
+	}
+
+	tests := map[string]struct {
+		at          time.Time
+		want        []change
+		wantPrice   float64
+		wantPending []string
+	}{
+		"NothingDue": {
+			at:          noon.Add(-time.Minute),
+			wantPrice:   10,
+			wantPending: []string{"first-sale-id", "new-price-id", "second-sale-id"},
+		},
+		"SaleStarts": {
+			at: noon,
+			want: []change{
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "first-sale-id", price: 8, delta: -2},
+			},
+			wantPrice:   8,
+			wantPending: []string{"first-sale-id", "new-price-id", "second-sale-id"},
+		},
+		"SaleEndsBeforeNewPrice": {
+			at: noon.Add(time.Hour),
+			want: []change{
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "first-sale-id", price: 8, delta: -2},
+				{name: ProductScheduledPriceRevertedEvent, scheduledPriceID: "first-sale-id", price: 10, delta: 2},
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "new-price-id", price: 12, delta: 2},
+			},
+			wantPrice:   12,
+			wantPending: []string{"second-sale-id"},
+		},
+		"CatchUp": {
+			at: noon.Add(4 * time.Hour),
+			want: []change{
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "first-sale-id", price: 8, delta: -2},
+				{name: ProductScheduledPriceRevertedEvent, scheduledPriceID: "first-sale-id", price: 10, delta: 2},
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "new-price-id", price: 12, delta: 2},
+				{name: ProductScheduledPriceActivatedEvent, scheduledPriceID: "second-sale-id", price: 9, delta: -3},
+				// the second sale reverts to the price set while catching up
+				{name: ProductScheduledPriceRevertedEvent, scheduledPriceID: "second-sale-id", price: 12, delta: 3},
+			},
+			wantPrice:   12,
+			wantPending: []string{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			product := scheduledProduct(t, noon.Add(-24*time.Hour), secondSale, newPrice, firstSale)
+
+			events := product.ApplyScheduledPrices(tc.at)
+			if assert.Len(t, events, len(tc.want)) {
+				for i, want := range tc.want {
+					payload := events[i].Payload().(*ProductScheduledPriceChange)
+					assert.Equal(t, want.name, events[i].EventName())
+					assert.Equal(t, want.scheduledPriceID, payload.ScheduledPrice.ID)
+					assert.Equal(t, want.price, payload.Price)
+					assert.Equal(t, want.delta, payload.Delta)
+				}
+			}
+			commitProduct(t, product)
+
+			assert.Equal(t, tc.wantPrice, product.Price)
+			pending := make([]string, 0, len(product.ScheduledPrices))
+			for _, sp := range product.ScheduledPrices {
+				pending = append(pending, sp.ID)
+			}
+			assert.ElementsMatch(t, tc.wantPending, pending)
+
+			// nothing is applied twice
+			assert.Empty(t, product.ApplyScheduledPrices(tc.at))
+		})
+	}
+}
+
+func TestProduct_ApplyScheduledPrices_ActiveSale(t *testing.T) {
+	noon := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	sale := ScheduledPrice{ID: "sale-id", Price: 8, EffectiveFrom: noon, EffectiveUntil: noon.Add(time.Hour)}
+
+	product := scheduledProduct(t, noon.Add(-time.Hour), sale)
+	product.ApplyScheduledPrices(noon)
+	commitProduct(t, product)
+
+	// the price is changed by hand while the sale is on
+	if _, err := product.DecreasePrice(7); err != nil {
+		t.Fatal(err)
+	}
+	commitProduct(t, product)
+
+	events := product.ApplyScheduledPrices(noon.Add(time.Hour))
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, ProductScheduledPriceRevertedEvent, events[0].EventName())
+		assert.Equal(t, 3.0, events[0].Payload().(*ProductScheduledPriceChange).Delta)
+	}
+	commitProduct(t, product)
+
+	// the sale reverts to the price from before it started
+	assert.Equal(t, 10.0, product.Price)
+	assert.Empty(t, product.ScheduledPrices)
+}
+
+func TestProductPriceHistory(t *testing.T) {
+	noon := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	sale := ScheduledPrice{ID: "sale-id", Price: 8, EffectiveFrom: noon, EffectiveUntil: noon.Add(time.Hour)}
+
+	product := NewProduct("product-id")
+	history := NewProductPriceHistory("product-id")
+	// the history is replayed from the same events the product is
+	record := func() {
+		t.Helper()
+		for _, event := range product.Events() {
+			if err := history.ApplyEvent(event); err != nil {
+				t.Fatal(err)
+			}
+		}
+		commitProduct(t, product)
+	}
+
+	if _, err := product.InitProduct("product-id", "store-id", "product", "", "sku", 10); err != nil {
+		t.Fatal(err)
+	}
+	record()
+	if _, err := product.Rebrand("renamed", ""); err != nil {
+		t.Fatal(err)
+	}
+	record()
+	if _, err := product.IncreasePrice(11); err != nil {
+		t.Fatal(err)
+	}
+	record()
+	if _, err := product.SchedulePrice(sale, noon.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	record()
+	product.ApplyScheduledPrices(noon.Add(time.Hour))
+	record()
+	if _, err := product.DecreasePrice(9.5); err != nil {
+		t.Fatal(err)
+	}
+	record()
+
+	want := []PriceChange{
+		{Price: 10, PreviousPrice: 0, Reason: PriceSet},
+		{Price: 11, PreviousPrice: 10, Reason: PriceIncreased},
+		{Price: 8, PreviousPrice: 11, Reason: PriceScheduled, ScheduledPriceID: "sale-id"},
+		{Price: 11, PreviousPrice: 8, Reason: PriceSaleEnded, ScheduledPriceID: "sale-id"},
+		{Price: 9.5, PreviousPrice: 11, Reason: PriceDecreased},
+	}
+	if assert.Len(t, history.Changes, len(want)) {
+		for i, change := range history.Changes {
+			assert.False(t, change.ChangedAt.IsZero())
+			change.ChangedAt = time.Time{}
+			assert.Equal(t, want[i], change)
+		}
+	}
+	assert.Equal(t, product.Price, history.price())
+}
+
+// scheduledProduct is a product priced at 10 with the scheduled prices given
+func scheduledProduct(t *testing.T, now time.Time, scheduledPrices ...ScheduledPrice) *Product {
+	t.Helper()
+
+	product := NewProduct("product-id")
+	if _, err := product.InitProduct("product-id", "store-id", "product", "", "sku", 10); err != nil {
+		t.Fatal(err)
+	}
+	for _, scheduledPrice := range scheduledPrices {
+		if _, err := product.SchedulePrice(scheduledPrice, now); err != nil {
+			t.Fatal(err)
+		}
+		commitProduct(t, product)
+	}
+	commitProduct(t, product)
+
+	return product
+}
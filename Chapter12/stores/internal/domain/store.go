@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/ddd"
@@ -14,6 +16,7 @@ var (
 	ErrStoreLocationIsBlank           = errors.Wrap(errors.ErrBadRequest, "the store location cannot be blank")
 	ErrStoreIsAlreadyParticipating    = errors.Wrap(errors.ErrBadRequest, "the store is already participating")
 	ErrStoreIsAlreadyNotParticipating = errors.Wrap(errors.ErrBadRequest, "the store is already not participating")
+	ErrStoreParticipationIsScheduled  = errors.Wrap(errors.ErrFailedPrecondition, "the participation of the store is set by its schedule")
 )
 
 type Store struct {
@@ -21,6 +24,7 @@ type Store struct {
 	Name          string
 	Location      string
 	Participating bool
+	Schedule      StoreSchedule
 }
 
 var _ interface {
@@ -55,6 +59,53 @@ func (s *Store) InitStore(name, location string) (ddd.Event, error) {
 func (Store) Key() string { return StoreAggregate }
 
 func (s *Store) EnableParticipation() (ddd.Event, error) {
+	if s.Schedule.IsScheduled() {
+		return nil, ErrStoreParticipationIsScheduled
+	}
+
+	return s.enableParticipation()
+}
+
+func (s *Store) DisableParticipation() (ddd.Event, error) {
+	if s.Schedule.IsScheduled() {
+		return nil, ErrStoreParticipationIsScheduled
+	}
+
+	return s.disableParticipation()
+}
+
+// SetSchedule replaces the opening hours and holidays of the store; a
+// schedule without hours hands participation back to the manual toggle
+func (s *Store) SetSchedule(schedule StoreSchedule) (ddd.Event, error) {
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+
+	s.AddEvent(StoreScheduleSetEvent, &StoreScheduleSet{
+		Schedule: schedule,
+	})
+
+	return ddd.NewEvent(StoreScheduleSetEvent, s), nil
+}
+
+// ApplySchedule toggles the participation of the store when the schedule has
+// crossed an opening or closing boundary; no event is returned otherwise
+func (s *Store) ApplySchedule(at time.Time) (ddd.Event, error) {
+	if !s.Schedule.IsScheduled() {
+		return nil, nil
+	}
+
+	switch open := s.Schedule.IsOpen(at); {
+	case open && !s.Participating:
+		return s.enableParticipation()
+	case !open && s.Participating:
+		return s.disableParticipation()
+	}
+
+	return nil, nil
+}
+
+func (s *Store) enableParticipation() (ddd.Event, error) {
 	if s.Participating {
 		return nil, ErrStoreIsAlreadyParticipating
 	}
@@ -66,7 +117,7 @@ func (s *Store) EnableParticipation() (ddd.Event, error) {
 	return ddd.NewEvent(StoreParticipationEnabledEvent, s), nil
 }
 
-func (s *Store) DisableParticipation() (ddd.Event, error) {
+func (s *Store) disableParticipation() (ddd.Event, error) {
 	if !s.Participating {
 		return nil, ErrStoreIsAlreadyNotParticipating
 	}
@@ -99,6 +150,9 @@ func (s *Store) ApplyEvent(event ddd.Event) error {
 	case *StoreRebranded:
 		s.Name = payload.Name
 
+	case *StoreScheduleSet:
+		s.Schedule = payload.Schedule
+
 	default:
 		return errors.ErrInternal.Msgf("%T received the event %s with unexpected payload %T", s, event.EventName(), payload)
 	}
@@ -113,6 +167,7 @@ func (s *Store) ApplySnapshot(snapshot es.Snapshot) error {
 		s.Name = ss.Name
 		s.Location = ss.Location
 		s.Participating = ss.Participating
+		s.Schedule = ss.Schedule
 
 	default:
 		return errors.ErrInternal.Msgf("%T received the unexpected snapshot %T", s, snapshot)
@@ -127,5 +182,6 @@ func (s Store) ToSnapshot() es.Snapshot {
 		Name:          s.Name,
 		Location:      s.Location,
 		Participating: s.Participating,
+		Schedule:      s.Schedule,
 	}
 }
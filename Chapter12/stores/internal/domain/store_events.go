@@ -5,6 +5,7 @@ const (
 	StoreParticipationEnabledEvent  = "stores.StoreParticipationEnabled"
 	StoreParticipationDisabledEvent = "stores.StoreParticipationDisabled"
 	StoreRebrandedEvent             = "stores.StoreRebranded"
+	StoreScheduleSetEvent           = "stores.StoreScheduleSet"
 )
 
 type StoreCreated struct {
@@ -25,3 +26,10 @@ type StoreRebranded struct {
 
 // Key implements registry.Registerable
 func (StoreRebranded) Key() string { return StoreRebrandedEvent }
+
+type StoreScheduleSet struct {
+	Schedule StoreSchedule
+}
+
+// Key implements registry.Registerable
+func (StoreScheduleSet) Key() string { return StoreScheduleSetEvent }
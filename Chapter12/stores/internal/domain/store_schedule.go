@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/stackus/errors"
+)
+
+const (
+	scheduleTimeLayout = "15:04"
+	scheduleDateLayout = "2006-01-02"
+	// closing at the end of the day is written as 24:00
+	scheduleEndOfDay = "24:00"
+)
+
+var (
+	ErrUnknownScheduleTimeZone      = errors.Wrap(errors.ErrBadRequest, "the schedule time zone is not known")
+	ErrInvalidOpeningDay            = errors.Wrap(errors.ErrBadRequest, "the opening day must be between 0 (Sunday) and 6 (Saturday)")
+	ErrInvalidOpeningTime           = errors.Wrap(errors.ErrBadRequest, "the opening and closing times must be given as HH:MM")
+	ErrClosingAtOpening             = errors.Wrap(errors.ErrBadRequest, "the store cannot close at the time it opens")
+	ErrInvalidHolidayDate           = errors.Wrap(errors.ErrBadRequest, "the holiday date must be given as YYYY-MM-DD")
+	ErrScheduleHolidaysWithoutHours = errors.Wrap(errors.ErrBadRequest, "a schedule with holidays must also have opening hours")
+)
+
+// StoreSchedule decides when a store takes part in the mall; a store without
+// opening hours is not scheduled and its participation is toggled by hand
+type StoreSchedule struct {
+	// TimeZone is the IANA name of the zone the hours and holidays are kept in
+	TimeZone string
+	Hours    []OpeningHours
+	Holidays []Holiday
+}
+
+// OpeningHours is a span of a weekday the store is open; a day may have more
+// than one span. Hours that close before they open run overnight and close on
+// the following day
+type OpeningHours struct {
+	Day    time.Weekday
+	Opens  string
+	Closes string
+}
+
+// Holiday is a date the store stays closed all day; this includes the part of
+// any overnight hours from the day before that runs into the holiday
+type Holiday struct {
+	Date string
+	Name string
+}
+
+// IsScheduled tells whether the schedule decides the participation of the store
+func (s StoreSchedule) IsScheduled() bool {
+	return len(s.Hours) > 0
+}
+
+// IsOpen tells whether the store is open at the time
+func (s StoreSchedule) IsOpen(at time.Time) bool {
+	loc, err := s.location()
+	if err != nil {
+		return false
+	}
+
+	at = at.In(loc)
+	date := at.Format(scheduleDateLayout)
+	for _, holiday := range s.Holidays {
+		if holiday.Date == date {
+			return false
+		}
+	}
+
+	clock := at.Format(scheduleTimeLayout)
+	for _, hours := range s.Hours {
+		if hours.isOpen(at.Weekday(), clock) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h OpeningHours) isOpen(day time.Weekday, clock string) bool {
+	if h.Opens < h.Closes {
+		return h.Day == day && h.Opens <= clock && clock < h.Closes
+	}
+
+	// overnight hours are open until midnight and then on into the next day
+	return (h.Day == day && h.Opens <= clock) || (h.Day == (day+6)%7 && clock < h.Closes)
+}
+
+func (s StoreSchedule) validate() error {
+	if _, err := s.location(); err != nil {
+		return err
+	}
+
+	if len(s.Holidays) > 0 && len(s.Hours) == 0 {
+		return ErrScheduleHolidaysWithoutHours
+	}
+
+	for _, hours := range s.Hours {
+		if hours.Day < time.Sunday || hours.Day > time.Saturday {
+			return ErrInvalidOpeningDay
+		}
+		if !validClock(hours.Opens) || hours.Opens == scheduleEndOfDay || !validClock(hours.Closes) {
+			return ErrInvalidOpeningTime
+		}
+		if hours.Closes == hours.Opens {
+			return ErrClosingAtOpening
+		}
+	}
+
+	for _, holiday := range s.Holidays {
+		if _, err := time.Parse(scheduleDateLayout, holiday.Date); err != nil {
+			return ErrInvalidHolidayDate
+		}
+	}
+
+	return nil
+}
+
+func (s StoreSchedule) location() (*time.Location, error) {
+	if s.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnknownScheduleTimeZone, "time zone: %s", s.TimeZone)
+	}
+
+	return loc, nil
+}
+
+// validClock accepts the HH:MM times that compare correctly as strings
+func validClock(clock string) bool {
+	if clock == scheduleEndOfDay {
+		return true
+	}
+	if len(clock) != len(scheduleTimeLayout) {
+		return false
+	}
+	_, err := time.Parse(scheduleTimeLayout, clock)
+
+	return err == nil
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreSchedule_IsOpen(t *testing.T) {
+	schedule := StoreSchedule{
+		TimeZone: "America/New_York",
+		Hours: []OpeningHours{
+			{Day: time.Monday, Opens: "09:00", Closes: "12:00"},
+			{Day: time.Monday, Opens: "13:00", Closes: "17:30"},
+			{Day: time.Friday, Opens: "22:00", Closes: "02:00"},
+			{Day: time.Sunday, Opens: "10:00", Closes: "24:00"},
+		},
+		Holidays: []Holiday{
+			{Date: "2026-10-26", Name: "closed"},
+		},
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		at   time.Time
+		want bool
+	}{
+		"Opening":             {at: time.Date(2026, 10, 19, 9, 0, 0, 0, newYork), want: true},
+		"BeforeOpening":       {at: time.Date(2026, 10, 19, 8, 59, 0, 0, newYork)},
+		"Closing":             {at: time.Date(2026, 10, 19, 12, 0, 0, 0, newYork)},
+		"SecondSpan":          {at: time.Date(2026, 10, 19, 17, 29, 0, 0, newYork), want: true},
+		"OtherDay":            {at: time.Date(2026, 10, 20, 10, 0, 0, 0, newYork)},
+		"TimeZone":            {at: time.Date(2026, 10, 19, 13, 30, 0, 0, time.UTC), want: true},
+		"TimeZoneOtherDay":    {at: time.Date(2026, 10, 26, 3, 0, 0, 0, time.UTC), want: true},
+		"OvernightOpening":    {at: time.Date(2026, 10, 23, 22, 0, 0, 0, newYork), want: true},
+		"OvernightNextDay":    {at: time.Date(2026, 10, 24, 1, 59, 0, 0, newYork), want: true},
+		"OvernightClosing":    {at: time.Date(2026, 10, 24, 2, 0, 0, 0, newYork)},
+		"OvernightBefore":     {at: time.Date(2026, 10, 23, 21, 59, 0, 0, newYork)},
+		"OvernightOtherNight": {at: time.Date(2026, 10, 23, 1, 0, 0, 0, newYork)},
+		"EndOfDay":            {at: time.Date(2026, 10, 25, 23, 59, 0, 0, newYork), want: true},
+		"Holiday":             {at: time.Date(2026, 10, 26, 10, 0, 0, 0, newYork)},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, schedule.IsOpen(tc.at))
+		})
+	}
+}
+
+func TestStoreSchedule_IsOpen_NotScheduled(t *testing.T) {
+	assert.False(t, StoreSchedule{}.IsScheduled())
+	assert.False(t, StoreSchedule{}.IsOpen(time.Now()))
+}
+
+func TestStoreSchedule_validate(t *testing.T) {
+	tests := map[string]struct {
+		schedule StoreSchedule
+		wantErr  error
+	}{
+		"NoSchedule": {
+			schedule: StoreSchedule{},
+		},
+		"Hours": {
+			schedule: StoreSchedule{
+				TimeZone: "Europe/London",
+				Hours:    []OpeningHours{{Day: time.Saturday, Opens: "00:00", Closes: "24:00"}},
+				Holidays: []Holiday{{Date: "2026-12-25", Name: "Christmas"}},
+			},
+		},
+		"Overnight": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: time.Friday, Opens: "22:00", Closes: "02:00"}}},
+		},
+		"UnknownTimeZone": {
+			schedule: StoreSchedule{TimeZone: "Mall/Central"},
+			wantErr:  ErrUnknownScheduleTimeZone,
+		},
+		"HolidaysWithoutHours": {
+			schedule: StoreSchedule{Holidays: []Holiday{{Date: "2026-12-25"}}},
+			wantErr:  ErrScheduleHolidaysWithoutHours,
+		},
+		"UnknownDay": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: 7, Opens: "09:00", Closes: "17:00"}}},
+			wantErr:  ErrInvalidOpeningDay,
+		},
+		"ShortTime": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: time.Monday, Opens: "9:00", Closes: "17:00"}}},
+			wantErr:  ErrInvalidOpeningTime,
+		},
+		"NotATime": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: time.Monday, Opens: "09:00", Closes: "25:00"}}},
+			wantErr:  ErrInvalidOpeningTime,
+		},
+		"OpensAtEndOfDay": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: time.Monday, Opens: "24:00", Closes: "02:00"}}},
+			wantErr:  ErrInvalidOpeningTime,
+		},
+		"ClosesAtOpening": {
+			schedule: StoreSchedule{Hours: []OpeningHours{{Day: time.Monday, Opens: "09:00", Closes: "09:00"}}},
+			wantErr:  ErrClosingAtOpening,
+		},
+		"InvalidHoliday": {
+			schedule: StoreSchedule{
+				Hours:    []OpeningHours{{Day: time.Monday, Opens: "09:00", Closes: "17:00"}},
+				Holidays: []Holiday{{Date: "12/25/2026"}},
+			},
+			wantErr: ErrInvalidHolidayDate,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.schedule.validate()
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ type StoreV1 struct {
 	Name          string
 	Location      string
 	Participating bool
+	Schedule      StoreSchedule
 }
 
 func (StoreV1) SnapshotName() string { return "stores.StoreV1" }
@@ -2,12 +2,14 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"eda-in-golang/internal/errorsotel"
 	"eda-in-golang/stores/storespb"
@@ -112,6 +114,25 @@ func (s server) RebrandStore(ctx context.Context, request *storespb.RebrandStore
 	return &storespb.RebrandStoreResponse{}, err
 }
 
+func (s server) SetStoreSchedule(ctx context.Context, request *storespb.SetStoreScheduleRequest) (*storespb.SetStoreScheduleResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("StoreID", request.GetId()),
+	)
+
+	err := s.app.SetStoreSchedule(ctx, commands.SetStoreSchedule{
+		ID:       request.GetId(),
+		Schedule: s.scheduleToDomain(request.GetSchedule()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.SetStoreScheduleResponse{}, err
+}
+
 func (s server) GetStore(ctx context.Context, request *storespb.GetStoreRequest) (*storespb.GetStoreResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -253,6 +274,82 @@ func (s server) DecreaseProductPrice(ctx context.Context, request *storespb.Decr
 	return &storespb.DecreaseProductPriceResponse{}, err
 }
 
+func (s server) ScheduleProductPrice(ctx context.Context, request *storespb.ScheduleProductPriceRequest) (*storespb.ScheduleProductPriceResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	scheduledPriceID := uuid.New().String()
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+		attribute.String("ScheduledPriceID", scheduledPriceID),
+	)
+
+	err := s.app.ScheduleProductPrice(ctx, commands.ScheduleProductPrice{
+		ID:               request.GetId(),
+		ScheduledPriceID: scheduledPriceID,
+		Price:            request.GetPrice(),
+		EffectiveFrom:    timeToDomain(request.GetEffectiveFrom()),
+		EffectiveUntil:   timeToDomain(request.GetEffectiveUntil()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return &storespb.ScheduleProductPriceResponse{Id: scheduledPriceID}, nil
+}
+
+func (s server) CancelScheduledProductPrice(ctx context.Context, request *storespb.CancelScheduledProductPriceRequest) (*storespb.CancelScheduledProductPriceResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+		attribute.String("ScheduledPriceID", request.GetScheduledPriceId()),
+	)
+
+	err := s.app.CancelScheduledProductPrice(ctx, commands.CancelScheduledProductPrice{
+		ID:               request.GetId(),
+		ScheduledPriceID: request.GetScheduledPriceId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.CancelScheduledProductPriceResponse{}, err
+}
+
+func (s server) GetProductPriceHistory(ctx context.Context, request *storespb.GetProductPriceHistoryRequest) (*storespb.GetProductPriceHistoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+	)
+
+	history, err := s.app.GetProductPriceHistory(ctx, queries.GetProductPriceHistory{
+		ID: request.GetId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	changes := make([]*storespb.PriceChange, len(history.Changes))
+	for i, change := range history.Changes {
+		changes[i] = &storespb.PriceChange{
+			Price:            change.Price,
+			PreviousPrice:    change.PreviousPrice,
+			Reason:           string(change.Reason),
+			ScheduledPriceId: change.ScheduledPriceID,
+			ChangedAt:        timestampFromDomain(change.ChangedAt),
+		}
+	}
+
+	return &storespb.GetProductPriceHistoryResponse{Changes: changes}, nil
+}
+
 func (s server) RemoveProduct(ctx context.Context, request *storespb.RemoveProductRequest) (*storespb.RemoveProductResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -271,6 +368,72 @@ func (s server) RemoveProduct(ctx context.Context, request *storespb.RemoveProdu
 	return &storespb.RemoveProductResponse{}, err
 }
 
+func (s server) CategorizeProduct(ctx context.Context, request *storespb.CategorizeProductRequest) (*storespb.CategorizeProductResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+	)
+
+	err := s.app.CategorizeProduct(ctx, commands.CategorizeProduct{
+		ID:       request.GetId(),
+		Category: request.GetCategory(),
+		Tags:     request.GetTags(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.CategorizeProductResponse{}, err
+}
+
+func (s server) AddProductVariant(ctx context.Context, request *storespb.AddProductVariantRequest) (*storespb.AddProductVariantResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	variantID := uuid.New().String()
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+		attribute.String("VariantID", variantID),
+	)
+
+	err := s.app.AddProductVariant(ctx, commands.AddProductVariant{
+		ID:         request.GetId(),
+		VariantID:  variantID,
+		SKU:        request.GetSku(),
+		Price:      request.GetPrice(),
+		Attributes: request.GetAttributes(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return &storespb.AddProductVariantResponse{Id: variantID}, nil
+}
+
+func (s server) RemoveProductVariant(ctx context.Context, request *storespb.RemoveProductVariantRequest) (*storespb.RemoveProductVariantResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+		attribute.String("VariantID", request.GetVariantId()),
+	)
+
+	err := s.app.RemoveProductVariant(ctx, commands.RemoveProductVariant{
+		ID:        request.GetId(),
+		VariantID: request.GetVariantId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.RemoveProductVariantResponse{}, err
+}
+
 func (s server) GetProduct(ctx context.Context, request *storespb.GetProductRequest) (*storespb.GetProductResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -314,22 +477,190 @@ func (s server) GetCatalog(ctx context.Context, request *storespb.GetCatalogRequ
 	}, nil
 }
 
+func (s server) ReceiveInventory(ctx context.Context, request *storespb.ReceiveInventoryRequest) (*storespb.ReceiveInventoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+	)
+
+	err := s.app.ReceiveInventory(ctx, commands.ReceiveInventory{
+		ProductID: request.GetId(),
+		Quantity:  int(request.GetQuantity()),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.ReceiveInventoryResponse{}, err
+}
+
+func (s server) AdjustInventory(ctx context.Context, request *storespb.AdjustInventoryRequest) (*storespb.AdjustInventoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+	)
+
+	err := s.app.AdjustInventory(ctx, commands.AdjustInventory{
+		ProductID: request.GetId(),
+		Count:     int(request.GetCount()),
+		Reason:    request.GetReason(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return &storespb.AdjustInventoryResponse{}, err
+}
+
+func (s server) GetInventory(ctx context.Context, request *storespb.GetInventoryRequest) (*storespb.GetInventoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("ProductID", request.GetId()),
+	)
+
+	inventory, err := s.app.GetInventory(ctx, queries.GetInventory{
+		ProductID: request.GetId(),
+	})
+	if err != nil {
+		span.RecordError(err, trace.WithAttributes(errorsotel.ErrAttrs(err)...))
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return &storespb.GetInventoryResponse{Inventory: s.inventoryFromDomain(inventory)}, nil
+}
+
 func (s server) storeFromDomain(store *domain.MallStore) *storespb.Store {
 	return &storespb.Store{
 		Id:            store.ID,
 		Name:          store.Name,
 		Location:      store.Location,
 		Participating: store.Participating,
+		Schedule:      s.scheduleFromDomain(store.Schedule),
+	}
+}
+
+func (s server) scheduleFromDomain(schedule domain.StoreSchedule) *storespb.StoreSchedule {
+	protoHours := make([]*storespb.OpeningHours, len(schedule.Hours))
+	for i, hours := range schedule.Hours {
+		protoHours[i] = &storespb.OpeningHours{
+			Day:    int32(hours.Day),
+			Opens:  hours.Opens,
+			Closes: hours.Closes,
+		}
+	}
+
+	protoHolidays := make([]*storespb.Holiday, len(schedule.Holidays))
+	for i, holiday := range schedule.Holidays {
+		protoHolidays[i] = &storespb.Holiday{
+			Date: holiday.Date,
+			Name: holiday.Name,
+		}
+	}
+
+	return &storespb.StoreSchedule{
+		TimeZone: schedule.TimeZone,
+		Hours:    protoHours,
+		Holidays: protoHolidays,
+	}
+}
+
+func (s server) scheduleToDomain(schedule *storespb.StoreSchedule) domain.StoreSchedule {
+	hours := make([]domain.OpeningHours, len(schedule.GetHours()))
+	for i, protoHours := range schedule.GetHours() {
+		hours[i] = domain.OpeningHours{
+			Day:    time.Weekday(protoHours.GetDay()),
+			Opens:  protoHours.GetOpens(),
+			Closes: protoHours.GetCloses(),
+		}
+	}
+
+	holidays := make([]domain.Holiday, len(schedule.GetHolidays()))
+	for i, protoHoliday := range schedule.GetHolidays() {
+		holidays[i] = domain.Holiday{
+			Date: protoHoliday.GetDate(),
+			Name: protoHoliday.GetName(),
+		}
+	}
+
+	return domain.StoreSchedule{
+		TimeZone: schedule.GetTimeZone(),
+		Hours:    hours,
+		Holidays: holidays,
 	}
 }
 
 func (s server) productFromDomain(product *domain.CatalogProduct) *storespb.Product {
 	return &storespb.Product{
-		Id:          product.ID,
-		StoreId:     product.StoreID,
-		Name:        product.Name,
-		Description: product.Description,
-		Sku:         product.SKU,
-		Price:       product.Price,
+		Id:              product.ID,
+		StoreId:         product.StoreID,
+		Name:            product.Name,
+		Description:     product.Description,
+		Sku:             product.SKU,
+		Price:           product.Price,
+		Category:        product.Category,
+		Tags:            product.Tags,
+		Variants:        s.variantsFromDomain(product.Variants),
+		ScheduledPrices: s.scheduledPricesFromDomain(product.ScheduledPrices),
+	}
+}
+
+func (s server) variantsFromDomain(variants []domain.ProductVariant) []*storespb.ProductVariant {
+	protoVariants := make([]*storespb.ProductVariant, len(variants))
+	for i, variant := range variants {
+		protoVariants[i] = &storespb.ProductVariant{
+			Id:         variant.ID,
+			Sku:        variant.SKU,
+			Price:      variant.Price,
+			Attributes: variant.Attributes,
+		}
+	}
+
+	return protoVariants
+}
+
+func (s server) scheduledPricesFromDomain(scheduledPrices domain.ScheduledPrices) []*storespb.ScheduledPrice {
+	protoScheduledPrices := make([]*storespb.ScheduledPrice, len(scheduledPrices))
+	for i, scheduledPrice := range scheduledPrices {
+		protoScheduledPrices[i] = &storespb.ScheduledPrice{
+			Id:             scheduledPrice.ID,
+			Price:          scheduledPrice.Price,
+			EffectiveFrom:  timestampFromDomain(scheduledPrice.EffectiveFrom),
+			EffectiveUntil: timestampFromDomain(scheduledPrice.EffectiveUntil),
+			Active:         scheduledPrice.Active,
+		}
+	}
+
+	return protoScheduledPrices
+}
+
+func (s server) inventoryFromDomain(inventory *domain.Inventory) *storespb.Inventory {
+	return &storespb.Inventory{
+		ProductId: inventory.ID(),
+		OnHand:    int32(inventory.OnHand),
+		Reserved:  int32(inventory.Reserved()),
+		Available: int32(inventory.Available()),
+		Tracked:   inventory.IsTracked(),
+	}
+}
+
+// timestampFromDomain leaves unset times out of the response
+func timestampFromDomain(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// timeToDomain keeps unset timestamps as the zero time
+func timeToDomain(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
 	}
+	return t.AsTime()
 }
@@ -70,6 +70,17 @@ func (s serverTx) RebrandStore(ctx context.Context, request *storespb.RebrandSto
 	return next.RebrandStore(ctx, request)
 }
 
+func (s serverTx) SetStoreSchedule(ctx context.Context, request *storespb.SetStoreScheduleRequest) (resp *storespb.SetStoreScheduleResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.SetStoreSchedule(ctx, request)
+}
+
 func (s serverTx) GetStore(ctx context.Context, request *storespb.GetStoreRequest) (resp *storespb.GetStoreResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
@@ -147,6 +158,39 @@ func (s serverTx) DecreaseProductPrice(ctx context.Context, request *storespb.De
 	return next.DecreaseProductPrice(ctx, request)
 }
 
+func (s serverTx) ScheduleProductPrice(ctx context.Context, request *storespb.ScheduleProductPriceRequest) (resp *storespb.ScheduleProductPriceResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ScheduleProductPrice(ctx, request)
+}
+
+func (s serverTx) CancelScheduledProductPrice(ctx context.Context, request *storespb.CancelScheduledProductPriceRequest) (resp *storespb.CancelScheduledProductPriceResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.CancelScheduledProductPrice(ctx, request)
+}
+
+func (s serverTx) GetProductPriceHistory(ctx context.Context, request *storespb.GetProductPriceHistoryRequest) (resp *storespb.GetProductPriceHistoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.GetProductPriceHistory(ctx, request)
+}
+
 func (s serverTx) RemoveProduct(ctx context.Context, request *storespb.RemoveProductRequest) (resp *storespb.RemoveProductResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
@@ -158,6 +202,39 @@ func (s serverTx) RemoveProduct(ctx context.Context, request *storespb.RemovePro
 	return next.RemoveProduct(ctx, request)
 }
 
+func (s serverTx) CategorizeProduct(ctx context.Context, request *storespb.CategorizeProductRequest) (resp *storespb.CategorizeProductResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.CategorizeProduct(ctx, request)
+}
+
+func (s serverTx) AddProductVariant(ctx context.Context, request *storespb.AddProductVariantRequest) (resp *storespb.AddProductVariantResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.AddProductVariant(ctx, request)
+}
+
+func (s serverTx) RemoveProductVariant(ctx context.Context, request *storespb.RemoveProductVariantRequest) (resp *storespb.RemoveProductVariantResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.RemoveProductVariant(ctx, request)
+}
+
 func (s serverTx) GetProduct(ctx context.Context, request *storespb.GetProductRequest) (resp *storespb.GetProductResponse, err error) {
 	ctx = s.c.Scoped(ctx)
 	defer func(tx *sql.Tx) {
@@ -180,6 +257,39 @@ func (s serverTx) GetCatalog(ctx context.Context, request *storespb.GetCatalogRe
 	return next.GetCatalog(ctx, request)
 }
 
+func (s serverTx) ReceiveInventory(ctx context.Context, request *storespb.ReceiveInventoryRequest) (resp *storespb.ReceiveInventoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.ReceiveInventory(ctx, request)
+}
+
+func (s serverTx) AdjustInventory(ctx context.Context, request *storespb.AdjustInventoryRequest) (resp *storespb.AdjustInventoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.AdjustInventory(ctx, request)
+}
+
+func (s serverTx) GetInventory(ctx context.Context, request *storespb.GetInventoryRequest) (resp *storespb.GetInventoryResponse, err error) {
+	ctx = s.c.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		err = s.closeTx(tx, err)
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	next := server{app: di.Get(ctx, constants.ApplicationKey).(application.App)}
+
+	return next.GetInventory(ctx, request)
+}
+
 func (s serverTx) closeTx(tx *sql.Tx, err error) error {
 	if p := recover(); p != nil {
 		_ = tx.Rollback()
@@ -33,6 +33,13 @@ func RegisterCatalogHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers
 		domain.ProductPriceIncreasedEvent,
 		domain.ProductPriceDecreasedEvent,
 		domain.ProductRemovedEvent,
+		domain.ProductCategorizedEvent,
+		domain.ProductVariantAddedEvent,
+		domain.ProductVariantRemovedEvent,
+		domain.ProductPriceScheduledEvent,
+		domain.ProductScheduledPriceCanceledEvent,
+		domain.ProductScheduledPriceActivatedEvent,
+		domain.ProductScheduledPriceRevertedEvent,
 	)
 }
 
@@ -77,6 +84,15 @@ func (h catalogHandlers[T]) HandleEvent(ctx context.Context, event T) (err error
 		return h.onProductPriceDecreased(ctx, event)
 	case domain.ProductRemovedEvent:
 		return h.onProductRemoved(ctx, event)
+	case domain.ProductCategorizedEvent:
+		return h.onProductCategorized(ctx, event)
+	case domain.ProductVariantAddedEvent:
+		return h.onProductVariantAdded(ctx, event)
+	case domain.ProductVariantRemovedEvent:
+		return h.onProductVariantRemoved(ctx, event)
+	case domain.ProductPriceScheduledEvent, domain.ProductScheduledPriceCanceledEvent,
+		domain.ProductScheduledPriceActivatedEvent, domain.ProductScheduledPriceRevertedEvent:
+		return h.onProductScheduledPriceChanged(ctx, event)
 	}
 	return nil
 }
@@ -105,3 +121,24 @@ func (h catalogHandlers[T]) onProductRemoved(ctx context.Context, event ddd.Even
 	payload := event.Payload().(*domain.Product)
 	return h.catalog.RemoveProduct(ctx, payload.ID())
 }
+
+func (h catalogHandlers[T]) onProductCategorized(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Product)
+	return h.catalog.Categorize(ctx, payload.ID(), payload.Category, payload.Tags)
+}
+
+func (h catalogHandlers[T]) onProductVariantAdded(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductVariantChange)
+	return h.catalog.AddVariant(ctx, payload.Product.ID(), payload.Variant)
+}
+
+func (h catalogHandlers[T]) onProductVariantRemoved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductVariantChange)
+	return h.catalog.RemoveVariant(ctx, payload.Product.ID(), payload.Variant.ID)
+}
+
+func (h catalogHandlers[T]) onProductScheduledPriceChanged(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductScheduledPriceChange)
+	// the product has every change of the command applied; later events repeat the same update
+	return h.catalog.UpdateScheduledPrices(ctx, payload.Product.ID(), payload.Product.Price, payload.Product.ScheduledPrices)
+}
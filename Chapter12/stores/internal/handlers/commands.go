@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"eda-in-golang/internal/am"
+	"eda-in-golang/internal/ddd"
+	"eda-in-golang/internal/errorsotel"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/stores/internal/application"
+	"eda-in-golang/stores/internal/application/commands"
+	"eda-in-golang/stores/storespb"
+)
+
+type commandHandlers struct {
+	app application.App
+}
+
+func NewCommandHandlers(reg registry.Registry, app application.App, replyPublisher am.ReplyPublisher, mws ...am.MessageHandlerMiddleware) am.MessageHandler {
+	return am.NewCommandHandler(reg, replyPublisher, commandHandlers{
+		app: app,
+	}, mws...)
+}
+
+func RegisterCommandHandlers(subscriber am.MessageSubscriber, handlers am.MessageHandler) error {
+	_, err := subscriber.Subscribe(storespb.CommandChannel, handlers, am.MessageFilter{
+		storespb.ReserveStockCommand,
+		storespb.ReleaseStockCommand,
+		storespb.CommitStockCommand,
+	}, am.GroupName("stores-commands"))
+
+	return err
+}
+
+func (h commandHandlers) HandleCommand(ctx context.Context, cmd ddd.Command) (reply ddd.Reply, err error) {
+	span := trace.SpanFromContext(ctx)
+	defer func(started time.Time) {
+		if err != nil {
+			span.AddEvent(
+				"Encountered an error handling command",
+				trace.WithAttributes(errorsotel.ErrAttrs(err)...),
+			)
+		}
+		span.AddEvent("Handled command", trace.WithAttributes(
+			attribute.Int64("TookMS", time.Since(started).Milliseconds()),
+		))
+	}(time.Now())
+
+	span.AddEvent("Handling command", trace.WithAttributes(
+		attribute.String("Command", cmd.CommandName()),
+	))
+
+	switch cmd.CommandName() {
+	case storespb.ReserveStockCommand:
+		return h.doReserveStock(ctx, cmd)
+	case storespb.ReleaseStockCommand:
+		return h.doReleaseStock(ctx, cmd)
+	case storespb.CommitStockCommand:
+		return h.doCommitStock(ctx, cmd)
+	}
+
+	return nil, nil
+}
+
+func (h commandHandlers) doReserveStock(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*storespb.ReserveStock)
+
+	items := make([]commands.StockItem, 0, len(payload.GetItems()))
+	for _, item := range payload.GetItems() {
+		items = append(items, commands.StockItem{
+			ProductID: item.GetProductId(),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	err := h.app.ReserveStock(ctx, commands.ReserveStock{
+		ReservationID: payload.GetReservationId(),
+		Items:         items,
+	})
+
+	// returning nil returns a simple Success or Failure reply; err being nil determines which
+	return nil, err
+}
+
+func (h commandHandlers) doReleaseStock(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*storespb.ReleaseStock)
+
+	err := h.app.ReleaseStock(ctx, commands.ReleaseStock{
+		ReservationID: payload.GetReservationId(),
+		ProductIDs:    payload.GetProductIds(),
+	})
+
+	return nil, err
+}
+
+func (h commandHandlers) doCommitStock(ctx context.Context, cmd ddd.Command) (ddd.Reply, error) {
+	payload := cmd.Payload().(*storespb.CommitStock)
+
+	err := h.app.CommitStock(ctx, commands.CommitStock{
+		ReservationID: payload.GetReservationId(),
+		ProductIDs:    payload.GetProductIds(),
+	})
+
+	return nil, err
+}
@@ -31,12 +31,18 @@ func RegisterDomainEventHandlers(subscriber ddd.EventSubscriber[ddd.Event], hand
 		domain.StoreCreatedEvent,
 		domain.StoreParticipationEnabledEvent,
 		domain.StoreParticipationDisabledEvent,
+		domain.StoreScheduleSetEvent,
 		domain.StoreRebrandedEvent,
 		domain.ProductAddedEvent,
 		domain.ProductRebrandedEvent,
 		domain.ProductPriceIncreasedEvent,
 		domain.ProductPriceDecreasedEvent,
 		domain.ProductRemovedEvent,
+		domain.ProductCategorizedEvent,
+		domain.ProductVariantAddedEvent,
+		domain.ProductVariantRemovedEvent,
+		domain.ProductScheduledPriceActivatedEvent,
+		domain.ProductScheduledPriceRevertedEvent,
 	)
 }
 func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error) {
@@ -64,6 +70,8 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 		return h.onStoreParticipationEnabled(ctx, event)
 	case domain.StoreParticipationDisabledEvent:
 		return h.onStoreParticipationDisabled(ctx, event)
+	case domain.StoreScheduleSetEvent:
+		return h.onStoreScheduleSet(ctx, event)
 	case domain.StoreRebrandedEvent:
 		return h.onStoreRebranded(ctx, event)
 
@@ -77,6 +85,16 @@ func (h domainHandlers[T]) HandleEvent(ctx context.Context, event T) (err error)
 		return h.onProductPriceDecreased(ctx, event)
 	case domain.ProductRemovedEvent:
 		return h.onProductRemoved(ctx, event)
+	case domain.ProductCategorizedEvent:
+		return h.onProductCategorized(ctx, event)
+	case domain.ProductVariantAddedEvent:
+		return h.onProductVariantAdded(ctx, event)
+	case domain.ProductVariantRemovedEvent:
+		return h.onProductVariantRemoved(ctx, event)
+	case domain.ProductScheduledPriceActivatedEvent:
+		return h.onProductScheduledPriceActivated(ctx, event)
+	case domain.ProductScheduledPriceRevertedEvent:
+		return h.onProductScheduledPriceReverted(ctx, event)
 	}
 	return nil
 }
@@ -98,6 +116,7 @@ func (h domainHandlers[T]) onStoreParticipationEnabled(ctx context.Context, even
 		ddd.NewEvent(storespb.StoreParticipatingToggledEvent, &storespb.StoreParticipationToggled{
 			Id:            store.ID(),
 			Participating: true,
+			Scheduled:     store.Schedule.IsScheduled(),
 		}),
 	)
 }
@@ -108,6 +127,20 @@ func (h domainHandlers[T]) onStoreParticipationDisabled(ctx context.Context, eve
 		ddd.NewEvent(storespb.StoreParticipatingToggledEvent, &storespb.StoreParticipationToggled{
 			Id:            store.ID(),
 			Participating: false,
+			Scheduled:     store.Schedule.IsScheduled(),
+		}),
+	)
+}
+
+// onStoreScheduleSet republishes the participation of the store; adding or
+// removing the opening hours changes whether the store is closed elsewhere
+func (h domainHandlers[T]) onStoreScheduleSet(ctx context.Context, event ddd.Event) error {
+	store := event.Payload().(*domain.Store)
+	return h.publisher.Publish(ctx, storespb.StoreAggregateChannel,
+		ddd.NewEvent(storespb.StoreParticipatingToggledEvent, &storespb.StoreParticipationToggled{
+			Id:            store.ID(),
+			Participating: store.Participating,
+			Scheduled:     store.Schedule.IsScheduled(),
 		}),
 	)
 }
@@ -175,3 +208,61 @@ func (h domainHandlers[T]) onProductRemoved(ctx context.Context, event ddd.Event
 		}),
 	)
 }
+
+func (h domainHandlers[T]) onProductCategorized(ctx context.Context, event ddd.Event) error {
+	product := event.Payload().(*domain.Product)
+	return h.publisher.Publish(ctx, storespb.ProductAggregateChannel,
+		ddd.NewEvent(storespb.ProductCategorizedEvent, &storespb.ProductCategorized{
+			Id:       product.ID(),
+			Category: product.Category,
+			Tags:     product.Tags,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onProductVariantAdded(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductVariantChange)
+	return h.publisher.Publish(ctx, storespb.ProductAggregateChannel,
+		ddd.NewEvent(storespb.ProductVariantAddedEvent, &storespb.ProductVariantAdded{
+			Id:         payload.Product.ID(),
+			VariantId:  payload.Variant.ID,
+			Sku:        payload.Variant.SKU,
+			Price:      payload.Variant.Price,
+			Attributes: payload.Variant.Attributes,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onProductVariantRemoved(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductVariantChange)
+	return h.publisher.Publish(ctx, storespb.ProductAggregateChannel,
+		ddd.NewEvent(storespb.ProductVariantRemovedEvent, &storespb.ProductVariantRemoved{
+			Id:        payload.Product.ID(),
+			VariantId: payload.Variant.ID,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onProductScheduledPriceActivated(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductScheduledPriceChange)
+	return h.publisher.Publish(ctx, storespb.ProductAggregateChannel,
+		ddd.NewEvent(storespb.ProductScheduledPriceActivatedEvent, &storespb.ProductScheduledPriceChanged{
+			Id:               payload.Product.ID(),
+			ScheduledPriceId: payload.ScheduledPrice.ID,
+			Price:            payload.Price,
+			Delta:            payload.Delta,
+		}),
+	)
+}
+
+func (h domainHandlers[T]) onProductScheduledPriceReverted(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.ProductScheduledPriceChange)
+	return h.publisher.Publish(ctx, storespb.ProductAggregateChannel,
+		ddd.NewEvent(storespb.ProductScheduledPriceRevertedEvent, &storespb.ProductScheduledPriceChanged{
+			Id:               payload.Product.ID(),
+			ScheduledPriceId: payload.ScheduledPrice.ID,
+			Price:            payload.Price,
+			Delta:            payload.Delta,
+		}),
+	)
+}
@@ -80,7 +80,7 @@ func TestStoresProducer(t *testing.T) {
 			"a StoreCreated message": func(states []models.ProviderState) (message.Body, message.Metadata, error) {
 				// Assign
 				dispatcher := ddd.NewEventDispatcher[ddd.Event]()
-				app := application.New(stores, products, catalog, mall, dispatcher)
+				app := application.New(stores, products, nil, catalog, mall, nil, dispatcher)
 				publisher := am.NewFakeEventPublisher()
 				handler := NewDomainEventHandlers(publisher)
 				RegisterDomainEventHandlers(dispatcher, handler)
@@ -110,7 +110,7 @@ func TestStoresProducer(t *testing.T) {
 			},
 			"a StoreRebranded message": func(states []models.ProviderState) (message.Body, message.Metadata, error) {
 				dispatcher := ddd.NewEventDispatcher[ddd.Event]()
-				app := application.New(stores, products, catalog, mall, dispatcher)
+				app := application.New(stores, products, nil, catalog, mall, nil, dispatcher)
 				publisher := am.NewFakeEventPublisher()
 				handler := NewDomainEventHandlers(publisher)
 				RegisterDomainEventHandlers(dispatcher, handler)
@@ -32,6 +32,7 @@ func RegisterMallHandlers(subscriber ddd.EventSubscriber[ddd.Event], handlers dd
 		domain.StoreParticipationEnabledEvent,
 		domain.StoreParticipationDisabledEvent,
 		domain.StoreRebrandedEvent,
+		domain.StoreScheduleSetEvent,
 	)
 }
 
@@ -74,6 +75,8 @@ func (h mallHandlers[T]) HandleEvent(ctx context.Context, event T) (err error) {
 		return h.onStoreParticipationDisabled(ctx, event)
 	case domain.StoreRebrandedEvent:
 		return h.onStoreRebranded(ctx, event)
+	case domain.StoreScheduleSetEvent:
+		return h.onStoreScheduleSet(ctx, event)
 	}
 	return nil
 }
@@ -97,3 +100,8 @@ func (h mallHandlers[T]) onStoreRebranded(ctx context.Context, event ddd.Event)
 	payload := event.Payload().(*domain.Store)
 	return h.mall.RenameStore(ctx, payload.ID(), payload.Name)
 }
+
+func (h mallHandlers[T]) onStoreScheduleSet(ctx context.Context, event ddd.Event) error {
+	payload := event.Payload().(*domain.Store)
+	return h.mall.SetStoreSchedule(ctx, payload.ID(), payload.Schedule)
+}
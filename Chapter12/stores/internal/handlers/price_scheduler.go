@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/stores/internal/application"
+	"eda-in-golang/stores/internal/application/commands"
+	"eda-in-golang/stores/internal/application/queries"
+	"eda-in-golang/stores/internal/constants"
+)
+
+// StartPriceScheduler periodically puts the scheduled prices into effect and
+// reverts the sales that have ended; the products of each tenant are handled
+// on their own
+func StartPriceScheduler(ctx context.Context, container di.Container, tenants tenant.Tenants, logger zerolog.Logger) {
+	go func() {
+		ticker := time.NewTicker(constants.PriceSchedulerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, tenantID := range tenants.IDs() {
+					applyScheduledPrices(tenant.WithID(ctx, tenantID), container, logger.With().Str("Tenant", tenantID).Logger())
+				}
+			}
+		}
+	}()
+}
+
+// applyScheduledPrices changes the prices of each product that is due in a
+// transaction of its own so that one failing product does not hold back the
+// others
+func applyScheduledPrices(ctx context.Context, container di.Container, logger zerolog.Logger) {
+	at := time.Now()
+
+	var productIDs []string
+	err := inTransaction(ctx, container, func(ctx context.Context, app application.App) (err error) {
+		productIDs, err = app.GetProductsWithDuePrices(ctx, queries.GetProductsWithDuePrices{At: at})
+		return err
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("stores price scheduler encountered an error")
+		return
+	}
+
+	for _, productID := range productIDs {
+		err = inTransaction(ctx, container, func(ctx context.Context, app application.App) error {
+			return app.ApplyScheduledPrices(ctx, commands.ApplyScheduledPrices{
+				ID: productID,
+				At: at,
+			})
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("ProductID", productID).Msg("stores price scheduler encountered an error")
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/tenant"
+	"eda-in-golang/stores/internal/application"
+	"eda-in-golang/stores/internal/application/commands"
+	"eda-in-golang/stores/internal/application/queries"
+	"eda-in-golang/stores/internal/constants"
+	"eda-in-golang/stores/internal/domain"
+)
+
+// StartStoreScheduler periodically opens and closes the stores that follow a
+// schedule; the stores of each tenant are scheduled on their own
+func StartStoreScheduler(ctx context.Context, container di.Container, tenants tenant.Tenants, logger zerolog.Logger) {
+	go func() {
+		ticker := time.NewTicker(constants.StoreSchedulerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, tenantID := range tenants.IDs() {
+					applyStoreSchedules(tenant.WithID(ctx, tenantID), container, logger.With().Str("Tenant", tenantID).Logger())
+				}
+			}
+		}
+	}()
+}
+
+// applyStoreSchedules toggles each store that is due in a transaction of its
+// own so that one failing store does not hold back the others
+func applyStoreSchedules(ctx context.Context, container di.Container, logger zerolog.Logger) {
+	at := time.Now()
+
+	var stores []*domain.MallStore
+	err := inTransaction(ctx, container, func(ctx context.Context, app application.App) (err error) {
+		stores, err = app.GetStoresDueForSchedule(ctx, queries.GetStoresDueForSchedule{At: at})
+		return err
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("stores store scheduler encountered an error")
+		return
+	}
+
+	for _, store := range stores {
+		err = inTransaction(ctx, container, func(ctx context.Context, app application.App) error {
+			return app.ApplyStoreSchedule(ctx, commands.ApplyStoreSchedule{
+				ID: store.ID,
+				At: at,
+			})
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("StoreID", store.ID).Msg("stores store scheduler encountered an error")
+		}
+	}
+}
+
+// inTransaction runs fn with the application of a new scope; the transaction
+// of the scope is committed when fn succeeds
+func inTransaction(ctx context.Context, container di.Container, fn func(ctx context.Context, app application.App) error) (err error) {
+	ctx = container.Scoped(ctx)
+	defer func(tx *sql.Tx) {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}(di.Get(ctx, constants.DatabaseTransactionKey).(*sql.Tx))
+
+	return fn(ctx, di.Get(ctx, constants.ApplicationKey).(application.App))
+}
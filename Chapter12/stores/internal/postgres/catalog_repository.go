@@ -3,11 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/stores/internal/domain"
 )
 
@@ -26,45 +29,100 @@ func NewCatalogRepository(tableName string, db postgres.DB) CatalogRepository {
 }
 
 func (r CatalogRepository) AddProduct(ctx context.Context, productID, storeID, name, description, sku string, price float64) error {
-	const query = `INSERT INTO %s (id, store_id, NAME, description, sku, price) VALUES ($1, $2, $3, $4, $5, $6)`
+	const query = `INSERT INTO %s (id, store_id, NAME, description, sku, price, tenant_id) VALUES ($1, $2, $3, $4, $5, $6, $7)`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name, description, sku, price)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, storeID, name, description, sku, price, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CatalogRepository) Rebrand(ctx context.Context, productID, name, description string) error {
-	const query = `UPDATE %s SET NAME = $2, description = $3 WHERE id = $1`
+	const query = `UPDATE %s SET NAME = $2, description = $3 WHERE id = $1 AND tenant_id = $4`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, name, description)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, name, description, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CatalogRepository) UpdatePrice(ctx context.Context, productID string, delta float64) error {
-	const query = `UPDATE %s SET price = price + $2 WHERE id = $1`
+	const query = `UPDATE %s SET price = price + $2 WHERE id = $1 AND tenant_id = $3`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID, delta)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, delta, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r CatalogRepository) Categorize(ctx context.Context, productID, category string, tags []string) error {
+	const query = `UPDATE %s SET category = $2, tags = $3 WHERE id = $1 AND tenant_id = $4`
+
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return errors.Wrap(err, "encoding product tags")
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), productID, category, data, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r CatalogRepository) AddVariant(ctx context.Context, productID string, variant domain.ProductVariant) error {
+	const query = `UPDATE %s SET variants = variants || $2::jsonb WHERE id = $1 AND tenant_id = $3`
+
+	data, err := json.Marshal([]domain.ProductVariant{variant})
+	if err != nil {
+		return errors.Wrap(err, "encoding product variant")
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), productID, data, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r CatalogRepository) RemoveVariant(ctx context.Context, productID, variantID string) error {
+	const query = `UPDATE %s SET variants = (
+  SELECT COALESCE(jsonb_agg(v), '[]') FROM jsonb_array_elements(variants) v WHERE v ->> 'ID' <> $2
+) WHERE id = $1 AND tenant_id = $3`
+
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, variantID, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r CatalogRepository) UpdateScheduledPrices(ctx context.Context, productID string, price float64, scheduledPrices domain.ScheduledPrices) error {
+	const query = `UPDATE %s SET price = $2, scheduled_prices = $3, next_price_change_at = $4 WHERE id = $1 AND tenant_id = $5`
+
+	data, err := json.Marshal(scheduledPrices)
+	if err != nil {
+		return errors.Wrap(err, "encoding product scheduled prices")
+	}
+
+	var nextChangeAt sql.NullTime
+	nextChangeAt.Time, nextChangeAt.Valid = scheduledPrices.NextChangeAt()
+
+	_, err = r.db.ExecContext(ctx, r.table(query), productID, price, data, nextChangeAt, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CatalogRepository) RemoveProduct(ctx context.Context, productID string) error {
-	const query = `DELETE FROM %s WHERE id = $1`
+	const query = `DELETE FROM %s WHERE id = $1 AND tenant_id = $2`
 
-	_, err := r.db.ExecContext(ctx, r.table(query), productID)
+	_, err := r.db.ExecContext(ctx, r.table(query), productID, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r CatalogRepository) Find(ctx context.Context, productID string) (*domain.CatalogProduct, error) {
-	const query = `SELECT store_id, name, description, sku, price FROM %s WHERE id = $1 LIMIT 1`
+	const query = `SELECT store_id, name, description, sku, price, category, tags, variants, scheduled_prices FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1`
 
 	product := &domain.CatalogProduct{
 		ID: productID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), productID).Scan(&product.StoreID, &product.Name, &product.Description, &product.SKU, &product.Price)
+	var tags, variants, scheduledPrices []byte
+	err := r.db.QueryRowContext(ctx, r.table(query), productID, tenant.FromContext(ctx)).Scan(
+		&product.StoreID, &product.Name, &product.Description, &product.SKU, &product.Price, &product.Category, &tags, &variants, &scheduledPrices,
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.ErrNotFound.Msg("product with that ID does not exist")
@@ -72,14 +130,18 @@ func (r CatalogRepository) Find(ctx context.Context, productID string) (*domain.
 		return nil, errors.Wrap(err, "scanning product")
 	}
 
+	if err = decodeProductDetails(product, tags, variants, scheduledPrices); err != nil {
+		return nil, err
+	}
+
 	return product, nil
 }
 
 func (r CatalogRepository) GetCatalog(ctx context.Context, storeID string) (products []*domain.CatalogProduct, err error) {
-	const query = `SELECT id, name, description, sku, price FROM %s WHERE store_id = $1`
+	const query = `SELECT id, name, description, sku, price, category, tags, variants, scheduled_prices FROM %s WHERE store_id = $1 AND tenant_id = $2`
 
 	var rows *sql.Rows
-	rows, err = r.db.QueryContext(ctx, r.table(query), storeID)
+	rows, err = r.db.QueryContext(ctx, r.table(query), storeID, tenant.FromContext(ctx))
 	if err != nil {
 		return nil, errors.Wrap(err, "querying products")
 	}
@@ -94,10 +156,14 @@ func (r CatalogRepository) GetCatalog(ctx context.Context, storeID string) (prod
 		product := &domain.CatalogProduct{
 			StoreID: storeID,
 		}
-		err := rows.Scan(&product.ID, &product.Name, &product.Description, &product.SKU, &product.Price)
+		var tags, variants, scheduledPrices []byte
+		err := rows.Scan(&product.ID, &product.Name, &product.Description, &product.SKU, &product.Price, &product.Category, &tags, &variants, &scheduledPrices)
 		if err != nil {
 			return nil, errors.Wrap(err, "scanning product")
 		}
+		if err = decodeProductDetails(product, tags, variants, scheduledPrices); err != nil {
+			return nil, err
+		}
 
 		products = append(products, product)
 	}
@@ -109,6 +175,51 @@ func (r CatalogRepository) GetCatalog(ctx context.Context, storeID string) (prod
 	return products, nil
 }
 
+func (r CatalogRepository) AllWithDuePrices(ctx context.Context, at time.Time) (productIDs []string, err error) {
+	const query = `SELECT id FROM %s WHERE next_price_change_at <= $1 AND tenant_id = $2`
+
+	var rows *sql.Rows
+	rows, err = r.db.QueryContext(ctx, r.table(query), at, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying products")
+	}
+	defer func(rows *sql.Rows) {
+		err := rows.Close()
+		if err != nil {
+			err = errors.Wrap(err, "closing product rows")
+		}
+	}(rows)
+
+	for rows.Next() {
+		var productID string
+		if err := rows.Scan(&productID); err != nil {
+			return nil, errors.Wrap(err, "scanning product")
+		}
+
+		productIDs = append(productIDs, productID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "finishing product rows")
+	}
+
+	return productIDs, nil
+}
+
+func decodeProductDetails(product *domain.CatalogProduct, tags, variants, scheduledPrices []byte) error {
+	if err := json.Unmarshal(tags, &product.Tags); err != nil {
+		return errors.Wrap(err, "decoding product tags")
+	}
+	if err := json.Unmarshal(variants, &product.Variants); err != nil {
+		return errors.Wrap(err, "decoding product variants")
+	}
+	if err := json.Unmarshal(scheduledPrices, &product.ScheduledPrices); err != nil {
+		return errors.Wrap(err, "decoding product scheduled prices")
+	}
+
+	return nil
+}
+
 func (r CatalogRepository) table(query string) string {
 	return fmt.Sprintf(query, r.tableName)
 }
@@ -3,11 +3,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/stackus/errors"
 
 	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/stores/internal/domain"
 )
 
@@ -26,103 +28,109 @@ func NewMallRepository(tableName string, db postgres.DB) MallRepository {
 }
 
 func (r MallRepository) AddStore(ctx context.Context, storeID, name, location string) error {
-	const query = "INSERT INTO %s (id, NAME, location, participating) VALUES ($1, $2, $3, $4)"
+	const query = "INSERT INTO %s (id, NAME, location, participating, tenant_id) VALUES ($1, $2, $3, $4, $5)"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, location, false)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, location, false, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r MallRepository) SetStoreParticipation(ctx context.Context, storeID string, participating bool) error {
-	const query = "UPDATE %s SET participating = $2 WHERE id = $1"
+	const query = "UPDATE %s SET participating = $2 WHERE id = $1 AND tenant_id = $3"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, participating)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, participating, tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r MallRepository) RenameStore(ctx context.Context, storeID, name string) error {
-	const query = "UPDATE %s SET NAME = $2 WHERE id = $1"
+	const query = "UPDATE %s SET NAME = $2 WHERE id = $1 AND tenant_id = $3"
 
-	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name)
+	_, err := r.db.ExecContext(ctx, r.table(query), storeID, name, tenant.FromContext(ctx))
+
+	return err
+}
+
+func (r MallRepository) SetStoreSchedule(ctx context.Context, storeID string, schedule domain.StoreSchedule) error {
+	const query = "UPDATE %s SET schedule = $2, scheduled = $3 WHERE id = $1 AND tenant_id = $4"
+
+	data, err := json.Marshal(schedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, r.table(query), storeID, data, schedule.IsScheduled(), tenant.FromContext(ctx))
 
 	return err
 }
 
 func (r MallRepository) Find(ctx context.Context, storeID string) (*domain.MallStore, error) {
-	const query = "SELECT name, location, participating FROM %s WHERE id = $1 LIMIT 1"
+	const query = "SELECT name, location, participating, schedule FROM %s WHERE id = $1 AND tenant_id = $2 LIMIT 1"
 
 	store := &domain.MallStore{
 		ID: storeID,
 	}
 
-	err := r.db.QueryRowContext(ctx, r.table(query), storeID).Scan(&store.Name, &store.Location, &store.Participating)
+	var schedule []byte
+	err := r.db.QueryRowContext(ctx, r.table(query), storeID, tenant.FromContext(ctx)).Scan(&store.Name, &store.Location, &store.Participating, &schedule)
 	if err != nil {
 		return nil, errors.Wrap(err, "scanning store")
 	}
 
+	if err = json.Unmarshal(schedule, &store.Schedule); err != nil {
+		return nil, errors.Wrap(err, "decoding store schedule")
+	}
+
 	return store, nil
 }
 
-func (r MallRepository) All(ctx context.Context) (stores []*domain.MallStore, err error) {
-	const query = "SELECT id, name, location, participating FROM %s"
+func (r MallRepository) All(ctx context.Context) ([]*domain.MallStore, error) {
+	const query = "SELECT id, name, location, participating, schedule FROM %s WHERE tenant_id = $1"
 
-	var rows *sql.Rows
-	rows, err = r.db.QueryContext(ctx, r.table(query))
-	if err != nil {
-		return nil, errors.Wrap(err, "querying stores")
-	}
-	defer func(rows *sql.Rows) {
-		err := rows.Close()
-		if err != nil {
-			err = errors.Wrap(err, "closing store rows")
-		}
-	}(rows)
+	return r.find(ctx, r.table(query), tenant.FromContext(ctx))
+}
 
-	for rows.Next() {
-		store := new(domain.MallStore)
-		err := rows.Scan(&store.ID, &store.Name, &store.Location, &store.Participating)
-		if err != nil {
-			return nil, errors.Wrap(err, "scanning store")
-		}
+func (r MallRepository) AllParticipating(ctx context.Context) ([]*domain.MallStore, error) {
+	const query = "SELECT id, name, location, participating, schedule FROM %s WHERE participating IS TRUE AND tenant_id = $1"
 
-		stores = append(stores, store)
-	}
+	return r.find(ctx, r.table(query), tenant.FromContext(ctx))
+}
 
-	if err = rows.Err(); err != nil {
-		return nil, errors.Wrap(err, "finishing store rows")
-	}
+func (r MallRepository) AllScheduled(ctx context.Context) ([]*domain.MallStore, error) {
+	const query = "SELECT id, name, location, participating, schedule FROM %s WHERE scheduled IS TRUE AND tenant_id = $1"
 
-	return stores, nil
+	return r.find(ctx, r.table(query), tenant.FromContext(ctx))
 }
 
-func (r MallRepository) AllParticipating(ctx context.Context) (stores []*domain.MallStore, err error) {
-	const query = "SELECT id, name, location, participating FROM %s WHERE participating IS TRUE"
-
+func (r MallRepository) find(ctx context.Context, query string, args ...any) (stores []*domain.MallStore, err error) {
 	var rows *sql.Rows
-	rows, err = r.db.QueryContext(ctx, r.table(query))
+	rows, err = r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, errors.Wrap(err, "querying participating stores")
+		return nil, errors.Wrap(err, "querying stores")
 	}
 	defer func(rows *sql.Rows) {
 		err := rows.Close()
 		if err != nil {
-			err = errors.Wrap(err, "closing participating store rows")
+			err = errors.Wrap(err, "closing store rows")
 		}
 	}(rows)
 
 	for rows.Next() {
+		var schedule []byte
 		store := new(domain.MallStore)
-		err := rows.Scan(&store.ID, &store.Name, &store.Location, &store.Participating)
+		err := rows.Scan(&store.ID, &store.Name, &store.Location, &store.Participating, &schedule)
 		if err != nil {
 			return nil, errors.Wrap(err, "scanning store")
 		}
+		if err = json.Unmarshal(schedule, &store.Schedule); err != nil {
+			return nil, errors.Wrap(err, "decoding store schedule")
+		}
 
 		stores = append(stores, store)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, errors.Wrap(err, "finishing participating store rows")
+		return nil, errors.Wrap(err, "finishing store rows")
 	}
 
 	return stores, nil
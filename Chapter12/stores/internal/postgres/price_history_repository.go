@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/stackus/errors"
+
+	"eda-in-golang/internal/postgres"
+	"eda-in-golang/internal/registry"
+	"eda-in-golang/stores/internal/domain"
+)
+
+// PriceHistoryRepository replays the whole event stream of a product; the
+// snapshots are skipped because they would hide the earlier prices
+type PriceHistoryRepository struct {
+	events postgres.EventStore
+}
+
+var _ domain.ProductPriceHistoryRepository = (*PriceHistoryRepository)(nil)
+
+func NewPriceHistoryRepository(tableName string, db postgres.DB, registry registry.Registry) PriceHistoryRepository {
+	return PriceHistoryRepository{
+		events: postgres.NewEventStore(tableName, db, registry),
+	}
+}
+
+func (r PriceHistoryRepository) Load(ctx context.Context, productID string) (*domain.ProductPriceHistory, error) {
+	history := domain.NewProductPriceHistory(productID)
+
+	if err := r.events.Load(ctx, history); err != nil {
+		return nil, errors.Wrap(err, "loading product events")
+	}
+
+	if history.Version() == 0 {
+		return nil, errors.ErrNotFound.Msg("product with that ID does not exist")
+	}
+
+	return history, nil
+}
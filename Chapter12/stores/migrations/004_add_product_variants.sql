@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE products
+  ADD COLUMN category text  NOT NULL DEFAULT '',
+  ADD COLUMN tags     jsonb NOT NULL DEFAULT '[]',
+  ADD COLUMN variants jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE products
+  DROP COLUMN category,
+  DROP COLUMN tags,
+  DROP COLUMN variants;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE products
+  ADD COLUMN scheduled_prices     jsonb NOT NULL DEFAULT '[]',
+  ADD COLUMN next_price_change_at timestamptz;
+
+CREATE INDEX products_price_change_due_idx ON products (next_price_change_at) WHERE next_price_change_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS products_price_change_due_idx;
+
+ALTER TABLE products
+  DROP COLUMN scheduled_prices,
+  DROP COLUMN next_price_change_at;
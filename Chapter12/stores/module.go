@@ -9,8 +9,10 @@ import (
 	"eda-in-golang/internal/am"
 	"eda-in-golang/internal/amotel"
 	"eda-in-golang/internal/amprom"
+	"eda-in-golang/internal/claimcheck"
 	"eda-in-golang/internal/ddd"
 	"eda-in-golang/internal/di"
+	"eda-in-golang/internal/encryption"
 	"eda-in-golang/internal/es"
 	"eda-in-golang/internal/jetstream"
 	pg "eda-in-golang/internal/postgres"
@@ -18,6 +20,7 @@ import (
 	"eda-in-golang/internal/registry"
 	"eda-in-golang/internal/registry/serdes"
 	"eda-in-golang/internal/system"
+	"eda-in-golang/internal/tenant"
 	"eda-in-golang/internal/tm"
 	"eda-in-golang/stores/internal/application"
 	"eda-in-golang/stores/internal/constants"
@@ -49,7 +52,8 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		}
 		return reg, nil
 	})
-	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Logger())
+	stream := jetstream.NewStream(svc.Config().Nats.Stream, svc.JS(), svc.Tenants(), svc.Logger())
+	svc.DrainStream(stream.Drain)
 	container.AddSingleton(constants.DomainDispatcherKey, func(c di.Container) (any, error) {
 		return ddd.NewEventDispatcher[ddd.Event](), nil
 	})
@@ -62,16 +66,24 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 		outboxStore := pg.NewOutboxStore(constants.OutboxTableName, tx)
 		return am.NewMessagePublisher(
 			stream,
+			tenant.MessageContextInjector(),
 			amotel.OtelMessageContextInjector(),
 			sentCounter,
+			am.CompressingPublisher(svc.Config().Nats.Compression),
+			encryption.EncryptingPublisher(svc.Keys()),
+			claimcheck.Publisher(svc.BlobStore(), svc.Config().ClaimCheck),
 			tm.OutboxPublisher(outboxStore),
 		), nil
 	})
 	container.AddSingleton(constants.MessageSubscriberKey, func(c di.Container) (any, error) {
 		return am.NewMessageSubscriber(
 			stream,
+			tenant.MessageContextExtractor(svc.Tenants()),
 			amotel.OtelMessageContextExtractor(),
 			amprom.ReceivedMessagesCounter(constants.ServiceName),
+			claimcheck.Handler(svc.BlobStore()),
+			encryption.DecryptingHandler(svc.Keys()),
+			am.DecompressingHandler(svc.Config().Nats.Compression),
 		), nil
 	})
 	container.AddScoped(constants.EventPublisherKey, func(c di.Container) (any, error) {
@@ -80,6 +92,12 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			c.Get(constants.MessagePublisherKey).(am.MessagePublisher),
 		), nil
 	})
+	container.AddScoped(constants.ReplyPublisherKey, func(c di.Container) (any, error) {
+		return am.NewReplyPublisher(
+			c.Get(constants.RegistryKey).(registry.Registry),
+			c.Get(constants.MessagePublisherKey).(am.MessagePublisher),
+		), nil
+	})
 	container.AddScoped(constants.InboxStoreKey, func(c di.Container) (any, error) {
 		tx := postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx))
 		return pg.NewInboxStore(constants.InboxTableName, tx), nil
@@ -106,6 +124,13 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			c.Get(constants.AggregateStoreKey).(es.AggregateStore),
 		), nil
 	})
+	container.AddScoped(constants.InventoryRepoKey, func(c di.Container) (any, error) {
+		return es.NewAggregateRepository[*domain.Inventory](
+			domain.InventoryAggregate,
+			c.Get(constants.RegistryKey).(registry.Registry),
+			c.Get(constants.AggregateStoreKey).(es.AggregateStore),
+		), nil
+	})
 	container.AddScoped(constants.CatalogRepoKey, func(c di.Container) (any, error) {
 		return postgres.NewCatalogRepository(
 			constants.CatalogTableName,
@@ -118,14 +143,23 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
 		), nil
 	})
+	container.AddScoped(constants.PriceHistoryRepoKey, func(c di.Container) (any, error) {
+		return postgres.NewPriceHistoryRepository(
+			constants.EventsTableName,
+			postgresotel.Trace(c.Get(constants.DatabaseTransactionKey).(*sql.Tx)),
+			c.Get(constants.RegistryKey).(registry.Registry),
+		), nil
+	})
 
 	// setup application
 	container.AddScoped(constants.ApplicationKey, func(c di.Container) (any, error) {
 		return application.New(
 			c.Get(constants.StoresRepoKey).(domain.StoreRepository),
 			c.Get(constants.ProductsRepoKey).(domain.ProductRepository),
+			c.Get(constants.InventoryRepoKey).(domain.InventoryRepository),
 			c.Get(constants.CatalogRepoKey).(domain.CatalogRepository),
 			c.Get(constants.MallRepoKey).(domain.MallRepository),
+			c.Get(constants.PriceHistoryRepoKey).(domain.ProductPriceHistoryRepository),
 			c.Get(constants.DomainDispatcherKey).(ddd.EventPublisher[ddd.Event]),
 		), nil
 	})
@@ -138,6 +172,14 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	container.AddScoped(constants.DomainEventHandlersKey, func(c di.Container) (any, error) {
 		return handlers.NewDomainEventHandlers(c.Get(constants.EventPublisherKey).(am.EventPublisher)), nil
 	})
+	container.AddScoped(constants.CommandHandlersKey, func(c di.Container) (any, error) {
+		return handlers.NewCommandHandlers(
+			c.Get(constants.RegistryKey).(registry.Registry),
+			c.Get(constants.ApplicationKey).(application.App),
+			c.Get(constants.ReplyPublisherKey).(am.ReplyPublisher),
+			tm.InboxHandler(c.Get(constants.InboxStoreKey).(tm.InboxStore)),
+		), nil
+	})
 	outboxProcessor := tm.NewOutboxProcessor(
 		stream,
 		pg.NewOutboxStore(constants.OutboxTableName, svc.DB()),
@@ -156,10 +198,16 @@ func Root(ctx context.Context, svc system.Service) (err error) {
 	handlers.RegisterCatalogHandlersTx(container)
 	handlers.RegisterMallHandlersTx(container)
 	handlers.RegisterDomainEventHandlersTx(container)
+	if err = handlers.RegisterCommandHandlersTx(container); err != nil {
+		return err
+	}
 	if err = storespb.RegisterAsyncAPI(svc.Mux()); err != nil {
 		return err
 	}
+	handlers.StartStoreScheduler(ctx, container, svc.Tenants(), svc.Logger())
+	handlers.StartPriceScheduler(ctx, container, svc.Tenants(), svc.Logger())
 	startOutboxProcessor(ctx, outboxProcessor, svc.Logger())
+	svc.DrainOutbox(outboxProcessor.Flush)
 
 	return nil
 }
@@ -188,6 +236,9 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(domain.StoreRebranded{}); err != nil {
 		return
 	}
+	if err = serde.Register(domain.StoreScheduleSet{}); err != nil {
+		return
+	}
 	// store snapshots
 	if err = serde.RegisterKey(domain.StoreV1{}.SnapshotName(), domain.StoreV1{}); err != nil {
 		return
@@ -217,11 +268,62 @@ func registrations(reg registry.Registry) (err error) {
 	if err = serde.Register(domain.ProductRemoved{}); err != nil {
 		return
 	}
+	if err = serde.Register(domain.ProductCategorized{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductVariantAdded{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductVariantRemoved{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductPriceScheduled{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductScheduledPriceCanceled{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductScheduledPriceActivated{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.ProductScheduledPriceReverted{}); err != nil {
+		return
+	}
 	// product snapshots
 	if err = serde.RegisterKey(domain.ProductV1{}.SnapshotName(), domain.ProductV1{}); err != nil {
 		return
 	}
 
+	// Inventory
+	if err = serde.Register(domain.Inventory{}, func(v any) error {
+		inventory := v.(*domain.Inventory)
+		inventory.Aggregate = es.NewAggregate("", domain.InventoryAggregate)
+		inventory.Reservations = make(map[string]int)
+		return nil
+	}); err != nil {
+		return
+	}
+	// inventory events
+	if err = serde.Register(domain.InventoryReceived{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.InventoryAdjusted{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.InventoryReserved{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.InventoryReleased{}); err != nil {
+		return
+	}
+	if err = serde.Register(domain.InventoryCommitted{}); err != nil {
+		return
+	}
+	// inventory snapshots
+	if err = serde.RegisterKey(domain.InventoryV1{}.SnapshotName(), domain.InventoryV1{}); err != nil {
+		return
+	}
+
 	return
 }
 func startOutboxProcessor(ctx context.Context, outboxProcessor tm.OutboxProcessor, logger zerolog.Logger) {
@@ -9,6 +9,7 @@ package storespb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -25,10 +26,11 @@ type Store struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Participating bool   `protobuf:"varint,4,opt,name=participating,proto3" json:"participating,omitempty"`
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location      string         `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Participating bool           `protobuf:"varint,4,opt,name=participating,proto3" json:"participating,omitempty"`
+	Schedule      *StoreSchedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (x *Store) Reset() {
@@ -91,23 +93,215 @@ func (x *Store) GetParticipating() bool {
 	return false
 }
 
+func (x *Store) GetSchedule() *StoreSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type StoreSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeZone string          `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Hours    []*OpeningHours `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`
+	Holidays []*Holiday      `protobuf:"bytes,3,rep,name=holidays,proto3" json:"holidays,omitempty"`
+}
+
+func (x *StoreSchedule) Reset() {
+	*x = StoreSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreSchedule) ProtoMessage() {}
+
+func (x *StoreSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreSchedule.ProtoReflect.Descriptor instead.
+func (*StoreSchedule) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoreSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *StoreSchedule) GetHours() []*OpeningHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *StoreSchedule) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type OpeningHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day    int32  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Opens  string `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	Closes string `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OpeningHours) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type Product struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StoreId     string  `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	Name        string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Sku         string  `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
-	Price       float64 `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Id              string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StoreId         string            `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Name            string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Sku             string            `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price           float64           `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Category        string            `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Tags            []string          `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Variants        []*ProductVariant `protobuf:"bytes,9,rep,name=variants,proto3" json:"variants,omitempty"`
+	ScheduledPrices []*ScheduledPrice `protobuf:"bytes,10,rep,name=scheduled_prices,json=scheduledPrices,proto3" json:"scheduled_prices,omitempty"`
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[1]
+		mi := &file_storespb_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -119,8 +313,1280 @@ func (x *Product) String() string {
 
 func (*Product) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[1]
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Product) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Product) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetScheduledPrices() []*ScheduledPrice {
+	if x != nil {
+		return x.ScheduledPrices
+	}
+	return nil
+}
+
+type ProductVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku        string            `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price      float64           `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVariant) ProtoMessage() {}
+
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
+func (*ProductVariant) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProductVariant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// a price the product switches to at effective_from; a sale also has an
+// effective_until at which the price reverts
+type ScheduledPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price          float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	// set while a sale is in effect
+	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *ScheduledPrice) Reset() {
+	*x = ScheduledPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPrice) ProtoMessage() {}
+
+func (x *ScheduledPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPrice.ProtoReflect.Descriptor instead.
+func (*ScheduledPrice) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScheduledPrice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ScheduledPrice) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *ScheduledPrice) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price            float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	PreviousPrice    float64                `protobuf:"fixed64,2,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"`
+	Reason           string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ScheduledPriceId string                 `protobuf:"bytes,4,opt,name=scheduled_price_id,json=scheduledPriceId,proto3" json:"scheduled_price_id,omitempty"`
+	ChangedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PriceChange) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceChange) GetPreviousPrice() float64 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *PriceChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PriceChange) GetScheduledPriceId() string {
+	if x != nil {
+		return x.ScheduledPriceId
+	}
+	return ""
+}
+
+func (x *PriceChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type Inventory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OnHand    int32  `protobuf:"varint,2,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	Reserved  int32  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available int32  `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	// stock that is not tracked is unlimited
+	Tracked bool `protobuf:"varint,5,opt,name=tracked,proto3" json:"tracked,omitempty"`
+}
+
+func (x *Inventory) Reset() {
+	*x = Inventory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Inventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inventory) ProtoMessage() {}
+
+func (x *Inventory) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Inventory) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Inventory) GetOnHand() int32 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *Inventory) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *Inventory) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Inventory) GetTracked() bool {
+	if x != nil {
+		return x.Tracked
+	}
+	return false
+}
+
+type CreateStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *CreateStoreRequest) Reset() {
+	*x = CreateStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoreRequest) ProtoMessage() {}
+
+func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoreRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type CreateStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateStoreResponse) Reset() {
+	*x = CreateStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoreResponse) ProtoMessage() {}
+
+func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoreResponse.ProtoReflect.Descriptor instead.
+func (*CreateStoreResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateStoreResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EnableParticipationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnableParticipationRequest) Reset() {
+	*x = EnableParticipationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableParticipationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableParticipationRequest) ProtoMessage() {}
+
+func (x *EnableParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableParticipationRequest.ProtoReflect.Descriptor instead.
+func (*EnableParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnableParticipationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EnableParticipationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EnableParticipationResponse) Reset() {
+	*x = EnableParticipationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableParticipationResponse) ProtoMessage() {}
+
+func (x *EnableParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableParticipationResponse.ProtoReflect.Descriptor instead.
+func (*EnableParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{12}
+}
+
+type DisableParticipationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DisableParticipationRequest) Reset() {
+	*x = DisableParticipationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableParticipationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableParticipationRequest) ProtoMessage() {}
+
+func (x *DisableParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableParticipationRequest.ProtoReflect.Descriptor instead.
+func (*DisableParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisableParticipationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DisableParticipationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisableParticipationResponse) Reset() {
+	*x = DisableParticipationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableParticipationResponse) ProtoMessage() {}
+
+func (x *DisableParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableParticipationResponse.ProtoReflect.Descriptor instead.
+func (*DisableParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{14}
+}
+
+type RebrandStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RebrandStoreRequest) Reset() {
+	*x = RebrandStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebrandStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebrandStoreRequest) ProtoMessage() {}
+
+func (x *RebrandStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebrandStoreRequest.ProtoReflect.Descriptor instead.
+func (*RebrandStoreRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RebrandStoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RebrandStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RebrandStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RebrandStoreResponse) Reset() {
+	*x = RebrandStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebrandStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebrandStoreResponse) ProtoMessage() {}
+
+func (x *RebrandStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebrandStoreResponse.ProtoReflect.Descriptor instead.
+func (*RebrandStoreResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{16}
+}
+
+type SetStoreScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schedule *StoreSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *SetStoreScheduleRequest) Reset() {
+	*x = SetStoreScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStoreScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStoreScheduleRequest) ProtoMessage() {}
+
+func (x *SetStoreScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStoreScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetStoreScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetStoreScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetStoreScheduleRequest) GetSchedule() *StoreSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetStoreScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetStoreScheduleResponse) Reset() {
+	*x = SetStoreScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStoreScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStoreScheduleResponse) ProtoMessage() {}
+
+func (x *SetStoreScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStoreScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetStoreScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{18}
+}
+
+type GetStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetStoreRequest) Reset() {
+	*x = GetStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreRequest) ProtoMessage() {}
+
+func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Store *Store `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+}
+
+func (x *GetStoreResponse) Reset() {
+	*x = GetStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreResponse) ProtoMessage() {}
+
+func (x *GetStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetStoreResponse) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+type GetStoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStoresRequest) Reset() {
+	*x = GetStoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoresRequest) ProtoMessage() {}
+
+func (x *GetStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoresRequest.ProtoReflect.Descriptor instead.
+func (*GetStoresRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{21}
+}
+
+type GetStoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stores []*Store `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *GetStoresResponse) Reset() {
+	*x = GetStoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoresResponse) ProtoMessage() {}
+
+func (x *GetStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoresResponse.ProtoReflect.Descriptor instead.
+func (*GetStoresResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type GetParticipatingStoresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetParticipatingStoresRequest) Reset() {
+	*x = GetParticipatingStoresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParticipatingStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParticipatingStoresRequest) ProtoMessage() {}
+
+func (x *GetParticipatingStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParticipatingStoresRequest.ProtoReflect.Descriptor instead.
+func (*GetParticipatingStoresRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{23}
+}
+
+type GetParticipatingStoresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stores []*Store `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *GetParticipatingStoresResponse) Reset() {
+	*x = GetParticipatingStoresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetParticipatingStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetParticipatingStoresResponse) ProtoMessage() {}
+
+func (x *GetParticipatingStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetParticipatingStoresResponse.ProtoReflect.Descriptor instead.
+func (*GetParticipatingStoresResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetParticipatingStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoreId     string  `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Sku         string  `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price       float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddProductRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RebrandProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *RebrandProductRequest) Reset() {
+	*x = RebrandProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebrandProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebrandProductRequest) ProtoMessage() {}
+
+func (x *RebrandProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,79 +1597,96 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RebrandProductRequest.ProtoReflect.Descriptor instead.
+func (*RebrandProductRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Product) GetId() string {
+func (x *RebrandProductRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Product) GetStoreId() string {
-	if x != nil {
-		return x.StoreId
-	}
-	return ""
-}
-
-func (x *Product) GetName() string {
+func (x *RebrandProductRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Product) GetDescription() string {
+func (x *RebrandProductRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *Product) GetSku() string {
-	if x != nil {
-		return x.Sku
+type RebrandProductResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RebrandProductResponse) Reset() {
+	*x = RebrandProductResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_storespb_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Product) GetPrice() float64 {
-	if x != nil {
-		return x.Price
+func (x *RebrandProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebrandProductResponse) ProtoMessage() {}
+
+func (x *RebrandProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type CreateStoreRequest struct {
+// Deprecated: Use RebrandProductResponse.ProtoReflect.Descriptor instead.
+func (*RebrandProductResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{28}
+}
+
+type IncreaseProductPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *CreateStoreRequest) Reset() {
-	*x = CreateStoreRequest{}
+func (x *IncreaseProductPriceRequest) Reset() {
+	*x = IncreaseProductPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[2]
+		mi := &file_storespb_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateStoreRequest) String() string {
+func (x *IncreaseProductPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStoreRequest) ProtoMessage() {}
+func (*IncreaseProductPriceRequest) ProtoMessage() {}
 
-func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[2]
+func (x *IncreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,50 +1697,48 @@ func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStoreRequest.ProtoReflect.Descriptor instead.
-func (*CreateStoreRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use IncreaseProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*IncreaseProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateStoreRequest) GetName() string {
+func (x *IncreaseProductPriceRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateStoreRequest) GetLocation() string {
+func (x *IncreaseProductPriceRequest) GetPrice() float64 {
 	if x != nil {
-		return x.Location
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-type CreateStoreResponse struct {
+type IncreaseProductPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateStoreResponse) Reset() {
-	*x = CreateStoreResponse{}
+func (x *IncreaseProductPriceResponse) Reset() {
+	*x = IncreaseProductPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[3]
+		mi := &file_storespb_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateStoreResponse) String() string {
+func (x *IncreaseProductPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStoreResponse) ProtoMessage() {}
+func (*IncreaseProductPriceResponse) ProtoMessage() {}
 
-func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[3]
+func (x *IncreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,43 +1749,37 @@ func (x *CreateStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStoreResponse.ProtoReflect.Descriptor instead.
-func (*CreateStoreResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CreateStoreResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use IncreaseProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*IncreaseProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{30}
 }
 
-type EnableParticipationRequest struct {
+type DecreaseProductPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *EnableParticipationRequest) Reset() {
-	*x = EnableParticipationRequest{}
+func (x *DecreaseProductPriceRequest) Reset() {
+	*x = DecreaseProductPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[4]
+		mi := &file_storespb_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnableParticipationRequest) String() string {
+func (x *DecreaseProductPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableParticipationRequest) ProtoMessage() {}
+func (*DecreaseProductPriceRequest) ProtoMessage() {}
 
-func (x *EnableParticipationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[4]
+func (x *DecreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,41 +1790,48 @@ func (x *EnableParticipationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableParticipationRequest.ProtoReflect.Descriptor instead.
-func (*EnableParticipationRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DecreaseProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*DecreaseProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *EnableParticipationRequest) GetId() string {
+func (x *DecreaseProductPriceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type EnableParticipationResponse struct {
+func (x *DecreaseProductPriceRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type DecreaseProductPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *EnableParticipationResponse) Reset() {
-	*x = EnableParticipationResponse{}
+func (x *DecreaseProductPriceResponse) Reset() {
+	*x = DecreaseProductPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[5]
+		mi := &file_storespb_api_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnableParticipationResponse) String() string {
+func (x *DecreaseProductPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableParticipationResponse) ProtoMessage() {}
+func (*DecreaseProductPriceResponse) ProtoMessage() {}
 
-func (x *EnableParticipationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[5]
+func (x *DecreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,36 +1842,40 @@ func (x *EnableParticipationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableParticipationResponse.ProtoReflect.Descriptor instead.
-func (*EnableParticipationResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DecreaseProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*DecreaseProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{32}
 }
 
-type DisableParticipationRequest struct {
+type ScheduleProductPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// leave unset for a permanent price change
+	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
 }
 
-func (x *DisableParticipationRequest) Reset() {
-	*x = DisableParticipationRequest{}
+func (x *ScheduleProductPriceRequest) Reset() {
+	*x = ScheduleProductPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[6]
+		mi := &file_storespb_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisableParticipationRequest) String() string {
+func (x *ScheduleProductPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableParticipationRequest) ProtoMessage() {}
+func (*ScheduleProductPriceRequest) ProtoMessage() {}
 
-func (x *DisableParticipationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[6]
+func (x *ScheduleProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,41 +1886,64 @@ func (x *DisableParticipationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableParticipationRequest.ProtoReflect.Descriptor instead.
-func (*DisableParticipationRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ScheduleProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DisableParticipationRequest) GetId() string {
+func (x *ScheduleProductPriceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DisableParticipationResponse struct {
+func (x *ScheduleProductPriceRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ScheduleProductPriceRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *ScheduleProductPriceRequest) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+type ScheduleProductPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DisableParticipationResponse) Reset() {
-	*x = DisableParticipationResponse{}
+func (x *ScheduleProductPriceResponse) Reset() {
+	*x = ScheduleProductPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[7]
+		mi := &file_storespb_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisableParticipationResponse) String() string {
+func (x *ScheduleProductPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableParticipationResponse) ProtoMessage() {}
+func (*ScheduleProductPriceResponse) ProtoMessage() {}
 
-func (x *DisableParticipationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[7]
+func (x *ScheduleProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,37 +1954,44 @@ func (x *DisableParticipationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableParticipationResponse.ProtoReflect.Descriptor instead.
-func (*DisableParticipationResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ScheduleProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{34}
 }
 
-type RebrandStoreRequest struct {
+func (x *ScheduleProductPriceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelScheduledProductPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledPriceId string `protobuf:"bytes,2,opt,name=scheduled_price_id,json=scheduledPriceId,proto3" json:"scheduled_price_id,omitempty"`
 }
 
-func (x *RebrandStoreRequest) Reset() {
-	*x = RebrandStoreRequest{}
+func (x *CancelScheduledProductPriceRequest) Reset() {
+	*x = CancelScheduledProductPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[8]
+		mi := &file_storespb_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebrandStoreRequest) String() string {
+func (x *CancelScheduledProductPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebrandStoreRequest) ProtoMessage() {}
+func (*CancelScheduledProductPriceRequest) ProtoMessage() {}
 
-func (x *RebrandStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[8]
+func (x *CancelScheduledProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,48 +2002,48 @@ func (x *RebrandStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebrandStoreRequest.ProtoReflect.Descriptor instead.
-func (*RebrandStoreRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CancelScheduledProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RebrandStoreRequest) GetId() string {
+func (x *CancelScheduledProductPriceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RebrandStoreRequest) GetName() string {
+func (x *CancelScheduledProductPriceRequest) GetScheduledPriceId() string {
 	if x != nil {
-		return x.Name
+		return x.ScheduledPriceId
 	}
 	return ""
 }
 
-type RebrandStoreResponse struct {
+type CancelScheduledProductPriceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RebrandStoreResponse) Reset() {
-	*x = RebrandStoreResponse{}
+func (x *CancelScheduledProductPriceResponse) Reset() {
+	*x = CancelScheduledProductPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[9]
+		mi := &file_storespb_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebrandStoreResponse) String() string {
+func (x *CancelScheduledProductPriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebrandStoreResponse) ProtoMessage() {}
+func (*CancelScheduledProductPriceResponse) ProtoMessage() {}
 
-func (x *RebrandStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[9]
+func (x *CancelScheduledProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,12 +2054,12 @@ func (x *RebrandStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebrandStoreResponse.ProtoReflect.Descriptor instead.
-func (*RebrandStoreResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CancelScheduledProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{36}
 }
 
-type GetStoreRequest struct {
+type GetProductPriceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -551,23 +2067,23 @@ type GetStoreRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetStoreRequest) Reset() {
-	*x = GetStoreRequest{}
+func (x *GetProductPriceHistoryRequest) Reset() {
+	*x = GetProductPriceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[10]
+		mi := &file_storespb_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStoreRequest) String() string {
+func (x *GetProductPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreRequest) ProtoMessage() {}
+func (*GetProductPriceHistoryRequest) ProtoMessage() {}
 
-func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[10]
+func (x *GetProductPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,43 +2094,43 @@ func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreRequest.ProtoReflect.Descriptor instead.
-func (*GetStoreRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetProductPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetStoreRequest) GetId() string {
+func (x *GetProductPriceHistoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetStoreResponse struct {
+type GetProductPriceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Store *Store `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Changes []*PriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *GetStoreResponse) Reset() {
-	*x = GetStoreResponse{}
+func (x *GetProductPriceHistoryResponse) Reset() {
+	*x = GetProductPriceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[11]
+		mi := &file_storespb_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStoreResponse) String() string {
+func (x *GetProductPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoreResponse) ProtoMessage() {}
+func (*GetProductPriceHistoryResponse) ProtoMessage() {}
 
-func (x *GetStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[11]
+func (x *GetProductPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,41 +2141,43 @@ func (x *GetStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoreResponse.ProtoReflect.Descriptor instead.
-func (*GetStoreResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetProductPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetStoreResponse) GetStore() *Store {
+func (x *GetProductPriceHistoryResponse) GetChanges() []*PriceChange {
 	if x != nil {
-		return x.Store
+		return x.Changes
 	}
 	return nil
 }
 
-type GetStoresRequest struct {
+type RemoveProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetStoresRequest) Reset() {
-	*x = GetStoresRequest{}
+func (x *RemoveProductRequest) Reset() {
+	*x = RemoveProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[12]
+		mi := &file_storespb_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStoresRequest) String() string {
+func (x *RemoveProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoresRequest) ProtoMessage() {}
+func (*RemoveProductRequest) ProtoMessage() {}
 
-func (x *GetStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[12]
+func (x *RemoveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,36 +2188,41 @@ func (x *GetStoresRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoresRequest.ProtoReflect.Descriptor instead.
-func (*GetStoresRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RemoveProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{39}
 }
 
-type GetStoresResponse struct {
+func (x *RemoveProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Stores []*Store `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
 }
 
-func (x *GetStoresResponse) Reset() {
-	*x = GetStoresResponse{}
+func (x *RemoveProductResponse) Reset() {
+	*x = RemoveProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[13]
+		mi := &file_storespb_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStoresResponse) String() string {
+func (x *RemoveProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoresResponse) ProtoMessage() {}
+func (*RemoveProductResponse) ProtoMessage() {}
 
-func (x *GetStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[13]
+func (x *RemoveProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,41 +2233,38 @@ func (x *GetStoresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoresResponse.ProtoReflect.Descriptor instead.
-func (*GetStoresResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GetStoresResponse) GetStores() []*Store {
-	if x != nil {
-		return x.Stores
-	}
-	return nil
+// Deprecated: Use RemoveProductResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProductResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{40}
 }
 
-type GetParticipatingStoresRequest struct {
+type CategorizeProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category string   `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *GetParticipatingStoresRequest) Reset() {
-	*x = GetParticipatingStoresRequest{}
+func (x *CategorizeProductRequest) Reset() {
+	*x = CategorizeProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[14]
+		mi := &file_storespb_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetParticipatingStoresRequest) String() string {
+func (x *CategorizeProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetParticipatingStoresRequest) ProtoMessage() {}
+func (*CategorizeProductRequest) ProtoMessage() {}
 
-func (x *GetParticipatingStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[14]
+func (x *CategorizeProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,36 +2275,55 @@ func (x *GetParticipatingStoresRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetParticipatingStoresRequest.ProtoReflect.Descriptor instead.
-func (*GetParticipatingStoresRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CategorizeProductRequest.ProtoReflect.Descriptor instead.
+func (*CategorizeProductRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{41}
 }
 
-type GetParticipatingStoresResponse struct {
+func (x *CategorizeProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CategorizeProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategorizeProductRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CategorizeProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Stores []*Store `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
 }
 
-func (x *GetParticipatingStoresResponse) Reset() {
-	*x = GetParticipatingStoresResponse{}
+func (x *CategorizeProductResponse) Reset() {
+	*x = CategorizeProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[15]
+		mi := &file_storespb_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetParticipatingStoresResponse) String() string {
+func (x *CategorizeProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetParticipatingStoresResponse) ProtoMessage() {}
+func (*CategorizeProductResponse) ProtoMessage() {}
 
-func (x *GetParticipatingStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[15]
+func (x *CategorizeProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,47 +2334,41 @@ func (x *GetParticipatingStoresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetParticipatingStoresResponse.ProtoReflect.Descriptor instead.
-func (*GetParticipatingStoresResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetParticipatingStoresResponse) GetStores() []*Store {
-	if x != nil {
-		return x.Stores
-	}
-	return nil
+// Deprecated: Use CategorizeProductResponse.ProtoReflect.Descriptor instead.
+func (*CategorizeProductResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{42}
 }
 
-type AddProductRequest struct {
+// the price of a variant cannot be changed, nor do product price changes
+// apply to it; remove the variant and add it again to reprice it
+type AddProductVariantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StoreId     string  `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
-	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Sku         string  `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
-	Price       float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku        string            `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price      float64           `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *AddProductRequest) Reset() {
-	*x = AddProductRequest{}
+func (x *AddProductVariantRequest) Reset() {
+	*x = AddProductVariantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[16]
+		mi := &file_storespb_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddProductRequest) String() string {
+func (x *AddProductVariantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductRequest) ProtoMessage() {}
+func (*AddProductVariantRequest) ProtoMessage() {}
 
-func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[16]
+func (x *AddProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,47 +2379,40 @@ func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
-func (*AddProductRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *AddProductRequest) GetStoreId() string {
-	if x != nil {
-		return x.StoreId
-	}
-	return ""
+// Deprecated: Use AddProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*AddProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddProductRequest) GetName() string {
+func (x *AddProductVariantRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddProductRequest) GetDescription() string {
+func (x *AddProductVariantRequest) GetSku() string {
 	if x != nil {
-		return x.Description
+		return x.Sku
 	}
 	return ""
 }
 
-func (x *AddProductRequest) GetSku() string {
+func (x *AddProductVariantRequest) GetPrice() float64 {
 	if x != nil {
-		return x.Sku
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *AddProductRequest) GetPrice() float64 {
+func (x *AddProductVariantRequest) GetAttributes() map[string]string {
 	if x != nil {
-		return x.Price
+		return x.Attributes
 	}
-	return 0
+	return nil
 }
 
-type AddProductResponse struct {
+type AddProductVariantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -894,23 +2420,23 @@ type AddProductResponse struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AddProductResponse) Reset() {
-	*x = AddProductResponse{}
+func (x *AddProductVariantResponse) Reset() {
+	*x = AddProductVariantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[17]
+		mi := &file_storespb_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddProductResponse) String() string {
+func (x *AddProductVariantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProductResponse) ProtoMessage() {}
+func (*AddProductVariantResponse) ProtoMessage() {}
 
-func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[17]
+func (x *AddProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,45 +2447,44 @@ func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
-func (*AddProductResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AddProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*AddProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AddProductResponse) GetId() string {
+func (x *AddProductVariantResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type RebrandProductRequest struct {
+type RemoveProductVariantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VariantId string `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 }
 
-func (x *RebrandProductRequest) Reset() {
-	*x = RebrandProductRequest{}
+func (x *RemoveProductVariantRequest) Reset() {
+	*x = RemoveProductVariantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[18]
+		mi := &file_storespb_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebrandProductRequest) String() string {
+func (x *RemoveProductVariantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebrandProductRequest) ProtoMessage() {}
+func (*RemoveProductVariantRequest) ProtoMessage() {}
 
-func (x *RebrandProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[18]
+func (x *RemoveProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,55 +2495,48 @@ func (x *RebrandProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebrandProductRequest.ProtoReflect.Descriptor instead.
-func (*RebrandProductRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RemoveProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RebrandProductRequest) GetId() string {
+func (x *RemoveProductVariantRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RebrandProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RebrandProductRequest) GetDescription() string {
+func (x *RemoveProductVariantRequest) GetVariantId() string {
 	if x != nil {
-		return x.Description
+		return x.VariantId
 	}
 	return ""
 }
 
-type RebrandProductResponse struct {
+type RemoveProductVariantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RebrandProductResponse) Reset() {
-	*x = RebrandProductResponse{}
+func (x *RemoveProductVariantResponse) Reset() {
+	*x = RemoveProductVariantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[19]
+		mi := &file_storespb_api_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RebrandProductResponse) String() string {
+func (x *RemoveProductVariantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RebrandProductResponse) ProtoMessage() {}
+func (*RemoveProductVariantResponse) ProtoMessage() {}
 
-func (x *RebrandProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[19]
+func (x *RemoveProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,37 +2547,36 @@ func (x *RebrandProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RebrandProductResponse.ProtoReflect.Descriptor instead.
-func (*RebrandProductResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RemoveProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{46}
 }
 
-type IncreaseProductPriceRequest struct {
+type GetCatalogRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	StoreId string `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 }
 
-func (x *IncreaseProductPriceRequest) Reset() {
-	*x = IncreaseProductPriceRequest{}
+func (x *GetCatalogRequest) Reset() {
+	*x = GetCatalogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[20]
+		mi := &file_storespb_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncreaseProductPriceRequest) String() string {
+func (x *GetCatalogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncreaseProductPriceRequest) ProtoMessage() {}
+func (*GetCatalogRequest) ProtoMessage() {}
 
-func (x *IncreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[20]
+func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,48 +2587,43 @@ func (x *IncreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncreaseProductPriceRequest.ProtoReflect.Descriptor instead.
-func (*IncreaseProductPriceRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *IncreaseProductPriceRequest) GetId() string {
+func (x *GetCatalogRequest) GetStoreId() string {
 	if x != nil {
-		return x.Id
+		return x.StoreId
 	}
 	return ""
 }
 
-func (x *IncreaseProductPriceRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type IncreaseProductPriceResponse struct {
+type GetCatalogResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 }
 
-func (x *IncreaseProductPriceResponse) Reset() {
-	*x = IncreaseProductPriceResponse{}
+func (x *GetCatalogResponse) Reset() {
+	*x = GetCatalogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[21]
+		mi := &file_storespb_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncreaseProductPriceResponse) String() string {
+func (x *GetCatalogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncreaseProductPriceResponse) ProtoMessage() {}
+func (*GetCatalogResponse) ProtoMessage() {}
 
-func (x *IncreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[21]
+func (x *GetCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,37 +2634,43 @@ func (x *IncreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncreaseProductPriceResponse.ProtoReflect.Descriptor instead.
-func (*IncreaseProductPriceResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetCatalogResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{48}
 }
 
-type DecreaseProductPriceRequest struct {
+func (x *GetCatalogResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type GetProductRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DecreaseProductPriceRequest) Reset() {
-	*x = DecreaseProductPriceRequest{}
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[22]
+		mi := &file_storespb_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecreaseProductPriceRequest) String() string {
+func (x *GetProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecreaseProductPriceRequest) ProtoMessage() {}
+func (*GetProductRequest) ProtoMessage() {}
 
-func (x *DecreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[22]
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,48 +2681,43 @@ func (x *DecreaseProductPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecreaseProductPriceRequest.ProtoReflect.Descriptor instead.
-func (*DecreaseProductPriceRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DecreaseProductPriceRequest) GetId() string {
+func (x *GetProductRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DecreaseProductPriceRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type DecreaseProductPriceResponse struct {
+type GetProductResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 }
 
-func (x *DecreaseProductPriceResponse) Reset() {
-	*x = DecreaseProductPriceResponse{}
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[23]
+		mi := &file_storespb_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DecreaseProductPriceResponse) String() string {
+func (x *GetProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecreaseProductPriceResponse) ProtoMessage() {}
+func (*GetProductResponse) ProtoMessage() {}
 
-func (x *DecreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[23]
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,36 +2728,44 @@ func (x *DecreaseProductPriceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecreaseProductPriceResponse.ProtoReflect.Descriptor instead.
-func (*DecreaseProductPriceResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{50}
 }
 
-type RemoveProductRequest struct {
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ReceiveInventoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (x *RemoveProductRequest) Reset() {
-	*x = RemoveProductRequest{}
+func (x *ReceiveInventoryRequest) Reset() {
+	*x = ReceiveInventoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[24]
+		mi := &file_storespb_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveProductRequest) String() string {
+func (x *ReceiveInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveProductRequest) ProtoMessage() {}
+func (*ReceiveInventoryRequest) ProtoMessage() {}
 
-func (x *RemoveProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[24]
+func (x *ReceiveInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,41 +2776,48 @@ func (x *RemoveProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveProductRequest.ProtoReflect.Descriptor instead.
-func (*RemoveProductRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ReceiveInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RemoveProductRequest) GetId() string {
+func (x *ReceiveInventoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type RemoveProductResponse struct {
+func (x *ReceiveInventoryRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReceiveInventoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveProductResponse) Reset() {
-	*x = RemoveProductResponse{}
+func (x *ReceiveInventoryResponse) Reset() {
+	*x = ReceiveInventoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[25]
+		mi := &file_storespb_api_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveProductResponse) String() string {
+func (x *ReceiveInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveProductResponse) ProtoMessage() {}
+func (*ReceiveInventoryResponse) ProtoMessage() {}
 
-func (x *RemoveProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[25]
+func (x *ReceiveInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,36 +2828,38 @@ func (x *RemoveProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveProductResponse.ProtoReflect.Descriptor instead.
-func (*RemoveProductResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReceiveInventoryResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{52}
 }
 
-type GetCatalogRequest struct {
+type AdjustInventoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StoreId string `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count  int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetCatalogRequest) Reset() {
-	*x = GetCatalogRequest{}
+func (x *AdjustInventoryRequest) Reset() {
+	*x = AdjustInventoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[26]
+		mi := &file_storespb_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCatalogRequest) String() string {
+func (x *AdjustInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCatalogRequest) ProtoMessage() {}
+func (*AdjustInventoryRequest) ProtoMessage() {}
 
-func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[26]
+func (x *AdjustInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,43 +2870,55 @@ func (x *GetCatalogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCatalogRequest.ProtoReflect.Descriptor instead.
-func (*GetCatalogRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AdjustInventoryRequest.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetCatalogRequest) GetStoreId() string {
+func (x *AdjustInventoryRequest) GetId() string {
 	if x != nil {
-		return x.StoreId
+		return x.Id
 	}
 	return ""
 }
 
-type GetCatalogResponse struct {
+func (x *AdjustInventoryRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AdjustInventoryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustInventoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 }
 
-func (x *GetCatalogResponse) Reset() {
-	*x = GetCatalogResponse{}
+func (x *AdjustInventoryResponse) Reset() {
+	*x = AdjustInventoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[27]
+		mi := &file_storespb_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCatalogResponse) String() string {
+func (x *AdjustInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCatalogResponse) ProtoMessage() {}
+func (*AdjustInventoryResponse) ProtoMessage() {}
 
-func (x *GetCatalogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[27]
+func (x *AdjustInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,19 +2929,12 @@ func (x *GetCatalogResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCatalogResponse.ProtoReflect.Descriptor instead.
-func (*GetCatalogResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetCatalogResponse) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+// Deprecated: Use AdjustInventoryResponse.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{54}
 }
 
-type GetProductRequest struct {
+type GetInventoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1407,23 +2942,23 @@ type GetProductRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
+func (x *GetInventoryRequest) Reset() {
+	*x = GetInventoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[28]
+		mi := &file_storespb_api_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProductRequest) String() string {
+func (x *GetInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*GetInventoryRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[28]
+func (x *GetInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,43 +2969,43 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *GetInventoryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetProductResponse struct {
+type GetInventoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Product *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Inventory *Inventory `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
+func (x *GetInventoryResponse) Reset() {
+	*x = GetInventoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_storespb_api_proto_msgTypes[29]
+		mi := &file_storespb_api_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProductResponse) String() string {
+func (x *GetInventoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*GetInventoryResponse) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_storespb_api_proto_msgTypes[29]
+func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_storespb_api_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,14 +3016,14 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_storespb_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_storespb_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *GetInventoryResponse) GetInventory() *Inventory {
 	if x != nil {
-		return x.Product
+		return x.Inventory
 	}
 	return nil
 }
@@ -1497,136 +3032,317 @@ var File_storespb_api_proto protoreflect.FileDescriptor
 
 var file_storespb_api_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x22, 0x6d,
-	0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x92, 0x01,
-	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
-	0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x2c, 0x0a, 0x1a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a,
-	0x1b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x1b,
-	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x13, 0x52,
-	0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e,
-	0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa2, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x33, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x12, 0x2d, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x48,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x22, 0x4e, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64,
+	0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73,
+	0x22, 0x31, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xbd, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x43,
+	0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x97,
+	0x01, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6e,
+	0x48, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x1f,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x49, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x41,
-	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x41, 0x64, 0x64,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x5d, 0x0a, 0x15, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18,
-	0x0a, 0x16, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x1b, 0x49, 0x6e, 0x63, 0x72,
-	0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x1e, 0x0a,
-	0x1c, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a,
-	0x1b, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x33, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x12,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x22, 0x1f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x49, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a,
+	0x11, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x5d, 0x0a, 0x15, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64,
 	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x32, 0xd7, 0x09, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x64, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
-	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62,
-	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12,
-	0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x62, 0x72, 0x61,
-	0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e,
-	0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x1b, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x1e, 0x0a, 0x1c, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x43, 0x0a, 0x1b, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x43, 0x0a,
+	0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x2e, 0x0a, 0x1c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x62, 0x0a, 0x22, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x23, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x5a, 0x0a, 0x18, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x18,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x52, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e,
+	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4c, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x1e,
+	0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x22, 0x43,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x45, 0x0a, 0x17, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56,
+	0x0a, 0x16, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x32, 0xbb, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
 	0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
@@ -1666,30 +3382,86 @@ var file_storespb_api_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70,
 	0x62, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
 	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x52, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x1b, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61,
-	0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x80, 0x01, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x42, 0x08, 0x41, 0x70, 0x69,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x65, 0x64, 0x61, 0x2d, 0x69, 0x6e, 0x2d,
-	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0xa2,
-	0x02, 0x03, 0x53, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62,
-	0xca, 0x02, 0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0xe2, 0x02, 0x14, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x67, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1b, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x73, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70,
+	0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12,
+	0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x1b, 0x2e, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x80, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26,
+	0x65, 0x64, 0x61, 0x2d, 0x69, 0x6e, 0x2d, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0xca, 0x02, 0x08, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73,
+	0x70, 0x62, 0xe2, 0x02, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1704,78 +3476,143 @@ func file_storespb_api_proto_rawDescGZIP() []byte {
 	return file_storespb_api_proto_rawDescData
 }
 
-var file_storespb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_storespb_api_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_storespb_api_proto_goTypes = []interface{}{
-	(*Store)(nil),                          // 0: storespb.Store
-	(*Product)(nil),                        // 1: storespb.Product
-	(*CreateStoreRequest)(nil),             // 2: storespb.CreateStoreRequest
-	(*CreateStoreResponse)(nil),            // 3: storespb.CreateStoreResponse
-	(*EnableParticipationRequest)(nil),     // 4: storespb.EnableParticipationRequest
-	(*EnableParticipationResponse)(nil),    // 5: storespb.EnableParticipationResponse
-	(*DisableParticipationRequest)(nil),    // 6: storespb.DisableParticipationRequest
-	(*DisableParticipationResponse)(nil),   // 7: storespb.DisableParticipationResponse
-	(*RebrandStoreRequest)(nil),            // 8: storespb.RebrandStoreRequest
-	(*RebrandStoreResponse)(nil),           // 9: storespb.RebrandStoreResponse
-	(*GetStoreRequest)(nil),                // 10: storespb.GetStoreRequest
-	(*GetStoreResponse)(nil),               // 11: storespb.GetStoreResponse
-	(*GetStoresRequest)(nil),               // 12: storespb.GetStoresRequest
-	(*GetStoresResponse)(nil),              // 13: storespb.GetStoresResponse
-	(*GetParticipatingStoresRequest)(nil),  // 14: storespb.GetParticipatingStoresRequest
-	(*GetParticipatingStoresResponse)(nil), // 15: storespb.GetParticipatingStoresResponse
-	(*AddProductRequest)(nil),              // 16: storespb.AddProductRequest
-	(*AddProductResponse)(nil),             // 17: storespb.AddProductResponse
-	(*RebrandProductRequest)(nil),          // 18: storespb.RebrandProductRequest
-	(*RebrandProductResponse)(nil),         // 19: storespb.RebrandProductResponse
-	(*IncreaseProductPriceRequest)(nil),    // 20: storespb.IncreaseProductPriceRequest
-	(*IncreaseProductPriceResponse)(nil),   // 21: storespb.IncreaseProductPriceResponse
-	(*DecreaseProductPriceRequest)(nil),    // 22: storespb.DecreaseProductPriceRequest
-	(*DecreaseProductPriceResponse)(nil),   // 23: storespb.DecreaseProductPriceResponse
-	(*RemoveProductRequest)(nil),           // 24: storespb.RemoveProductRequest
-	(*RemoveProductResponse)(nil),          // 25: storespb.RemoveProductResponse
-	(*GetCatalogRequest)(nil),              // 26: storespb.GetCatalogRequest
-	(*GetCatalogResponse)(nil),             // 27: storespb.GetCatalogResponse
-	(*GetProductRequest)(nil),              // 28: storespb.GetProductRequest
-	(*GetProductResponse)(nil),             // 29: storespb.GetProductResponse
+	(*Store)(nil),                               // 0: storespb.Store
+	(*StoreSchedule)(nil),                       // 1: storespb.StoreSchedule
+	(*OpeningHours)(nil),                        // 2: storespb.OpeningHours
+	(*Holiday)(nil),                             // 3: storespb.Holiday
+	(*Product)(nil),                             // 4: storespb.Product
+	(*ProductVariant)(nil),                      // 5: storespb.ProductVariant
+	(*ScheduledPrice)(nil),                      // 6: storespb.ScheduledPrice
+	(*PriceChange)(nil),                         // 7: storespb.PriceChange
+	(*Inventory)(nil),                           // 8: storespb.Inventory
+	(*CreateStoreRequest)(nil),                  // 9: storespb.CreateStoreRequest
+	(*CreateStoreResponse)(nil),                 // 10: storespb.CreateStoreResponse
+	(*EnableParticipationRequest)(nil),          // 11: storespb.EnableParticipationRequest
+	(*EnableParticipationResponse)(nil),         // 12: storespb.EnableParticipationResponse
+	(*DisableParticipationRequest)(nil),         // 13: storespb.DisableParticipationRequest
+	(*DisableParticipationResponse)(nil),        // 14: storespb.DisableParticipationResponse
+	(*RebrandStoreRequest)(nil),                 // 15: storespb.RebrandStoreRequest
+	(*RebrandStoreResponse)(nil),                // 16: storespb.RebrandStoreResponse
+	(*SetStoreScheduleRequest)(nil),             // 17: storespb.SetStoreScheduleRequest
+	(*SetStoreScheduleResponse)(nil),            // 18: storespb.SetStoreScheduleResponse
+	(*GetStoreRequest)(nil),                     // 19: storespb.GetStoreRequest
+	(*GetStoreResponse)(nil),                    // 20: storespb.GetStoreResponse
+	(*GetStoresRequest)(nil),                    // 21: storespb.GetStoresRequest
+	(*GetStoresResponse)(nil),                   // 22: storespb.GetStoresResponse
+	(*GetParticipatingStoresRequest)(nil),       // 23: storespb.GetParticipatingStoresRequest
+	(*GetParticipatingStoresResponse)(nil),      // 24: storespb.GetParticipatingStoresResponse
+	(*AddProductRequest)(nil),                   // 25: storespb.AddProductRequest
+	(*AddProductResponse)(nil),                  // 26: storespb.AddProductResponse
+	(*RebrandProductRequest)(nil),               // 27: storespb.RebrandProductRequest
+	(*RebrandProductResponse)(nil),              // 28: storespb.RebrandProductResponse
+	(*IncreaseProductPriceRequest)(nil),         // 29: storespb.IncreaseProductPriceRequest
+	(*IncreaseProductPriceResponse)(nil),        // 30: storespb.IncreaseProductPriceResponse
+	(*DecreaseProductPriceRequest)(nil),         // 31: storespb.DecreaseProductPriceRequest
+	(*DecreaseProductPriceResponse)(nil),        // 32: storespb.DecreaseProductPriceResponse
+	(*ScheduleProductPriceRequest)(nil),         // 33: storespb.ScheduleProductPriceRequest
+	(*ScheduleProductPriceResponse)(nil),        // 34: storespb.ScheduleProductPriceResponse
+	(*CancelScheduledProductPriceRequest)(nil),  // 35: storespb.CancelScheduledProductPriceRequest
+	(*CancelScheduledProductPriceResponse)(nil), // 36: storespb.CancelScheduledProductPriceResponse
+	(*GetProductPriceHistoryRequest)(nil),       // 37: storespb.GetProductPriceHistoryRequest
+	(*GetProductPriceHistoryResponse)(nil),      // 38: storespb.GetProductPriceHistoryResponse
+	(*RemoveProductRequest)(nil),                // 39: storespb.RemoveProductRequest
+	(*RemoveProductResponse)(nil),               // 40: storespb.RemoveProductResponse
+	(*CategorizeProductRequest)(nil),            // 41: storespb.CategorizeProductRequest
+	(*CategorizeProductResponse)(nil),           // 42: storespb.CategorizeProductResponse
+	(*AddProductVariantRequest)(nil),            // 43: storespb.AddProductVariantRequest
+	(*AddProductVariantResponse)(nil),           // 44: storespb.AddProductVariantResponse
+	(*RemoveProductVariantRequest)(nil),         // 45: storespb.RemoveProductVariantRequest
+	(*RemoveProductVariantResponse)(nil),        // 46: storespb.RemoveProductVariantResponse
+	(*GetCatalogRequest)(nil),                   // 47: storespb.GetCatalogRequest
+	(*GetCatalogResponse)(nil),                  // 48: storespb.GetCatalogResponse
+	(*GetProductRequest)(nil),                   // 49: storespb.GetProductRequest
+	(*GetProductResponse)(nil),                  // 50: storespb.GetProductResponse
+	(*ReceiveInventoryRequest)(nil),             // 51: storespb.ReceiveInventoryRequest
+	(*ReceiveInventoryResponse)(nil),            // 52: storespb.ReceiveInventoryResponse
+	(*AdjustInventoryRequest)(nil),              // 53: storespb.AdjustInventoryRequest
+	(*AdjustInventoryResponse)(nil),             // 54: storespb.AdjustInventoryResponse
+	(*GetInventoryRequest)(nil),                 // 55: storespb.GetInventoryRequest
+	(*GetInventoryResponse)(nil),                // 56: storespb.GetInventoryResponse
+	nil,                                         // 57: storespb.ProductVariant.AttributesEntry
+	nil,                                         // 58: storespb.AddProductVariantRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),               // 59: google.protobuf.Timestamp
 }
 var file_storespb_api_proto_depIdxs = []int32{
-	0,  // 0: storespb.GetStoreResponse.store:type_name -> storespb.Store
-	0,  // 1: storespb.GetStoresResponse.stores:type_name -> storespb.Store
-	0,  // 2: storespb.GetParticipatingStoresResponse.stores:type_name -> storespb.Store
-	1,  // 3: storespb.GetCatalogResponse.products:type_name -> storespb.Product
-	1,  // 4: storespb.GetProductResponse.product:type_name -> storespb.Product
-	2,  // 5: storespb.StoresService.CreateStore:input_type -> storespb.CreateStoreRequest
-	4,  // 6: storespb.StoresService.EnableParticipation:input_type -> storespb.EnableParticipationRequest
-	6,  // 7: storespb.StoresService.DisableParticipation:input_type -> storespb.DisableParticipationRequest
-	8,  // 8: storespb.StoresService.RebrandStore:input_type -> storespb.RebrandStoreRequest
-	10, // 9: storespb.StoresService.GetStore:input_type -> storespb.GetStoreRequest
-	12, // 10: storespb.StoresService.GetStores:input_type -> storespb.GetStoresRequest
-	14, // 11: storespb.StoresService.GetParticipatingStores:input_type -> storespb.GetParticipatingStoresRequest
-	16, // 12: storespb.StoresService.AddProduct:input_type -> storespb.AddProductRequest
-	18, // 13: storespb.StoresService.RebrandProduct:input_type -> storespb.RebrandProductRequest
-	20, // 14: storespb.StoresService.IncreaseProductPrice:input_type -> storespb.IncreaseProductPriceRequest
-	22, // 15: storespb.StoresService.DecreaseProductPrice:input_type -> storespb.DecreaseProductPriceRequest
-	24, // 16: storespb.StoresService.RemoveProduct:input_type -> storespb.RemoveProductRequest
-	28, // 17: storespb.StoresService.GetProduct:input_type -> storespb.GetProductRequest
-	26, // 18: storespb.StoresService.GetCatalog:input_type -> storespb.GetCatalogRequest
-	3,  // 19: storespb.StoresService.CreateStore:output_type -> storespb.CreateStoreResponse
-	5,  // 20: storespb.StoresService.EnableParticipation:output_type -> storespb.EnableParticipationResponse
-	7,  // 21: storespb.StoresService.DisableParticipation:output_type -> storespb.DisableParticipationResponse
-	9,  // 22: storespb.StoresService.RebrandStore:output_type -> storespb.RebrandStoreResponse
-	11, // 23: storespb.StoresService.GetStore:output_type -> storespb.GetStoreResponse
-	13, // 24: storespb.StoresService.GetStores:output_type -> storespb.GetStoresResponse
-	15, // 25: storespb.StoresService.GetParticipatingStores:output_type -> storespb.GetParticipatingStoresResponse
-	17, // 26: storespb.StoresService.AddProduct:output_type -> storespb.AddProductResponse
-	19, // 27: storespb.StoresService.RebrandProduct:output_type -> storespb.RebrandProductResponse
-	21, // 28: storespb.StoresService.IncreaseProductPrice:output_type -> storespb.IncreaseProductPriceResponse
-	23, // 29: storespb.StoresService.DecreaseProductPrice:output_type -> storespb.DecreaseProductPriceResponse
-	25, // 30: storespb.StoresService.RemoveProduct:output_type -> storespb.RemoveProductResponse
-	29, // 31: storespb.StoresService.GetProduct:output_type -> storespb.GetProductResponse
-	27, // 32: storespb.StoresService.GetCatalog:output_type -> storespb.GetCatalogResponse
-	19, // [19:33] is the sub-list for method output_type
-	5,  // [5:19] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: storespb.Store.schedule:type_name -> storespb.StoreSchedule
+	2,  // 1: storespb.StoreSchedule.hours:type_name -> storespb.OpeningHours
+	3,  // 2: storespb.StoreSchedule.holidays:type_name -> storespb.Holiday
+	5,  // 3: storespb.Product.variants:type_name -> storespb.ProductVariant
+	6,  // 4: storespb.Product.scheduled_prices:type_name -> storespb.ScheduledPrice
+	57, // 5: storespb.ProductVariant.attributes:type_name -> storespb.ProductVariant.AttributesEntry
+	59, // 6: storespb.ScheduledPrice.effective_from:type_name -> google.protobuf.Timestamp
+	59, // 7: storespb.ScheduledPrice.effective_until:type_name -> google.protobuf.Timestamp
+	59, // 8: storespb.PriceChange.changed_at:type_name -> google.protobuf.Timestamp
+	1,  // 9: storespb.SetStoreScheduleRequest.schedule:type_name -> storespb.StoreSchedule
+	0,  // 10: storespb.GetStoreResponse.store:type_name -> storespb.Store
+	0,  // 11: storespb.GetStoresResponse.stores:type_name -> storespb.Store
+	0,  // 12: storespb.GetParticipatingStoresResponse.stores:type_name -> storespb.Store
+	59, // 13: storespb.ScheduleProductPriceRequest.effective_from:type_name -> google.protobuf.Timestamp
+	59, // 14: storespb.ScheduleProductPriceRequest.effective_until:type_name -> google.protobuf.Timestamp
+	7,  // 15: storespb.GetProductPriceHistoryResponse.changes:type_name -> storespb.PriceChange
+	58, // 16: storespb.AddProductVariantRequest.attributes:type_name -> storespb.AddProductVariantRequest.AttributesEntry
+	4,  // 17: storespb.GetCatalogResponse.products:type_name -> storespb.Product
+	4,  // 18: storespb.GetProductResponse.product:type_name -> storespb.Product
+	8,  // 19: storespb.GetInventoryResponse.inventory:type_name -> storespb.Inventory
+	9,  // 20: storespb.StoresService.CreateStore:input_type -> storespb.CreateStoreRequest
+	11, // 21: storespb.StoresService.EnableParticipation:input_type -> storespb.EnableParticipationRequest
+	13, // 22: storespb.StoresService.DisableParticipation:input_type -> storespb.DisableParticipationRequest
+	15, // 23: storespb.StoresService.RebrandStore:input_type -> storespb.RebrandStoreRequest
+	17, // 24: storespb.StoresService.SetStoreSchedule:input_type -> storespb.SetStoreScheduleRequest
+	19, // 25: storespb.StoresService.GetStore:input_type -> storespb.GetStoreRequest
+	21, // 26: storespb.StoresService.GetStores:input_type -> storespb.GetStoresRequest
+	23, // 27: storespb.StoresService.GetParticipatingStores:input_type -> storespb.GetParticipatingStoresRequest
+	25, // 28: storespb.StoresService.AddProduct:input_type -> storespb.AddProductRequest
+	27, // 29: storespb.StoresService.RebrandProduct:input_type -> storespb.RebrandProductRequest
+	29, // 30: storespb.StoresService.IncreaseProductPrice:input_type -> storespb.IncreaseProductPriceRequest
+	31, // 31: storespb.StoresService.DecreaseProductPrice:input_type -> storespb.DecreaseProductPriceRequest
+	33, // 32: storespb.StoresService.ScheduleProductPrice:input_type -> storespb.ScheduleProductPriceRequest
+	35, // 33: storespb.StoresService.CancelScheduledProductPrice:input_type -> storespb.CancelScheduledProductPriceRequest
+	37, // 34: storespb.StoresService.GetProductPriceHistory:input_type -> storespb.GetProductPriceHistoryRequest
+	39, // 35: storespb.StoresService.RemoveProduct:input_type -> storespb.RemoveProductRequest
+	41, // 36: storespb.StoresService.CategorizeProduct:input_type -> storespb.CategorizeProductRequest
+	43, // 37: storespb.StoresService.AddProductVariant:input_type -> storespb.AddProductVariantRequest
+	45, // 38: storespb.StoresService.RemoveProductVariant:input_type -> storespb.RemoveProductVariantRequest
+	49, // 39: storespb.StoresService.GetProduct:input_type -> storespb.GetProductRequest
+	47, // 40: storespb.StoresService.GetCatalog:input_type -> storespb.GetCatalogRequest
+	51, // 41: storespb.StoresService.ReceiveInventory:input_type -> storespb.ReceiveInventoryRequest
+	53, // 42: storespb.StoresService.AdjustInventory:input_type -> storespb.AdjustInventoryRequest
+	55, // 43: storespb.StoresService.GetInventory:input_type -> storespb.GetInventoryRequest
+	10, // 44: storespb.StoresService.CreateStore:output_type -> storespb.CreateStoreResponse
+	12, // 45: storespb.StoresService.EnableParticipation:output_type -> storespb.EnableParticipationResponse
+	14, // 46: storespb.StoresService.DisableParticipation:output_type -> storespb.DisableParticipationResponse
+	16, // 47: storespb.StoresService.RebrandStore:output_type -> storespb.RebrandStoreResponse
+	18, // 48: storespb.StoresService.SetStoreSchedule:output_type -> storespb.SetStoreScheduleResponse
+	20, // 49: storespb.StoresService.GetStore:output_type -> storespb.GetStoreResponse
+	22, // 50: storespb.StoresService.GetStores:output_type -> storespb.GetStoresResponse
+	24, // 51: storespb.StoresService.GetParticipatingStores:output_type -> storespb.GetParticipatingStoresResponse
+	26, // 52: storespb.StoresService.AddProduct:output_type -> storespb.AddProductResponse
+	28, // 53: storespb.StoresService.RebrandProduct:output_type -> storespb.RebrandProductResponse
+	30, // 54: storespb.StoresService.IncreaseProductPrice:output_type -> storespb.IncreaseProductPriceResponse
+	32, // 55: storespb.StoresService.DecreaseProductPrice:output_type -> storespb.DecreaseProductPriceResponse
+	34, // 56: storespb.StoresService.ScheduleProductPrice:output_type -> storespb.ScheduleProductPriceResponse
+	36, // 57: storespb.StoresService.CancelScheduledProductPrice:output_type -> storespb.CancelScheduledProductPriceResponse
+	38, // 58: storespb.StoresService.GetProductPriceHistory:output_type -> storespb.GetProductPriceHistoryResponse
+	40, // 59: storespb.StoresService.RemoveProduct:output_type -> storespb.RemoveProductResponse
+	42, // 60: storespb.StoresService.CategorizeProduct:output_type -> storespb.CategorizeProductResponse
+	44, // 61: storespb.StoresService.AddProductVariant:output_type -> storespb.AddProductVariantResponse
+	46, // 62: storespb.StoresService.RemoveProductVariant:output_type -> storespb.RemoveProductVariantResponse
+	50, // 63: storespb.StoresService.GetProduct:output_type -> storespb.GetProductResponse
+	48, // 64: storespb.StoresService.GetCatalog:output_type -> storespb.GetCatalogResponse
+	52, // 65: storespb.StoresService.ReceiveInventory:output_type -> storespb.ReceiveInventoryResponse
+	54, // 66: storespb.StoresService.AdjustInventory:output_type -> storespb.AdjustInventoryResponse
+	56, // 67: storespb.StoresService.GetInventory:output_type -> storespb.GetInventoryResponse
+	44, // [44:68] is the sub-list for method output_type
+	20, // [20:44] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_storespb_api_proto_init() }
@@ -1796,8 +3633,92 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Product); i {
+		file_storespb_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpeningHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holiday); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Product); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductVariant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inventory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,7 +3729,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateStoreRequest); i {
 			case 0:
 				return &v.state
@@ -1820,7 +3741,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateStoreResponse); i {
 			case 0:
 				return &v.state
@@ -1832,7 +3753,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnableParticipationRequest); i {
 			case 0:
 				return &v.state
@@ -1844,7 +3765,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnableParticipationResponse); i {
 			case 0:
 				return &v.state
@@ -1856,7 +3777,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisableParticipationRequest); i {
 			case 0:
 				return &v.state
@@ -1868,7 +3789,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisableParticipationResponse); i {
 			case 0:
 				return &v.state
@@ -1880,7 +3801,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebrandStoreRequest); i {
 			case 0:
 				return &v.state
@@ -1892,7 +3813,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebrandStoreResponse); i {
 			case 0:
 				return &v.state
@@ -1904,7 +3825,31 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStoreScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetStoreScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStoreRequest); i {
 			case 0:
 				return &v.state
@@ -1916,7 +3861,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStoreResponse); i {
 			case 0:
 				return &v.state
@@ -1928,7 +3873,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStoresRequest); i {
 			case 0:
 				return &v.state
@@ -1940,7 +3885,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStoresResponse); i {
 			case 0:
 				return &v.state
@@ -1952,7 +3897,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetParticipatingStoresRequest); i {
 			case 0:
 				return &v.state
@@ -1964,7 +3909,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetParticipatingStoresResponse); i {
 			case 0:
 				return &v.state
@@ -1976,7 +3921,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddProductRequest); i {
 			case 0:
 				return &v.state
@@ -1988,7 +3933,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddProductResponse); i {
 			case 0:
 				return &v.state
@@ -2000,7 +3945,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebrandProductRequest); i {
 			case 0:
 				return &v.state
@@ -2012,7 +3957,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RebrandProductResponse); i {
 			case 0:
 				return &v.state
@@ -2024,7 +3969,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IncreaseProductPriceRequest); i {
 			case 0:
 				return &v.state
@@ -2036,7 +3981,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IncreaseProductPriceResponse); i {
 			case 0:
 				return &v.state
@@ -2048,7 +3993,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DecreaseProductPriceRequest); i {
 			case 0:
 				return &v.state
@@ -2060,7 +4005,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DecreaseProductPriceResponse); i {
 			case 0:
 				return &v.state
@@ -2072,7 +4017,79 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleProductPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleProductPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledProductPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledProductPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductPriceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductPriceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveProductRequest); i {
 			case 0:
 				return &v.state
@@ -2084,7 +4101,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveProductResponse); i {
 			case 0:
 				return &v.state
@@ -2096,7 +4113,79 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategorizeProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategorizeProductResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProductVariantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProductVariantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProductVariantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProductVariantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCatalogRequest); i {
 			case 0:
 				return &v.state
@@ -2108,7 +4197,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCatalogResponse); i {
 			case 0:
 				return &v.state
@@ -2120,7 +4209,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProductRequest); i {
 			case 0:
 				return &v.state
@@ -2132,7 +4221,7 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
-		file_storespb_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_storespb_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProductResponse); i {
 			case 0:
 				return &v.state
@@ -2144,6 +4233,78 @@ func file_storespb_api_proto_init() {
 				return nil
 			}
 		}
+		file_storespb_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiveInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_storespb_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2151,7 +4312,7 @@ func file_storespb_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_storespb_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ type StoresServiceClient interface {
 	EnableParticipation(ctx context.Context, in *EnableParticipationRequest, opts ...grpc.CallOption) (*EnableParticipationResponse, error)
 	DisableParticipation(ctx context.Context, in *DisableParticipationRequest, opts ...grpc.CallOption) (*DisableParticipationResponse, error)
 	RebrandStore(ctx context.Context, in *RebrandStoreRequest, opts ...grpc.CallOption) (*RebrandStoreResponse, error)
+	SetStoreSchedule(ctx context.Context, in *SetStoreScheduleRequest, opts ...grpc.CallOption) (*SetStoreScheduleResponse, error)
 	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
 	GetStores(ctx context.Context, in *GetStoresRequest, opts ...grpc.CallOption) (*GetStoresResponse, error)
 	GetParticipatingStores(ctx context.Context, in *GetParticipatingStoresRequest, opts ...grpc.CallOption) (*GetParticipatingStoresResponse, error)
@@ -33,9 +34,18 @@ type StoresServiceClient interface {
 	RebrandProduct(ctx context.Context, in *RebrandProductRequest, opts ...grpc.CallOption) (*RebrandProductResponse, error)
 	IncreaseProductPrice(ctx context.Context, in *IncreaseProductPriceRequest, opts ...grpc.CallOption) (*IncreaseProductPriceResponse, error)
 	DecreaseProductPrice(ctx context.Context, in *DecreaseProductPriceRequest, opts ...grpc.CallOption) (*DecreaseProductPriceResponse, error)
+	ScheduleProductPrice(ctx context.Context, in *ScheduleProductPriceRequest, opts ...grpc.CallOption) (*ScheduleProductPriceResponse, error)
+	CancelScheduledProductPrice(ctx context.Context, in *CancelScheduledProductPriceRequest, opts ...grpc.CallOption) (*CancelScheduledProductPriceResponse, error)
+	GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error)
 	RemoveProduct(ctx context.Context, in *RemoveProductRequest, opts ...grpc.CallOption) (*RemoveProductResponse, error)
+	CategorizeProduct(ctx context.Context, in *CategorizeProductRequest, opts ...grpc.CallOption) (*CategorizeProductResponse, error)
+	AddProductVariant(ctx context.Context, in *AddProductVariantRequest, opts ...grpc.CallOption) (*AddProductVariantResponse, error)
+	RemoveProductVariant(ctx context.Context, in *RemoveProductVariantRequest, opts ...grpc.CallOption) (*RemoveProductVariantResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	ReceiveInventory(ctx context.Context, in *ReceiveInventoryRequest, opts ...grpc.CallOption) (*ReceiveInventoryResponse, error)
+	AdjustInventory(ctx context.Context, in *AdjustInventoryRequest, opts ...grpc.CallOption) (*AdjustInventoryResponse, error)
+	GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
 }
 
 type storesServiceClient struct {
@@ -82,6 +92,15 @@ func (c *storesServiceClient) RebrandStore(ctx context.Context, in *RebrandStore
 	return out, nil
 }
 
+func (c *storesServiceClient) SetStoreSchedule(ctx context.Context, in *SetStoreScheduleRequest, opts ...grpc.CallOption) (*SetStoreScheduleResponse, error) {
+	out := new(SetStoreScheduleResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/SetStoreSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storesServiceClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
 	out := new(GetStoreResponse)
 	err := c.cc.Invoke(ctx, "/storespb.StoresService/GetStore", in, out, opts...)
@@ -145,6 +164,33 @@ func (c *storesServiceClient) DecreaseProductPrice(ctx context.Context, in *Decr
 	return out, nil
 }
 
+func (c *storesServiceClient) ScheduleProductPrice(ctx context.Context, in *ScheduleProductPriceRequest, opts ...grpc.CallOption) (*ScheduleProductPriceResponse, error) {
+	out := new(ScheduleProductPriceResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/ScheduleProductPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) CancelScheduledProductPrice(ctx context.Context, in *CancelScheduledProductPriceRequest, opts ...grpc.CallOption) (*CancelScheduledProductPriceResponse, error) {
+	out := new(CancelScheduledProductPriceResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/CancelScheduledProductPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error) {
+	out := new(GetProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/GetProductPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storesServiceClient) RemoveProduct(ctx context.Context, in *RemoveProductRequest, opts ...grpc.CallOption) (*RemoveProductResponse, error) {
 	out := new(RemoveProductResponse)
 	err := c.cc.Invoke(ctx, "/storespb.StoresService/RemoveProduct", in, out, opts...)
@@ -154,6 +200,33 @@ func (c *storesServiceClient) RemoveProduct(ctx context.Context, in *RemoveProdu
 	return out, nil
 }
 
+func (c *storesServiceClient) CategorizeProduct(ctx context.Context, in *CategorizeProductRequest, opts ...grpc.CallOption) (*CategorizeProductResponse, error) {
+	out := new(CategorizeProductResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/CategorizeProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) AddProductVariant(ctx context.Context, in *AddProductVariantRequest, opts ...grpc.CallOption) (*AddProductVariantResponse, error) {
+	out := new(AddProductVariantResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/AddProductVariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) RemoveProductVariant(ctx context.Context, in *RemoveProductVariantRequest, opts ...grpc.CallOption) (*RemoveProductVariantResponse, error) {
+	out := new(RemoveProductVariantResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/RemoveProductVariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storesServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
 	out := new(GetProductResponse)
 	err := c.cc.Invoke(ctx, "/storespb.StoresService/GetProduct", in, out, opts...)
@@ -172,6 +245,33 @@ func (c *storesServiceClient) GetCatalog(ctx context.Context, in *GetCatalogRequ
 	return out, nil
 }
 
+func (c *storesServiceClient) ReceiveInventory(ctx context.Context, in *ReceiveInventoryRequest, opts ...grpc.CallOption) (*ReceiveInventoryResponse, error) {
+	out := new(ReceiveInventoryResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/ReceiveInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) AdjustInventory(ctx context.Context, in *AdjustInventoryRequest, opts ...grpc.CallOption) (*AdjustInventoryResponse, error) {
+	out := new(AdjustInventoryResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/AdjustInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storesServiceClient) GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error) {
+	out := new(GetInventoryResponse)
+	err := c.cc.Invoke(ctx, "/storespb.StoresService/GetInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoresServiceServer is the server API for StoresService service.
 // All implementations must embed UnimplementedStoresServiceServer
 // for forward compatibility
@@ -180,6 +280,7 @@ type StoresServiceServer interface {
 	EnableParticipation(context.Context, *EnableParticipationRequest) (*EnableParticipationResponse, error)
 	DisableParticipation(context.Context, *DisableParticipationRequest) (*DisableParticipationResponse, error)
 	RebrandStore(context.Context, *RebrandStoreRequest) (*RebrandStoreResponse, error)
+	SetStoreSchedule(context.Context, *SetStoreScheduleRequest) (*SetStoreScheduleResponse, error)
 	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
 	GetStores(context.Context, *GetStoresRequest) (*GetStoresResponse, error)
 	GetParticipatingStores(context.Context, *GetParticipatingStoresRequest) (*GetParticipatingStoresResponse, error)
@@ -187,9 +288,18 @@ type StoresServiceServer interface {
 	RebrandProduct(context.Context, *RebrandProductRequest) (*RebrandProductResponse, error)
 	IncreaseProductPrice(context.Context, *IncreaseProductPriceRequest) (*IncreaseProductPriceResponse, error)
 	DecreaseProductPrice(context.Context, *DecreaseProductPriceRequest) (*DecreaseProductPriceResponse, error)
+	ScheduleProductPrice(context.Context, *ScheduleProductPriceRequest) (*ScheduleProductPriceResponse, error)
+	CancelScheduledProductPrice(context.Context, *CancelScheduledProductPriceRequest) (*CancelScheduledProductPriceResponse, error)
+	GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error)
 	RemoveProduct(context.Context, *RemoveProductRequest) (*RemoveProductResponse, error)
+	CategorizeProduct(context.Context, *CategorizeProductRequest) (*CategorizeProductResponse, error)
+	AddProductVariant(context.Context, *AddProductVariantRequest) (*AddProductVariantResponse, error)
+	RemoveProductVariant(context.Context, *RemoveProductVariantRequest) (*RemoveProductVariantResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error)
+	ReceiveInventory(context.Context, *ReceiveInventoryRequest) (*ReceiveInventoryResponse, error)
+	AdjustInventory(context.Context, *AdjustInventoryRequest) (*AdjustInventoryResponse, error)
+	GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error)
 	mustEmbedUnimplementedStoresServiceServer()
 }
 
@@ -209,6 +319,9 @@ func (UnimplementedStoresServiceServer) DisableParticipation(context.Context, *D
 func (UnimplementedStoresServiceServer) RebrandStore(context.Context, *RebrandStoreRequest) (*RebrandStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebrandStore not implemented")
 }
+func (UnimplementedStoresServiceServer) SetStoreSchedule(context.Context, *SetStoreScheduleRequest) (*SetStoreScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStoreSchedule not implemented")
+}
 func (UnimplementedStoresServiceServer) GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStore not implemented")
 }
@@ -230,15 +343,42 @@ func (UnimplementedStoresServiceServer) IncreaseProductPrice(context.Context, *I
 func (UnimplementedStoresServiceServer) DecreaseProductPrice(context.Context, *DecreaseProductPriceRequest) (*DecreaseProductPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecreaseProductPrice not implemented")
 }
+func (UnimplementedStoresServiceServer) ScheduleProductPrice(context.Context, *ScheduleProductPriceRequest) (*ScheduleProductPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleProductPrice not implemented")
+}
+func (UnimplementedStoresServiceServer) CancelScheduledProductPrice(context.Context, *CancelScheduledProductPriceRequest) (*CancelScheduledProductPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledProductPrice not implemented")
+}
+func (UnimplementedStoresServiceServer) GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductPriceHistory not implemented")
+}
 func (UnimplementedStoresServiceServer) RemoveProduct(context.Context, *RemoveProductRequest) (*RemoveProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveProduct not implemented")
 }
+func (UnimplementedStoresServiceServer) CategorizeProduct(context.Context, *CategorizeProductRequest) (*CategorizeProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategorizeProduct not implemented")
+}
+func (UnimplementedStoresServiceServer) AddProductVariant(context.Context, *AddProductVariantRequest) (*AddProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductVariant not implemented")
+}
+func (UnimplementedStoresServiceServer) RemoveProductVariant(context.Context, *RemoveProductVariantRequest) (*RemoveProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProductVariant not implemented")
+}
 func (UnimplementedStoresServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
 func (UnimplementedStoresServiceServer) GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCatalog not implemented")
 }
+func (UnimplementedStoresServiceServer) ReceiveInventory(context.Context, *ReceiveInventoryRequest) (*ReceiveInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveInventory not implemented")
+}
+func (UnimplementedStoresServiceServer) AdjustInventory(context.Context, *AdjustInventoryRequest) (*AdjustInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustInventory not implemented")
+}
+func (UnimplementedStoresServiceServer) GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
+}
 func (UnimplementedStoresServiceServer) mustEmbedUnimplementedStoresServiceServer() {}
 
 // UnsafeStoresServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -324,6 +464,24 @@ func _StoresService_RebrandStore_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoresService_SetStoreSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStoreScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).SetStoreSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/SetStoreSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).SetStoreSchedule(ctx, req.(*SetStoreScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoresService_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStoreRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +608,60 @@ func _StoresService_DecreaseProductPrice_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoresService_ScheduleProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleProductPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).ScheduleProductPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/ScheduleProductPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).ScheduleProductPrice(ctx, req.(*ScheduleProductPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_CancelScheduledProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledProductPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).CancelScheduledProductPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/CancelScheduledProductPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).CancelScheduledProductPrice(ctx, req.(*CancelScheduledProductPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_GetProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).GetProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/GetProductPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).GetProductPriceHistory(ctx, req.(*GetProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoresService_RemoveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveProductRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +680,60 @@ func _StoresService_RemoveProduct_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoresService_CategorizeProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategorizeProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).CategorizeProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/CategorizeProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).CategorizeProduct(ctx, req.(*CategorizeProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_AddProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).AddProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/AddProductVariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).AddProductVariant(ctx, req.(*AddProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_RemoveProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).RemoveProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/RemoveProductVariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).RemoveProductVariant(ctx, req.(*RemoveProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoresService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +770,60 @@ func _StoresService_GetCatalog_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoresService_ReceiveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).ReceiveInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/ReceiveInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).ReceiveInventory(ctx, req.(*ReceiveInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_AdjustInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).AdjustInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/AdjustInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).AdjustInventory(ctx, req.(*AdjustInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StoresService_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoresServiceServer).GetInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storespb.StoresService/GetInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoresServiceServer).GetInventory(ctx, req.(*GetInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StoresService_ServiceDesc is the grpc.ServiceDesc for StoresService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -527,6 +847,10 @@ var StoresService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebrandStore",
 			Handler:    _StoresService_RebrandStore_Handler,
 		},
+		{
+			MethodName: "SetStoreSchedule",
+			Handler:    _StoresService_SetStoreSchedule_Handler,
+		},
 		{
 			MethodName: "GetStore",
 			Handler:    _StoresService_GetStore_Handler,
@@ -555,10 +879,34 @@ var StoresService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecreaseProductPrice",
 			Handler:    _StoresService_DecreaseProductPrice_Handler,
 		},
+		{
+			MethodName: "ScheduleProductPrice",
+			Handler:    _StoresService_ScheduleProductPrice_Handler,
+		},
+		{
+			MethodName: "CancelScheduledProductPrice",
+			Handler:    _StoresService_CancelScheduledProductPrice_Handler,
+		},
+		{
+			MethodName: "GetProductPriceHistory",
+			Handler:    _StoresService_GetProductPriceHistory_Handler,
+		},
 		{
 			MethodName: "RemoveProduct",
 			Handler:    _StoresService_RemoveProduct_Handler,
 		},
+		{
+			MethodName: "CategorizeProduct",
+			Handler:    _StoresService_CategorizeProduct_Handler,
+		},
+		{
+			MethodName: "AddProductVariant",
+			Handler:    _StoresService_AddProductVariant_Handler,
+		},
+		{
+			MethodName: "RemoveProductVariant",
+			Handler:    _StoresService_RemoveProductVariant_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _StoresService_GetProduct_Handler,
@@ -567,6 +915,18 @@ var StoresService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCatalog",
 			Handler:    _StoresService_GetCatalog_Handler,
 		},
+		{
+			MethodName: "ReceiveInventory",
+			Handler:    _StoresService_ReceiveInventory_Handler,
+		},
+		{
+			MethodName: "AdjustInventory",
+			Handler:    _StoresService_AdjustInventory_Handler,
+		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _StoresService_GetInventory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "storespb/api.proto",
@@ -0,0 +1,31 @@
+package storespb
+
+import (
+	"eda-in-golang/internal/registry"
+)
+
+const (
+	CommandChannel = "mallbots.stores.commands"
+
+	ReserveStockCommand = "storesapi.ReserveStockCommand"
+	ReleaseStockCommand = "storesapi.ReleaseStockCommand"
+	CommitStockCommand  = "storesapi.CommitStockCommand"
+)
+
+func commandRegistrations(serde registry.Serde) error {
+	if err := serde.Register(&ReserveStock{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&ReleaseStock{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&CommitStock{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (*ReserveStock) Key() string { return ReserveStockCommand }
+func (*ReleaseStock) Key() string { return ReleaseStockCommand }
+func (*CommitStock) Key() string  { return CommitStockCommand }
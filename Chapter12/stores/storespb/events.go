@@ -19,6 +19,12 @@ const (
 	ProductPriceIncreasedEvent = "storesapi.ProductPriceIncreased"
 	ProductPriceDecreasedEvent = "storesapi.ProductPriceDecreased"
 	ProductRemovedEvent        = "storesapi.ProductRemoved"
+	ProductCategorizedEvent    = "storesapi.ProductCategorized"
+	ProductVariantAddedEvent   = "storesapi.ProductVariantAdded"
+	ProductVariantRemovedEvent = "storesapi.ProductVariantRemoved"
+
+	ProductScheduledPriceActivatedEvent = "storesapi.ProductScheduledPriceActivated"
+	ProductScheduledPriceRevertedEvent  = "storesapi.ProductScheduledPriceReverted"
 )
 
 func Registrations(reg registry.Registry) error {
@@ -52,14 +58,32 @@ func RegistrationsWithSerde(serde registry.Serde) error {
 	if err := serde.Register(&ProductRemoved{}); err != nil {
 		return err
 	}
+	if err := serde.Register(&ProductCategorized{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&ProductVariantAdded{}); err != nil {
+		return err
+	}
+	if err := serde.Register(&ProductVariantRemoved{}); err != nil {
+		return err
+	}
+	if err := serde.RegisterKey(ProductScheduledPriceActivatedEvent, &ProductScheduledPriceChanged{}); err != nil {
+		return err
+	}
+	if err := serde.RegisterKey(ProductScheduledPriceRevertedEvent, &ProductScheduledPriceChanged{}); err != nil {
+		return err
+	}
 
-	return nil
+	return commandRegistrations(serde)
 }
 
 func (*StoreCreated) Key() string              { return StoreCreatedEvent }
 func (*StoreParticipationToggled) Key() string { return StoreParticipatingToggledEvent }
 func (*StoreRebranded) Key() string            { return StoreRebrandedEvent }
 
-func (*ProductAdded) Key() string     { return ProductAddedEvent }
-func (*ProductRebranded) Key() string { return ProductRebrandedEvent }
-func (*ProductRemoved) Key() string   { return ProductRemovedEvent }
+func (*ProductAdded) Key() string          { return ProductAddedEvent }
+func (*ProductRebranded) Key() string      { return ProductRebrandedEvent }
+func (*ProductRemoved) Key() string        { return ProductRemovedEvent }
+func (*ProductCategorized) Key() string    { return ProductCategorizedEvent }
+func (*ProductVariantAdded) Key() string   { return ProductVariantAddedEvent }
+func (*ProductVariantRemoved) Key() string { return ProductVariantRemovedEvent }
@@ -45,6 +45,126 @@ func (_m *MockStoresServiceClient) AddProduct(ctx context.Context, in *AddProduc
 	return r0, r1
 }
 
+// AddProductVariant provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) AddProductVariant(ctx context.Context, in *AddProductVariantRequest, opts ...grpc.CallOption) (*AddProductVariantResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *AddProductVariantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AddProductVariantRequest, ...grpc.CallOption) *AddProductVariantResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AddProductVariantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AddProductVariantRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdjustInventory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) AdjustInventory(ctx context.Context, in *AdjustInventoryRequest, opts ...grpc.CallOption) (*AdjustInventoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *AdjustInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AdjustInventoryRequest, ...grpc.CallOption) *AdjustInventoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AdjustInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AdjustInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledProductPrice provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) CancelScheduledProductPrice(ctx context.Context, in *CancelScheduledProductPriceRequest, opts ...grpc.CallOption) (*CancelScheduledProductPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *CancelScheduledProductPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CancelScheduledProductPriceRequest, ...grpc.CallOption) *CancelScheduledProductPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CancelScheduledProductPriceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CancelScheduledProductPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategorizeProduct provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) CategorizeProduct(ctx context.Context, in *CategorizeProductRequest, opts ...grpc.CallOption) (*CategorizeProductResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *CategorizeProductResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CategorizeProductRequest, ...grpc.CallOption) *CategorizeProductResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CategorizeProductResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CategorizeProductRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateStore provides a mock function with given fields: ctx, in, opts
 func (_m *MockStoresServiceClient) CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*CreateStoreResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -195,6 +315,36 @@ func (_m *MockStoresServiceClient) GetCatalog(ctx context.Context, in *GetCatalo
 	return r0, r1
 }
 
+// GetInventory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *GetInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetInventoryRequest, ...grpc.CallOption) *GetInventoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetParticipatingStores provides a mock function with given fields: ctx, in, opts
 func (_m *MockStoresServiceClient) GetParticipatingStores(ctx context.Context, in *GetParticipatingStoresRequest, opts ...grpc.CallOption) (*GetParticipatingStoresResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -255,6 +405,36 @@ func (_m *MockStoresServiceClient) GetProduct(ctx context.Context, in *GetProduc
 	return r0, r1
 }
 
+// GetProductPriceHistory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *GetProductPriceHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetProductPriceHistoryRequest, ...grpc.CallOption) *GetProductPriceHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetProductPriceHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetProductPriceHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStore provides a mock function with given fields: ctx, in, opts
 func (_m *MockStoresServiceClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -405,6 +585,36 @@ func (_m *MockStoresServiceClient) RebrandStore(ctx context.Context, in *Rebrand
 	return r0, r1
 }
 
+// ReceiveInventory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) ReceiveInventory(ctx context.Context, in *ReceiveInventoryRequest, opts ...grpc.CallOption) (*ReceiveInventoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ReceiveInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ReceiveInventoryRequest, ...grpc.CallOption) *ReceiveInventoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ReceiveInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ReceiveInventoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveProduct provides a mock function with given fields: ctx, in, opts
 func (_m *MockStoresServiceClient) RemoveProduct(ctx context.Context, in *RemoveProductRequest, opts ...grpc.CallOption) (*RemoveProductResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -435,6 +645,96 @@ func (_m *MockStoresServiceClient) RemoveProduct(ctx context.Context, in *Remove
 	return r0, r1
 }
 
+// RemoveProductVariant provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) RemoveProductVariant(ctx context.Context, in *RemoveProductVariantRequest, opts ...grpc.CallOption) (*RemoveProductVariantResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *RemoveProductVariantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemoveProductVariantRequest, ...grpc.CallOption) *RemoveProductVariantResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemoveProductVariantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemoveProductVariantRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleProductPrice provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) ScheduleProductPrice(ctx context.Context, in *ScheduleProductPriceRequest, opts ...grpc.CallOption) (*ScheduleProductPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *ScheduleProductPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ScheduleProductPriceRequest, ...grpc.CallOption) *ScheduleProductPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ScheduleProductPriceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ScheduleProductPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetStoreSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *MockStoresServiceClient) SetStoreSchedule(ctx context.Context, in *SetStoreScheduleRequest, opts ...grpc.CallOption) (*SetStoreScheduleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *SetStoreScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *SetStoreScheduleRequest, ...grpc.CallOption) *SetStoreScheduleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SetStoreScheduleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *SetStoreScheduleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMockStoresServiceClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -36,6 +36,98 @@ func (_m *MockStoresServiceServer) AddProduct(_a0 context.Context, _a1 *AddProdu
 	return r0, r1
 }
 
+// AddProductVariant provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) AddProductVariant(_a0 context.Context, _a1 *AddProductVariantRequest) (*AddProductVariantResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *AddProductVariantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AddProductVariantRequest) *AddProductVariantResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AddProductVariantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AddProductVariantRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdjustInventory provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) AdjustInventory(_a0 context.Context, _a1 *AdjustInventoryRequest) (*AdjustInventoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *AdjustInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *AdjustInventoryRequest) *AdjustInventoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AdjustInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *AdjustInventoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelScheduledProductPrice provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) CancelScheduledProductPrice(_a0 context.Context, _a1 *CancelScheduledProductPriceRequest) (*CancelScheduledProductPriceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *CancelScheduledProductPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CancelScheduledProductPriceRequest) *CancelScheduledProductPriceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CancelScheduledProductPriceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CancelScheduledProductPriceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategorizeProduct provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) CategorizeProduct(_a0 context.Context, _a1 *CategorizeProductRequest) (*CategorizeProductResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *CategorizeProductResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CategorizeProductRequest) *CategorizeProductResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CategorizeProductResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CategorizeProductRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateStore provides a mock function with given fields: _a0, _a1
 func (_m *MockStoresServiceServer) CreateStore(_a0 context.Context, _a1 *CreateStoreRequest) (*CreateStoreResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -151,6 +243,29 @@ func (_m *MockStoresServiceServer) GetCatalog(_a0 context.Context, _a1 *GetCatal
 	return r0, r1
 }
 
+// GetInventory provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) GetInventory(_a0 context.Context, _a1 *GetInventoryRequest) (*GetInventoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *GetInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetInventoryRequest) *GetInventoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetInventoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetParticipatingStores provides a mock function with given fields: _a0, _a1
 func (_m *MockStoresServiceServer) GetParticipatingStores(_a0 context.Context, _a1 *GetParticipatingStoresRequest) (*GetParticipatingStoresResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -197,6 +312,29 @@ func (_m *MockStoresServiceServer) GetProduct(_a0 context.Context, _a1 *GetProdu
 	return r0, r1
 }
 
+// GetProductPriceHistory provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) GetProductPriceHistory(_a0 context.Context, _a1 *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *GetProductPriceHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetProductPriceHistoryRequest) *GetProductPriceHistoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetProductPriceHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetProductPriceHistoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStore provides a mock function with given fields: _a0, _a1
 func (_m *MockStoresServiceServer) GetStore(_a0 context.Context, _a1 *GetStoreRequest) (*GetStoreResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -312,6 +450,29 @@ func (_m *MockStoresServiceServer) RebrandStore(_a0 context.Context, _a1 *Rebran
 	return r0, r1
 }
 
+// ReceiveInventory provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) ReceiveInventory(_a0 context.Context, _a1 *ReceiveInventoryRequest) (*ReceiveInventoryResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ReceiveInventoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ReceiveInventoryRequest) *ReceiveInventoryResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ReceiveInventoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ReceiveInventoryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveProduct provides a mock function with given fields: _a0, _a1
 func (_m *MockStoresServiceServer) RemoveProduct(_a0 context.Context, _a1 *RemoveProductRequest) (*RemoveProductResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -335,6 +496,75 @@ func (_m *MockStoresServiceServer) RemoveProduct(_a0 context.Context, _a1 *Remov
 	return r0, r1
 }
 
+// RemoveProductVariant provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) RemoveProductVariant(_a0 context.Context, _a1 *RemoveProductVariantRequest) (*RemoveProductVariantResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RemoveProductVariantResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *RemoveProductVariantRequest) *RemoveProductVariantResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemoveProductVariantResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *RemoveProductVariantRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleProductPrice provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) ScheduleProductPrice(_a0 context.Context, _a1 *ScheduleProductPriceRequest) (*ScheduleProductPriceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *ScheduleProductPriceResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ScheduleProductPriceRequest) *ScheduleProductPriceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ScheduleProductPriceResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ScheduleProductPriceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetStoreSchedule provides a mock function with given fields: _a0, _a1
+func (_m *MockStoresServiceServer) SetStoreSchedule(_a0 context.Context, _a1 *SetStoreScheduleRequest) (*SetStoreScheduleResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *SetStoreScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *SetStoreScheduleRequest) *SetStoreScheduleResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SetStoreScheduleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *SetStoreScheduleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // mustEmbedUnimplementedStoresServiceServer provides a mock function with given fields:
 func (_m *MockStoresServiceServer) mustEmbedUnimplementedStoresServiceServer() {
 	_m.Called()